@@ -6,11 +6,13 @@ package main
 import (
 	"context"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"sync"
 	"time"
 
+	"github.com/linuxfoundation/lfx-v2-query-service/cmd/service"
 	querysvcsvr "github.com/linuxfoundation/lfx-v2-query-service/gen/http/query_svc/server"
 	querysvc "github.com/linuxfoundation/lfx-v2-query-service/gen/query_svc"
 	"github.com/linuxfoundation/lfx-v2-query-service/internal/middleware"
@@ -20,16 +22,16 @@ import (
 )
 
 // handleHTTPServer starts configures and starts a HTTP server on the given
-// URL. It shuts down the server if any error is received in the error channel.
-func handleHTTPServer(ctx context.Context, host string, querySvcEndpoints *querysvc.Endpoints, wg *sync.WaitGroup, errc chan error, dbg bool) {
+// URL. It shuts down the server if any error is received in the error channel,
+// draining in-flight responses for at most the given duration.
+func handleHTTPServer(ctx context.Context, host string, querySvcEndpoints *querysvc.Endpoints, wg *sync.WaitGroup, errc chan error, dbg bool, drain time.Duration) {
 
 	// Provide the transport specific request decoder and response encoder.
-	// The goa http package has built-in support for JSON, XML and gob.
-	// Other encodings can be used by providing the corresponding functions,
-	// see goa.design/implement/encoding.
+	// The goa http package has built-in support for JSON, XML and gob; the
+	// service encoder adds CSV for resource searches.
 	var (
 		dec = goahttp.RequestDecoder
-		enc = goahttp.ResponseEncoder
+		enc = service.ResponseEncoder(service.CSVColumns())
 	)
 
 	// Build the service HTTP request multiplexer and mount debug and profiler
@@ -42,6 +44,9 @@ func handleHTTPServer(ctx context.Context, host string, querySvcEndpoints *query
 			debug.MountPprofHandlers(debug.Adapt(mux))
 			// Mount /debug endpoint to enable or disable debug logs at runtime.
 			debug.MountDebugLogEnabler(debug.Adapt(mux))
+			// Mount /config endpoint to inspect the effective configuration,
+			// with secrets redacted.
+			mux.Handle(http.MethodGet, "/config", service.ConfigHandler())
 		}
 	}
 
@@ -67,6 +72,13 @@ func handleHTTPServer(ctx context.Context, host string, querySvcEndpoints *query
 
 	var handler http.Handler = mux
 
+	// Keep the request URL around for pagination links
+	handler = middleware.RequestURLMiddleware()(handler)
+
+	// Bound the requests in flight, so bursts can't exhaust the backend
+	// connections; health checks are always served
+	handler = middleware.ConcurrencyLimitMiddleware(service.MaxConcurrentRequests(), "/livez", "/readyz")(handler)
+
 	// Add RequestID middleware first
 	handler = middleware.RequestIDMiddleware()(handler)
 
@@ -86,21 +98,32 @@ func handleHTTPServer(ctx context.Context, host string, querySvcEndpoints *query
 		)
 	}
 
+	ln, err := net.Listen("tcp", host)
+	if err != nil {
+		go func() { errc <- err }()
+		return
+	}
+	serveHTTP(ctx, srv, ln, wg, errc, drain)
+}
+
+// serveHTTP serves HTTP requests on the listener until the context is done,
+// then shuts the server down, letting in-flight responses finish within the
+// drain duration rather than cutting them off.
+func serveHTTP(ctx context.Context, srv *http.Server, ln net.Listener, wg *sync.WaitGroup, errc chan error, drain time.Duration) {
 	(*wg).Add(1)
 	go func() {
 		defer (*wg).Done()
 
 		// Start HTTP server in a separate goroutine.
 		go func() {
-			slog.InfoContext(ctx, "HTTP server listening", "host", host)
-			errc <- srv.ListenAndServe()
+			slog.InfoContext(ctx, "HTTP server listening", "host", ln.Addr().String())
+			errc <- srv.Serve(ln)
 		}()
 
 		<-ctx.Done()
-		slog.InfoContext(ctx, "shutting down HTTP server", "host", host)
+		slog.InfoContext(ctx, "shutting down HTTP server", "host", ln.Addr().String(), "drain", drain)
 
-		// Shutdown gracefully with a 30s timeout.
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(context.Background(), drain)
 		defer cancel()
 
 		err := srv.Shutdown(ctx)
@@ -0,0 +1,69 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServeHTTPDrainsInFlightRequests(t *testing.T) {
+	started := make(chan struct{})
+	srv := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			close(started)
+			// The response is still being produced when shutdown starts
+			time.Sleep(200 * time.Millisecond)
+			_, _ = io.WriteString(w, "complete")
+		}),
+		ReadHeaderTimeout: time.Second,
+	}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var wg sync.WaitGroup
+	errc := make(chan error, 1)
+	serveHTTP(ctx, srv, ln, &wg, errc, 5*time.Second)
+
+	type response struct {
+		status int
+		body   string
+		err    error
+	}
+	responses := make(chan response, 1)
+	go func() {
+		resp, err := http.Get("http://" + ln.Addr().String())
+		if err != nil {
+			responses <- response{err: err}
+			return
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		responses <- response{status: resp.StatusCode, body: string(body), err: err}
+	}()
+
+	<-started
+	cancel()
+	wg.Wait()
+
+	result := <-responses
+	require.NoError(t, result.err)
+	assert.Equal(t, http.StatusOK, result.status)
+	assert.Equal(t, "complete", result.body)
+	assert.ErrorIs(t, <-errc, http.ErrServerClosed)
+
+	// New connections are refused once the server is shut down
+	_, err = http.Get("http://" + ln.Addr().String())
+	assert.Error(t, err)
+}
@@ -26,6 +26,9 @@ const (
 	// request timeout, and lower than the pod or liveness probe's
 	// terminationGracePeriodSeconds.
 	gracefulShutdownSeconds = 25
+	// defaultHTTPDrain is the default time in-flight HTTP responses have to
+	// finish on shutdown; it must leave room within gracefulShutdownSeconds.
+	defaultHTTPDrain = 20 * time.Second
 )
 
 func init() {
@@ -40,6 +43,7 @@ func main() {
 		dbgF = flag.Bool("d", false, "enable debug logging")
 		port = flag.String("p", defaultPort, "listen port")
 		bind = flag.String("bind", "*", "interface to bind on")
+		drnF = flag.Duration("drain", defaultHTTPDrain, "time in-flight HTTP responses have to finish on shutdown")
 	)
 	flag.Usage = func() {
 		flag.PrintDefaults()
@@ -52,7 +56,14 @@ func main() {
 		"bind", *bind,
 		"http-port", *port,
 		"graceful-shutdown-seconds", gracefulShutdownSeconds,
+		"http-drain", *drnF,
 	)
+	if *drnF <= 0 || *drnF >= gracefulShutdownSeconds*time.Second {
+		slog.ErrorContext(ctx, "invalid HTTP drain duration, must be positive and below the graceful shutdown budget",
+			"http-drain", *drnF,
+		)
+		os.Exit(2)
+	}
 
 	// Initialize the resource searcher based on configuration
 	resourceSearcher := service.SearcherImpl(ctx)
@@ -94,7 +105,7 @@ func main() {
 		addr = *bind + ":" + *port
 	}
 
-	handleHTTPServer(ctx, addr, querySvcEndpoints, &wg, errc, *dbgF)
+	handleHTTPServer(ctx, addr, querySvcEndpoints, &wg, errc, *dbgF, *drnF)
 
 	// Wait for signal.
 	slog.InfoContext(ctx, "received shutdown signal, stopping servers",
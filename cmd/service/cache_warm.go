@@ -0,0 +1,107 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	querysvc "github.com/linuxfoundation/lfx-v2-query-service/gen/query_svc"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/constants"
+)
+
+// CacheWarmQuery is a common resource search run on behalf of a principal
+// after the cache is flushed, so that its result is cached again before
+// clients ask for it. Its fields are the query-resources parameters.
+type CacheWarmQuery struct {
+	// Principal is the principal the search runs for; results are cached
+	// per principal
+	Principal string `json:"principal"`
+	// Name is the resource name or alias
+	Name string `json:"name,omitempty"`
+	// Type is the resource type
+	Type string `json:"type,omitempty"`
+	// Parent is the parent resource
+	Parent string `json:"parent,omitempty"`
+	// Tags are the tags to search with OR logic
+	Tags []string `json:"tags,omitempty"`
+	// TagsAll are the tags to search with AND logic
+	TagsAll []string `json:"tags_all,omitempty"`
+	// Sort is the sort order, as the sort parameter (e.g. "updated_desc")
+	Sort string `json:"sort,omitempty"`
+	// Profile is the search profile
+	Profile string `json:"profile,omitempty"`
+}
+
+// ParseCacheWarmQueries parses a JSON array of warm-up queries, e.g.
+// [{"principal": "svc-dashboard", "type": "project", "sort": "name_asc"}]
+func ParseCacheWarmQueries(value string) ([]CacheWarmQuery, error) {
+	decoder := json.NewDecoder(strings.NewReader(value))
+	decoder.DisallowUnknownFields()
+
+	var queries []CacheWarmQuery
+	if err := decoder.Decode(&queries); err != nil {
+		return nil, fmt.Errorf("invalid cache warm queries: %w", err)
+	}
+	for idx, query := range queries {
+		// Anonymous results are not cached, so there is nothing to warm
+		if strings.TrimSpace(query.Principal) == "" || query.Principal == constants.AnonymousPrincipal {
+			return nil, fmt.Errorf("invalid cache warm query %d: an authenticated principal is required", idx)
+		}
+		if query.Sort != "" && !slices.Contains(sortValues, query.Sort) {
+			return nil, fmt.Errorf("invalid sort %q of cache warm query %d: must be one of %s", query.Sort, idx, strings.Join(sortValues, ", "))
+		}
+	}
+	return queries, nil
+}
+
+// payload returns the query-resources payload of the warm-up query
+func (q CacheWarmQuery) payload() *querysvc.QueryResourcesPayload {
+	optional := func(value string) *string {
+		if value == "" {
+			return nil
+		}
+		return &value
+	}
+	return &querysvc.QueryResourcesPayload{
+		Version: "1",
+		Name:    optional(q.Name),
+		Type:    optional(q.Type),
+		Parent:  optional(q.Parent),
+		Tags:    q.Tags,
+		TagsAll: q.TagsAll,
+		Sort:    optional(q.Sort),
+		Profile: optional(q.Profile),
+	}
+}
+
+// warmCache runs the warm-up queries, so that their results are cached, and
+// returns how many succeeded. A failed query is logged and skipped.
+func (s *querySvcsrvc) warmCache(ctx context.Context) int {
+	warmed := 0
+	for idx, query := range s.cacheWarmQueries {
+		// The query goes through the same conversion as a request, for its
+		// result to be cached under the same key
+		criteria, err := s.payloadToCriteria(ctx, query.payload())
+		if err != nil {
+			slog.WarnContext(ctx, "invalid cache warm query", "query", idx, "error", err)
+			continue
+		}
+		principal := query.Principal
+		if s.normalizePrincipals {
+			principal = normalizePrincipal(principal)
+		}
+		warmCtx := context.WithValue(ctx, constants.PrincipalContextID, principal)
+		if _, err := s.resourceService.QueryResources(warmCtx, criteria); err != nil {
+			slog.WarnContext(ctx, "cache warm query failed", "query", idx, "error", err)
+			continue
+		}
+		warmed++
+	}
+	return warmed
+}
@@ -0,0 +1,162 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// redacted replaces the value of secret settings in the effective configuration
+const redacted = "[REDACTED]"
+
+// Setting is an environment variable the service is configured with
+type Setting struct {
+	// Name of the environment variable
+	Name string
+	// Default is the value used when the variable is unset, empty when there is none
+	Default string
+	// Secret settings are never exposed, only whether they are set
+	Secret bool
+	// URL settings, a URL or comma-separated URLs, are exposed without the
+	// credentials of their URLs
+	URL bool
+}
+
+// Settings lists every environment variable the service reads, with its
+// default; a new variable must be added here to show in the effective
+// configuration.
+var Settings = []Setting{
+	{Name: "AUTH_SOURCE", Default: "jwt"},
+	{Name: "JWKS_URL", URL: true},
+	{Name: "JWT_AUDIENCE"},
+	{Name: "JWT_AUTH_DISABLED_MOCK_LOCAL_PRINCIPAL"},
+	{Name: "PAGE_TOKEN_SECRET", Secret: true},
+	{Name: "SEARCH_SOURCE", Default: "opensearch"},
+	{Name: "SEARCH_SOURCE_FALLBACK"},
+	{Name: "OPENSEARCH_URL", Default: "http://localhost:9200", URL: true},
+	{Name: "OPENSEARCH_INDEX", Default: "resources"},
+	{Name: "OPENSEARCH_NAME_FIELDS"},
+	{Name: "OPENSEARCH_ID_FIELD", Default: "object_id"},
+	{Name: "OPENSEARCH_TYPE_FIELD", Default: "object_type"},
+	{Name: "OPENSEARCH_LEGACY_RESOURCE_ID", Default: "false"},
+	{Name: "OPENSEARCH_DEFAULT_PUBLIC_TYPES"},
+	{Name: "SEARCH_TEMPLATES_DIR"},
+	{Name: "OPENSEARCH_QUERY_LOG_MAX_BYTES", Default: "4096"},
+	{Name: "OPENSEARCH_HEADERS", Secret: true},
+	{Name: "LOCAL_SEARCH_FILE"},
+	{Name: "ACCESS_CONTROL_SOURCE", Default: "nats"},
+	{Name: "MOCK_LATENCY", Default: "0s"},
+	{Name: "MOCK_ERROR_RATE", Default: "0"},
+	{Name: "NATS_URL", Default: "nats://localhost:4222", URL: true},
+	{Name: "NATS_TIMEOUT", Default: "10s"},
+	{Name: "NATS_MAX_RECONNECT", Default: "3"},
+	{Name: "NATS_RECONNECT_WAIT", Default: "2s"},
+	{Name: "NATS_NO_RESPONDERS_RETRIES", Default: "2"},
+	{Name: "NATS_NO_RESPONDERS_BACKOFF", Default: "100ms"},
+	{Name: "NATS_JETSTREAM", Default: "false"},
+	{Name: "ORG_SEARCH_SOURCE", Default: "clearbit"},
+	{Name: "CLEARBIT_CREDENTIAL", Secret: true},
+	{Name: "CLEARBIT_BASE_URL", Default: "https://company.clearbit.com", URL: true},
+	{Name: "CLEARBIT_AUTOCOMPLETE_BASE_URL", URL: true},
+	{Name: "CLEARBIT_TIMEOUT", Default: "10s"},
+	{Name: "CLEARBIT_MAX_RETRIES", Default: "3"},
+	{Name: "CLEARBIT_RETRY_DELAY", Default: "1s"},
+	{Name: "ORG_SEARCH_TIMEOUT"},
+	{Name: "SUGGEST_MIN_QUERY_LEN"},
+	{Name: "SUGGEST_ALLOW_EMPTY_QUERY", Default: "true"},
+	{Name: "ACCESS_CHECK_ALLOWED_VALUES", Default: "true"},
+	{Name: "ALLOWED_RESOURCE_TYPES"},
+	{Name: "MISSING_ACCESS_METADATA_POLICY"},
+	{Name: "RECENCY_BOOST_SCALE"},
+	{Name: "EXACT_NAME_BOOST", Default: "false"},
+	{Name: "SEARCH_SCOPE"},
+	{Name: "REFRESH_WAIT_PRINCIPALS"},
+	{Name: "REFRESH_WAIT", Default: "1s"},
+	{Name: "EXPLAIN_PRINCIPALS"},
+	{Name: "ACCESS_CHECK_PRINCIPALS"},
+	{Name: "PERMISSION_RELATIONS", Default: "edit"},
+	{Name: "PERMISSION_PRINCIPALS"},
+	{Name: "TOTAL_COUNT_PRINCIPALS"},
+	{Name: "TRACK_TOTAL_HITS"},
+	{Name: "AGGREGATION_BUCKET_LIMIT", Default: "100"},
+	{Name: "PUBLIC_PATH_HEADER", Default: "false"},
+	{Name: "ALLOW_UNFILTERED_SEARCH", Default: "false"},
+	{Name: "ALLOW_EMPTY_CRITERIA", Default: "false"},
+	{Name: "STRICT_ACCESS_CHECK", Default: "false"},
+	{Name: "READINESS_TIMEOUT", Default: "2s"},
+	{Name: "PRINCIPAL_CACHE_TTL"},
+	{Name: "CACHE_ADMIN_PRINCIPALS"},
+	{Name: "CACHE_WARM_QUERIES"},
+	{Name: "MIN_QUERY_LENGTH", Default: "1"},
+	{Name: "NORMALIZE_PRINCIPAL", Default: "true"},
+	{Name: "NORMALIZE_SEARCH_INPUT", Default: "true"},
+	{Name: "CASE_FOLD_SEARCH_INPUT", Default: "false"},
+	{Name: "NORMALIZE_RESOURCE_TYPE", Default: "true"},
+	{Name: "RESOURCE_DATA_MAX_BYTES"},
+	{Name: "INTERNAL_DATA_FIELDS"},
+	{Name: "SEARCH_PROFILES"},
+	{Name: "PAGE_TOKEN_MAX_SORT_VALUES", Default: "4"},
+	{Name: "MAX_OR_TERMS", Default: "50"},
+	{Name: "DEFAULT_PAGE_SIZE_BY_TYPE"},
+	{Name: "DEBUG_RESPONSES", Default: "false"},
+	{Name: "CSV_COLUMNS", Default: "type,id,name"},
+	{Name: "ERROR_VERBOSITY", Default: "verbose"},
+	{Name: "LOG_LEVEL"},
+	{Name: "LOG_ADD_SOURCE"},
+	{Name: "LOG_REDACT_FIELDS"},
+	{Name: "LOG_REDACT_MODE", Default: "hash"},
+	{Name: "MAX_CONCURRENT_REQUESTS"},
+	{Name: "KO_DATA_PATH", Default: "./gen/http"},
+}
+
+// EffectiveConfig returns the value of each setting, or its default when
+// unset. Secret settings are redacted when set and left empty otherwise.
+func EffectiveConfig() map[string]string {
+	config := make(map[string]string, len(Settings))
+	for _, setting := range Settings {
+		value := os.Getenv(setting.Name)
+		switch {
+		case setting.Secret && value != "":
+			value = redacted
+		case value == "":
+			value = setting.Default
+		case setting.URL:
+			value = redactURLs(value)
+		}
+		config[setting.Name] = value
+	}
+	return config
+}
+
+// redactURLs masks the credentials of each of the comma-separated URLs, as
+// url.URL.Redacted does passwords; a value that does not parse is redacted
+// whole, as it may hold them
+func redactURLs(value string) string {
+	urls := strings.Split(value, ",")
+	for i, raw := range urls {
+		parsed, err := url.Parse(strings.TrimSpace(raw))
+		if err != nil {
+			return redacted
+		}
+		if parsed.User != nil {
+			parsed.User = url.User("xxxxx")
+			urls[i] = parsed.String()
+		}
+	}
+	return strings.Join(urls, ",")
+}
+
+// ConfigHandler serves the effective configuration as JSON, for debugging
+func ConfigHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(EffectiveConfig()); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
@@ -5,29 +5,77 @@ package service
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
 	"log/slog"
+	"net/url"
+	"strconv"
+	"strings"
 
 	querysvc "github.com/linuxfoundation/lfx-v2-query-service/gen/query_svc"
 	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/model"
 	"github.com/linuxfoundation/lfx-v2-query-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/errors"
 	"github.com/linuxfoundation/lfx-v2-query-service/pkg/global"
 	"github.com/linuxfoundation/lfx-v2-query-service/pkg/paging"
 )
 
+// defaultPageSize returns the page size of a resource search of the given
+// type, the configured one for the type when set
+func (s *querySvcsrvc) defaultPageSize(resourceType *string) int {
+	if resourceType != nil {
+		if pageSize, ok := s.pageSizeByType[*resourceType]; ok {
+			return pageSize
+		}
+	}
+	return constants.DefaultPageSize
+}
+
 // payloadToCriteria converts the generated payload to domain search criteria
 func (s *querySvcsrvc) payloadToCriteria(ctx context.Context, p *querysvc.QueryResourcesPayload) (model.SearchCriteria, error) {
 
 	criteria := model.SearchCriteria{
-		Name:         p.Name,
-		Parent:       p.Parent,
-		ResourceType: p.Type,
-		Tags:         p.Tags,
-		TagsAll:      p.TagsAll,
-		SortBy:       p.Sort,
-		PageToken:    p.PageToken,
-		PageSize:     constants.DefaultPageSize,
+		Name:               s.inputNormalization.normalizeOptional(p.Name),
+		Parent:             p.Parent,
+		Parents:            p.Parents,
+		ResourceType:       s.inputNormalization.normalizeType(p.Type),
+		CreatedBy:          p.CreatedBy,
+		UpdatedBy:          p.UpdatedBy,
+		TransactionID:      p.TransactionID,
+		NameExact:          s.inputNormalization.normalizeOptional(p.NameExact),
+		ExcludeIDs:         p.ExcludeID,
+		Tags:               p.Tags,
+		TagsMinMatch:       p.TagsMinMatch,
+		TagsAll:            p.TagsAll,
+		PageToken:          p.PageToken,
+		PageSize:           s.defaultPageSize(s.inputNormalization.normalizeType(p.Type)),
+		IncludeScore:       p.IncludeScore,
+		IncludeChildCounts: p.IncludeChildCounts,
+		IncludePermissions: p.IncludePermissions,
+		IncludeAncestry:    p.IncludeAncestry,
+		CollapseBy:         p.CollapseBy,
+		WaitForRefresh:     p.WaitForRefresh,
+		SuggestOnEmpty:     p.SuggestOnEmpty,
+		RecencyBoost:       p.RecencyBoost,
 	}
-	switch p.Sort {
+
+	// The profile settings apply first, for explicit parameters to override them
+	profile, errProfile := s.searchProfile(p.Profile)
+	if errProfile != nil {
+		return criteria, s.wrapError(ctx, errProfile)
+	}
+	profile.apply(&criteria)
+
+	sortValue := defaultSort
+	if p.Sort != nil {
+		sortValue = *p.Sort
+	} else if profile.Sort != "" {
+		sortValue = profile.Sort
+	}
+	criteria.SortBy = sortValue
+	switch sortValue {
 	case "name_asc":
 		criteria.SortBy = "sort_name"
 		criteria.SortOrder = "asc"
@@ -41,12 +89,52 @@ func (s *querySvcsrvc) payloadToCriteria(ctx context.Context, p *querysvc.QueryR
 		criteria.SortBy = "updated_at"
 		criteria.SortOrder = "desc"
 	}
+	if p.SearchScope != nil {
+		criteria.SearchScope = *p.SearchScope
+	}
+	if p.Template != nil {
+		criteria.Template = *p.Template
+		criteria.TemplateParams = make(map[string]string, len(p.TemplateParams))
+		for _, param := range p.TemplateParams {
+			name, value, _ := strings.Cut(param, "=")
+			if _, duplicate := criteria.TemplateParams[name]; duplicate {
+				return criteria, s.wrapError(ctx, errors.NewValidation(fmt.Sprintf("duplicate template parameter %q", name)))
+			}
+			criteria.TemplateParams[name] = value
+		}
+	} else if len(p.TemplateParams) > 0 {
+		return criteria, s.wrapError(ctx, errors.NewValidation("template_params require a template"))
+	}
+
+	// OR groups are bounded, as each alternative is a clause of the query
+	if len(criteria.Tags) > s.orTermsLimit() {
+		return criteria, s.wrapError(ctx, errors.NewValidation(fmt.Sprintf("at most %d tags can be searched at once", s.orTermsLimit())))
+	}
+	parents := len(criteria.Parents)
+	if criteria.Parent != nil {
+		parents++
+	}
+	if parents > s.orTermsLimit() {
+		return criteria, s.wrapError(ctx, errors.NewValidation(fmt.Sprintf("at most %d parents can be searched at once", s.orTermsLimit())))
+	}
+
+	if p.TrackTotalHits != nil {
+		trackTotalHits, errTrackTotalHits := parseTrackTotalHits(*p.TrackTotalHits)
+		if errTrackTotalHits != nil {
+			return criteria, s.wrapError(ctx, errors.NewValidation("track_total_hits must be a boolean or a non-negative integer", errTrackTotalHits))
+		}
+		criteria.TrackTotalHits = &trackTotalHits
+	}
 
 	if criteria.PageToken != nil {
 		pageToken, errPageToken := paging.DecodePageToken(ctx, *criteria.PageToken, global.PageTokenSecret(ctx))
 		if errPageToken != nil {
 			slog.ErrorContext(ctx, "failed to decode page token", "error", errPageToken)
-			return criteria, wrapError(ctx, errPageToken)
+			return criteria, s.wrapError(ctx, errPageToken)
+		}
+		if errSortValues := paging.ValidateSearchAfter(pageToken, s.maxSortValues()); errSortValues != nil {
+			slog.ErrorContext(ctx, "invalid page token sort values", "error", errSortValues)
+			return criteria, s.wrapError(ctx, errSortValues)
 		}
 		criteria.SearchAfter = &pageToken
 		slog.DebugContext(ctx, "decoded page token",
@@ -58,34 +146,116 @@ func (s *querySvcsrvc) payloadToCriteria(ctx context.Context, p *querysvc.QueryR
 	return criteria, nil
 }
 
-// domainResultToResponse converts domain search result to generated response
-func (s *querySvcsrvc) domainResultToResponse(result *model.SearchResult) *querysvc.QueryResourcesResult {
+// parseTrackTotalHits parses a total hit counting setting: either a number of
+// hits counted exactly or a boolean
+func parseTrackTotalHits(value string) (model.TrackTotalHits, error) {
+	if upTo, err := strconv.Atoi(value); err == nil && upTo >= 0 {
+		return model.TrackTotalHits{UpTo: upTo}, nil
+	}
+	exact, err := strconv.ParseBool(value)
+	if err != nil {
+		return model.TrackTotalHits{}, err
+	}
+	return model.TrackTotalHits{Exact: exact}, nil
+}
+
+// dataView returns the view of resource data named by the view parameter,
+// the identity view when none is named
+func (s *querySvcsrvc) dataView(name *string) (DataView, error) {
+	if name == nil || *name == "" || *name == DataViewDefault {
+		return identityView, nil
+	}
+	view, ok := s.dataViews[*name]
+	if !ok {
+		return nil, errors.NewValidation(fmt.Sprintf("unknown view %q", *name))
+	}
+	return view, nil
+}
+
+// domainResultToResponse converts domain search result to generated response,
+// shaping the data of each resource with the given view (nil keeps it as is)
+func (s *querySvcsrvc) domainResultToResponse(result *model.SearchResult, view DataView) *querysvc.QueryResourcesResult {
+	if view == nil {
+		view = identityView
+	}
+
 	response := &querysvc.QueryResourcesResult{
 		Resources:    make([]*querysvc.Resource, len(result.Resources)),
 		PageToken:    result.PageToken,
+		Suggestions:  result.Suggestions,
 		CacheControl: result.CacheControl,
+		PublicPath:   publicPathFlag(result.PublicPath),
+		Degraded:     degradedFlag(result.Degraded),
+	}
+	// Searches that do not count their hits leave the relation empty
+	if result.TotalRelation != "" {
+		total, relation := result.Total, result.TotalRelation
+		response.Total = &total
+		response.TotalRelation = &relation
 	}
 
 	for i, domainResource := range result.Resources {
-		// Create local copies to avoid taking addresses of loop variables
-		resourceType := domainResource.Type
-		resourceID := domainResource.ID
-		response.Resources[i] = &querysvc.Resource{
-			Type: &resourceType,
-			ID:   &resourceID,
-			Data: domainResource.Data,
+		response.Resources[i] = domainResourceToResponse(domainResource)
+		response.Resources[i].Data = limitData(view(domainResource.Data), s.maxDataBytes)
+		if s.debugResponses && domainResource.Index != "" {
+			index := domainResource.Index
+			response.Resources[i].Index = &index
 		}
 	}
 
 	return response
 }
 
+// limitData returns the data of a resource, or a {"_truncated": true} marker
+// when its serialized size exceeds maxBytes; a non-positive limit keeps it
+func limitData(data any, maxBytes int) any {
+	if maxBytes <= 0 || data == nil {
+		return data
+	}
+	encoded, err := json.Marshal(data)
+	if err != nil || len(encoded) <= maxBytes {
+		return data
+	}
+	return map[string]any{"_truncated": true}
+}
+
+// domainResourceToResponse converts a domain resource to a generated resource
+func domainResourceToResponse(domainResource model.Resource) *querysvc.Resource {
+	// Create local copies to avoid taking addresses of the caller's variables
+	resourceType := domainResource.Type
+	resourceID := domainResource.ID
+	response := &querysvc.Resource{
+		Type:            &resourceType,
+		ID:              &resourceID,
+		Data:            domainResource.Data,
+		Score:           domainResource.Score,
+		NormalizedScore: domainResource.NormalizedScore,
+		MatchedTags:     domainResource.MatchedTags,
+		ChildCounts:     domainResource.ChildCounts,
+		Permissions:     domainResource.Permissions,
+		Ancestry:        domainResource.Ancestry,
+	}
+	if domainResource.DocumentID != "" {
+		documentID := domainResource.DocumentID
+		response.DocumentID = &documentID
+	}
+	if domainResource.CreatedBy != "" {
+		createdBy := domainResource.CreatedBy
+		response.CreatedBy = &createdBy
+	}
+	if domainResource.UpdatedBy != "" {
+		updatedBy := domainResource.UpdatedBy
+		response.UpdatedBy = &updatedBy
+	}
+	return response
+}
+
 func (s *querySvcsrvc) payloadToCountPublicCriteria(payload *querysvc.QueryResourcesCountPayload) model.SearchCriteria {
 	// Parameters used for /<index>/_count search.
 	criteria := model.SearchCriteria{
 		GroupBySize: constants.DefaultBucketSize,
 		// Page size is not passed to this endpoint.
-		PageSize: -1,
+		PageSize: model.PageSizeCount,
 		// For _count, we only want public resources.
 		PublicOnly: true,
 	}
@@ -97,7 +267,7 @@ func (s *querySvcsrvc) payloadToCountPublicCriteria(payload *querysvc.QueryResou
 		criteria.Name = payload.Name
 	}
 	if payload.Type != nil {
-		criteria.ResourceType = payload.Type
+		criteria.ResourceType = s.inputNormalization.normalizeType(payload.Type)
 	}
 	if payload.Parent != nil {
 		criteria.ParentRef = payload.Parent
@@ -126,7 +296,7 @@ func (s *querySvcsrvc) payloadToCountAggregationCriteria(payload *querysvc.Query
 		criteria.Name = payload.Name
 	}
 	if payload.Type != nil {
-		criteria.ResourceType = payload.Type
+		criteria.ResourceType = s.inputNormalization.normalizeType(payload.Type)
 	}
 	if payload.Parent != nil {
 		criteria.ParentRef = payload.Parent
@@ -136,18 +306,233 @@ func (s *querySvcsrvc) payloadToCountAggregationCriteria(payload *querysvc.Query
 }
 
 func (s *querySvcsrvc) domainCountResultToResponse(result *model.CountResult) *querysvc.QueryResourcesCountResult {
-	return &querysvc.QueryResourcesCountResult{
+	res := &querysvc.QueryResourcesCountResult{
 		Count:        uint64(result.Count),
+		VisibleCount: uint64(result.VisibleCount),
 		HasMore:      result.HasMore,
 		CacheControl: result.CacheControl,
+		PublicPath:   publicPathFlag(result.PublicPath),
+		Degraded:     degradedFlag(result.Degraded),
+	}
+	if result.TotalCount != nil {
+		total := uint64(*result.TotalCount)
+		res.TotalCount = &total
+	}
+	return res
+}
+
+// degradedFlag returns the X-Degraded header value, which is only sent for
+// results served by the fallback search source
+func degradedFlag(degraded bool) *bool {
+	if !degraded {
+		return nil
+	}
+	return &degraded
+}
+
+// publicPathFlag returns the X-Public-Path header value, which is only sent
+// for results served by the public-only path
+func publicPathFlag(publicPath bool) *bool {
+	if !publicPath {
+		return nil
+	}
+	return &publicPath
+}
+
+// payloadToFacetCriteria converts the facet payload to the domain search criteria
+func (s *querySvcsrvc) payloadToFacetCriteria(p *querysvc.QueryResourcesFacetsPayload) model.SearchCriteria {
+	return model.SearchCriteria{
+		Name:         p.Name,
+		Parent:       p.Parent,
+		ResourceType: s.inputNormalization.normalizeType(p.Type),
+		Tags:         p.Tags,
+		TagsAll:      p.TagsAll,
+		IncludeTotal: p.IncludeTotal,
+		// We only want the aggregations, not the actual results.
+		PageSize: 0,
+	}
+}
+
+// domainFacetResultToResponse converts a domain facet result to the response
+func (s *querySvcsrvc) domainFacetResultToResponse(result *model.FacetResult) *querysvc.QueryResourcesFacetsResult {
+	facets := make(map[string][]*querysvc.FacetValue, len(result.Facets))
+	for field, values := range result.Facets {
+		facetValues := make([]*querysvc.FacetValue, len(values))
+		for idx, value := range values {
+			facetValues[idx] = &querysvc.FacetValue{
+				Value: value.Value,
+				Count: value.Count,
+			}
+		}
+		facets[field] = facetValues
+	}
+	response := &querysvc.QueryResourcesFacetsResult{
+		Facets:       facets,
+		Total:        result.Total,
+		CacheControl: result.CacheControl,
+		PublicPath:   publicPathFlag(result.PublicPath),
+	}
+	if result.TotalRelation != "" {
+		relation := result.TotalRelation
+		response.TotalRelation = &relation
+	}
+	return response
+}
+
+// payloadToExplainCriteria converts the explain payload to the domain search criteria
+func (s *querySvcsrvc) payloadToExplainCriteria(p *querysvc.ExplainResourcePayload) model.SearchCriteria {
+	return model.SearchCriteria{
+		Name:         p.Name,
+		Parent:       p.Parent,
+		ResourceType: s.inputNormalization.normalizeType(p.Type),
+		CreatedBy:    p.CreatedBy,
+		Tags:         p.Tags,
+		TagsAll:      p.TagsAll,
+	}
+}
+
+// domainExplanationToResponse converts a domain resource explanation to the response
+func (s *querySvcsrvc) domainExplanationToResponse(explanation *model.ResourceExplanation) *querysvc.ResourceExplanation {
+	response := &querysvc.ResourceExplanation{
+		ObjectRef:       explanation.ObjectRef,
+		Stage:           string(explanation.Stage),
+		Reason:          explanation.Reason,
+		Found:           explanation.Found,
+		MatchedCriteria: explanation.MatchedCriteria,
+		AccessGranted:   explanation.AccessGranted,
+	}
+	if explanation.AccessCheckQuery != "" {
+		accessCheckQuery := explanation.AccessCheckQuery
+		response.AccessCheckQuery = &accessCheckQuery
+	}
+	return response
+}
+
+// resultETag returns a strong entity tag for the result: a hash of its
+// serialized form, so identical results always get the same tag. Page tokens
+// are sealed with a random nonce, so the sort values they hold are hashed
+// instead of the token and the link built from it.
+func resultETag(ctx context.Context, res *querysvc.QueryResourcesResult) (string, error) {
+	tagged := *res
+	tagged.PageToken, tagged.Link, tagged.Etag, tagged.CacheStatus = nil, nil, nil, nil
+	entity := struct {
+		Result      *querysvc.QueryResourcesResult `json:"result"`
+		SearchAfter *string                        `json:"search_after,omitempty"`
+	}{Result: &tagged}
+	if res.PageToken != nil {
+		searchAfter, err := paging.DecodePageToken(ctx, *res.PageToken, global.PageTokenSecret(ctx))
+		if err != nil {
+			return "", err
+		}
+		entity.SearchAfter = &searchAfter
 	}
+
+	encoded, err := json.Marshal(entity)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(encoded)
+	return strconv.Quote(hex.EncodeToString(hash[:])), nil
+}
+
+// etagMatches reports whether an If-None-Match header value matches the
+// entity tag, using the weak comparison RFC 9110 requires for it
+func etagMatches(ifNoneMatch *string, etag string) bool {
+	if ifNoneMatch == nil {
+		return false
+	}
+	for _, candidate := range strings.Split(*ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// notModifiedResult returns the bodiless 304 Not Modified response for a result
+func notModifiedResult(res *querysvc.QueryResourcesResult) *querysvc.QueryResourcesResult {
+	cacheStatus := constants.CacheStatusNotModified
+	return &querysvc.QueryResourcesResult{
+		Resources:    []*querysvc.Resource{},
+		CacheControl: res.CacheControl,
+		Etag:         res.Etag,
+		CacheStatus:  &cacheStatus,
+	}
+}
+
+// nextPageLink returns the RFC 8288 Link header value pointing at the next
+// page: the request URL, as a relative reference, with its page token
+// replaced. It returns nil on the last page or when the request URL is unknown.
+func nextPageLink(ctx context.Context, pageToken *string) *string {
+	if pageToken == nil || *pageToken == "" {
+		return nil
+	}
+
+	requestURL, ok := ctx.Value(constants.RequestURLContextID).(*url.URL)
+	if !ok || requestURL == nil {
+		return nil
+	}
+
+	query := requestURL.Query()
+	query.Set("page_token", *pageToken)
+	next := url.URL{Path: requestURL.Path, RawQuery: query.Encode()}
+
+	link := fmt.Sprintf("<%s>; rel=\"next\"", next.String())
+	return &link
+}
+
+// payloadToCountBatchCriteria converts each query of the batch payload to the
+// same criteria pair used by the single count endpoint
+func (s *querySvcsrvc) payloadToCountBatchCriteria(p *querysvc.QueryResourcesCountBatchPayload) []model.CountBatchCriteria {
+	batch := make([]model.CountBatchCriteria, 0, len(p.Queries))
+	for _, query := range p.Queries {
+		if query == nil {
+			query = &querysvc.CountQuery{}
+		}
+		countPayload := &querysvc.QueryResourcesCountPayload{
+			Name:    query.Name,
+			Parent:  query.Parent,
+			Type:    query.Type,
+			Tags:    query.Tags,
+			TagsAll: query.TagsAll,
+		}
+		batch = append(batch, model.CountBatchCriteria{
+			Count:       s.payloadToCountPublicCriteria(countPayload),
+			Aggregation: s.payloadToCountAggregationCriteria(countPayload),
+		})
+	}
+	return batch
+}
+
+func (s *querySvcsrvc) domainCountItemsToResponse(ctx context.Context, items []model.CountItem) *querysvc.QueryResourcesCountBatchResult {
+	response := &querysvc.QueryResourcesCountBatchResult{
+		Items: make([]*querysvc.CountItem, len(items)),
+	}
+	for idx, item := range items {
+		if item.Error != nil {
+			response.Items[idx] = &querysvc.CountItem{
+				Error: s.wrapItemError(ctx, item.Error),
+			}
+			continue
+		}
+		count := uint64(item.Result.Count)
+		hasMore := item.Result.HasMore
+		response.Items[idx] = &querysvc.CountItem{
+			Count:   &count,
+			HasMore: &hasMore,
+		}
+	}
+	return response
 }
 
 // payloadToOrganizationCriteria converts the generated payload to domain organization search criteria
 func (s *querySvcsrvc) payloadToOrganizationCriteria(ctx context.Context, p *querysvc.QueryOrgsPayload) model.OrganizationSearchCriteria {
 	criteria := model.OrganizationSearchCriteria{
-		Name:   p.Name,
-		Domain: p.Domain,
+		Name:     s.inputNormalization.normalizeOptional(p.Name),
+		Domain:   p.Domain,
+		Domains:  p.Domains,
+		MatchAll: p.MatchAll,
 	}
 	return criteria
 }
@@ -160,13 +545,89 @@ func (s *querySvcsrvc) domainOrganizationToResponse(org *model.Organization) *qu
 		Industry:  &org.Industry,
 		Sector:    &org.Sector,
 		Employees: &org.Employees,
+		Metadata:  org.Metadata,
+	}
+}
+
+// domainResolvedOrganizationsToResponse lists the resolution of each requested
+// domain, in the request order
+func (s *querySvcsrvc) domainResolvedOrganizationsToResponse(domains []string, resolved map[string]*model.Organization) *querysvc.ResolveOrgsResult {
+	response := &querysvc.ResolveOrgsResult{
+		Organizations: make([]*querysvc.ResolvedOrganization, len(domains)),
+	}
+	for idx, domain := range domains {
+		response.Organizations[idx] = &querysvc.ResolvedOrganization{
+			Domain: domain,
+		}
+		if org := resolved[domain]; org != nil {
+			response.Organizations[idx].Organization = s.domainOrganizationToResponse(org)
+		}
+	}
+	return response
+}
+
+// payloadToOrganizationListCriteria converts the generated payload to domain organization list search criteria
+func (s *querySvcsrvc) payloadToOrganizationListCriteria(ctx context.Context, p *querysvc.QueryOrgsListPayload) (model.OrganizationSearchCriteria, error) {
+	criteria := model.OrganizationSearchCriteria{
+		Name:     s.inputNormalization.normalizeOptional(p.Name),
+		Domain:   p.Domain,
+		MatchAll: p.MatchAll,
+		PageSize: constants.DefaultPageSize,
+	}
+
+	// The page token carries the offset of the next page
+	if p.PageToken != nil {
+		pageToken, errPageToken := paging.DecodePageToken(ctx, *p.PageToken, global.PageTokenSecret(ctx))
+		if errPageToken != nil {
+			slog.ErrorContext(ctx, "failed to decode page token", "error", errPageToken)
+			return criteria, s.wrapError(ctx, errPageToken)
+		}
+		offset, errOffset := strconv.Atoi(pageToken)
+		if errOffset != nil || offset < 0 {
+			slog.ErrorContext(ctx, "invalid page token offset", "decoded", pageToken)
+			return criteria, s.wrapError(ctx, errors.NewValidation("invalid page token"))
+		}
+		criteria.Offset = offset
 	}
+
+	return criteria, nil
+}
+
+// payloadToOrganizationCountCriteria converts the generated payload to domain organization count criteria
+func (s *querySvcsrvc) payloadToOrganizationCountCriteria(ctx context.Context, p *querysvc.CountOrgsPayload) model.OrganizationSearchCriteria {
+	return model.OrganizationSearchCriteria{
+		Name:     s.inputNormalization.normalizeOptional(p.Name),
+		Domain:   p.Domain,
+		MatchAll: p.MatchAll,
+	}
+}
+
+// domainOrganizationsToResponse converts domain organization list result to generated response
+func (s *querySvcsrvc) domainOrganizationsToResponse(ctx context.Context, criteria model.OrganizationSearchCriteria, result *model.OrganizationsResult) (*querysvc.QueryOrgsListResult, error) {
+	response := &querysvc.QueryOrgsListResult{
+		Organizations: make([]*querysvc.Organization, len(result.Organizations)),
+	}
+	for i := range result.Organizations {
+		response.Organizations[i] = s.domainOrganizationToResponse(&result.Organizations[i])
+	}
+
+	nextOffset := criteria.Offset + len(result.Organizations)
+	if len(result.Organizations) > 0 && nextOffset < result.Total {
+		pageToken, errPageToken := paging.EncodePageToken(nextOffset, global.PageTokenSecret(ctx))
+		if errPageToken != nil {
+			slog.ErrorContext(ctx, "failed to encode page token", "error", errPageToken)
+			return nil, s.wrapError(ctx, errPageToken)
+		}
+		response.PageToken = &pageToken
+	}
+
+	return response, nil
 }
 
 // payloadToOrganizationSuggestionCriteria converts the generated payload to domain organization suggestion criteria
 func (s *querySvcsrvc) payloadToOrganizationSuggestionCriteria(ctx context.Context, p *querysvc.SuggestOrgsPayload) model.OrganizationSuggestionCriteria {
 	criteria := model.OrganizationSuggestionCriteria{
-		Query: p.Query,
+		Query: s.inputNormalization.normalize(p.Query),
 	}
 	return criteria
 }
@@ -179,14 +640,62 @@ func (s *querySvcsrvc) domainOrganizationSuggestionsToResponse(result *model.Org
 	suggestions := make([]*querysvc.OrganizationSuggestion, len(result.Suggestions))
 
 	for i, domainSuggestion := range result.Suggestions {
-		suggestions[i] = &querysvc.OrganizationSuggestion{
-			Name:   domainSuggestion.Name,
-			Domain: domainSuggestion.Domain,
-			Logo:   domainSuggestion.Logo,
-		}
+		suggestions[i] = domainOrganizationSuggestionToResponse(domainSuggestion)
 	}
 
 	return &querysvc.SuggestOrgsResult{
 		Suggestions: suggestions,
 	}
 }
+
+// domainOrganizationSuggestionToResponse converts a domain organization suggestion to generated response
+func domainOrganizationSuggestionToResponse(suggestion model.OrganizationSuggestion) *querysvc.OrganizationSuggestion {
+	return &querysvc.OrganizationSuggestion{
+		Kind:   model.SuggestionKindOrganization,
+		Name:   suggestion.Name,
+		Domain: suggestion.Domain,
+		Logo:   suggestion.Logo,
+	}
+}
+
+// domainUnifiedSearchToResponse converts a domain unified search result to generated response
+func (s *querySvcsrvc) domainUnifiedSearchToResponse(result *model.UnifiedSearchResult) *querysvc.UnifiedSearchResult {
+	response := &querysvc.UnifiedSearchResult{
+		Resources:                make([]*querysvc.Resource, len(result.Resources)),
+		Organizations:            make([]*querysvc.OrganizationSuggestion, len(result.Organizations)),
+		OrganizationsUnavailable: result.OrganizationsUnavailable,
+	}
+
+	for i, domainResource := range result.Resources {
+		response.Resources[i] = domainResourceToResponse(domainResource)
+	}
+	for i, domainSuggestion := range result.Organizations {
+		response.Organizations[i] = domainOrganizationSuggestionToResponse(domainSuggestion)
+	}
+
+	return response
+}
+
+// domainSuggestionsToResponse converts domain combined suggestions to generated response
+func (s *querySvcsrvc) domainSuggestionsToResponse(result *model.SuggestionsResult) *querysvc.SuggestResult {
+	response := &querysvc.SuggestResult{
+		Suggestions:              make([]*querysvc.Suggestion, len(result.Suggestions)),
+		OrganizationsUnavailable: result.OrganizationsUnavailable,
+	}
+
+	for i, domainSuggestion := range result.Suggestions {
+		suggestion := &querysvc.Suggestion{
+			Kind: domainSuggestion.Kind,
+			Name: domainSuggestion.Name,
+		}
+		if domainSuggestion.Resource != nil {
+			suggestion.Resource = domainResourceToResponse(*domainSuggestion.Resource)
+		}
+		if domainSuggestion.Organization != nil {
+			suggestion.Organization = domainOrganizationSuggestionToResponse(*domainSuggestion.Organization)
+		}
+		response.Suggestions[i] = suggestion
+	}
+
+	return response
+}
@@ -5,12 +5,18 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
 	"testing"
 
+	querysvcsvr "github.com/linuxfoundation/lfx-v2-query-service/gen/http/query_svc/server"
 	querysvc "github.com/linuxfoundation/lfx-v2-query-service/gen/query_svc"
 	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/model"
 	"github.com/linuxfoundation/lfx-v2-query-service/internal/infrastructure/mock"
 	"github.com/linuxfoundation/lfx-v2-query-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/global"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/paging"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -40,6 +46,8 @@ func TestPayloadToCriteria(t *testing.T) {
 				Tags: []string{"active", "governance"},
 			},
 			expectedCriteria: model.SearchCriteria{
+				SortBy:       "sort_name",
+				SortOrder:    "asc",
 				Name:         stringPtr("test-project"),
 				ResourceType: stringPtr("project"),
 				Tags:         []string{"active", "governance"},
@@ -47,6 +55,62 @@ func TestPayloadToCriteria(t *testing.T) {
 			},
 			expectedError: false,
 		},
+		{
+			name: "payload with creator",
+			payload: &querysvc.QueryResourcesPayload{
+				CreatedBy: stringPtr("user:jdoe"),
+			},
+			expectedCriteria: model.SearchCriteria{
+				SortBy:    "sort_name",
+				SortOrder: "asc",
+				CreatedBy: stringPtr("user:jdoe"),
+				PageSize:  constants.DefaultPageSize,
+			},
+			expectedError: false,
+		},
+		{
+			name: "payload with wait for refresh",
+			payload: &querysvc.QueryResourcesPayload{
+				Type:           stringPtr("project"),
+				WaitForRefresh: true,
+			},
+			expectedCriteria: model.SearchCriteria{
+				SortBy:         "sort_name",
+				SortOrder:      "asc",
+				ResourceType:   stringPtr("project"),
+				WaitForRefresh: true,
+				PageSize:       constants.DefaultPageSize,
+			},
+			expectedError: false,
+		},
+		{
+			name: "payload with transaction",
+			payload: &querysvc.QueryResourcesPayload{
+				TransactionID: stringPtr("txn-1"),
+			},
+			expectedCriteria: model.SearchCriteria{
+				SortBy:        "sort_name",
+				SortOrder:     "asc",
+				TransactionID: stringPtr("txn-1"),
+				PageSize:      constants.DefaultPageSize,
+			},
+			expectedError: false,
+		},
+		{
+			name: "payload with excluded IDs",
+			payload: &querysvc.QueryResourcesPayload{
+				Type:      stringPtr("committee"),
+				ExcludeID: []string{"123", "456"},
+			},
+			expectedCriteria: model.SearchCriteria{
+				SortBy:       "sort_name",
+				SortOrder:    "asc",
+				ResourceType: stringPtr("committee"),
+				ExcludeIDs:   []string{"123", "456"},
+				PageSize:     constants.DefaultPageSize,
+			},
+			expectedError: false,
+		},
 		{
 			name: "payload with parent",
 			payload: &querysvc.QueryResourcesPayload{
@@ -54,9 +118,11 @@ func TestPayloadToCriteria(t *testing.T) {
 				Name:   stringPtr("child-resource"),
 			},
 			expectedCriteria: model.SearchCriteria{
-				Name:     stringPtr("child-resource"),
-				Parent:   stringPtr("parent-id"),
-				PageSize: constants.DefaultPageSize,
+				SortBy:    "sort_name",
+				SortOrder: "asc",
+				Name:      stringPtr("child-resource"),
+				Parent:    stringPtr("parent-id"),
+				PageSize:  constants.DefaultPageSize,
 			},
 			expectedError: false,
 		},
@@ -64,7 +130,7 @@ func TestPayloadToCriteria(t *testing.T) {
 			name: "payload with sorting - name_asc",
 			payload: &querysvc.QueryResourcesPayload{
 				Name: stringPtr("test"),
-				Sort: "name_asc",
+				Sort: stringPtr("name_asc"),
 			},
 			expectedCriteria: model.SearchCriteria{
 				Name:      stringPtr("test"),
@@ -78,7 +144,7 @@ func TestPayloadToCriteria(t *testing.T) {
 			name: "payload with sorting - name_desc",
 			payload: &querysvc.QueryResourcesPayload{
 				Name: stringPtr("test"),
-				Sort: "name_desc",
+				Sort: stringPtr("name_desc"),
 			},
 			expectedCriteria: model.SearchCriteria{
 				Name:      stringPtr("test"),
@@ -92,7 +158,7 @@ func TestPayloadToCriteria(t *testing.T) {
 			name: "payload with sorting - updated_asc",
 			payload: &querysvc.QueryResourcesPayload{
 				Name: stringPtr("test"),
-				Sort: "updated_asc",
+				Sort: stringPtr("updated_asc"),
 			},
 			expectedCriteria: model.SearchCriteria{
 				Name:      stringPtr("test"),
@@ -106,7 +172,7 @@ func TestPayloadToCriteria(t *testing.T) {
 			name: "payload with sorting - updated_desc",
 			payload: &querysvc.QueryResourcesPayload{
 				Name: stringPtr("test"),
-				Sort: "updated_desc",
+				Sort: stringPtr("updated_desc"),
 			},
 			expectedCriteria: model.SearchCriteria{
 				Name:      stringPtr("test"),
@@ -129,10 +195,57 @@ func TestPayloadToCriteria(t *testing.T) {
 			name:    "empty payload",
 			payload: &querysvc.QueryResourcesPayload{},
 			expectedCriteria: model.SearchCriteria{
-				PageSize: constants.DefaultPageSize,
+				SortBy:    "sort_name",
+				SortOrder: "asc",
+				PageSize:  constants.DefaultPageSize,
 			},
 			expectedError: false,
 		},
+		{
+			name: "payload with include score",
+			payload: &querysvc.QueryResourcesPayload{
+				Name:         stringPtr("test"),
+				IncludeScore: true,
+			},
+			expectedCriteria: model.SearchCriteria{
+				SortBy:       "sort_name",
+				SortOrder:    "asc",
+				Name:         stringPtr("test"),
+				IncludeScore: true,
+				PageSize:     constants.DefaultPageSize,
+			},
+			expectedError: false,
+		},
+		{
+			name: "payload with search template",
+			payload: &querysvc.QueryResourcesPayload{
+				Template:       stringPtr("active_committees"),
+				TemplateParams: []string{"status=active", "filter=a=b"},
+			},
+			expectedCriteria: model.SearchCriteria{
+				SortBy:         "sort_name",
+				SortOrder:      "asc",
+				Template:       "active_committees",
+				TemplateParams: map[string]string{"status": "active", "filter": "a=b"},
+				PageSize:       constants.DefaultPageSize,
+			},
+			expectedError: false,
+		},
+		{
+			name: "payload with duplicate template parameter",
+			payload: &querysvc.QueryResourcesPayload{
+				Template:       stringPtr("active_committees"),
+				TemplateParams: []string{"status=active", "status=archived"},
+			},
+			expectedError: true,
+		},
+		{
+			name: "payload with template parameters but no template",
+			payload: &querysvc.QueryResourcesPayload{
+				TemplateParams: []string{"status=active"},
+			},
+			expectedError: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -154,11 +267,36 @@ func TestPayloadToCriteria(t *testing.T) {
 				assert.Equal(t, tc.expectedCriteria.SortBy, result.SortBy)
 				assert.Equal(t, tc.expectedCriteria.SortOrder, result.SortOrder)
 				assert.Equal(t, tc.expectedCriteria.PageSize, result.PageSize)
+				assert.Equal(t, tc.expectedCriteria.IncludeScore, result.IncludeScore)
+				assert.Equal(t, tc.expectedCriteria.Template, result.Template)
+				if tc.expectedCriteria.Template != "" {
+					assert.Equal(t, tc.expectedCriteria.TemplateParams, result.TemplateParams)
+				}
 			}
 		})
 	}
 }
 
+func TestPayloadToCountCriteria(t *testing.T) {
+	service := NewQuerySvc(mock.NewMockResourceSearcher(), mock.NewMockAccessControlChecker(), mock.NewMockOrganizationSearcher(), mock.NewMockAuthService())
+	svc := service.(*querySvcsrvc)
+
+	payload := &querysvc.QueryResourcesCountPayload{
+		Version: "1",
+		Type:    stringPtr("committee"),
+	}
+
+	// The public count keeps the count sentinel, so it returns no resources
+	countCriteria := svc.payloadToCountPublicCriteria(payload)
+	assert.Equal(t, model.PageSizeCount, countCriteria.PageSize)
+	assert.True(t, countCriteria.CountOnly())
+
+	// The aggregation only needs its buckets
+	aggregationCriteria := svc.payloadToCountAggregationCriteria(payload)
+	assert.Equal(t, 0, aggregationCriteria.PageSize)
+	assert.False(t, aggregationCriteria.CountOnly())
+}
+
 func TestDomainResultToResponse(t *testing.T) {
 	// Setup service for testing
 	mockResourceSearcher := mock.NewMockResourceSearcher()
@@ -238,8 +376,9 @@ func TestDomainResultToResponse(t *testing.T) {
 			domainResult: &model.SearchResult{
 				Resources: []model.Resource{
 					{
-						Type: "project",
-						ID:   "single-project",
+						Type:       "project",
+						ID:         "single-project",
+						DocumentID: "project:single-project",
 						Data: map[string]any{
 							"name": "Single Project",
 						},
@@ -250,8 +389,9 @@ func TestDomainResultToResponse(t *testing.T) {
 			expectedResponse: &querysvc.QueryResourcesResult{
 				Resources: []*querysvc.Resource{
 					{
-						Type: stringPtr("project"),
-						ID:   stringPtr("single-project"),
+						Type:       stringPtr("project"),
+						ID:         stringPtr("single-project"),
+						DocumentID: stringPtr("project:single-project"),
 						Data: map[string]any{
 							"name": "Single Project",
 						},
@@ -261,12 +401,64 @@ func TestDomainResultToResponse(t *testing.T) {
 				CacheControl: nil,
 			},
 		},
+		{
+			name: "resource with score and matched tags",
+			domainResult: &model.SearchResult{
+				Resources: []model.Resource{
+					{
+						Type:            "project",
+						ID:              "scored-project",
+						Data:            map[string]any{"name": "Scored Project"},
+						Score:           float64Ptr(3.5),
+						NormalizedScore: float64Ptr(1.0),
+						MatchedTags:     []string{"active"},
+					},
+				},
+				Total: 1,
+			},
+			expectedResponse: &querysvc.QueryResourcesResult{
+				Resources: []*querysvc.Resource{
+					{
+						Type:            stringPtr("project"),
+						ID:              stringPtr("scored-project"),
+						Data:            map[string]any{"name": "Scored Project"},
+						Score:           float64Ptr(3.5),
+						NormalizedScore: float64Ptr(1.0),
+						MatchedTags:     []string{"active"},
+					},
+				},
+			},
+		},
+		{
+			name: "resource with child counts",
+			domainResult: &model.SearchResult{
+				Resources: []model.Resource{
+					{
+						Type:        "project",
+						ID:          "parent-project",
+						Data:        map[string]any{"name": "Parent Project"},
+						ChildCounts: map[string]uint64{"committee": 12},
+					},
+				},
+				Total: 1,
+			},
+			expectedResponse: &querysvc.QueryResourcesResult{
+				Resources: []*querysvc.Resource{
+					{
+						Type:        stringPtr("project"),
+						ID:          stringPtr("parent-project"),
+						Data:        map[string]any{"name": "Parent Project"},
+						ChildCounts: map[string]uint64{"committee": 12},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			// Execute
-			result := svc.domainResultToResponse(tc.domainResult)
+			result := svc.domainResultToResponse(tc.domainResult, nil)
 
 			// Verify
 			assert.NotNil(t, result)
@@ -275,7 +467,12 @@ func TestDomainResultToResponse(t *testing.T) {
 			for i, expectedResource := range tc.expectedResponse.Resources {
 				assert.Equal(t, expectedResource.Type, result.Resources[i].Type)
 				assert.Equal(t, expectedResource.ID, result.Resources[i].ID)
+				assert.Equal(t, expectedResource.DocumentID, result.Resources[i].DocumentID)
 				assert.Equal(t, expectedResource.Data, result.Resources[i].Data)
+				assert.Equal(t, expectedResource.Score, result.Resources[i].Score)
+				assert.Equal(t, expectedResource.NormalizedScore, result.Resources[i].NormalizedScore)
+				assert.Equal(t, expectedResource.MatchedTags, result.Resources[i].MatchedTags)
+				assert.Equal(t, expectedResource.ChildCounts, result.Resources[i].ChildCounts)
 			}
 
 			assert.Equal(t, tc.expectedResponse.PageToken, result.PageToken)
@@ -284,6 +481,168 @@ func TestDomainResultToResponse(t *testing.T) {
 	}
 }
 
+func TestDomainResultToResponseIndex(t *testing.T) {
+	tests := []struct {
+		name          string
+		debug         string
+		expectedIndex *string
+	}{
+		{
+			name:          "debug mode reports the index",
+			debug:         "true",
+			expectedIndex: stringPtr("resources-v2"),
+		},
+		{
+			name: "index is not reported otherwise",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("DEBUG_RESPONSES", tc.debug)
+
+			service := NewQuerySvc(mock.NewMockResourceSearcher(), mock.NewMockAccessControlChecker(), mock.NewMockOrganizationSearcher(), mock.NewMockAuthService())
+			svc := service.(*querySvcsrvc)
+
+			result := svc.domainResultToResponse(&model.SearchResult{
+				Resources: []model.Resource{{Type: "project", ID: "123", Index: "resources-v2"}},
+			}, nil)
+
+			if assert.Len(t, result.Resources, 1) {
+				assert.Equal(t, tc.expectedIndex, result.Resources[0].Index)
+			}
+		})
+	}
+}
+
+func TestDomainResultToResponseAttribution(t *testing.T) {
+	service := NewQuerySvc(mock.NewMockResourceSearcher(), mock.NewMockAccessControlChecker(), mock.NewMockOrganizationSearcher(), mock.NewMockAuthService())
+	svc := service.(*querySvcsrvc)
+
+	result := svc.domainResultToResponse(&model.SearchResult{
+		Resources: []model.Resource{
+			{Type: "project", ID: "123", TransactionBodyStub: model.TransactionBodyStub{CreatedBy: "user:alice", UpdatedBy: "user:bob"}},
+			{Type: "project", ID: "456"},
+		},
+	}, nil)
+
+	if assert.Len(t, result.Resources, 2) {
+		assert.Equal(t, stringPtr("user:alice"), result.Resources[0].CreatedBy)
+		assert.Equal(t, stringPtr("user:bob"), result.Resources[0].UpdatedBy)
+		// Unattributed resources leave the fields out
+		assert.Nil(t, result.Resources[1].CreatedBy)
+		assert.Nil(t, result.Resources[1].UpdatedBy)
+	}
+}
+
+func TestDomainResultToResponseTotal(t *testing.T) {
+	service := NewQuerySvc(mock.NewMockResourceSearcher(), mock.NewMockAccessControlChecker(), mock.NewMockOrganizationSearcher(), mock.NewMockAuthService())
+	svc := service.(*querySvcsrvc)
+
+	tests := []struct {
+		name             string
+		domainResult     *model.SearchResult
+		expectedTotal    *int
+		expectedRelation *string
+	}{
+		{
+			name:             "exact total",
+			domainResult:     &model.SearchResult{Total: 42, TotalRelation: "eq"},
+			expectedTotal:    intPtr(42),
+			expectedRelation: stringPtr("eq"),
+		},
+		{
+			name:             "lower bound",
+			domainResult:     &model.SearchResult{Total: 10000, TotalRelation: "gte"},
+			expectedTotal:    intPtr(10000),
+			expectedRelation: stringPtr("gte"),
+		},
+		{
+			name:         "total not reported",
+			domainResult: &model.SearchResult{Total: 0},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := svc.domainResultToResponse(tc.domainResult, nil)
+			assert.Equal(t, tc.expectedTotal, result.Total)
+			assert.Equal(t, tc.expectedRelation, result.TotalRelation)
+		})
+	}
+}
+
+func TestDomainCountResultToResponse(t *testing.T) {
+	service := NewQuerySvc(mock.NewMockResourceSearcher(), mock.NewMockAccessControlChecker(), mock.NewMockOrganizationSearcher(), mock.NewMockAuthService())
+	svc := service.(*querySvcsrvc)
+
+	total := uint64(10)
+	tests := []struct {
+		name            string
+		domainResult    *model.CountResult
+		expectedVisible uint64
+		expectedTotal   *uint64
+	}{
+		{
+			name:            "visible and total counts",
+			domainResult:    &model.CountResult{Count: 7, VisibleCount: 7, TotalCount: intPtr(10)},
+			expectedVisible: 7,
+			expectedTotal:   &total,
+		},
+		{
+			name:            "total not reported",
+			domainResult:    &model.CountResult{Count: 7, VisibleCount: 7},
+			expectedVisible: 7,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := svc.domainCountResultToResponse(tc.domainResult)
+			assert.Equal(t, uint64(tc.domainResult.Count), result.Count)
+			assert.Equal(t, tc.expectedVisible, result.VisibleCount)
+			assert.Equal(t, tc.expectedTotal, result.TotalCount)
+		})
+	}
+}
+
+func TestDomainResultToResponseDataLimit(t *testing.T) {
+	t.Setenv("RESOURCE_DATA_MAX_BYTES", "64")
+
+	service := NewQuerySvc(mock.NewMockResourceSearcher(), mock.NewMockAccessControlChecker(), mock.NewMockOrganizationSearcher(), mock.NewMockAuthService())
+	svc := service.(*querySvcsrvc)
+
+	tests := []struct {
+		name         string
+		data         map[string]any
+		expectedData any
+	}{
+		{
+			name:         "data within the limit",
+			data:         map[string]any{"name": "Test Project"},
+			expectedData: map[string]any{"name": "Test Project"},
+		},
+		{
+			name:         "data over the limit",
+			data:         map[string]any{"name": "Test Project", "description": strings.Repeat("a", 64)},
+			expectedData: map[string]any{"_truncated": true},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := svc.domainResultToResponse(&model.SearchResult{
+				Resources: []model.Resource{{Type: "project", ID: "test-project-1", Data: tc.data}},
+			}, nil)
+
+			if assert.Len(t, result.Resources, 1) {
+				assert.Equal(t, "test-project-1", *result.Resources[0].ID)
+				assert.Equal(t, tc.expectedData, result.Resources[0].Data)
+			}
+		})
+	}
+}
+
 func TestPayloadToOrganizationCriteria(t *testing.T) {
 	// Setup service for testing
 	mockResourceSearcher := mock.NewMockResourceSearcher()
@@ -327,6 +686,19 @@ func TestPayloadToOrganizationCriteria(t *testing.T) {
 				Domain: stringPtr("linuxfoundation.org"),
 			},
 		},
+		{
+			name: "payload with match all",
+			payload: &querysvc.QueryOrgsPayload{
+				Name:     stringPtr("The Linux Foundation"),
+				Domain:   stringPtr("linuxfoundation.org"),
+				MatchAll: true,
+			},
+			expectedCriteria: model.OrganizationSearchCriteria{
+				Name:     stringPtr("The Linux Foundation"),
+				Domain:   stringPtr("linuxfoundation.org"),
+				MatchAll: true,
+			},
+		},
 		{
 			name:    "empty payload",
 			payload: &querysvc.QueryOrgsPayload{},
@@ -347,6 +719,7 @@ func TestPayloadToOrganizationCriteria(t *testing.T) {
 			// Verify
 			assert.Equal(t, tc.expectedCriteria.Name, result.Name)
 			assert.Equal(t, tc.expectedCriteria.Domain, result.Domain)
+			assert.Equal(t, tc.expectedCriteria.MatchAll, result.MatchAll)
 		})
 	}
 }
@@ -396,6 +769,30 @@ func TestDomainOrganizationToResponse(t *testing.T) {
 				Employees: stringPtr(""),
 			},
 		},
+		{
+			name: "organization with metadata",
+			domainOrg: &model.Organization{
+				Name:   "Metadata Org",
+				Domain: "metadata.org",
+				Metadata: map[string]any{
+					"website":      "https://metadata.org",
+					"founded_year": float64(2000),
+					"headquarters": map[string]any{"city": "San Francisco", "country": "US"},
+				},
+			},
+			expectedResponse: &querysvc.Organization{
+				Name:      stringPtr("Metadata Org"),
+				Domain:    stringPtr("metadata.org"),
+				Industry:  stringPtr(""),
+				Sector:    stringPtr(""),
+				Employees: stringPtr(""),
+				Metadata: map[string]any{
+					"website":      "https://metadata.org",
+					"founded_year": float64(2000),
+					"headquarters": map[string]any{"city": "San Francisco", "country": "US"},
+				},
+			},
+		},
 		{
 			name: "organization with empty fields",
 			domainOrg: &model.Organization{
@@ -427,10 +824,82 @@ func TestDomainOrganizationToResponse(t *testing.T) {
 			assert.Equal(t, tc.expectedResponse.Industry, result.Industry)
 			assert.Equal(t, tc.expectedResponse.Sector, result.Sector)
 			assert.Equal(t, tc.expectedResponse.Employees, result.Employees)
+			assert.Equal(t, tc.expectedResponse.Metadata, result.Metadata)
+
+			// Metadata round-trips through the response body
+			encoded, err := json.Marshal(querysvcsvr.NewQueryOrgsResponseBody(result))
+			assert.NoError(t, err)
+			var decoded querysvc.Organization
+			assert.NoError(t, json.Unmarshal(encoded, &decoded))
+			assert.Equal(t, tc.expectedResponse.Metadata, decoded.Metadata)
 		})
 	}
 }
 
+func TestOrganizationListConversion(t *testing.T) {
+	assertion := assert.New(t)
+	t.Setenv("PAGE_TOKEN_SECRET", "12345678901234567890123456789012") // 32 chars
+
+	mockResourceSearcher := mock.NewMockResourceSearcher()
+	mockAccessChecker := mock.NewMockAccessControlChecker()
+	mockOrgSearcher := mock.NewMockOrganizationSearcher()
+	mockAuth := mock.NewMockAuthService()
+	service := NewQuerySvc(mockResourceSearcher, mockAccessChecker, mockOrgSearcher, mockAuth)
+	svc := service.(*querySvcsrvc)
+	ctx := context.Background()
+
+	// First page, no token
+	criteria, err := svc.payloadToOrganizationListCriteria(ctx, &querysvc.QueryOrgsListPayload{
+		Name:     stringPtr("linux"),
+		MatchAll: true,
+	})
+	assertion.NoError(err)
+	assertion.Equal(stringPtr("linux"), criteria.Name)
+	assertion.True(criteria.MatchAll)
+	assertion.Equal(constants.DefaultPageSize, criteria.PageSize)
+	assertion.Equal(0, criteria.Offset)
+
+	// More results available: a page token to the next offset is returned
+	response, err := svc.domainOrganizationsToResponse(ctx, model.OrganizationSearchCriteria{Offset: 0}, &model.OrganizationsResult{
+		Organizations: []model.Organization{
+			{Name: "Linux Foundation", Domain: "linux.dev"},
+			{Name: "The Linux Foundation", Domain: "linuxfoundation.org"},
+		},
+		Total: 3,
+	})
+	assertion.NoError(err)
+	assertion.Len(response.Organizations, 2)
+	assertion.Equal("Linux Foundation", *response.Organizations[0].Name)
+	if !assertion.NotNil(response.PageToken) {
+		return
+	}
+
+	// The token decodes back to the next offset
+	criteria, err = svc.payloadToOrganizationListCriteria(ctx, &querysvc.QueryOrgsListPayload{
+		Name:      stringPtr("linux"),
+		PageToken: response.PageToken,
+	})
+	assertion.NoError(err)
+	assertion.Equal(2, criteria.Offset)
+
+	// Last page: no page token
+	response, err = svc.domainOrganizationsToResponse(ctx, criteria, &model.OrganizationsResult{
+		Organizations: []model.Organization{{Name: "Openlinux Labs", Domain: "openlinux.example"}},
+		Total:         3,
+	})
+	assertion.NoError(err)
+	assertion.Len(response.Organizations, 1)
+	assertion.Nil(response.PageToken)
+
+	// Invalid token
+	_, err = svc.payloadToOrganizationListCriteria(ctx, &querysvc.QueryOrgsListPayload{
+		Name:      stringPtr("linux"),
+		PageToken: stringPtr("invalid-token"),
+	})
+	var badRequest *querysvc.BadRequestError
+	assertion.ErrorAs(err, &badRequest)
+}
+
 func TestPayloadToOrganizationSuggestionCriteria(t *testing.T) {
 	// Setup service for testing
 	mockResourceSearcher := mock.NewMockResourceSearcher()
@@ -520,11 +989,13 @@ func TestDomainOrganizationSuggestionsToResponse(t *testing.T) {
 			expectedResponse: &querysvc.SuggestOrgsResult{
 				Suggestions: []*querysvc.OrganizationSuggestion{
 					{
+						Kind:   "organization",
 						Name:   "The Linux Foundation",
 						Domain: "linuxfoundation.org",
 						Logo:   stringPtr("https://example.com/logo1.png"),
 					},
 					{
+						Kind:   "organization",
 						Name:   "Linux Kernel Organization",
 						Domain: "kernel.org",
 						Logo:   stringPtr("https://example.com/logo2.png"),
@@ -562,6 +1033,7 @@ func TestDomainOrganizationSuggestionsToResponse(t *testing.T) {
 			expectedResponse: &querysvc.SuggestOrgsResult{
 				Suggestions: []*querysvc.OrganizationSuggestion{
 					{
+						Kind:   "organization",
 						Name:   "Test Org",
 						Domain: "test.org",
 						Logo:   nil,
@@ -582,6 +1054,7 @@ func TestDomainOrganizationSuggestionsToResponse(t *testing.T) {
 			assert.Equal(t, len(tc.expectedResponse.Suggestions), len(result.Suggestions))
 
 			for i, expectedSuggestion := range tc.expectedResponse.Suggestions {
+				assert.Equal(t, expectedSuggestion.Kind, result.Suggestions[i].Kind)
 				assert.Equal(t, expectedSuggestion.Name, result.Suggestions[i].Name)
 				assert.Equal(t, expectedSuggestion.Domain, result.Suggestions[i].Domain)
 				assert.Equal(t, expectedSuggestion.Logo, result.Suggestions[i].Logo)
@@ -590,7 +1063,525 @@ func TestDomainOrganizationSuggestionsToResponse(t *testing.T) {
 	}
 }
 
+func TestETagMatches(t *testing.T) {
+	const etag = `"abc123"`
+
+	tests := []struct {
+		name        string
+		ifNoneMatch *string
+		expected    bool
+	}{
+		{name: "no header", ifNoneMatch: nil, expected: false},
+		{name: "exact match", ifNoneMatch: stringPtr(`"abc123"`), expected: true},
+		{name: "weak match", ifNoneMatch: stringPtr(`W/"abc123"`), expected: true},
+		{name: "match in list", ifNoneMatch: stringPtr(`"other", "abc123"`), expected: true},
+		{name: "wildcard", ifNoneMatch: stringPtr("*"), expected: true},
+		{name: "mismatch", ifNoneMatch: stringPtr(`"other"`), expected: false},
+		{name: "unquoted value", ifNoneMatch: stringPtr("abc123"), expected: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, etagMatches(tc.ifNoneMatch, etag))
+		})
+	}
+}
+
 // Helper function to create string pointers
 func stringPtr(s string) *string {
 	return &s
 }
+
+// Helper function to create float64 pointers
+func float64Ptr(f float64) *float64 {
+	return &f
+}
+
+// Helper function to create bool pointers
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+// Helper function to create int pointers
+func intPtr(i int) *int {
+	return &i
+}
+
+func TestDomainResultToResponseDataView(t *testing.T) {
+	t.Setenv("INTERNAL_DATA_FIELDS", "audit_log, acl")
+
+	service := NewQuerySvc(mock.NewMockResourceSearcher(), mock.NewMockAccessControlChecker(), mock.NewMockOrganizationSearcher(), mock.NewMockAuthService())
+	svc := service.(*querySvcsrvc)
+
+	data := map[string]any{
+		"name":      "Test Project",
+		"_version":  3,
+		"acl":       []any{"user:1"},
+		"audit_log": "internal",
+		"settings": map[string]any{
+			"visibility": "public",
+			"_etag":      "abc",
+		},
+		"members": []any{
+			map[string]any{"name": "Jane", "_source": "ldap"},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		view         *string
+		expectedData any
+		expectedErr  bool
+	}{
+		{
+			name:         "no view keeps the data",
+			expectedData: data,
+		},
+		{
+			name:         "default view keeps the data",
+			view:         stringPtr(DataViewDefault),
+			expectedData: data,
+		},
+		{
+			name: "public view strips underscored and internal fields",
+			view: stringPtr(DataViewPublic),
+			expectedData: map[string]any{
+				"name":     "Test Project",
+				"settings": map[string]any{"visibility": "public"},
+				"members":  []any{map[string]any{"name": "Jane"}},
+			},
+		},
+		{
+			name:        "unknown view",
+			view:        stringPtr("camel"),
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			view, err := svc.dataView(tc.view)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			result := svc.domainResultToResponse(&model.SearchResult{
+				Resources: []model.Resource{{Type: "project", ID: "test-project-1", Data: data}},
+			}, view)
+
+			if assert.Len(t, result.Resources, 1) {
+				assert.Equal(t, tc.expectedData, result.Resources[0].Data)
+			}
+		})
+	}
+}
+
+func TestPayloadToCriteriaPageTokenSortValues(t *testing.T) {
+	t.Setenv("PAGE_TOKEN_SECRET", "12345678901234567890123456789012") // 32 chars
+	t.Setenv("PAGE_TOKEN_MAX_SORT_VALUES", "3")
+	service := NewQuerySvc(mock.NewMockResourceSearcher(), mock.NewMockAccessControlChecker(), mock.NewMockOrganizationSearcher(), mock.NewMockAuthService())
+	svc := service.(*querySvcsrvc)
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		searchAfter   any
+		expectedError bool
+	}{
+		{name: "sort values within the limit", searchAfter: []any{"board", 1700000000, "committee:123"}},
+		{name: "offset", searchAfter: 50},
+		{name: "too many sort values", searchAfter: []any{"a", "b", "c", "d"}, expectedError: true},
+		{name: "nested sort value", searchAfter: []any{"board", map[string]any{"query": "x"}}, expectedError: true},
+		{name: "object", searchAfter: map[string]any{"query": "x"}, expectedError: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pageToken, err := paging.EncodePageToken(tc.searchAfter, global.PageTokenSecret(ctx))
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			criteria, err := svc.payloadToCriteria(ctx, &querysvc.QueryResourcesPayload{PageToken: &pageToken})
+			if tc.expectedError {
+				var badRequest *querysvc.BadRequestError
+				assert.ErrorAs(t, err, &badRequest)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotNil(t, criteria.SearchAfter)
+		})
+	}
+}
+
+func TestPayloadToCriteriaTrackTotalHits(t *testing.T) {
+	service := NewQuerySvc(mock.NewMockResourceSearcher(), mock.NewMockAccessControlChecker(), mock.NewMockOrganizationSearcher(), mock.NewMockAuthService())
+	svc := service.(*querySvcsrvc)
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		value         *string
+		expected      *model.TrackTotalHits
+		expectedError bool
+	}{
+		{name: "deployment setting", value: nil, expected: nil},
+		{name: "exact", value: stringPtr("true"), expected: &model.TrackTotalHits{Exact: true}},
+		{name: "disabled", value: stringPtr("false"), expected: &model.TrackTotalHits{}},
+		{name: "threshold", value: stringPtr("50000"), expected: &model.TrackTotalHits{UpTo: 50000}},
+		{name: "out of range", value: stringPtr("99999999999999999999"), expectedError: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			criteria, err := svc.payloadToCriteria(ctx, &querysvc.QueryResourcesPayload{TrackTotalHits: tc.value})
+			if tc.expectedError {
+				var badRequest *querysvc.BadRequestError
+				assert.ErrorAs(t, err, &badRequest)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, criteria.TrackTotalHits)
+		})
+	}
+}
+
+func TestPayloadToCriteriaPageSizeByType(t *testing.T) {
+	service := NewQuerySvc(mock.NewMockResourceSearcher(), mock.NewMockAccessControlChecker(), mock.NewMockOrganizationSearcher(), mock.NewMockAuthService())
+	svc := service.(*querySvcsrvc)
+	svc.pageSizeByType = map[string]int{"meeting": 200, "project": 10}
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		payload  *querysvc.QueryResourcesPayload
+		expected int
+	}{
+		{name: "configured type", payload: &querysvc.QueryResourcesPayload{Type: stringPtr("meeting")}, expected: 200},
+		{name: "other configured type", payload: &querysvc.QueryResourcesPayload{Type: stringPtr("project")}, expected: 10},
+		{name: "type not configured", payload: &querysvc.QueryResourcesPayload{Type: stringPtr("committee")}, expected: constants.DefaultPageSize},
+		{name: "no type", payload: &querysvc.QueryResourcesPayload{Name: stringPtr("meeting")}, expected: constants.DefaultPageSize},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			criteria, err := svc.payloadToCriteria(ctx, tc.payload)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, criteria.PageSize)
+		})
+	}
+}
+
+func TestPayloadToCriteriaMaxOrTerms(t *testing.T) {
+	service := NewQuerySvc(mock.NewMockResourceSearcher(), mock.NewMockAccessControlChecker(), mock.NewMockOrganizationSearcher(), mock.NewMockAuthService())
+	svc := service.(*querySvcsrvc)
+	svc.maxOrTerms = 3
+	ctx := context.Background()
+
+	terms := func(n int) []string {
+		values := make([]string, n)
+		for i := range values {
+			values[i] = "project:" + strconv.Itoa(i)
+		}
+		return values
+	}
+
+	tests := []struct {
+		name          string
+		payload       *querysvc.QueryResourcesPayload
+		expectedError bool
+	}{
+		{name: "tags at the limit", payload: &querysvc.QueryResourcesPayload{Tags: terms(3)}},
+		{name: "tags beyond the limit", payload: &querysvc.QueryResourcesPayload{Tags: terms(4)}, expectedError: true},
+		{name: "parents at the limit", payload: &querysvc.QueryResourcesPayload{Parents: terms(3)}},
+		{name: "parents beyond the limit", payload: &querysvc.QueryResourcesPayload{Parents: terms(4)}, expectedError: true},
+		{name: "parent and parents at the limit", payload: &querysvc.QueryResourcesPayload{Parent: stringPtr("project:x"), Parents: terms(2)}},
+		{name: "parent and parents beyond the limit", payload: &querysvc.QueryResourcesPayload{Parent: stringPtr("project:x"), Parents: terms(3)}, expectedError: true},
+		{name: "tags_all not bounded", payload: &querysvc.QueryResourcesPayload{TagsAll: terms(4)}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := svc.payloadToCriteria(ctx, tc.payload)
+			if tc.expectedError {
+				var badRequest *querysvc.BadRequestError
+				assert.ErrorAs(t, err, &badRequest)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestPayloadToCriteriaInputNormalization(t *testing.T) {
+	tests := []struct {
+		name         string
+		normalize    string
+		caseFold     string
+		input        *string
+		expectedName *string
+	}{
+		{
+			name:         "surrounding whitespace is trimmed",
+			input:        stringPtr("  linux  "),
+			expectedName: stringPtr("linux"),
+		},
+		{
+			name:         "inner whitespace is collapsed",
+			input:        stringPtr("linux \t  foundation"),
+			expectedName: stringPtr("linux foundation"),
+		},
+		{
+			name:         "all-whitespace name is dropped",
+			input:        stringPtr(" \t "),
+			expectedName: nil,
+		},
+		{
+			name:         "case folding",
+			caseFold:     "true",
+			input:        stringPtr(" Linux  Foundation "),
+			expectedName: stringPtr("linux foundation"),
+		},
+		{
+			name:         "normalization disabled",
+			normalize:    "false",
+			input:        stringPtr("  Linux  "),
+			expectedName: stringPtr("  Linux  "),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("NORMALIZE_SEARCH_INPUT", tc.normalize)
+			t.Setenv("CASE_FOLD_SEARCH_INPUT", tc.caseFold)
+			service := NewQuerySvc(mock.NewMockResourceSearcher(), mock.NewMockAccessControlChecker(), mock.NewMockOrganizationSearcher(), mock.NewMockAuthService())
+			svc := service.(*querySvcsrvc)
+			ctx := context.Background()
+
+			criteria, err := svc.payloadToCriteria(ctx, &querysvc.QueryResourcesPayload{Name: tc.input})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedName, criteria.Name)
+
+			orgCriteria := svc.payloadToOrganizationCriteria(ctx, &querysvc.QueryOrgsPayload{Name: tc.input})
+			assert.Equal(t, tc.expectedName, orgCriteria.Name)
+
+			orgListCriteria, err := svc.payloadToOrganizationListCriteria(ctx, &querysvc.QueryOrgsListPayload{Name: tc.input})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedName, orgListCriteria.Name)
+
+			suggestionCriteria := svc.payloadToOrganizationSuggestionCriteria(ctx, &querysvc.SuggestOrgsPayload{Query: *tc.input})
+			if tc.expectedName == nil {
+				assert.Empty(t, suggestionCriteria.Query)
+			} else {
+				assert.Equal(t, *tc.expectedName, suggestionCriteria.Query)
+			}
+		})
+	}
+}
+
+func TestPayloadToCriteriaResourceTypeNormalization(t *testing.T) {
+	tests := []struct {
+		name          string
+		normalize     string
+		resourceType  *string
+		expectedType  *string
+		expectedMatch bool
+	}{
+		{
+			name:          "capitalized type",
+			resourceType:  stringPtr("Project"),
+			expectedType:  stringPtr("project"),
+			expectedMatch: true,
+		},
+		{
+			name:          "upper-case type",
+			resourceType:  stringPtr("PROJECT"),
+			expectedType:  stringPtr("project"),
+			expectedMatch: true,
+		},
+		{
+			name:          "lower-case type",
+			normalize:     "true",
+			resourceType:  stringPtr("project"),
+			expectedType:  stringPtr("project"),
+			expectedMatch: true,
+		},
+		{
+			name:         "case-sensitive types",
+			normalize:    "false",
+			resourceType: stringPtr("Project"),
+			expectedType: stringPtr("Project"),
+		},
+		{
+			name: "no type",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("NORMALIZE_RESOURCE_TYPE", tc.normalize)
+			searcher := mock.NewMockResourceSearcher()
+			service := NewQuerySvc(searcher, mock.NewMockAccessControlChecker(), mock.NewMockOrganizationSearcher(), mock.NewMockAuthService())
+			svc := service.(*querySvcsrvc)
+			ctx := context.Background()
+
+			criteria, err := svc.payloadToCriteria(ctx, &querysvc.QueryResourcesPayload{Type: tc.resourceType})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedType, criteria.ResourceType)
+
+			countPayload := &querysvc.QueryResourcesCountPayload{Type: tc.resourceType}
+			assert.Equal(t, tc.expectedType, svc.payloadToCountPublicCriteria(countPayload).ResourceType)
+			assert.Equal(t, tc.expectedType, svc.payloadToCountAggregationCriteria(countPayload).ResourceType)
+			assert.Equal(t, tc.expectedType, svc.payloadToFacetCriteria(&querysvc.QueryResourcesFacetsPayload{Type: tc.resourceType}).ResourceType)
+
+			if tc.resourceType != nil {
+				// The indexed types are lower-case
+				result, err := searcher.QueryResources(ctx, criteria)
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedMatch, len(result.Resources) > 0)
+				for _, resource := range result.Resources {
+					assert.Equal(t, "project", resource.Type)
+				}
+			}
+		})
+	}
+}
+
+func TestPayloadToCriteriaSearchProfile(t *testing.T) {
+	t.Setenv("SEARCH_PROFILES", `{
+		"directory": {"sort": "updated_desc", "search_scope": "all", "fuzzy": true, "recency_boost": "30d"},
+		"plain": {}
+	}`)
+	service := NewQuerySvc(mock.NewMockResourceSearcher(), mock.NewMockAccessControlChecker(), mock.NewMockOrganizationSearcher(), mock.NewMockAuthService())
+	svc := service.(*querySvcsrvc)
+
+	tests := []struct {
+		name          string
+		payload       *querysvc.QueryResourcesPayload
+		expected      model.SearchCriteria
+		expectedError bool
+	}{
+		{
+			name:    "profile sets sort, scope, fuzziness and recency boost",
+			payload: &querysvc.QueryResourcesPayload{Name: stringPtr("board"), Profile: stringPtr("directory")},
+			expected: model.SearchCriteria{
+				SortBy:            "updated_at",
+				SortOrder:         "desc",
+				SearchScope:       model.SearchScopeAll,
+				NameFuzzy:         true,
+				RecencyBoostScale: "30d",
+			},
+		},
+		{
+			name: "explicit parameters override the profile",
+			payload: &querysvc.QueryResourcesPayload{
+				Name:        stringPtr("board"),
+				Profile:     stringPtr("directory"),
+				Sort:        stringPtr("name_asc"),
+				SearchScope: stringPtr(model.SearchScopeName),
+			},
+			expected: model.SearchCriteria{
+				SortBy:            "sort_name",
+				SortOrder:         "asc",
+				SearchScope:       model.SearchScopeName,
+				NameFuzzy:         true,
+				RecencyBoostScale: "30d",
+			},
+		},
+		{
+			name:    "empty profile keeps the defaults",
+			payload: &querysvc.QueryResourcesPayload{Name: stringPtr("board"), Profile: stringPtr("plain")},
+			expected: model.SearchCriteria{
+				SortBy:    "sort_name",
+				SortOrder: "asc",
+			},
+		},
+		{
+			name:          "unknown profile",
+			payload:       &querysvc.QueryResourcesPayload{Name: stringPtr("board"), Profile: stringPtr("unknown")},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := svc.payloadToCriteria(context.Background(), tc.payload)
+			if tc.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected.SortBy, result.SortBy)
+			assert.Equal(t, tc.expected.SortOrder, result.SortOrder)
+			assert.Equal(t, tc.expected.SearchScope, result.SearchScope)
+			assert.Equal(t, tc.expected.NameFuzzy, result.NameFuzzy)
+			assert.Equal(t, tc.expected.RecencyBoostScale, result.RecencyBoostScale)
+		})
+	}
+}
+
+func TestParseSearchProfiles(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         string
+		expected      map[string]SearchProfile
+		expectedError bool
+	}{
+		{
+			name:     "valid profiles",
+			value:    `{"directory": {"sort": "name_desc", "fuzzy": true}}`,
+			expected: map[string]SearchProfile{"directory": {Sort: "name_desc", Fuzzy: true}},
+		},
+		{name: "invalid JSON", value: `{"directory":`, expectedError: true},
+		{name: "unknown setting", value: `{"directory": {"boost": 2}}`, expectedError: true},
+		{name: "invalid sort", value: `{"directory": {"sort": "created_asc"}}`, expectedError: true},
+		{name: "invalid search scope", value: `{"directory": {"search_scope": "everything"}}`, expectedError: true},
+		{name: "empty profile name", value: `{"": {}}`, expectedError: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			profiles, err := ParseSearchProfiles(tc.value)
+			if tc.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, profiles)
+		})
+	}
+}
+
+func TestParseCacheWarmQueries(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         string
+		expected      []CacheWarmQuery
+		expectedError bool
+	}{
+		{
+			name:     "valid queries",
+			value:    `[{"principal": "svc-dashboard", "type": "project", "sort": "updated_desc"}]`,
+			expected: []CacheWarmQuery{{Principal: "svc-dashboard", Type: "project", Sort: "updated_desc"}},
+		},
+		{name: "invalid JSON", value: `[{"principal":`, expectedError: true},
+		{name: "unknown parameter", value: `[{"principal": "svc-dashboard", "page_size": 10}]`, expectedError: true},
+		{name: "missing principal", value: `[{"type": "project"}]`, expectedError: true},
+		{name: "anonymous principal", value: `[{"principal": "_anonymous", "type": "project"}]`, expectedError: true},
+		{name: "invalid sort", value: `[{"principal": "svc-dashboard", "sort": "created_asc"}]`, expectedError: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			queries, err := ParseCacheWarmQueries(tc.value)
+			if tc.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, queries)
+		})
+	}
+}
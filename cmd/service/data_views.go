@@ -0,0 +1,63 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import "strings"
+
+// Views of resource data, selected with the view parameter
+const (
+	// DataViewDefault returns the data as indexed
+	DataViewDefault = "default"
+	// DataViewPublic strips fields prefixed with "_" and internal fields
+	DataViewPublic = "public"
+)
+
+// DataView reshapes the data of a resource on output
+type DataView func(data any) any
+
+// identityView returns the data unchanged
+func identityView(data any) any {
+	return data
+}
+
+// publicView returns a view stripping, at any depth, the fields prefixed with
+// "_" and the given internal fields
+func publicView(internalFields []string) DataView {
+	internal := make(map[string]struct{}, len(internalFields))
+	for _, field := range internalFields {
+		internal[field] = struct{}{}
+	}
+
+	var strip func(data any) any
+	strip = func(data any) any {
+		switch value := data.(type) {
+		case map[string]any:
+			stripped := make(map[string]any, len(value))
+			for field, fieldValue := range value {
+				if _, ok := internal[field]; ok || strings.HasPrefix(field, "_") {
+					continue
+				}
+				stripped[field] = strip(fieldValue)
+			}
+			return stripped
+		case []any:
+			stripped := make([]any, len(value))
+			for i, item := range value {
+				stripped[i] = strip(item)
+			}
+			return stripped
+		default:
+			return data
+		}
+	}
+	return strip
+}
+
+// DataViews returns the registered views of resource data, by name
+func DataViews() map[string]DataView {
+	return map[string]DataView{
+		DataViewDefault: identityView,
+		DataViewPublic:  publicView(InternalDataFields()),
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"slices"
+	"strings"
+
+	querysvcsvr "github.com/linuxfoundation/lfx-v2-query-service/gen/http/query_svc/server"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/constants"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// DefaultCSVColumns are the columns of CSV resource search results
+var DefaultCSVColumns = []string{"type", "id", "name"}
+
+// ResponseEncoder returns the response encoder of the service, which adds CSV
+// to the encodings negotiated by goahttp.ResponseEncoder for resource
+// searches. Only the given columns are exported: "type" and "id" are those of
+// the resource, the others are (dot separated paths of) its data fields.
+func ResponseEncoder(columns []string) func(context.Context, http.ResponseWriter) goahttp.Encoder {
+	return func(ctx context.Context, w http.ResponseWriter) goahttp.Encoder {
+		if method, _ := ctx.Value(goa.MethodKey).(string); method != "query-resources" {
+			return goahttp.ResponseEncoder(ctx, w)
+		}
+
+		// The representation depends on the Accept header, which caches
+		// must take into account for the public anonymous results
+		w.Header().Add("Vary", "Accept")
+		accept, _ := ctx.Value(goahttp.AcceptTypeKey).(string)
+		if !acceptsCSV(accept) {
+			return goahttp.ResponseEncoder(ctx, w)
+		}
+		goahttp.SetContentType(w, constants.ContentTypeCSV)
+		return &csvEncoder{writer: csv.NewWriter(w), columns: columns}
+	}
+}
+
+// acceptsCSV reports whether the Accept header asks for CSV
+func acceptsCSV(accept string) bool {
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err == nil && mediaType == constants.ContentTypeCSV {
+			return true
+		}
+	}
+	return false
+}
+
+// csvEncoder writes resources as CSV, one row per resource under a header row
+// of the column names
+type csvEncoder struct {
+	writer  *csv.Writer
+	columns []string
+}
+
+// Encode writes the resources of a search result, or any other body (e.g. an
+// error) as a single row of its top-level fields
+func (e *csvEncoder) Encode(v any) error {
+	body, ok := v.(*querysvcsvr.QueryResourcesOKResponseBody)
+	if !ok {
+		return e.encodeFields(v)
+	}
+
+	records := make([][]string, 0, len(body.Resources)+1)
+	records = append(records, e.columns)
+	for _, resource := range body.Resources {
+		record := make([]string, len(e.columns))
+		for i, column := range e.columns {
+			record[i] = csvColumnValue(resource, column)
+		}
+		records = append(records, record)
+	}
+	return e.writer.WriteAll(records)
+}
+
+// encodeFields writes the top-level fields of a body, sorted by name
+func (e *csvEncoder) encodeFields(v any) error {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(encoded, &fields); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	values := make([]string, len(names))
+	for i, name := range names {
+		values[i] = csvValue(fields[name])
+	}
+	return e.writer.WriteAll([][]string{names, values})
+}
+
+// csvColumnValue returns the value of a column for a resource
+func csvColumnValue(resource *querysvcsvr.ResourceResponseBody, column string) string {
+	switch column {
+	case "type":
+		return csvValue(resource.Type)
+	case "id":
+		return csvValue(resource.ID)
+	}
+
+	value := resource.Data
+	for _, key := range strings.Split(column, ".") {
+		fields, ok := value.(map[string]any)
+		if !ok {
+			return ""
+		}
+		value = fields[key]
+	}
+	return csvValue(value)
+}
+
+// csvValue formats a value for a CSV cell; lists and objects are kept as JSON
+func csvValue(value any) string {
+	switch typed := value.(type) {
+	case nil:
+		return ""
+	case *string:
+		if typed == nil {
+			return ""
+		}
+		return *typed
+	case string:
+		return typed
+	case bool, float64, int, int64, uint64:
+		return fmt.Sprint(typed)
+	default:
+		encoded, err := json.Marshal(typed)
+		if err != nil {
+			return fmt.Sprint(typed)
+		}
+		return string(encoded)
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	querysvcsvr "github.com/linuxfoundation/lfx-v2-query-service/gen/http/query_svc/server"
+	querysvc "github.com/linuxfoundation/lfx-v2-query-service/gen/query_svc"
+	"github.com/stretchr/testify/assert"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+func TestResponseEncoder(t *testing.T) {
+	result := &querysvc.QueryResourcesResult{
+		Resources: []*querysvc.Resource{
+			{
+				Type: stringPtr("project"),
+				ID:   stringPtr("123"),
+				Data: map[string]any{
+					"name":    "Acme, Inc. \"Widgets\"",
+					"slug":    "acme",
+					"stats":   map[string]any{"members": float64(3)},
+					"tags":    []any{"active", "public"},
+					"private": false,
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name                string
+		method              string
+		accept              string
+		columns             []string
+		expectedContentType string
+		expectedBody        string
+		expectedVary        bool
+	}{
+		{
+			name:                "csv with default columns",
+			method:              "query-resources",
+			accept:              "text/csv",
+			columns:             DefaultCSVColumns,
+			expectedContentType: "text/csv",
+			expectedBody:        "type,id,name\nproject,123,\"Acme, Inc. \"\"Widgets\"\"\"\n",
+			expectedVary:        true,
+		},
+		{
+			name:                "csv with nested and list columns",
+			method:              "query-resources",
+			accept:              "application/json;q=0.5, text/csv",
+			columns:             []string{"id", "stats.members", "tags", "private", "missing"},
+			expectedContentType: "text/csv",
+			expectedBody:        "id,stats.members,tags,private,missing\n123,3,\"[\"\"active\"\",\"\"public\"\"]\",false,\n",
+			expectedVary:        true,
+		},
+		{
+			name:                "json by default",
+			method:              "query-resources",
+			accept:              "",
+			columns:             DefaultCSVColumns,
+			expectedContentType: "application/json",
+			expectedVary:        true,
+		},
+		{
+			name:                "csv not negotiated for other methods",
+			method:              "query-orgs",
+			accept:              "text/csv",
+			columns:             DefaultCSVColumns,
+			expectedContentType: "application/json",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			ctx := context.WithValue(context.Background(), goa.MethodKey, tc.method)
+			ctx = context.WithValue(ctx, goahttp.AcceptTypeKey, tc.accept)
+			rec := httptest.NewRecorder()
+
+			encode := querysvcsvr.EncodeQueryResourcesResponse(ResponseEncoder(tc.columns))
+			assertion.NoError(encode(ctx, rec, result))
+
+			assertion.Equal(tc.expectedContentType, rec.Header().Get("Content-Type"))
+			if tc.expectedBody != "" {
+				assertion.Equal(tc.expectedBody, rec.Body.String())
+			} else {
+				assertion.Contains(rec.Body.String(), `"resources":[`)
+			}
+			if tc.expectedVary {
+				assertion.Equal("Accept", rec.Header().Get("Vary"))
+			} else {
+				assertion.Empty(rec.Header().Get("Vary"))
+			}
+		})
+	}
+}
+
+func TestResponseEncoderCSVError(t *testing.T) {
+	assertion := assert.New(t)
+
+	ctx := context.WithValue(context.Background(), goa.MethodKey, "query-resources")
+	ctx = context.WithValue(ctx, goahttp.AcceptTypeKey, "text/csv")
+	rec := httptest.NewRecorder()
+
+	encode := querysvcsvr.EncodeQueryResourcesError(ResponseEncoder(DefaultCSVColumns), nil)
+	assertion.NoError(encode(ctx, rec, &querysvc.BadRequestError{Message: "invalid sort, use name_asc"}))
+
+	assertion.Equal(400, rec.Code)
+	assertion.Equal("text/csv", rec.Header().Get("Content-Type"))
+	assertion.Equal("message\n\"invalid sort, use name_asc\"\n", rec.Body.String())
+}
@@ -5,13 +5,115 @@ package service
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
 	"log/slog"
 
 	querysvc "github.com/linuxfoundation/lfx-v2-query-service/gen/query_svc"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/constants"
 	"github.com/linuxfoundation/lfx-v2-query-service/pkg/errors"
+
+	"github.com/google/uuid"
 )
 
-func wrapError(ctx context.Context, err error) error {
+// correlationID returns the ID matching an error reported to a client with
+// its logs: the request ID when present, a new ID otherwise
+func correlationID(ctx context.Context) string {
+	requestID, ok := ctx.Value(constants.RequestIDHeader).(string)
+	if !ok || requestID == "" {
+		return uuid.New().String()
+	}
+	return requestID
+}
+
+// internalErrorMessage returns the message of an internal error for clients.
+// In safe mode the error is replaced by a generic message with a correlation
+// ID, which is logged along with the actual error.
+func (s *querySvcsrvc) internalErrorMessage(ctx context.Context, err error) string {
+	if s.errorVerbosity != constants.ErrorVerbositySafe {
+		return err.Error()
+	}
+
+	id := correlationID(ctx)
+	slog.ErrorContext(ctx, "internal error hidden from client",
+		"correlation_id", id,
+		"error", err,
+	)
+	return fmt.Sprintf("an internal error occurred (correlation ID: %s)", id)
+}
+
+// operationError annotates the error of a search with the operation that
+// failed and a hash of its criteria, so that failures can be told apart in
+// the logs without logging the criteria, which can hold personal data
+type operationError struct {
+	operation    string
+	criteriaHash string
+	err          error
+}
+
+// Error returns the message of the underlying error, prefixed with the operation
+func (e *operationError) Error() string {
+	return fmt.Sprintf("%s failed: %v", e.operation, e.err)
+}
+
+// Unwrap returns the underlying error
+func (e *operationError) Unwrap() error {
+	return e.err
+}
+
+// withOperation annotates the error of a search with its operation and
+// criteria, to be reported by wrapError
+func withOperation(operation string, criteria any, err error) error {
+	if err == nil {
+		return nil
+	}
+	criteriaHash := ""
+	if encoded, errEncode := json.Marshal(criteria); errEncode == nil {
+		hash := sha256.Sum256(encoded)
+		criteriaHash = hex.EncodeToString(hash[:8])
+	}
+	return &operationError{
+		operation:    operation,
+		criteriaHash: criteriaHash,
+		err:          err,
+	}
+}
+
+// errorClass returns the type of the innermost error of the chain, e.g.
+// *url.Error for a connection failure, to classify backend failures
+func errorClass(err error) string {
+	for {
+		unwrapped := stderrors.Unwrap(err)
+		if unwrapped == nil {
+			return fmt.Sprintf("%T", err)
+		}
+		err = unwrapped
+	}
+}
+
+// operationErrorMessage returns the message of an internal operation error
+// for clients. The failure is logged with the context of the operation and
+// the correlation ID of the message; the backend error is reported only in
+// verbose mode.
+func (s *querySvcsrvc) operationErrorMessage(ctx context.Context, err *operationError) string {
+	id := correlationID(ctx)
+	slog.ErrorContext(ctx, "operation failed",
+		"operation", err.operation,
+		"criteria_hash", err.criteriaHash,
+		"error_class", errorClass(err.err),
+		"correlation_id", id,
+		"error", err.err,
+	)
+	if s.errorVerbosity != constants.ErrorVerbositySafe {
+		return fmt.Sprintf("%s (correlation ID: %s)", err.Error(), id)
+	}
+	return fmt.Sprintf("%s failed (correlation ID: %s)", err.operation, id)
+}
+
+func (s *querySvcsrvc) wrapError(ctx context.Context, err error) error {
 
 	f := func(err error) error {
 		if err == nil {
@@ -20,6 +122,19 @@ func wrapError(ctx context.Context, err error) error {
 			}
 		}
 
+		// Errors already mapped, e.g. by the payload converters, are kept
+		switch err.(type) {
+		case *querysvc.BadRequestError, *querysvc.NotFoundError,
+			*querysvc.ServiceUnavailableError, *querysvc.InternalServerError:
+			return err
+		}
+
+		// Client errors are reported as usual, whatever the operation
+		operation, isOperation := err.(*operationError)
+		if isOperation {
+			err = operation.err
+		}
+
 		switch e := err.(type) {
 		case errors.Validation:
 			return &querysvc.BadRequestError{
@@ -34,8 +149,13 @@ func wrapError(ctx context.Context, err error) error {
 				Message: e.Error(),
 			}
 		default:
+			if isOperation {
+				return &querysvc.InternalServerError{
+					Message: s.operationErrorMessage(ctx, operation),
+				}
+			}
 			return &querysvc.InternalServerError{
-				Message: err.Error(),
+				Message: s.internalErrorMessage(ctx, err),
 			}
 		}
 	}
@@ -45,3 +165,29 @@ func wrapError(ctx context.Context, err error) error {
 	)
 	return f(err)
 }
+
+// wrapItemError maps the error of a single item of a batch to the error
+// reported in that item, using the same codes as the endpoint errors. Unlike
+// wrapError, wrapped domain errors are unwrapped to find their kind.
+func (s *querySvcsrvc) wrapItemError(ctx context.Context, err error) *querysvc.CountItemError {
+
+	slog.WarnContext(ctx, "batch item failed",
+		"error", err,
+	)
+
+	var (
+		validation         errors.Validation
+		notFound           errors.NotFound
+		serviceUnavailable errors.ServiceUnavailable
+	)
+	switch {
+	case stderrors.As(err, &validation):
+		return &querysvc.CountItemError{Code: "BadRequest", Message: err.Error()}
+	case stderrors.As(err, &notFound):
+		return &querysvc.CountItemError{Code: "NotFound", Message: err.Error()}
+	case stderrors.As(err, &serviceUnavailable):
+		return &querysvc.CountItemError{Code: "ServiceUnavailable", Message: err.Error()}
+	default:
+		return &querysvc.CountItemError{Code: "InternalServerError", Message: s.internalErrorMessage(ctx, err)}
+	}
+}
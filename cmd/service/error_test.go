@@ -6,14 +6,19 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
 
 	querysvc "github.com/linuxfoundation/lfx-v2-query-service/gen/query_svc"
+	"github.com/linuxfoundation/lfx-v2-query-service/internal/infrastructure/mock"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/constants"
 	pkgerrors "github.com/linuxfoundation/lfx-v2-query-service/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestWrapError(t *testing.T) {
+	svc := &querySvcsrvc{}
 	tests := []struct {
 		name                 string
 		inputError           error
@@ -86,6 +91,18 @@ func TestWrapError(t *testing.T) {
 			expectedErrorType:    &querysvc.InternalServerError{},
 			expectedErrorMessage: "unexpected client error",
 		},
+		{
+			name:                 "already mapped bad request is kept",
+			inputError:           &querysvc.BadRequestError{Message: "invalid page token"},
+			expectedErrorType:    &querysvc.BadRequestError{},
+			expectedErrorMessage: "invalid page token",
+		},
+		{
+			name:                 "already mapped not found is kept",
+			inputError:           &querysvc.NotFoundError{Message: "resource not found"},
+			expectedErrorType:    &querysvc.NotFoundError{},
+			expectedErrorMessage: "resource not found",
+		},
 	}
 
 	for _, tc := range tests {
@@ -93,7 +110,7 @@ func TestWrapError(t *testing.T) {
 			ctx := context.Background()
 
 			// Execute
-			result := wrapError(ctx, tc.inputError)
+			result := svc.wrapError(ctx, tc.inputError)
 
 			// Verify error type
 			assert.IsType(t, tc.expectedErrorType, result)
@@ -138,35 +155,76 @@ func TestWrapError(t *testing.T) {
 }
 
 func TestWrapError_ErrorMapping(t *testing.T) {
+	svc := &querySvcsrvc{}
 	// Test specific error type mappings
 	ctx := context.Background()
 
 	// Test Validation -> BadRequestError
 	validationErr := pkgerrors.NewValidation("test validation", nil)
-	wrappedErr := wrapError(ctx, validationErr)
+	wrappedErr := svc.wrapError(ctx, validationErr)
 	_, ok := wrappedErr.(*querysvc.BadRequestError)
 	assert.True(t, ok, "Validation error should map to BadRequestError")
 
 	// Test NotFound -> NotFoundError
 	notFoundErr := pkgerrors.NewNotFound("test not found", nil)
-	wrappedErr = wrapError(ctx, notFoundErr)
+	wrappedErr = svc.wrapError(ctx, notFoundErr)
 	_, ok = wrappedErr.(*querysvc.NotFoundError)
 	assert.True(t, ok, "NotFound error should map to NotFoundError")
 
 	// Test ServiceUnavailable -> ServiceUnavailableError
 	serviceUnavailableErr := pkgerrors.NewServiceUnavailable("test service unavailable", nil)
-	wrappedErr = wrapError(ctx, serviceUnavailableErr)
+	wrappedErr = svc.wrapError(ctx, serviceUnavailableErr)
 	_, ok = wrappedErr.(*querysvc.ServiceUnavailableError)
 	assert.True(t, ok, "ServiceUnavailable error should map to ServiceUnavailableError")
 
 	// Test any other error -> InternalServerError
 	genericErr := errors.New("generic error")
-	wrappedErr = wrapError(ctx, genericErr)
+	wrappedErr = svc.wrapError(ctx, genericErr)
 	_, ok = wrappedErr.(*querysvc.InternalServerError)
 	assert.True(t, ok, "Generic error should map to InternalServerError")
 }
 
+func TestWrapItemError(t *testing.T) {
+	svc := &querySvcsrvc{}
+	tests := []struct {
+		name         string
+		inputError   error
+		expectedCode string
+	}{
+		{
+			name:         "validation error",
+			inputError:   pkgerrors.NewValidation("resource type not allowed"),
+			expectedCode: "BadRequest",
+		},
+		{
+			name:         "wrapped not found error",
+			inputError:   fmt.Errorf("search operation failed: %w", pkgerrors.NewNotFound("index not found")),
+			expectedCode: "NotFound",
+		},
+		{
+			name:         "wrapped service unavailable error",
+			inputError:   fmt.Errorf("search operation failed: %w", pkgerrors.NewServiceUnavailable("opensearch unavailable")),
+			expectedCode: "ServiceUnavailable",
+		},
+		{
+			name:         "generic error",
+			inputError:   errors.New("generic error"),
+			expectedCode: "InternalServerError",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			itemErr := svc.wrapItemError(context.Background(), tc.inputError)
+
+			assert.Equal(t, tc.expectedCode, itemErr.Code)
+			assert.Equal(t, tc.inputError.Error(), itemErr.Message)
+		})
+	}
+}
+
 func TestWrapError_PreservesOriginalMessage(t *testing.T) {
+	svc := &querySvcsrvc{}
 	tests := []struct {
 		name              string
 		originalError     error
@@ -193,7 +251,7 @@ func TestWrapError_PreservesOriginalMessage(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			ctx := context.Background()
 
-			wrappedErr := wrapError(ctx, tc.originalError)
+			wrappedErr := svc.wrapError(ctx, tc.originalError)
 			// Check the Message field since Error() returns empty string
 			switch typedErr := wrappedErr.(type) {
 			case *querysvc.BadRequestError:
@@ -212,6 +270,7 @@ func TestWrapError_PreservesOriginalMessage(t *testing.T) {
 }
 
 func TestWrapError_ContextHandling(t *testing.T) {
+	svc := &querySvcsrvc{}
 	// Test that the function works with different context types
 	tests := []struct {
 		name string
@@ -238,7 +297,7 @@ func TestWrapError_ContextHandling(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			// Should not panic or fail with different context types
-			result := wrapError(tc.ctx, tc.err)
+			result := svc.wrapError(tc.ctx, tc.err)
 			assert.NotNil(t, result)
 		})
 	}
@@ -254,15 +313,17 @@ func (e *customError) Error() string {
 }
 
 func TestWrapError_NilHandling(t *testing.T) {
+	svc := &querySvcsrvc{}
 	ctx := context.Background()
 
 	// Test with nil error (should not panic)
-	result := wrapError(ctx, nil)
+	result := svc.wrapError(ctx, nil)
 	assert.NotNil(t, result)
 	assert.IsType(t, &querysvc.InternalServerError{}, result)
 }
 
 func TestWrapError_ComplexErrorChain(t *testing.T) {
+	svc := &querySvcsrvc{}
 	// Test with complex error chains
 	ctx := context.Background()
 
@@ -270,7 +331,7 @@ func TestWrapError_ComplexErrorChain(t *testing.T) {
 	rootErr := errors.New("root cause")
 	middleErr := pkgerrors.NewValidation("middle error", rootErr)
 
-	result := wrapError(ctx, middleErr)
+	result := svc.wrapError(ctx, middleErr)
 
 	assert.IsType(t, &querysvc.BadRequestError{}, result)
 	if badReqErr, ok := result.(*querysvc.BadRequestError); ok {
@@ -280,11 +341,12 @@ func TestWrapError_ComplexErrorChain(t *testing.T) {
 }
 
 func TestWrapError_ErrorTypeAssertions(t *testing.T) {
+	svc := &querySvcsrvc{}
 	ctx := context.Background()
 
 	// Test that we can properly assert the wrapped error types
 	validationErr := pkgerrors.NewValidation("validation error", nil)
-	wrapped := wrapError(ctx, validationErr)
+	wrapped := svc.wrapError(ctx, validationErr)
 
 	if badReqErr, ok := wrapped.(*querysvc.BadRequestError); ok {
 		assert.Equal(t, "validation error", badReqErr.Message)
@@ -296,3 +358,211 @@ func TestWrapError_ErrorTypeAssertions(t *testing.T) {
 	_, ok := wrapped.(*querysvc.NotFoundError)
 	assert.False(t, ok, "Wrong type assertion should fail")
 }
+
+func TestWrapError_ErrorVerbosity(t *testing.T) {
+	requestCtx := context.WithValue(context.Background(), constants.RequestIDHeader, "req-123")
+
+	tests := []struct {
+		name                 string
+		verbosity            string
+		ctx                  context.Context
+		inputError           error
+		expectedErrorMessage string
+		expectedPrefix       string
+	}{
+		{
+			name:                 "verbose mode exposes internal error",
+			verbosity:            constants.ErrorVerbosityVerbose,
+			ctx:                  requestCtx,
+			inputError:           errors.New("opensearch: connection reset by 10.0.0.5:9200"),
+			expectedErrorMessage: "opensearch: connection reset by 10.0.0.5:9200",
+		},
+		{
+			name:                 "safe mode hides internal error behind request ID",
+			verbosity:            constants.ErrorVerbositySafe,
+			ctx:                  requestCtx,
+			inputError:           errors.New("opensearch: connection reset by 10.0.0.5:9200"),
+			expectedErrorMessage: "an internal error occurred (correlation ID: req-123)",
+		},
+		{
+			name:           "safe mode without request ID generates a correlation ID",
+			verbosity:      constants.ErrorVerbositySafe,
+			ctx:            context.Background(),
+			inputError:     errors.New("opensearch: connection reset by 10.0.0.5:9200"),
+			expectedPrefix: "an internal error occurred (correlation ID: ",
+		},
+		{
+			name:                 "safe mode keeps validation message",
+			verbosity:            constants.ErrorVerbositySafe,
+			ctx:                  requestCtx,
+			inputError:           pkgerrors.NewValidation("invalid input"),
+			expectedErrorMessage: "invalid input",
+		},
+		{
+			name:                 "safe mode keeps not found message",
+			verbosity:            constants.ErrorVerbositySafe,
+			ctx:                  requestCtx,
+			inputError:           pkgerrors.NewNotFound("resource not found"),
+			expectedErrorMessage: "resource not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &querySvcsrvc{errorVerbosity: tc.verbosity}
+
+			// Error() returns empty string, so read the Message field
+			var message string
+			switch typedErr := svc.wrapError(tc.ctx, tc.inputError).(type) {
+			case *querysvc.BadRequestError:
+				message = typedErr.Message
+			case *querysvc.NotFoundError:
+				message = typedErr.Message
+			case *querysvc.InternalServerError:
+				message = typedErr.Message
+			default:
+				t.Fatalf("Unexpected error type: %T", typedErr)
+			}
+			itemErr := svc.wrapItemError(tc.ctx, tc.inputError)
+
+			if tc.expectedPrefix != "" {
+				assert.True(t, strings.HasPrefix(message, tc.expectedPrefix), message)
+				assert.True(t, strings.HasPrefix(itemErr.Message, tc.expectedPrefix), itemErr.Message)
+				assert.NotContains(t, message, "10.0.0.5")
+				return
+			}
+			assert.Equal(t, tc.expectedErrorMessage, message)
+			assert.Equal(t, tc.expectedErrorMessage, itemErr.Message)
+		})
+	}
+}
+
+func TestWrapError_ErrorVerbosityPerService(t *testing.T) {
+	newService := func(verbosity string) *querySvcsrvc {
+		t.Setenv("ERROR_VERBOSITY", verbosity)
+		return NewQuerySvc(mock.NewMockResourceSearcher(), mock.NewMockAccessControlChecker(), mock.NewMockOrganizationSearcher(), mock.NewMockAuthService()).(*querySvcsrvc)
+	}
+	safe := newService(constants.ErrorVerbositySafe)
+	verbose := newService(constants.ErrorVerbosityVerbose)
+	internalErr := errors.New("opensearch: connection reset by 10.0.0.5:9200")
+
+	// Creating the verbose service leaves the safe one safe
+	safeErr, ok := safe.wrapError(context.Background(), internalErr).(*querysvc.InternalServerError)
+	if assert.True(t, ok) {
+		assert.NotContains(t, safeErr.Message, "10.0.0.5")
+	}
+	verboseErr, ok := verbose.wrapError(context.Background(), internalErr).(*querysvc.InternalServerError)
+	if assert.True(t, ok) {
+		assert.Equal(t, internalErr.Error(), verboseErr.Message)
+	}
+}
+
+func TestWrapError_OperationError(t *testing.T) {
+	requestCtx := context.WithValue(context.Background(), constants.RequestIDHeader, "req-123")
+	backendError := errors.New("opensearch: [search_phase_execution_exception] all shards failed on index lfx-resources-v2")
+	criteria := map[string]string{"name": "jdoe@example.com"}
+
+	tests := []struct {
+		name                 string
+		ctx                  context.Context
+		verbosity            string
+		inputError           error
+		expectedErrorType    interface{}
+		expectedErrorMessage string
+		expectedPrefix       string
+	}{
+		{
+			name:                 "backend error is replaced by the operation and request ID",
+			ctx:                  requestCtx,
+			verbosity:            constants.ErrorVerbositySafe,
+			inputError:           withOperation("query-resources", criteria, fmt.Errorf("search operation failed: %w", backendError)),
+			expectedErrorType:    &querysvc.InternalServerError{},
+			expectedErrorMessage: "query-resources failed (correlation ID: req-123)",
+		},
+		{
+			name:              "correlation ID is generated without a request ID",
+			ctx:               context.Background(),
+			verbosity:         constants.ErrorVerbositySafe,
+			inputError:        withOperation("query-resources", criteria, backendError),
+			expectedErrorType: &querysvc.InternalServerError{},
+			expectedPrefix:    "query-resources failed (correlation ID: ",
+		},
+		{
+			name:                 "backend error is reported in verbose mode",
+			ctx:                  requestCtx,
+			verbosity:            constants.ErrorVerbosityVerbose,
+			inputError:           withOperation("query-resources", criteria, fmt.Errorf("search operation failed: %w", backendError)),
+			expectedErrorType:    &querysvc.InternalServerError{},
+			expectedErrorMessage: "query-resources failed: search operation failed: " + backendError.Error() + " (correlation ID: req-123)",
+		},
+		{
+			name:                 "client errors are reported as usual",
+			ctx:                  requestCtx,
+			verbosity:            constants.ErrorVerbositySafe,
+			inputError:           withOperation("query-resources", criteria, pkgerrors.NewValidation("invalid page token")),
+			expectedErrorType:    &querysvc.BadRequestError{},
+			expectedErrorMessage: "invalid page token",
+		},
+		{
+			name:                 "unavailable backend is reported as usual",
+			ctx:                  requestCtx,
+			verbosity:            constants.ErrorVerbositySafe,
+			inputError:           withOperation("query-resources", criteria, pkgerrors.NewServiceUnavailable("search unavailable")),
+			expectedErrorType:    &querysvc.ServiceUnavailableError{},
+			expectedErrorMessage: "search unavailable",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &querySvcsrvc{errorVerbosity: tc.verbosity}
+
+			result := svc.wrapError(tc.ctx, tc.inputError)
+			assert.IsType(t, tc.expectedErrorType, result)
+
+			var message string
+			switch typedErr := result.(type) {
+			case *querysvc.BadRequestError:
+				message = typedErr.Message
+			case *querysvc.ServiceUnavailableError:
+				message = typedErr.Message
+			case *querysvc.InternalServerError:
+				message = typedErr.Message
+			default:
+				t.Fatalf("Unexpected error type: %T", typedErr)
+			}
+
+			if tc.verbosity == constants.ErrorVerbositySafe {
+				assert.NotContains(t, message, "search_phase_execution_exception")
+			}
+			assert.NotContains(t, message, "jdoe@example.com")
+			if tc.expectedPrefix != "" {
+				assert.True(t, strings.HasPrefix(message, tc.expectedPrefix), message)
+				return
+			}
+			assert.Equal(t, tc.expectedErrorMessage, message)
+		})
+	}
+}
+
+func TestWithOperation(t *testing.T) {
+	assertion := assert.New(t)
+
+	backendError := &customError{message: "connection refused"}
+	err := withOperation("query-resources", map[string]string{"name": "test"}, fmt.Errorf("search operation failed: %w", backendError))
+
+	var opErr *operationError
+	if assertion.ErrorAs(err, &opErr) {
+		assertion.Equal("query-resources", opErr.operation)
+		assertion.Len(opErr.criteriaHash, 16)
+		assertion.NotContains(opErr.criteriaHash, "test")
+		assertion.Equal("*service.customError", errorClass(opErr.err))
+	}
+	assertion.ErrorIs(err, backendError)
+
+	// The same criteria hash the same, for the logs to group failures
+	again := withOperation("query-resources", map[string]string{"name": "test"}, backendError)
+	assertion.Equal(opErr.criteriaHash, again.(*operationError).criteriaHash)
+
+	assertion.NoError(withOperation("query-resources", nil, nil))
+}
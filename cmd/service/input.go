@@ -0,0 +1,54 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import "strings"
+
+// InputNormalization controls how the free-text search input (resource names
+// and organization queries) is normalized before searching
+type InputNormalization struct {
+	// Whitespace trims the input and collapses inner runs of whitespace to a
+	// single space
+	Whitespace bool
+	// CaseFold lower-cases the input
+	CaseFold bool
+	// TypeCaseFold lower-cases the resource type filter, for clients sending
+	// "Project" or "PROJECT" to match the lower-case indexed types
+	TypeCaseFold bool
+}
+
+// normalize returns the normalized input
+func (n InputNormalization) normalize(value string) string {
+	if n.Whitespace {
+		value = strings.Join(strings.Fields(value), " ")
+	}
+	if n.CaseFold {
+		value = strings.ToLower(value)
+	}
+	return value
+}
+
+// normalizeOptional returns the normalized optional input. An input left
+// empty, such as an all-whitespace one, is dropped, so that the searches
+// validate it like a missing parameter.
+func (n InputNormalization) normalizeOptional(value *string) *string {
+	if value == nil {
+		return nil
+	}
+	normalized := n.normalize(*value)
+	if normalized == "" {
+		return nil
+	}
+	return &normalized
+}
+
+// normalizeType returns the resource type filter, lower-cased unless the
+// deployment uses case-sensitive types
+func (n InputNormalization) normalizeType(value *string) *string {
+	if value == nil || !n.TypeCaseFold {
+		return value
+	}
+	normalized := strings.ToLower(*value)
+	return &normalized
+}
@@ -9,14 +9,20 @@ import (
 	"log/slog"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/model"
 	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/port"
 	"github.com/linuxfoundation/lfx-v2-query-service/internal/infrastructure/auth"
 	"github.com/linuxfoundation/lfx-v2-query-service/internal/infrastructure/clearbit"
+	"github.com/linuxfoundation/lfx-v2-query-service/internal/infrastructure/fallback"
+	"github.com/linuxfoundation/lfx-v2-query-service/internal/infrastructure/local"
 	"github.com/linuxfoundation/lfx-v2-query-service/internal/infrastructure/mock"
 	"github.com/linuxfoundation/lfx-v2-query-service/internal/infrastructure/nats"
 	"github.com/linuxfoundation/lfx-v2-query-service/internal/infrastructure/opensearch"
+	"github.com/linuxfoundation/lfx-v2-query-service/internal/service"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/constants"
 )
 
 // AuthServiceImpl initializes the authentication service implementation
@@ -54,16 +60,60 @@ func AuthServiceImpl(ctx context.Context) port.Authenticator {
 // SearcherImpl injects the resource searcher implementation
 func SearcherImpl(ctx context.Context) port.ResourceSearcher {
 
-	var (
-		resourceSearcher port.ResourceSearcher
-		err              error
-	)
-
 	// Search source implementation configuration
 	searchSource := os.Getenv("SEARCH_SOURCE")
 	if searchSource == "" {
 		searchSource = "opensearch"
 	}
+	resourceSearcher := newResourceSearcher(ctx, searchSource)
+
+	// Search source serving when the primary one fails, e.g. a local replica
+	searchSourceFallback := os.Getenv("SEARCH_SOURCE_FALLBACK")
+	if searchSourceFallback != "" {
+		if searchSourceFallback == searchSource {
+			log.Fatalf("invalid search source fallback %s: must differ from the search source", searchSourceFallback)
+		}
+		slog.InfoContext(ctx, "initializing fallback resource searcher",
+			"source", searchSourceFallback,
+		)
+		resourceSearcher = fallback.NewSearcher(resourceSearcher, newResourceSearcher(ctx, searchSourceFallback))
+	}
+
+	return resourceSearcher
+}
+
+// mockFaults returns the latency and error rate simulated by the mock search
+// and access control sources, e.g. to load-test the service with production
+// timing
+func mockFaults() (time.Duration, float64) {
+	var latency time.Duration
+	if mockLatency := os.Getenv("MOCK_LATENCY"); mockLatency != "" {
+		var err error
+		latency, err = time.ParseDuration(mockLatency)
+		if err != nil || latency < 0 {
+			log.Fatalf("invalid mock latency %s: %v", mockLatency, err)
+		}
+	}
+
+	var errorRate float64
+	if mockErrorRate := os.Getenv("MOCK_ERROR_RATE"); mockErrorRate != "" {
+		var err error
+		errorRate, err = strconv.ParseFloat(mockErrorRate, 64)
+		if err != nil || errorRate < 0 || errorRate > 1 {
+			log.Fatalf("invalid mock error rate %s: must be between 0 and 1", mockErrorRate)
+		}
+	}
+
+	return latency, errorRate
+}
+
+// newResourceSearcher returns the resource searcher of a search source
+func newResourceSearcher(ctx context.Context, searchSource string) port.ResourceSearcher {
+
+	var (
+		resourceSearcher port.ResourceSearcher
+		err              error
+	)
 
 	opensearchURL := os.Getenv("OPENSEARCH_URL")
 	if opensearchURL == "" {
@@ -78,7 +128,12 @@ func SearcherImpl(ctx context.Context) port.ResourceSearcher {
 	switch searchSource {
 	case "mock":
 		slog.InfoContext(ctx, "initializing mock resource searcher")
-		resourceSearcher = mock.NewMockResourceSearcher()
+		mockSearcher := mock.NewMockResourceSearcher()
+		mockSearcher.CaseInsensitiveTypes = SearchInputNormalization().TypeCaseFold
+		latency, errorRate := mockFaults()
+		mockSearcher.SetLatency(latency)
+		mockSearcher.SetErrorRate(errorRate)
+		resourceSearcher = mockSearcher
 
 	case "opensearch":
 		slog.InfoContext(ctx, "initializing opensearch resource searcher",
@@ -88,6 +143,59 @@ func SearcherImpl(ctx context.Context) port.ResourceSearcher {
 		opensearchConfig := opensearch.Config{
 			URL:   opensearchURL,
 			Index: opensearchIndex,
+			// Source fields of the resource identifier and type, for indices
+			// not using the default object_id and object_type fields
+			IDField:   os.Getenv("OPENSEARCH_ID_FIELD"),
+			TypeField: os.Getenv("OPENSEARCH_TYPE_FIELD"),
+		}
+
+		// Resource IDs are the document _id rather than the identifier field
+		opensearchLegacyResourceID := os.Getenv("OPENSEARCH_LEGACY_RESOURCE_ID")
+		if opensearchLegacyResourceID != "" {
+			legacyResourceID, errLegacyResourceID := strconv.ParseBool(opensearchLegacyResourceID)
+			if errLegacyResourceID != nil {
+				log.Fatalf("invalid opensearch legacy resource id value %s: %v", opensearchLegacyResourceID, errLegacyResourceID)
+			}
+			opensearchConfig.LegacyResourceID = legacyResourceID
+		}
+
+		// Resource types considered public when indexed without the public field
+		opensearchDefaultPublicTypes := os.Getenv("OPENSEARCH_DEFAULT_PUBLIC_TYPES")
+		if opensearchDefaultPublicTypes != "" {
+			opensearchConfig.DefaultPublicTypes = strings.Split(opensearchDefaultPublicTypes, ",")
+		}
+
+		// Per-field boosts of the name search, e.g. "name_and_aliases^3,description"
+		opensearchNameFields := os.Getenv("OPENSEARCH_NAME_FIELDS")
+		if opensearchNameFields != "" {
+			nameFields, errNameFields := opensearch.ParseNameFields(opensearchNameFields)
+			if errNameFields != nil {
+				log.Fatalf("invalid opensearch name fields %s: %v", opensearchNameFields, errNameFields)
+			}
+			opensearchConfig.NameFields = nameFields
+		}
+
+		// Size above which rendered queries are truncated in debug logs
+		opensearchQueryLogMaxBytes := os.Getenv("OPENSEARCH_QUERY_LOG_MAX_BYTES")
+		if opensearchQueryLogMaxBytes != "" {
+			queryLogMaxBytes, errQueryLogMaxBytes := strconv.Atoi(opensearchQueryLogMaxBytes)
+			if errQueryLogMaxBytes != nil {
+				log.Fatalf("invalid opensearch query log max bytes value %s: %v", opensearchQueryLogMaxBytes, errQueryLogMaxBytes)
+			}
+			opensearchConfig.QueryLogMaxBytes = queryLogMaxBytes
+		}
+
+		// Directory of the search templates clients invoke by name
+		opensearchConfig.TemplatesDir = os.Getenv("SEARCH_TEMPLATES_DIR")
+
+		// Headers sent with every request, e.g. to identify the service
+		opensearchHeaders := os.Getenv("OPENSEARCH_HEADERS")
+		if opensearchHeaders != "" {
+			headers, errHeaders := opensearch.ParseHeaders(opensearchHeaders)
+			if errHeaders != nil {
+				log.Fatalf("invalid opensearch headers %s: %v", opensearchHeaders, errHeaders)
+			}
+			opensearchConfig.Headers = headers
 		}
 
 		resourceSearcher, err = opensearch.NewSearcher(ctx, opensearchConfig)
@@ -95,6 +203,18 @@ func SearcherImpl(ctx context.Context) port.ResourceSearcher {
 			log.Fatalf("failed to initialize OpenSearch searcher: %v", err)
 		}
 
+	case "local":
+		localSearchFile := os.Getenv("LOCAL_SEARCH_FILE")
+		slog.InfoContext(ctx, "initializing local resource searcher",
+			"path", localSearchFile,
+		)
+		resourceSearcher, err = local.NewSearcher(ctx, local.Config{
+			Path: localSearchFile,
+		})
+		if err != nil {
+			log.Fatalf("failed to initialize local searcher: %v", err)
+		}
+
 	default:
 		log.Fatalf("unsupported search implementation: %s", searchSource)
 	}
@@ -149,19 +269,53 @@ func AccessControlCheckerImpl(ctx context.Context) port.AccessControlChecker {
 		log.Fatalf("invalid NATS reconnect wait duration %s : %v", natsReconnectWait, err)
 	}
 
+	natsNoRespondersRetries := os.Getenv("NATS_NO_RESPONDERS_RETRIES")
+	if natsNoRespondersRetries == "" {
+		natsNoRespondersRetries = "2"
+	}
+	natsNoRespondersRetriesInt, err := strconv.Atoi(natsNoRespondersRetries)
+	if err != nil || natsNoRespondersRetriesInt < 0 {
+		log.Fatalf("invalid NATS no responders retries value %s: %v", natsNoRespondersRetries, err)
+	}
+
+	natsNoRespondersBackoff := os.Getenv("NATS_NO_RESPONDERS_BACKOFF")
+	if natsNoRespondersBackoff == "" {
+		natsNoRespondersBackoff = "100ms"
+	}
+	natsNoRespondersBackoffDuration, err := time.ParseDuration(natsNoRespondersBackoff)
+	if err != nil {
+		log.Fatalf("invalid NATS no responders backoff duration %s : %v", natsNoRespondersBackoff, err)
+	}
+
+	natsJetStream := os.Getenv("NATS_JETSTREAM")
+	if natsJetStream == "" {
+		natsJetStream = "false"
+	}
+	natsJetStreamBool, err := strconv.ParseBool(natsJetStream)
+	if err != nil {
+		log.Fatalf("invalid NATS JetStream value %s: %v", natsJetStream, err)
+	}
+
 	// Initialize the access control checker based on configuration
 	switch accessControlSource {
 	case "mock":
 		slog.InfoContext(ctx, "initializing mock access control checker")
-		accessControlChecker = mock.NewMockAccessControlChecker()
+		mockChecker := mock.NewMockAccessControlChecker()
+		latency, errorRate := mockFaults()
+		mockChecker.SetLatency(latency)
+		mockChecker.SetErrorRate(errorRate)
+		accessControlChecker = mockChecker
 
 	case "nats":
 		slog.InfoContext(ctx, "initializing NATS access control checker")
 		natsConfig := nats.Config{
-			URL:           natsURL,
-			Timeout:       natsTimeoutDuration,
-			MaxReconnect:  natsMaxReconnectInt,
-			ReconnectWait: natsReconnectWaitDuration,
+			URL:                 natsURL,
+			Timeout:             natsTimeoutDuration,
+			MaxReconnect:        natsMaxReconnectInt,
+			ReconnectWait:       natsReconnectWaitDuration,
+			NoRespondersRetries: natsNoRespondersRetriesInt,
+			NoRespondersBackoff: natsNoRespondersBackoffDuration,
+			JetStream:           natsJetStreamBool,
 		}
 
 		accessControlChecker, err = nats.NewAccessControlChecker(ctx, natsConfig)
@@ -242,3 +396,466 @@ func OrganizationSearcherImpl(ctx context.Context) port.OrganizationSearcher {
 
 	return organizationSearcher
 }
+
+// CSVColumns returns the columns of CSV resource search results
+func CSVColumns() []string {
+	csvColumns := os.Getenv("CSV_COLUMNS")
+	if csvColumns == "" {
+		return DefaultCSVColumns
+	}
+	var columns []string
+	for _, column := range strings.Split(csvColumns, ",") {
+		if column = strings.TrimSpace(column); column != "" {
+			columns = append(columns, column)
+		}
+	}
+	if len(columns) == 0 {
+		log.Fatalf("invalid CSV columns %s: at least one column is required", csvColumns)
+	}
+	return columns
+}
+
+// NormalizePrincipals returns whether principals are normalized to their
+// canonical form when they enter the request context
+func NormalizePrincipals() bool {
+	normalizePrincipal := os.Getenv("NORMALIZE_PRINCIPAL")
+	if normalizePrincipal == "" {
+		return true
+	}
+	normalizePrincipalBool, err := strconv.ParseBool(normalizePrincipal)
+	if err != nil {
+		log.Fatalf("invalid normalize principal value %s: %v", normalizePrincipal, err)
+	}
+	return normalizePrincipalBool
+}
+
+// SearchInputNormalization returns how the free-text search input is
+// normalized: whitespace is trimmed and collapsed unless disabled, and the
+// input is only lower-cased on request. Resource types are lower-cased unless
+// disabled.
+func SearchInputNormalization() InputNormalization {
+	normalization := InputNormalization{Whitespace: true, TypeCaseFold: true}
+
+	if normalizeInput := os.Getenv("NORMALIZE_SEARCH_INPUT"); normalizeInput != "" {
+		normalizeInputBool, err := strconv.ParseBool(normalizeInput)
+		if err != nil {
+			log.Fatalf("invalid normalize search input value %s: %v", normalizeInput, err)
+		}
+		normalization.Whitespace = normalizeInputBool
+	}
+
+	if caseFoldInput := os.Getenv("CASE_FOLD_SEARCH_INPUT"); caseFoldInput != "" {
+		caseFoldInputBool, err := strconv.ParseBool(caseFoldInput)
+		if err != nil {
+			log.Fatalf("invalid case fold search input value %s: %v", caseFoldInput, err)
+		}
+		normalization.CaseFold = caseFoldInputBool
+	}
+
+	if normalizeType := os.Getenv("NORMALIZE_RESOURCE_TYPE"); normalizeType != "" {
+		normalizeTypeBool, err := strconv.ParseBool(normalizeType)
+		if err != nil {
+			log.Fatalf("invalid normalize resource type value %s: %v", normalizeType, err)
+		}
+		normalization.TypeCaseFold = normalizeTypeBool
+	}
+
+	return normalization
+}
+
+// ResourceDataMaxBytes returns the size above which the data of a searched
+// resource is replaced by a truncation marker, 0 to never truncate it
+func ResourceDataMaxBytes() int {
+	maxBytes := os.Getenv("RESOURCE_DATA_MAX_BYTES")
+	if maxBytes == "" {
+		return 0
+	}
+	maxBytesInt, err := strconv.Atoi(maxBytes)
+	if err != nil || maxBytesInt < 0 {
+		log.Fatalf("invalid resource data max bytes value %s: %v", maxBytes, err)
+	}
+	return maxBytesInt
+}
+
+// InternalDataFields returns the resource data fields the public data view
+// strips, besides those prefixed with "_"
+func InternalDataFields() []string {
+	internalDataFields := os.Getenv("INTERNAL_DATA_FIELDS")
+	if internalDataFields == "" {
+		return nil
+	}
+	var fields []string
+	for _, field := range strings.Split(internalDataFields, ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// SearchProfiles returns the search profiles selectable with the profile
+// parameter, by name
+func SearchProfiles() map[string]SearchProfile {
+	searchProfiles := os.Getenv("SEARCH_PROFILES")
+	if searchProfiles == "" {
+		return nil
+	}
+	profiles, err := ParseSearchProfiles(searchProfiles)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	return profiles
+}
+
+// CacheWarmQueries returns the resource searches run to warm the cache up
+// after a flush
+func CacheWarmQueries() []CacheWarmQuery {
+	cacheWarmQueries := os.Getenv("CACHE_WARM_QUERIES")
+	if cacheWarmQueries == "" {
+		return nil
+	}
+	queries, err := ParseCacheWarmQueries(cacheWarmQueries)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	return queries
+}
+
+// PageTokenMaxSortValues returns the maximum number of sort values a page
+// token may carry; tokens with more are rejected
+func PageTokenMaxSortValues() int {
+	maxSortValues := os.Getenv("PAGE_TOKEN_MAX_SORT_VALUES")
+	if maxSortValues == "" {
+		return constants.DefaultMaxSortValues
+	}
+	maxSortValuesInt, err := strconv.Atoi(maxSortValues)
+	if err != nil || maxSortValuesInt < 1 {
+		log.Fatalf("invalid page token max sort values value %s: %v", maxSortValues, err)
+	}
+	return maxSortValuesInt
+}
+
+// DefaultPageSizeByType returns the default page size of resource searches of
+// a single type, by type; other searches use constants.DefaultPageSize
+func DefaultPageSizeByType() map[string]int {
+	pageSizeByType := os.Getenv("DEFAULT_PAGE_SIZE_BY_TYPE")
+	if pageSizeByType == "" {
+		return nil
+	}
+	pageSizes := make(map[string]int)
+	for _, entry := range strings.Split(pageSizeByType, ",") {
+		resourceType, size, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		resourceType, size = strings.TrimSpace(resourceType), strings.TrimSpace(size)
+		if !ok || resourceType == "" {
+			log.Fatalf("invalid default page size %q: must be <type>=<size>", entry)
+		}
+		sizeInt, err := strconv.Atoi(size)
+		if err != nil || sizeInt < 1 {
+			log.Fatalf("invalid default page size %s for type %s: %v", size, resourceType, err)
+		}
+		pageSizes[resourceType] = sizeInt
+	}
+	return pageSizes
+}
+
+// MaxOrTerms returns the maximum number of alternatives of an OR group of a
+// resource search; searches with more are rejected
+func MaxOrTerms() int {
+	maxOrTerms := os.Getenv("MAX_OR_TERMS")
+	if maxOrTerms == "" {
+		return constants.DefaultMaxOrTerms
+	}
+	maxOrTermsInt, err := strconv.Atoi(maxOrTerms)
+	if err != nil || maxOrTermsInt < 1 {
+		log.Fatalf("invalid max OR terms value %s: %v", maxOrTerms, err)
+	}
+	return maxOrTermsInt
+}
+
+// DebugResponses returns whether responses carry debugging details, such as
+// the index that served each resource
+func DebugResponses() bool {
+	debugResponses := os.Getenv("DEBUG_RESPONSES")
+	if debugResponses == "" {
+		return false
+	}
+	debugResponsesBool, err := strconv.ParseBool(debugResponses)
+	if err != nil {
+		log.Fatalf("invalid debug responses value %s: %v", debugResponses, err)
+	}
+	return debugResponsesBool
+}
+
+// MaxConcurrentRequests returns the number of requests served at once, 0 for
+// no limit
+func MaxConcurrentRequests() int {
+	maxConcurrent := os.Getenv("MAX_CONCURRENT_REQUESTS")
+	if maxConcurrent == "" {
+		return 0
+	}
+	maxConcurrentInt, err := strconv.Atoi(maxConcurrent)
+	if err != nil || maxConcurrentInt < 0 {
+		log.Fatalf("invalid max concurrent requests value %s: %v", maxConcurrent, err)
+	}
+	return maxConcurrentInt
+}
+
+// ErrorVerbosity returns how much of internal errors is reported to clients
+func ErrorVerbosity() string {
+	verbosity := os.Getenv("ERROR_VERBOSITY")
+	switch verbosity {
+	case "":
+		return constants.ErrorVerbosityVerbose
+	case constants.ErrorVerbositySafe, constants.ErrorVerbosityVerbose:
+		return verbosity
+	default:
+		log.Fatalf("invalid error verbosity %s: must be %q or %q", verbosity, constants.ErrorVerbositySafe, constants.ErrorVerbosityVerbose)
+		return ""
+	}
+}
+
+// ResourceSearchOptions builds the resource search service options from the environment
+func ResourceSearchOptions() []service.ResourceSearchOption {
+
+	var opts []service.ResourceSearchOption
+
+	accessAllowedValues := os.Getenv("ACCESS_CHECK_ALLOWED_VALUES")
+	if accessAllowedValues != "" {
+		var values []string
+		for _, value := range strings.Split(accessAllowedValues, ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+		if len(values) == 0 {
+			log.Fatalf("invalid access check allowed values %s", accessAllowedValues)
+		}
+		opts = append(opts, service.WithAccessAllowedValues(values...))
+	}
+
+	allowedResourceTypes := os.Getenv("ALLOWED_RESOURCE_TYPES")
+	if allowedResourceTypes != "" {
+		opts = append(opts, service.WithAllowedResourceTypes(strings.Split(allowedResourceTypes, ",")...))
+	}
+
+	if minQueryLen, ok := MinQueryLength(); ok {
+		opts = append(opts, service.WithNameMinQueryLength(minQueryLen))
+	}
+
+	recencyBoostScale := os.Getenv("RECENCY_BOOST_SCALE")
+	if recencyBoostScale != "" {
+		opts = append(opts, service.WithRecencyBoost(recencyBoostScale))
+	}
+
+	exactNameBoost := os.Getenv("EXACT_NAME_BOOST")
+	if exactNameBoost != "" {
+		exactNameBoostBool, err := strconv.ParseBool(exactNameBoost)
+		if err != nil {
+			log.Fatalf("invalid exact name boost value %s: %v", exactNameBoost, err)
+		}
+		opts = append(opts, service.WithExactNameBoost(exactNameBoostBool))
+	}
+
+	searchScope := os.Getenv("SEARCH_SCOPE")
+	if searchScope != "" {
+		if searchScope != model.SearchScopeName && searchScope != model.SearchScopeAll {
+			log.Fatalf("invalid search scope %s: must be %q or %q", searchScope, model.SearchScopeName, model.SearchScopeAll)
+		}
+		opts = append(opts, service.WithSearchScope(searchScope))
+	}
+
+	missingAccessPolicy := os.Getenv("MISSING_ACCESS_METADATA_POLICY")
+	if missingAccessPolicy != "" {
+		policies := make(map[string]string)
+		for _, entry := range strings.Split(missingAccessPolicy, ",") {
+			resourceType, policy, ok := strings.Cut(strings.TrimSpace(entry), "=")
+			resourceType, policy = strings.TrimSpace(resourceType), strings.TrimSpace(policy)
+			switch {
+			case !ok || resourceType == "":
+				log.Fatalf("invalid missing access metadata policy %q: must be <type>=<policy>", entry)
+			case policy != model.MissingAccessMetadataSkip &&
+				policy != model.MissingAccessMetadataDeny &&
+				policy != model.MissingAccessMetadataIncludeIfPublic:
+				log.Fatalf("invalid missing access metadata policy %s for type %s: must be %q, %q or %q", policy, resourceType,
+					model.MissingAccessMetadataSkip, model.MissingAccessMetadataDeny, model.MissingAccessMetadataIncludeIfPublic)
+			}
+			policies[resourceType] = policy
+		}
+		opts = append(opts, service.WithMissingAccessMetadataPolicy(policies))
+	}
+
+	refreshWaitPrincipals := os.Getenv("REFRESH_WAIT_PRINCIPALS")
+	if refreshWaitPrincipals != "" {
+		refreshWait := os.Getenv("REFRESH_WAIT")
+		if refreshWait == "" {
+			refreshWait = "1s"
+		}
+		refreshWaitDuration, err := time.ParseDuration(refreshWait)
+		if err != nil || refreshWaitDuration < 0 {
+			log.Fatalf("invalid refresh wait duration %s: %v", refreshWait, err)
+		}
+		opts = append(opts, service.WithRefreshWait(refreshWaitDuration, strings.Split(refreshWaitPrincipals, ",")...))
+	}
+
+	// Relations reported per resource on request, and who may request them
+	permissionRelations := os.Getenv("PERMISSION_RELATIONS")
+	permissionPrincipals := os.Getenv("PERMISSION_PRINCIPALS")
+	if permissionRelations != "" || permissionPrincipals != "" {
+		relations := []string{constants.DefaultPermissionRelation}
+		if permissionRelations != "" {
+			relations = strings.Split(permissionRelations, ",")
+		}
+		var principals []string
+		if permissionPrincipals != "" {
+			principals = strings.Split(permissionPrincipals, ",")
+		}
+		opts = append(opts, service.WithPermissions(relations, principals...))
+	}
+
+	// Principals the total count, before the access checks, is reported to
+	totalCountPrincipals := os.Getenv("TOTAL_COUNT_PRINCIPALS")
+	if totalCountPrincipals != "" {
+		opts = append(opts, service.WithTotalCountPrincipals(strings.Split(totalCountPrincipals, ",")...))
+	}
+
+	explainPrincipals := os.Getenv("EXPLAIN_PRINCIPALS")
+	if explainPrincipals != "" {
+		opts = append(opts, service.WithExplainPrincipals(strings.Split(explainPrincipals, ",")...))
+	}
+
+	accessCheckPrincipals := os.Getenv("ACCESS_CHECK_PRINCIPALS")
+	if accessCheckPrincipals != "" {
+		opts = append(opts, service.WithTupleCheckPrincipals(strings.Split(accessCheckPrincipals, ",")...))
+	}
+
+	trackTotalHits := os.Getenv("TRACK_TOTAL_HITS")
+	if trackTotalHits != "" {
+		trackTotalHitsValue, err := parseTrackTotalHits(trackTotalHits)
+		if err != nil {
+			log.Fatalf("invalid track total hits value %s: must be a boolean or a non-negative integer", trackTotalHits)
+		}
+		opts = append(opts, service.WithTrackTotalHits(trackTotalHitsValue))
+	}
+
+	aggregationBucketLimit := os.Getenv("AGGREGATION_BUCKET_LIMIT")
+	if aggregationBucketLimit != "" {
+		aggregationBucketLimitInt, err := strconv.Atoi(aggregationBucketLimit)
+		if err != nil || aggregationBucketLimitInt <= 0 {
+			log.Fatalf("invalid aggregation bucket limit %s: must be a positive integer", aggregationBucketLimit)
+		}
+		opts = append(opts, service.WithAggregationBucketLimit(aggregationBucketLimitInt))
+	}
+
+	publicPathHeader := os.Getenv("PUBLIC_PATH_HEADER")
+	if publicPathHeader != "" {
+		publicPathHeaderBool, err := strconv.ParseBool(publicPathHeader)
+		if err != nil {
+			log.Fatalf("invalid public path header value %s: %v", publicPathHeader, err)
+		}
+		opts = append(opts, service.WithPublicPathReporting(publicPathHeaderBool))
+	}
+
+	allowUnfilteredSearch := os.Getenv("ALLOW_UNFILTERED_SEARCH")
+	if allowUnfilteredSearch != "" {
+		allowUnfilteredSearchBool, err := strconv.ParseBool(allowUnfilteredSearch)
+		if err != nil {
+			log.Fatalf("invalid allow unfiltered search value %s: %v", allowUnfilteredSearch, err)
+		}
+		opts = append(opts, service.WithUnfilteredSearch(allowUnfilteredSearchBool))
+	}
+
+	allowEmptyCriteria := os.Getenv("ALLOW_EMPTY_CRITERIA")
+	if allowEmptyCriteria != "" {
+		allowEmptyCriteriaBool, err := strconv.ParseBool(allowEmptyCriteria)
+		if err != nil {
+			log.Fatalf("invalid allow empty criteria value %s: %v", allowEmptyCriteria, err)
+		}
+		opts = append(opts, service.WithEmptyCriteria(allowEmptyCriteriaBool))
+	}
+
+	readinessTimeout := os.Getenv("READINESS_TIMEOUT")
+	if readinessTimeout != "" {
+		readinessTimeoutDuration, err := time.ParseDuration(readinessTimeout)
+		if err != nil || readinessTimeoutDuration <= 0 {
+			log.Fatalf("invalid readiness timeout %s: %v", readinessTimeout, err)
+		}
+		opts = append(opts, service.WithReadinessTimeout(readinessTimeoutDuration))
+	}
+
+	strictAccessCheck := os.Getenv("STRICT_ACCESS_CHECK")
+	if strictAccessCheck != "" {
+		strictAccessCheckBool, err := strconv.ParseBool(strictAccessCheck)
+		if err != nil {
+			log.Fatalf("invalid strict access check value %s: %v", strictAccessCheck, err)
+		}
+		opts = append(opts, service.WithStrictAccessCheck(strictAccessCheckBool))
+	}
+
+	cacheAdminPrincipals := os.Getenv("CACHE_ADMIN_PRINCIPALS")
+	if cacheAdminPrincipals != "" {
+		opts = append(opts, service.WithCacheAdminPrincipals(strings.Split(cacheAdminPrincipals, ",")...))
+	}
+
+	principalCacheTTL := os.Getenv("PRINCIPAL_CACHE_TTL")
+	if principalCacheTTL != "" {
+		principalCacheTTLDuration, err := time.ParseDuration(principalCacheTTL)
+		if err != nil || principalCacheTTLDuration < 0 {
+			log.Fatalf("invalid principal cache TTL duration %s: %v", principalCacheTTL, err)
+		}
+		opts = append(opts, service.WithPrincipalCache(principalCacheTTLDuration, constants.DefaultPrincipalCacheMaxEntries))
+	}
+
+	return opts
+}
+
+// MinQueryLength returns the minimum length of name and suggestion queries,
+// and whether it is set
+func MinQueryLength() (int, bool) {
+	minQueryLen := os.Getenv("MIN_QUERY_LENGTH")
+	if minQueryLen == "" {
+		return 0, false
+	}
+	minQueryLenInt, err := strconv.Atoi(minQueryLen)
+	if err != nil || minQueryLenInt < 0 {
+		log.Fatalf("invalid minimum query length value %s: %v", minQueryLen, err)
+	}
+	return minQueryLenInt, true
+}
+
+// OrganizationSearchOptions builds the organization search service options from the environment
+func OrganizationSearchOptions() []service.OrganizationSearchOption {
+
+	var opts []service.OrganizationSearchOption
+
+	orgSearchTimeout := os.Getenv("ORG_SEARCH_TIMEOUT")
+	if orgSearchTimeout != "" {
+		orgSearchTimeoutDuration, err := time.ParseDuration(orgSearchTimeout)
+		if err != nil || orgSearchTimeoutDuration < 0 {
+			log.Fatalf("invalid organization search timeout %s: %v", orgSearchTimeout, err)
+		}
+		opts = append(opts, service.WithSearchTimeout(orgSearchTimeoutDuration))
+	}
+
+	// The suggestions specific minimum takes precedence over the general one
+	suggestMinQueryLen := os.Getenv("SUGGEST_MIN_QUERY_LEN")
+	if suggestMinQueryLen != "" {
+		suggestMinQueryLenInt, err := strconv.Atoi(suggestMinQueryLen)
+		if err != nil || suggestMinQueryLenInt < 0 {
+			log.Fatalf("invalid suggest minimum query length value %s: %v", suggestMinQueryLen, err)
+		}
+		opts = append(opts, service.WithSuggestMinQueryLength(suggestMinQueryLenInt))
+	} else if minQueryLen, ok := MinQueryLength(); ok {
+		opts = append(opts, service.WithSuggestMinQueryLength(minQueryLen))
+	}
+
+	suggestAllowEmptyQuery := os.Getenv("SUGGEST_ALLOW_EMPTY_QUERY")
+	if suggestAllowEmptyQuery != "" {
+		suggestAllowEmptyQueryBool, err := strconv.ParseBool(suggestAllowEmptyQuery)
+		if err != nil {
+			log.Fatalf("invalid suggest allow empty query value %s: %v", suggestAllowEmptyQuery, err)
+		}
+		opts = append(opts, service.WithSuggestAllowEmptyQuery(suggestAllowEmptyQueryBool))
+	}
+
+	return opts
+}
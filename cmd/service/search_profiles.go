@@ -0,0 +1,81 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/model"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/errors"
+)
+
+// defaultSort is the sort order of searches without a sort parameter or a
+// profile sort
+const defaultSort = "name_asc"
+
+// sortValues are the accepted values of the sort parameter
+var sortValues = []string{"name_asc", "name_desc", "updated_asc", "updated_desc"}
+
+// SearchProfile is a named bundle of search settings, selected with the
+// profile parameter so that callers don't repeat them on every request.
+// Explicit parameters override the settings of the profile.
+type SearchProfile struct {
+	// Sort is the sort order, as the sort parameter (e.g. "updated_desc")
+	Sort string `json:"sort,omitempty"`
+	// SearchScope is the scope of name searches, as the search_scope parameter
+	SearchScope string `json:"search_scope,omitempty"`
+	// Fuzzy lets names match with typos
+	Fuzzy bool `json:"fuzzy,omitempty"`
+	// RecencyBoost boosts recently updated resources, as RECENCY_BOOST_SCALE
+	// (e.g. "30d")
+	RecencyBoost string `json:"recency_boost,omitempty"`
+}
+
+// ParseSearchProfiles parses a JSON object of search profiles by name, e.g.
+// {"directory": {"sort": "updated_desc", "fuzzy": true}}
+func ParseSearchProfiles(value string) (map[string]SearchProfile, error) {
+	decoder := json.NewDecoder(strings.NewReader(value))
+	decoder.DisallowUnknownFields()
+
+	var profiles map[string]SearchProfile
+	if err := decoder.Decode(&profiles); err != nil {
+		return nil, fmt.Errorf("invalid search profiles: %w", err)
+	}
+	for name, profile := range profiles {
+		if strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("invalid search profiles: empty profile name")
+		}
+		if profile.Sort != "" && !slices.Contains(sortValues, profile.Sort) {
+			return nil, fmt.Errorf("invalid sort %q of search profile %s: must be one of %s", profile.Sort, name, strings.Join(sortValues, ", "))
+		}
+		if profile.SearchScope != "" && profile.SearchScope != model.SearchScopeName && profile.SearchScope != model.SearchScopeAll {
+			return nil, fmt.Errorf("invalid search scope %q of search profile %s: must be %q or %q", profile.SearchScope, name, model.SearchScopeName, model.SearchScopeAll)
+		}
+	}
+	return profiles, nil
+}
+
+// searchProfile returns the search profile named by the profile parameter,
+// the empty profile when none is named
+func (s *querySvcsrvc) searchProfile(name *string) (SearchProfile, error) {
+	if name == nil || *name == "" {
+		return SearchProfile{}, nil
+	}
+	profile, ok := s.searchProfiles[*name]
+	if !ok {
+		return SearchProfile{}, errors.NewValidation(fmt.Sprintf("unknown search profile %q", *name))
+	}
+	return profile, nil
+}
+
+// apply sets the criteria the profile has settings for; explicit parameters
+// are set over them afterwards
+func (p SearchProfile) apply(criteria *model.SearchCriteria) {
+	criteria.SearchScope = p.SearchScope
+	criteria.NameFuzzy = p.Fuzzy
+	criteria.RecencyBoostScale = p.RecencyBoost
+}
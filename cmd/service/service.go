@@ -6,6 +6,7 @@ package service
 import (
 	"context"
 	"log/slog"
+	"strings"
 
 	querysvc "github.com/linuxfoundation/lfx-v2-query-service/gen/query_svc"
 	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/port"
@@ -20,7 +21,46 @@ import (
 type querySvcsrvc struct {
 	resourceService     service.ResourceSearcher
 	organizationService service.OrganizationSearcher
+	unifiedService      service.UnifiedSearcher
 	auth                port.Authenticator
+	normalizePrincipals bool
+	maxDataBytes        int
+	debugResponses      bool
+	dataViews           map[string]DataView
+	searchProfiles      map[string]SearchProfile
+	pageTokenSortValues int
+	maxOrTerms          int
+	pageSizeByType      map[string]int
+	inputNormalization  InputNormalization
+	cacheWarmQueries    []CacheWarmQuery
+	// errorVerbosity controls whether internal error messages reach clients,
+	// see constants.ErrorVerbositySafe
+	errorVerbosity string
+}
+
+// maxSortValues returns the maximum number of sort values a page token may
+// carry, the default when unset
+func (s *querySvcsrvc) maxSortValues() int {
+	if s.pageTokenSortValues <= 0 {
+		return constants.DefaultMaxSortValues
+	}
+	return s.pageTokenSortValues
+}
+
+// orTermsLimit returns the maximum number of alternatives of an OR group of a
+// resource search, the default when unset
+func (s *querySvcsrvc) orTermsLimit() int {
+	if s.maxOrTerms <= 0 {
+		return constants.DefaultMaxOrTerms
+	}
+	return s.maxOrTerms
+}
+
+// normalizePrincipal returns the canonical form of a principal: the bare user
+// ID, as the access check tuples add the "user:" type themselves
+func normalizePrincipal(principal string) string {
+	principal = strings.TrimSpace(principal)
+	return strings.TrimPrefix(principal, constants.PrincipalUserPrefix)
 }
 
 // JWTAuth implements the authorization logic for service "query-svc" for the
@@ -30,7 +70,10 @@ func (s *querySvcsrvc) JWTAuth(ctx context.Context, token string, scheme *securi
 	// Parse the Heimdall-authorized principal from the token.
 	principal, err := s.auth.ParsePrincipal(ctx, token, slog.Default())
 	if err != nil {
-		return ctx, wrapError(ctx, err)
+		return ctx, s.wrapError(ctx, err)
+	}
+	if s.normalizePrincipals {
+		principal = normalizePrincipal(principal)
 	}
 
 	// Log the principal for debugging purposes in all logs for this request.
@@ -48,21 +91,43 @@ func (s *querySvcsrvc) QueryResources(ctx context.Context, p *querysvc.QueryReso
 		"name", p.Name,
 	)
 
+	view, errView := s.dataView(p.View)
+	if errView != nil {
+		return nil, s.wrapError(ctx, errView)
+	}
+
 	// Convert payload to domain criteria
 	criteria, errCriteria := s.payloadToCriteria(ctx, p)
 	if errCriteria != nil {
 		slog.ErrorContext(ctx, "failed to convert payload to criteria", "error", errCriteria)
-		return nil, wrapError(ctx, errCriteria)
+		return nil, s.wrapError(ctx, errCriteria)
 	}
 
 	// Execute search using the service layer
 	result, errQueryResources := s.resourceService.QueryResources(ctx, criteria)
 	if errQueryResources != nil {
-		return nil, wrapError(ctx, errQueryResources)
+		return nil, s.wrapError(ctx, withOperation("query-resources", criteria, errQueryResources))
 	}
 
 	// Convert domain result to response
-	res = s.domainResultToResponse(result)
+	res = s.domainResultToResponse(result, view)
+	res.Link = nextPageLink(ctx, res.PageToken)
+
+	// Anonymous results are the same for every caller, so they can be tagged
+	// for cheap revalidation without leaking results across principals.
+	principal, _ := ctx.Value(constants.PrincipalContextID).(string)
+	if principal == constants.AnonymousPrincipal && res.CacheControl != nil {
+		etag, errETag := resultETag(ctx, res)
+		if errETag != nil {
+			slog.WarnContext(ctx, "failed to compute result etag", "error", errETag)
+			return res, nil
+		}
+		res.Etag = &etag
+		if etagMatches(p.IfNoneMatch, etag) {
+			return notModifiedResult(res), nil
+		}
+	}
+
 	return res, nil
 }
 
@@ -82,10 +147,87 @@ func (s *querySvcsrvc) QueryResourcesCount(ctx context.Context, p *querysvc.Quer
 	// Execute search using the service layer
 	result, errQueryResources := s.resourceService.QueryResourcesCount(ctx, countCriteria, aggregationCriteria)
 	if errQueryResources != nil {
-		return nil, wrapError(ctx, errQueryResources)
+		return nil, s.wrapError(ctx, withOperation("query-resources-count", countCriteria, errQueryResources))
+	}
+
+	res := s.domainCountResultToResponse(result)
+	if p.IncludeRelations {
+		res.RelationCounts = result.RelationCounts
+	}
+	return res, nil
+}
+
+// QueryResourcesCountBatch counts resources for several queries at once,
+// reporting the error of a failing query in its item.
+func (s *querySvcsrvc) QueryResourcesCountBatch(ctx context.Context, p *querysvc.QueryResourcesCountBatchPayload) (*querysvc.QueryResourcesCountBatchResult, error) {
+
+	slog.DebugContext(ctx, "querySvc.query-resources-count-batch",
+		"queries", len(p.Queries),
+	)
+
+	// Convert payload to domain criteria
+	batch := s.payloadToCountBatchCriteria(p)
+
+	// Execute the counts using the service layer
+	items, errQueryResources := s.resourceService.QueryResourcesCountBatch(ctx, batch)
+	if errQueryResources != nil {
+		return nil, s.wrapError(ctx, errQueryResources)
+	}
+
+	return s.domainCountItemsToResponse(ctx, items), nil
+}
+
+// Break the matching resources down by the values of one or more fields.
+func (s *querySvcsrvc) QueryResourcesFacets(ctx context.Context, p *querysvc.QueryResourcesFacetsPayload) (*querysvc.QueryResourcesFacetsResult, error) {
+
+	slog.DebugContext(ctx, "querySvc.query-resources-facets",
+		"facets", p.Facet,
+	)
+
+	// Convert payload to domain criteria
+	criteria := s.payloadToFacetCriteria(p)
+
+	// Execute the facet search using the service layer
+	result, errQueryFacets := s.resourceService.QueryFacets(ctx, criteria, p.Facet)
+	if errQueryFacets != nil {
+		return nil, s.wrapError(ctx, withOperation("query-resources-facets", criteria, errQueryFacets))
 	}
 
-	return s.domainCountResultToResponse(result), nil
+	return s.domainFacetResultToResponse(result), nil
+}
+
+// Explain why a resource search does or doesn't return a resource.
+func (s *querySvcsrvc) ExplainResource(ctx context.Context, p *querysvc.ExplainResourcePayload) (*querysvc.ResourceExplanation, error) {
+
+	slog.DebugContext(ctx, "querySvc.explain-resource",
+		"object_ref", p.ObjectRef,
+	)
+
+	// Convert payload to domain criteria
+	criteria := s.payloadToExplainCriteria(p)
+
+	// Explain the search using the service layer
+	explanation, errExplain := s.resourceService.ExplainResource(ctx, criteria, p.ObjectRef)
+	if errExplain != nil {
+		return nil, s.wrapError(ctx, errExplain)
+	}
+
+	return s.domainExplanationToResponse(explanation), nil
+}
+
+// Check a list of access check tuples and return the answer of each.
+func (s *querySvcsrvc) CheckTuples(ctx context.Context, p *querysvc.CheckTuplesPayload) (*querysvc.CheckTuplesResult, error) {
+
+	slog.DebugContext(ctx, "querySvc.check-tuples",
+		"tuples", len(p.Tuples),
+	)
+
+	results, errCheck := s.resourceService.CheckTuples(ctx, p.Tuples)
+	if errCheck != nil {
+		return nil, s.wrapError(ctx, errCheck)
+	}
+
+	return &querysvc.CheckTuplesResult{Results: results}, nil
 }
 
 // Locate a single organization by name or domain.
@@ -94,6 +236,7 @@ func (s *querySvcsrvc) QueryOrgs(ctx context.Context, p *querysvc.QueryOrgsPaylo
 	slog.DebugContext(ctx, "querySvc.query-orgs",
 		"name", p.Name,
 		"domain", p.Domain,
+		"domains", p.Domains,
 	)
 
 	// Convert payload to domain criteria
@@ -102,7 +245,7 @@ func (s *querySvcsrvc) QueryOrgs(ctx context.Context, p *querysvc.QueryOrgsPaylo
 	// Execute search using the service layer
 	result, errQueryOrgs := s.organizationService.QueryOrganizations(ctx, criteria)
 	if errQueryOrgs != nil {
-		return nil, wrapError(ctx, errQueryOrgs)
+		return nil, s.wrapError(ctx, errQueryOrgs)
 	}
 
 	// Convert domain result to response
@@ -110,6 +253,67 @@ func (s *querySvcsrvc) QueryOrgs(ctx context.Context, p *querysvc.QueryOrgsPaylo
 	return res, nil
 }
 
+// List the organizations matching a name fragment or domain, ordered by relevance.
+func (s *querySvcsrvc) QueryOrgsList(ctx context.Context, p *querysvc.QueryOrgsListPayload) (res *querysvc.QueryOrgsListResult, err error) {
+
+	slog.DebugContext(ctx, "querySvc.query-orgs-list",
+		"name", p.Name,
+		"domain", p.Domain,
+	)
+
+	// Convert payload to domain criteria
+	criteria, errCriteria := s.payloadToOrganizationListCriteria(ctx, p)
+	if errCriteria != nil {
+		return nil, errCriteria
+	}
+
+	// Execute search using the service layer
+	result, errQueryOrgs := s.organizationService.QueryOrganizationsList(ctx, criteria)
+	if errQueryOrgs != nil {
+		return nil, s.wrapError(ctx, errQueryOrgs)
+	}
+
+	// Convert domain result to response
+	return s.domainOrganizationsToResponse(ctx, criteria, result)
+}
+
+// Count the organizations matching a name fragment or domain, or all of them when neither is given.
+func (s *querySvcsrvc) CountOrgs(ctx context.Context, p *querysvc.CountOrgsPayload) (res *querysvc.CountOrgsResult, err error) {
+
+	slog.DebugContext(ctx, "querySvc.count-orgs",
+		"name", p.Name,
+		"domain", p.Domain,
+	)
+
+	// Convert payload to domain criteria
+	criteria := s.payloadToOrganizationCountCriteria(ctx, p)
+
+	// Execute the count using the service layer
+	count, errCountOrgs := s.organizationService.CountOrganizations(ctx, criteria)
+	if errCountOrgs != nil {
+		return nil, s.wrapError(ctx, errCountOrgs)
+	}
+
+	return &querysvc.CountOrgsResult{Count: count}, nil
+}
+
+// Resolve a batch of domains or website URLs to their organizations in one call.
+func (s *querySvcsrvc) ResolveOrgs(ctx context.Context, p *querysvc.ResolveOrgsPayload) (res *querysvc.ResolveOrgsResult, err error) {
+
+	slog.DebugContext(ctx, "querySvc.resolve-orgs",
+		"domains", len(p.Domains),
+	)
+
+	// Execute the resolution using the service layer
+	result, errResolveOrgs := s.organizationService.ResolveOrganizations(ctx, p.Domains)
+	if errResolveOrgs != nil {
+		return nil, s.wrapError(ctx, errResolveOrgs)
+	}
+
+	// Convert domain result to response
+	return s.domainResolvedOrganizationsToResponse(p.Domains, result), nil
+}
+
 // Get organization suggestions for typeahead search based on a query.
 func (s *querySvcsrvc) SuggestOrgs(ctx context.Context, p *querysvc.SuggestOrgsPayload) (res *querysvc.SuggestOrgsResult, err error) {
 
@@ -123,7 +327,7 @@ func (s *querySvcsrvc) SuggestOrgs(ctx context.Context, p *querysvc.SuggestOrgsP
 	// Execute search using the service layer
 	result, errSuggestOrgs := s.organizationService.SuggestOrganizations(ctx, criteria)
 	if errSuggestOrgs != nil {
-		return nil, wrapError(ctx, errSuggestOrgs)
+		return nil, s.wrapError(ctx, errSuggestOrgs)
 	}
 
 	// Convert domain result to response
@@ -131,12 +335,70 @@ func (s *querySvcsrvc) SuggestOrgs(ctx context.Context, p *querysvc.SuggestOrgsP
 	return res, nil
 }
 
+// Search resources and organizations in one call, e.g. for a global search bar.
+func (s *querySvcsrvc) UnifiedSearch(ctx context.Context, p *querysvc.UnifiedSearchPayload) (res *querysvc.UnifiedSearchResult, err error) {
+
+	slog.DebugContext(ctx, "querySvc.unified-search",
+		"query", p.Query,
+	)
+
+	// Execute search using the service layer
+	result, errUnifiedSearch := s.unifiedService.UnifiedSearch(ctx, p.Query)
+	if errUnifiedSearch != nil {
+		return nil, s.wrapError(ctx, errUnifiedSearch)
+	}
+
+	// Convert domain result to response
+	res = s.domainUnifiedSearchToResponse(result)
+	return res, nil
+}
+
+// Suggest resources and organizations matching a query in a single list
+// ordered by relevance.
+func (s *querySvcsrvc) Suggest(ctx context.Context, p *querysvc.SuggestPayload) (res *querysvc.SuggestResult, err error) {
+
+	slog.DebugContext(ctx, "querySvc.suggest",
+		"query", p.Query,
+		"kinds", p.Kind,
+	)
+
+	// Execute the suggestions using the service layer
+	result, errSuggest := s.unifiedService.Suggest(ctx, p.Query, p.Kind)
+	if errSuggest != nil {
+		return nil, s.wrapError(ctx, errSuggest)
+	}
+
+	// Convert domain result to response
+	res = s.domainSuggestionsToResponse(result)
+	return res, nil
+}
+
+// Clear the cached search results and warm the cache up again.
+func (s *querySvcsrvc) FlushCache(ctx context.Context, p *querysvc.FlushCachePayload) (*querysvc.FlushCacheResult, error) {
+
+	slog.DebugContext(ctx, "querySvc.flush-cache",
+		"warm", p.Warm,
+	)
+
+	flushed, errFlush := s.resourceService.FlushCache(ctx)
+	if errFlush != nil {
+		return nil, s.wrapError(ctx, errFlush)
+	}
+
+	warmed := 0
+	if p.Warm {
+		warmed = s.warmCache(ctx)
+	}
+
+	return &querysvc.FlushCacheResult{Flushed: flushed, Warmed: warmed}, nil
+}
+
 // Check if the service is able to take inbound requests.
 func (s *querySvcsrvc) Readyz(ctx context.Context) (res []byte, err error) {
 	errIsReady := s.resourceService.IsReady(ctx)
 	if errIsReady != nil {
 		slog.ErrorContext(ctx, "querySvc.readyz failed", "error", errIsReady)
-		return nil, wrapError(ctx, errIsReady)
+		return nil, s.wrapError(ctx, errIsReady)
 	}
 
 	return []byte("OK\n"), nil
@@ -157,11 +419,23 @@ func NewQuerySvc(resourceSearcher port.ResourceSearcher,
 	organizationSearcher port.OrganizationSearcher,
 	auth port.Authenticator,
 ) querysvc.Service {
-	resourceService := service.NewResourceSearch(resourceSearcher, accessControlChecker)
-	organizationService := service.NewOrganizationSearch(organizationSearcher)
+	resourceService := service.NewResourceSearch(resourceSearcher, accessControlChecker, ResourceSearchOptions()...)
+	organizationService := service.NewOrganizationSearch(organizationSearcher, OrganizationSearchOptions()...)
 	return &querySvcsrvc{
 		resourceService:     resourceService,
 		organizationService: organizationService,
+		unifiedService:      service.NewUnifiedSearch(resourceService, organizationService),
 		auth:                auth,
+		normalizePrincipals: NormalizePrincipals(),
+		maxDataBytes:        ResourceDataMaxBytes(),
+		debugResponses:      DebugResponses(),
+		dataViews:           DataViews(),
+		searchProfiles:      SearchProfiles(),
+		pageTokenSortValues: PageTokenMaxSortValues(),
+		maxOrTerms:          MaxOrTerms(),
+		pageSizeByType:      DefaultPageSizeByType(),
+		inputNormalization:  SearchInputNormalization(),
+		cacheWarmQueries:    CacheWarmQueries(),
+		errorVerbosity:      ErrorVerbosity(),
 	}
 }
@@ -5,14 +5,24 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
 	"testing"
 
+	querysvcsvr "github.com/linuxfoundation/lfx-v2-query-service/gen/http/query_svc/server"
 	querysvc "github.com/linuxfoundation/lfx-v2-query-service/gen/query_svc"
 	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/model"
 	"github.com/linuxfoundation/lfx-v2-query-service/internal/infrastructure/mock"
 	"github.com/linuxfoundation/lfx-v2-query-service/pkg/constants"
+	pkgerrors "github.com/linuxfoundation/lfx-v2-query-service/pkg/errors"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/global"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/paging"
 	"github.com/stretchr/testify/assert"
+	goahttp "goa.design/goa/v3/http"
 	"goa.design/goa/v3/security"
 )
 
@@ -83,6 +93,89 @@ func TestQuerySvcsrvc_JWTAuth(t *testing.T) {
 	}
 }
 
+func TestNormalizePrincipal(t *testing.T) {
+	tests := []struct {
+		principal string
+		expected  string
+	}{
+		{principal: "test-user", expected: "test-user"},
+		{principal: "user:test-user", expected: "test-user"},
+		{principal: " user:test-user ", expected: "test-user"},
+		{principal: constants.AnonymousPrincipal, expected: constants.AnonymousPrincipal},
+		{principal: "clients@example", expected: "clients@example"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.principal, func(t *testing.T) {
+			assert.Equal(t, tc.expected, normalizePrincipal(tc.principal))
+		})
+	}
+}
+
+func TestQuerySvcsrvc_JWTAuthPrincipalForms(t *testing.T) {
+	tests := []struct {
+		name              string
+		principal         string
+		normalize         string
+		expectedPrincipal string
+		expectedGranted   bool
+	}{
+		{
+			name:              "bare principal",
+			principal:         "test-user",
+			expectedPrincipal: "test-user",
+			expectedGranted:   true,
+		},
+		{
+			name:              "prefixed principal",
+			principal:         "user:test-user",
+			expectedPrincipal: "test-user",
+			expectedGranted:   true,
+		},
+		{
+			name:              "prefixed principal without normalization",
+			principal:         "user:test-user",
+			normalize:         "false",
+			expectedPrincipal: "user:test-user",
+			expectedGranted:   false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("JWT_AUTH_DISABLED_MOCK_LOCAL_PRINCIPAL", tc.principal)
+			t.Setenv("NORMALIZE_PRINCIPAL", tc.normalize)
+
+			// Only the tuple of the canonical principal grants access
+			mockAccessChecker := mock.NewMockAccessControlChecker()
+			mockAccessChecker.SetCheckAccessResponse(map[string]string{
+				"committee:123#member@user:test-user": "true",
+			})
+			service := NewQuerySvc(mock.NewMockResourceSearcher(), mockAccessChecker, mock.NewMockOrganizationSearcher(), mock.NewMockAuthService())
+			svc, ok := service.(*querySvcsrvc)
+			assert.True(t, ok)
+
+			ctx, err := svc.JWTAuth(context.Background(), "mock-token", &security.JWTScheme{})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedPrincipal, ctx.Value(constants.PrincipalContextID))
+
+			result, err := svc.QueryResources(ctx, &querysvc.QueryResourcesPayload{
+				Version: "1",
+				Type:    stringPtr("committee"),
+				Sort:    stringPtr("name_asc"),
+			})
+			assert.NoError(t, err)
+			var granted bool
+			for _, resource := range result.Resources {
+				if *resource.ID == "123" {
+					granted = true
+				}
+			}
+			assert.Equal(t, tc.expectedGranted, granted)
+		})
+	}
+}
+
 func TestQuerySvcsrvc_QueryResources(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -126,6 +219,29 @@ func TestQuerySvcsrvc_QueryResources(t *testing.T) {
 			expectedError:     true,
 			expectedErrorType: &querysvc.BadRequestError{},
 		},
+		{
+			name: "query with all-whitespace name",
+			payload: &querysvc.QueryResourcesPayload{
+				Name: stringPtr("   "),
+			},
+			setupMocks: func(searcher *mock.MockResourceSearcher, accessChecker *mock.MockAccessControlChecker) {
+				// No setup needed: the name is dropped, leaving no criteria
+			},
+			expectedError:     true,
+			expectedErrorType: &querysvc.BadRequestError{},
+		},
+		{
+			name: "query with padded name",
+			payload: &querysvc.QueryResourcesPayload{
+				Name: stringPtr("  Test   Project  "),
+				Type: stringPtr("project"),
+			},
+			setupMocks: func(searcher *mock.MockResourceSearcher, accessChecker *mock.MockAccessControlChecker) {
+				searcher.AddResource(mock.NewResourceWithDefaults("project", "test-project-1", map[string]any{"name": "Test Project 1"}, true))
+			},
+			expectedError:     false,
+			expectedResources: 1,
+		},
 		{
 			name: "query with pagination",
 			payload: &querysvc.QueryResourcesPayload{
@@ -136,7 +252,7 @@ func TestQuerySvcsrvc_QueryResources(t *testing.T) {
 				// No setup needed as we expect error during token parsing
 			},
 			expectedError:     true,
-			expectedErrorType: &querysvc.InternalServerError{}, // Changed to match actual behavior
+			expectedErrorType: &querysvc.BadRequestError{},
 		},
 	}
 
@@ -296,6 +412,580 @@ func TestQuerySvcsrvc_QueryResourcesCount(t *testing.T) {
 	}
 }
 
+func TestQuerySvcsrvc_QueryResourcesCountBatch(t *testing.T) {
+	t.Setenv("ALLOWED_RESOURCE_TYPES", "project,committee")
+
+	mockResourceSearcher := mock.NewMockResourceSearcher()
+	mockResourceSearcher.SetQueryResourcesCountResponse(&model.CountResult{Count: 4})
+	service := NewQuerySvc(mockResourceSearcher, mock.NewMockAccessControlChecker(), mock.NewMockOrganizationSearcher(), mock.NewMockAuthService())
+	svc, ok := service.(*querySvcsrvc)
+	assert.True(t, ok)
+
+	ctx := context.WithValue(context.Background(), constants.PrincipalContextID, constants.AnonymousPrincipal)
+
+	// The second query targets a type outside the allowlist
+	result, err := svc.QueryResourcesCountBatch(ctx, &querysvc.QueryResourcesCountBatchPayload{
+		Version: "1",
+		Queries: []*querysvc.CountQuery{
+			{Type: stringPtr("project")},
+			{Type: stringPtr("meeting")},
+			{Type: stringPtr("committee"), Tags: []string{"active"}},
+		},
+	})
+
+	assert.NoError(t, err)
+	if !assert.Len(t, result.Items, 3) {
+		return
+	}
+	for _, idx := range []int{0, 2} {
+		assert.Nil(t, result.Items[idx].Error)
+		if assert.NotNil(t, result.Items[idx].Count) {
+			assert.Equal(t, uint64(4), *result.Items[idx].Count)
+		}
+		if assert.NotNil(t, result.Items[idx].HasMore) {
+			assert.False(t, *result.Items[idx].HasMore)
+		}
+	}
+	assert.Nil(t, result.Items[1].Count)
+	assert.Nil(t, result.Items[1].HasMore)
+	if assert.NotNil(t, result.Items[1].Error) {
+		assert.Equal(t, "BadRequest", result.Items[1].Error.Code)
+		assert.NotEmpty(t, result.Items[1].Error.Message)
+	}
+}
+
+func TestQuerySvcsrvc_QueryResourcesFacets(t *testing.T) {
+	mockResourceSearcher := mock.NewMockResourceSearcher()
+	mockResourceSearcher.ClearResources()
+	mockResourceSearcher.AddResource(mock.NewResourceWithDefaults("committee", "1", map[string]any{"name": "Board", "tags": []string{"active"}}, true))
+	mockResourceSearcher.AddResource(mock.NewResourceWithDefaults("committee", "2", map[string]any{"name": "TAC", "tags": []string{"active"}}, true))
+	mockResourceSearcher.AddResource(mock.NewResourceWithDefaults("meeting", "3", map[string]any{"name": "Sync"}, true))
+	service := NewQuerySvc(mockResourceSearcher, mock.NewMockAccessControlChecker(), mock.NewMockOrganizationSearcher(), mock.NewMockAuthService())
+	svc, ok := service.(*querySvcsrvc)
+	assert.True(t, ok)
+
+	ctx := context.WithValue(context.Background(), constants.PrincipalContextID, constants.AnonymousPrincipal)
+
+	result, err := svc.QueryResourcesFacets(ctx, &querysvc.QueryResourcesFacetsPayload{
+		Version: "1",
+		Facet:   []string{"type", "tags"},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]*querysvc.FacetValue{
+		"type": {{Value: "committee", Count: 2}, {Value: "meeting", Count: 1}},
+		"tags": {{Value: "active", Count: 2}},
+	}, result.Facets)
+	if assert.NotNil(t, result.CacheControl) {
+		assert.Equal(t, constants.AnonymousCacheControlHeader, *result.CacheControl)
+	}
+
+	// A total undercounted by overflowing access check buckets is a lower bound
+	mockResourceSearcher.SetQueryResourcesCountResponse(&model.CountResult{Count: 1, HasMore: true})
+	userCtx := context.WithValue(context.Background(), constants.PrincipalContextID, "test-user")
+	result, err = svc.QueryResourcesFacets(userCtx, &querysvc.QueryResourcesFacetsPayload{
+		Version:      "1",
+		Facet:        []string{"type"},
+		IncludeTotal: true,
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, result.Total)
+	assert.Equal(t, stringPtr("gte"), result.TotalRelation)
+
+	// An unsupported field is a bad request
+	_, err = svc.QueryResourcesFacets(ctx, &querysvc.QueryResourcesFacetsPayload{
+		Version: "1",
+		Facet:   []string{"name"},
+	})
+	assert.IsType(t, &querysvc.BadRequestError{}, err)
+}
+
+func TestQuerySvcsrvc_PublicPathHeader(t *testing.T) {
+	t.Setenv("PUBLIC_PATH_HEADER", "true")
+
+	tests := []struct {
+		name               string
+		principal          string
+		expectedPublicPath *bool
+	}{
+		{
+			name:               "anonymous request",
+			principal:          constants.AnonymousPrincipal,
+			expectedPublicPath: boolPtr(true),
+		},
+		{
+			name:               "authenticated request",
+			principal:          "test-user",
+			expectedPublicPath: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockResourceSearcher := mock.NewMockResourceSearcher()
+			mockResourceSearcher.SetQueryResourcesCountResponse(&model.CountResult{Count: 1})
+			service := NewQuerySvc(mockResourceSearcher, mock.NewMockAccessControlChecker(), mock.NewMockOrganizationSearcher(), mock.NewMockAuthService())
+			svc, ok := service.(*querySvcsrvc)
+			assert.True(t, ok)
+
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, tc.principal)
+
+			result, err := svc.QueryResources(ctx, &querysvc.QueryResourcesPayload{
+				Version: "1",
+				Type:    stringPtr("project"),
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedPublicPath, result.PublicPath)
+
+			countResult, err := svc.QueryResourcesCount(ctx, &querysvc.QueryResourcesCountPayload{
+				Version: "1",
+				Type:    stringPtr("project"),
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedPublicPath, countResult.PublicPath)
+		})
+	}
+}
+
+func TestQuerySvcsrvc_MinQueryLength(t *testing.T) {
+	t.Setenv("MIN_QUERY_LENGTH", "3")
+
+	tests := []struct {
+		name                string
+		query               string
+		expectedError       bool
+		expectedSuggestions int
+	}{
+		{
+			name:          "below minimum",
+			query:         "li",
+			expectedError: true,
+		},
+		{
+			name:                "at minimum",
+			query:               "lin",
+			expectedSuggestions: 1,
+		},
+		{
+			name:                "above minimum",
+			query:               "linux",
+			expectedSuggestions: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			service := NewQuerySvc(mock.NewMockResourceSearcher(), mock.NewMockAccessControlChecker(), mock.NewMockOrganizationSearcher(), mock.NewMockAuthService())
+			svc, ok := service.(*querySvcsrvc)
+			assert.True(t, ok)
+
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "test-user")
+
+			// Short names are rejected by resource search...
+			result, err := svc.QueryResources(ctx, &querysvc.QueryResourcesPayload{
+				Version: "1",
+				Name:    stringPtr(tc.query),
+			})
+			if tc.expectedError {
+				assert.IsType(t, &querysvc.BadRequestError{}, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, result)
+			}
+
+			// ...while suggestions answer them with no suggestions
+			suggestions, err := svc.SuggestOrgs(ctx, &querysvc.SuggestOrgsPayload{
+				Version: "1",
+				Query:   tc.query,
+			})
+			assert.NoError(t, err)
+			if assert.NotNil(t, suggestions) {
+				assert.Len(t, suggestions.Suggestions, tc.expectedSuggestions)
+			}
+		})
+	}
+}
+
+func TestQuerySvcsrvc_NextPageLinkHeader(t *testing.T) {
+	tests := []struct {
+		name         string
+		pageToken    string
+		expectedLink *string
+	}{
+		{
+			name:         "more pages available",
+			pageToken:    "next-token",
+			expectedLink: stringPtr(`</query/resources?page_token=next-token&type=project&v=1>; rel="next"`),
+		},
+		{
+			name:         "last page",
+			expectedLink: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockResourceSearcher := mock.NewMockResourceSearcher()
+			if tc.pageToken != "" {
+				mockResourceSearcher.SetQueryResourcesPageToken(tc.pageToken)
+			}
+			service := NewQuerySvc(mockResourceSearcher, mock.NewMockAccessControlChecker(), mock.NewMockOrganizationSearcher(), mock.NewMockAuthService())
+			svc, ok := service.(*querySvcsrvc)
+			assert.True(t, ok)
+
+			requestURL, err := url.Parse("/query/resources?v=1&type=project&page_token=previous-token")
+			assert.NoError(t, err)
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "test-user")
+			ctx = context.WithValue(ctx, constants.RequestURLContextID, requestURL)
+
+			result, err := svc.QueryResources(ctx, &querysvc.QueryResourcesPayload{
+				Version: "1",
+				Type:    stringPtr("project"),
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedLink, result.Link)
+			if tc.pageToken != "" {
+				// The body token is kept alongside the header
+				assert.Equal(t, stringPtr(tc.pageToken), result.PageToken)
+			}
+		})
+	}
+}
+
+func TestQuerySvcsrvc_ETag(t *testing.T) {
+	tests := []struct {
+		name               string
+		principal          string
+		ifNoneMatch        func(etag string) *string
+		expectETag         bool
+		expectNotModified  bool
+		expectedStatusCode int
+	}{
+		{
+			name:               "anonymous request is tagged",
+			principal:          constants.AnonymousPrincipal,
+			expectETag:         true,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "anonymous request with matching If-None-Match",
+			principal:          constants.AnonymousPrincipal,
+			ifNoneMatch:        func(etag string) *string { return stringPtr(etag) },
+			expectETag:         true,
+			expectNotModified:  true,
+			expectedStatusCode: http.StatusNotModified,
+		},
+		{
+			name:               "anonymous request with stale If-None-Match",
+			principal:          constants.AnonymousPrincipal,
+			ifNoneMatch:        func(string) *string { return stringPtr(`"stale"`) },
+			expectETag:         true,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "authenticated request is never tagged",
+			principal:          "test-user",
+			ifNoneMatch:        func(string) *string { return stringPtr("*") },
+			expectETag:         false,
+			expectedStatusCode: http.StatusOK,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			service := NewQuerySvc(mock.NewMockResourceSearcher(), mock.NewMockAccessControlChecker(), mock.NewMockOrganizationSearcher(), mock.NewMockAuthService())
+			svc, ok := service.(*querySvcsrvc)
+			assertion.True(ok)
+
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, tc.principal)
+			payload := &querysvc.QueryResourcesPayload{
+				Version: "1",
+				Type:    stringPtr("project"),
+			}
+
+			// A first request learns the current ETag
+			first, err := svc.QueryResources(ctx, payload)
+			assertion.NoError(err)
+
+			if tc.ifNoneMatch != nil {
+				etag := ""
+				if first.Etag != nil {
+					etag = *first.Etag
+				}
+				payload.IfNoneMatch = tc.ifNoneMatch(etag)
+			}
+			result, err := svc.QueryResources(ctx, payload)
+			assertion.NoError(err)
+
+			if !tc.expectETag {
+				assertion.Nil(result.Etag)
+				assertion.Nil(result.CacheStatus)
+			} else if assertion.NotNil(result.Etag) {
+				// Identical results get identical tags
+				assertion.Equal(*first.Etag, *result.Etag)
+			}
+
+			if tc.expectNotModified {
+				assertion.Equal(stringPtr(constants.CacheStatusNotModified), result.CacheStatus)
+				assertion.Empty(result.Resources)
+			} else {
+				assertion.Nil(result.CacheStatus)
+				assertion.NotEmpty(result.Resources)
+			}
+
+			rec := httptest.NewRecorder()
+			encode := querysvcsvr.EncodeQueryResourcesResponse(goahttp.ResponseEncoder)
+			assertion.NoError(encode(context.Background(), rec, result))
+			assertion.Equal(tc.expectedStatusCode, rec.Code)
+			if tc.expectETag {
+				assertion.Equal(*result.Etag, rec.Header().Get("ETag"))
+			}
+			if tc.expectNotModified {
+				assertion.Empty(rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestQuerySvcsrvc_ETagPageToken(t *testing.T) {
+	t.Setenv("PAGE_TOKEN_SECRET", "12345678901234567890123456789012") // 32 chars
+	assertion := assert.New(t)
+
+	mockResourceSearcher := mock.NewMockResourceSearcher()
+	service := NewQuerySvc(mockResourceSearcher, mock.NewMockAccessControlChecker(), mock.NewMockOrganizationSearcher(), mock.NewMockAuthService())
+	svc, ok := service.(*querySvcsrvc)
+	assertion.True(ok)
+
+	ctx := context.WithValue(context.Background(), constants.PrincipalContextID, constants.AnonymousPrincipal)
+	payload := &querysvc.QueryResourcesPayload{
+		Version: "1",
+		Type:    stringPtr("project"),
+	}
+	search := func(searchAfter string) *querysvc.QueryResourcesResult {
+		// Each search seals the same sort values into a fresh token
+		pageToken, err := paging.EncodePageToken(searchAfter, global.PageTokenSecret(ctx))
+		assertion.NoError(err)
+		mockResourceSearcher.SetQueryResourcesPageToken(pageToken)
+
+		result, err := svc.QueryResources(ctx, payload)
+		assertion.NoError(err)
+		assertion.NotNil(result.Etag)
+		return result
+	}
+
+	first := search(`["alpha","project:1"]`)
+	second := search(`["alpha","project:1"]`)
+	assertion.NotEqual(*first.PageToken, *second.PageToken)
+	assertion.Equal(*first.Etag, *second.Etag)
+
+	// The next page starting elsewhere is a different result
+	other := search(`["beta","project:2"]`)
+	assertion.NotEqual(*first.Etag, *other.Etag)
+
+	// A fresh token still revalidates the first result
+	pageToken, err := paging.EncodePageToken(`["alpha","project:1"]`, global.PageTokenSecret(ctx))
+	assertion.NoError(err)
+	mockResourceSearcher.SetQueryResourcesPageToken(pageToken)
+	payload.IfNoneMatch = first.Etag
+	result, err := svc.QueryResources(ctx, payload)
+	assertion.NoError(err)
+	assertion.Equal(stringPtr(constants.CacheStatusNotModified), result.CacheStatus)
+}
+
+func TestQuerySvcsrvc_ExplainResource(t *testing.T) {
+	t.Setenv("EXPLAIN_PRINCIPALS", "test-user")
+
+	tests := []struct {
+		name          string
+		principal     string
+		expectedError bool
+		expectedStage string
+	}{
+		{
+			name:          "allowed principal",
+			principal:     "test-user",
+			expectedStage: "returned",
+		},
+		{
+			name:          "principal not allowed",
+			principal:     "other-user",
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			service := NewQuerySvc(mock.NewMockResourceSearcher(), mock.NewMockAccessControlChecker(), mock.NewMockOrganizationSearcher(), mock.NewMockAuthService())
+			svc, ok := service.(*querySvcsrvc)
+			assert.True(t, ok)
+
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, tc.principal)
+			result, err := svc.ExplainResource(ctx, &querysvc.ExplainResourcePayload{
+				Version:   "1",
+				ObjectRef: "project:456",
+				Type:      stringPtr("project"),
+			})
+
+			if tc.expectedError {
+				var notFoundErr *querysvc.NotFoundError
+				assert.ErrorAs(t, err, &notFoundErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "project:456", result.ObjectRef)
+			assert.Equal(t, tc.expectedStage, result.Stage)
+			assert.True(t, result.Found)
+			assert.True(t, result.MatchedCriteria)
+			assert.True(t, result.AccessGranted)
+		})
+	}
+}
+
+func TestQuerySvcsrvc_QueryResourcesInternalError(t *testing.T) {
+	t.Setenv("ERROR_VERBOSITY", constants.ErrorVerbositySafe)
+	mockResourceSearcher := mock.NewMockResourceSearcher()
+	mockResourceSearcher.SetQueryResourcesError(errors.New("opensearch: [illegal_argument_exception] no mapping found for [sort_name]"))
+	service := NewQuerySvc(mockResourceSearcher, mock.NewMockAccessControlChecker(), mock.NewMockOrganizationSearcher(), mock.NewMockAuthService())
+	svc, ok := service.(*querySvcsrvc)
+	assert.True(t, ok)
+
+	ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "test-user")
+	ctx = context.WithValue(ctx, constants.RequestIDHeader, "req-456")
+
+	_, err := svc.QueryResources(ctx, &querysvc.QueryResourcesPayload{Version: "1", Type: stringPtr("project")})
+
+	var internalErr *querysvc.InternalServerError
+	if assert.ErrorAs(t, err, &internalErr) {
+		assert.Contains(t, internalErr.Message, "correlation ID: req-456")
+		assert.NotContains(t, internalErr.Message, "illegal_argument_exception")
+	}
+}
+
+func TestQuerySvcsrvc_QueryResourcesInvalidPayload(t *testing.T) {
+	t.Setenv("PAGE_TOKEN_SECRET", "12345678901234567890123456789012") // 32 chars
+	t.Setenv("PAGE_TOKEN_MAX_SORT_VALUES", "3")
+	ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "test-user")
+	pageToken := func(searchAfter any) *string {
+		token, err := paging.EncodePageToken(searchAfter, global.PageTokenSecret(ctx))
+		assert.NoError(t, err)
+		return &token
+	}
+
+	tests := []struct {
+		name    string
+		payload *querysvc.QueryResourcesPayload
+	}{
+		{name: "malformed page token", payload: &querysvc.QueryResourcesPayload{PageToken: stringPtr("not-a-token")}},
+		{name: "too many sort values", payload: &querysvc.QueryResourcesPayload{PageToken: pageToken([]any{"a", "b", "c", "d"})}},
+		{name: "nested sort value", payload: &querysvc.QueryResourcesPayload{PageToken: pageToken([]any{"board", map[string]any{"query": "x"}})}},
+		{name: "invalid track_total_hits", payload: &querysvc.QueryResourcesPayload{TrackTotalHits: stringPtr("99999999999999999999")}},
+		{name: "template params without a template", payload: &querysvc.QueryResourcesPayload{TemplateParams: []string{"a=1"}}},
+		{name: "duplicate template params", payload: &querysvc.QueryResourcesPayload{Template: stringPtr("custom"), TemplateParams: []string{"a=1", "a=2"}}},
+		{name: "unknown profile", payload: &querysvc.QueryResourcesPayload{Profile: stringPtr("unknown")}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			service := NewQuerySvc(mock.NewMockResourceSearcher(), mock.NewMockAccessControlChecker(), mock.NewMockOrganizationSearcher(), mock.NewMockAuthService())
+
+			tc.payload.Version = "1"
+			tc.payload.Type = stringPtr("project")
+			_, err := service.QueryResources(ctx, tc.payload)
+
+			var badRequest *querysvc.BadRequestError
+			if assert.ErrorAs(t, err, &badRequest) {
+				assert.NotEmpty(t, badRequest.Message)
+			}
+		})
+	}
+}
+
+func TestQuerySvcsrvc_QueryResourcesMaxOrTerms(t *testing.T) {
+	ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "test-user")
+	terms := func(n int) []string {
+		values := make([]string, n)
+		for i := range values {
+			values[i] = fmt.Sprintf("project:%d", i)
+		}
+		return values
+	}
+
+	tests := []struct {
+		name    string
+		payload *querysvc.QueryResourcesPayload
+	}{
+		{name: "too many tags", payload: &querysvc.QueryResourcesPayload{Tags: terms(500)}},
+		{name: "too many parents", payload: &querysvc.QueryResourcesPayload{Parents: terms(constants.DefaultMaxOrTerms + 1)}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			service := NewQuerySvc(mock.NewMockResourceSearcher(), mock.NewMockAccessControlChecker(), mock.NewMockOrganizationSearcher(), mock.NewMockAuthService())
+
+			tc.payload.Version = "1"
+			_, err := service.QueryResources(ctx, tc.payload)
+
+			var badRequest *querysvc.BadRequestError
+			if assert.ErrorAs(t, err, &badRequest) {
+				assert.Contains(t, badRequest.Message, "can be searched at once")
+			}
+		})
+	}
+}
+
+func TestQuerySvcsrvc_QueryResourcesIndexNotFound(t *testing.T) {
+	mockResourceSearcher := mock.NewMockResourceSearcher()
+	mockResourceSearcher.SetQueryResourcesError(pkgerrors.NewServiceUnavailable("index lfx-resources not found"))
+	service := NewQuerySvc(mockResourceSearcher, mock.NewMockAccessControlChecker(), mock.NewMockOrganizationSearcher(), mock.NewMockAuthService())
+	svc, ok := service.(*querySvcsrvc)
+	assert.True(t, ok)
+
+	ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "test-user")
+	_, err := svc.QueryResources(ctx, &querysvc.QueryResourcesPayload{Version: "1", Type: stringPtr("project")})
+
+	var unavailableErr *querysvc.ServiceUnavailableError
+	if assert.ErrorAs(t, err, &unavailableErr) {
+		assert.Equal(t, "index lfx-resources not found", unavailableErr.Message)
+	}
+}
+
+func TestQuerySvcsrvc_FlushCache(t *testing.T) {
+	t.Setenv("PRINCIPAL_CACHE_TTL", "1m")
+	t.Setenv("CACHE_ADMIN_PRINCIPALS", "admin-user")
+	t.Setenv("CACHE_WARM_QUERIES", `[{"principal": "test-user", "type": "project"}, {"principal": "test-user", "profile": "unknown"}]`)
+
+	service := NewQuerySvc(mock.NewMockResourceSearcher(), mock.NewMockAccessControlChecker(), mock.NewMockOrganizationSearcher(), mock.NewMockAuthService())
+	svc, ok := service.(*querySvcsrvc)
+	assert.True(t, ok)
+
+	adminCtx := context.WithValue(context.Background(), constants.PrincipalContextID, "admin-user")
+	userCtx := context.WithValue(context.Background(), constants.PrincipalContextID, "test-user")
+
+	// Only cache admins can flush
+	_, err := svc.FlushCache(userCtx, &querysvc.FlushCachePayload{Version: "1"})
+	var notFoundErr *querysvc.NotFoundError
+	assert.ErrorAs(t, err, &notFoundErr)
+
+	_, err = svc.QueryResources(userCtx, &querysvc.QueryResourcesPayload{Version: "1", Type: stringPtr("project")})
+	assert.NoError(t, err)
+
+	result, err := svc.FlushCache(adminCtx, &querysvc.FlushCachePayload{Version: "1", Warm: true})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.Flushed)
+	// The query with an unknown profile is skipped
+	assert.Equal(t, 1, result.Warmed)
+
+	// The warm-up cached the query again
+	result, err = svc.FlushCache(adminCtx, &querysvc.FlushCachePayload{Version: "1", Warm: false})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.Flushed)
+	assert.Equal(t, 0, result.Warmed)
+}
+
 func TestQuerySvcsrvc_QueryOrgs(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -452,6 +1142,131 @@ func TestQuerySvcsrvc_SuggestOrgs(t *testing.T) {
 	}
 }
 
+func TestQuerySvcsrvc_UnifiedSearch(t *testing.T) {
+	tests := []struct {
+		name                    string
+		setupMocks              func(*mock.MockResourceSearcher, *mock.MockOrganizationSearcher)
+		expectedError           bool
+		expectedErrorType       interface{}
+		expectedOrganizations   int
+		expectedOrgsUnavailable bool
+	}{
+		{
+			name:                  "both sections populated",
+			setupMocks:            func(*mock.MockResourceSearcher, *mock.MockOrganizationSearcher) {},
+			expectedOrganizations: 1,
+		},
+		{
+			name: "organization failure is reported",
+			setupMocks: func(_ *mock.MockResourceSearcher, orgSearcher *mock.MockOrganizationSearcher) {
+				orgSearcher.SetSuggestOrganizationsError(errors.New("clearbit timeout"))
+			},
+			expectedOrgsUnavailable: true,
+		},
+		{
+			name: "resource failure is an error",
+			setupMocks: func(resourceSearcher *mock.MockResourceSearcher, _ *mock.MockOrganizationSearcher) {
+				resourceSearcher.SetQueryResourcesError(errors.New("opensearch timeout"))
+			},
+			expectedError:     true,
+			expectedErrorType: &querysvc.InternalServerError{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockResourceSearcher := mock.NewMockResourceSearcher()
+			mockOrgSearcher := mock.NewMockOrganizationSearcher()
+			tc.setupMocks(mockResourceSearcher, mockOrgSearcher)
+
+			service := NewQuerySvc(mockResourceSearcher, mock.NewMockAccessControlChecker(), mockOrgSearcher, mock.NewMockAuthService())
+			svc, ok := service.(*querySvcsrvc)
+			assert.True(t, ok)
+
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "test-user")
+
+			// Execute
+			result, err := svc.UnifiedSearch(ctx, &querysvc.UnifiedSearchPayload{Version: "1", Query: "linux"})
+
+			// Verify
+			if tc.expectedError {
+				assert.Error(t, err)
+				assert.IsType(t, tc.expectedErrorType, err)
+				assert.Nil(t, result)
+				return
+			}
+			assert.NoError(t, err)
+			if assert.NotNil(t, result) {
+				assert.NotNil(t, result.Resources)
+				assert.Len(t, result.Organizations, tc.expectedOrganizations)
+				for _, organization := range result.Organizations {
+					assert.Equal(t, "organization", organization.Kind)
+				}
+				assert.Equal(t, tc.expectedOrgsUnavailable, result.OrganizationsUnavailable)
+			}
+		})
+	}
+}
+
+func TestQuerySvcsrvc_Suggest(t *testing.T) {
+	tests := []struct {
+		name          string
+		kinds         []string
+		expectedKinds []string
+		expectedError bool
+	}{
+		{
+			name:          "mock fixtures of both kinds",
+			expectedKinds: []string{"resource", "organization"},
+		},
+		{
+			name:          "organizations only",
+			kinds:         []string{"organization"},
+			expectedKinds: []string{"organization"},
+		},
+		{
+			name:          "unsupported kind",
+			kinds:         []string{"meeting"},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			service := NewQuerySvc(mock.NewMockResourceSearcher(), mock.NewMockAccessControlChecker(), mock.NewMockOrganizationSearcher(), mock.NewMockAuthService())
+			svc, ok := service.(*querySvcsrvc)
+			assert.True(t, ok)
+
+			// "security" matches both resource and organization fixtures
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "user123")
+			result, err := svc.Suggest(ctx, &querysvc.SuggestPayload{
+				Version: "1",
+				Query:   "security",
+				Kind:    tc.kinds,
+			})
+
+			if tc.expectedError {
+				assert.IsType(t, &querysvc.BadRequestError{}, err)
+				return
+			}
+			assert.NoError(t, err)
+			if !assert.NotNil(t, result) {
+				return
+			}
+			kinds := []string{}
+			for _, suggestion := range result.Suggestions {
+				if !slices.Contains(kinds, suggestion.Kind) {
+					kinds = append(kinds, suggestion.Kind)
+				}
+				assert.NotEmpty(t, suggestion.Name)
+				assert.Equal(t, suggestion.Kind == "resource", suggestion.Resource != nil)
+				assert.Equal(t, suggestion.Kind == "organization", suggestion.Organization != nil)
+			}
+			assert.Equal(t, tc.expectedKinds, kinds)
+		})
+	}
+}
+
 func TestQuerySvcsrvc_Readyz(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -43,30 +43,139 @@ var _ = dsl.Service("query-svc", func() {
 				dsl.Example("gov board")
 				dsl.MinLength(1)
 			})
+			dsl.Attribute("name_exact", dsl.String, "Whole resource name, matched exactly but regardless of case", func() {
+				dsl.Example("Governing Board")
+				dsl.MinLength(1)
+			})
 			dsl.Attribute("parent", dsl.String, "Parent (for navigation; varies by object type)", func() {
 				dsl.Example("project:123")
 				dsl.Pattern(`^[a-zA-Z]+:[a-zA-Z0-9_-]+$`)
 			})
+			dsl.Attribute("parents", dsl.ArrayOf(dsl.String, func() {
+				dsl.Pattern(`^[a-zA-Z]+:[a-zA-Z0-9_-]+$`)
+			}), "Parents to search with OR logic - matches resources under any of these parents, or under parent when also given", func() {
+				dsl.Example([]string{"project:123", "project:456"})
+			})
+			dsl.Attribute("search_scope", dsl.String, "Fields the name search matches: names only, or descriptions as well; defaults to the deployment setting", func() {
+				dsl.Enum("name", "all")
+				dsl.Example("name")
+			})
 			dsl.Attribute("type", dsl.String, "Resource type to search", func() {
 				dsl.Example("committee")
 			})
 			dsl.Attribute("tags", dsl.ArrayOf(dsl.String), "Tags to search with OR logic - matches resources with any of these tags", func() {
 				dsl.Example([]string{"active", "public"})
 			})
+			dsl.Attribute("tags_min_match", dsl.Int, "Minimum number of tags a resource must match; defaults to 1 and may not exceed the number of tags", func() {
+				dsl.Minimum(1)
+				dsl.Example(2)
+			})
 			dsl.Attribute("tags_all", dsl.ArrayOf(dsl.String), "Tags to search with AND logic - matches resources that have all of these tags", func() {
 				dsl.Example([]string{"governance", "security"})
 			})
+			dsl.Attribute("created_by", dsl.String, "Principal who created the resource; only narrows the results, access control still applies", func() {
+				dsl.Example("user:jdoe")
+			})
+			dsl.Attribute("updated_by", dsl.String, "Principal who last updated the resource; only narrows the results, access control still applies", func() {
+				dsl.Example("user:jdoe")
+			})
+			dsl.Attribute("transaction_id", dsl.String, "Transaction that last wrote the resource, e.g. to reconcile after an update event", func() {
+				dsl.Example("9a7c6b1e-2f4d-4c1a-8e3b-5d6f7a8b9c0d")
+			})
+			dsl.Attribute("exclude_id", dsl.ArrayOf(dsl.String), "Resource IDs to leave out of the results; may be repeated", func() {
+				dsl.Example([]string{"123", "456"})
+			})
+			dsl.Attribute("include_score", dsl.Boolean, "Include the relevance score of each resource in the response", func() {
+				dsl.Default(false)
+				dsl.Example(true)
+			})
+			dsl.Attribute("include_child_counts", dsl.Boolean, "Include the number of children of each resource, by child type", func() {
+				dsl.Default(false)
+				dsl.Example(true)
+			})
+			dsl.Attribute("include_permissions", dsl.Boolean, "Include whether the caller has each configured relation beyond viewing (e.g. edit) on each resource", func() {
+				dsl.Default(false)
+				dsl.Example(true)
+			})
+			dsl.Attribute("collapse_by", dsl.String, "Field whose duplicates are collapsed, returning only the first resource of each group sharing its value", func() {
+				dsl.Enum("object_ref", "slug")
+				dsl.Example("slug")
+			})
+			dsl.Attribute("include_ancestry", dsl.Boolean, "Include the references of the ancestors of each resource (e.g. its project and committee), for breadcrumbs", func() {
+				dsl.Default(false)
+				dsl.Example(true)
+			})
+			dsl.Attribute("wait_for_refresh", dsl.Boolean, "Wait for recent writes to become searchable before searching, at the cost of latency; reserved to privileged callers", func() {
+				dsl.Default(false)
+				dsl.Example(true)
+			})
+			dsl.Attribute("suggest_on_empty", dsl.Boolean, "When a name search finds nothing, return alternative names (\"did you mean\") in suggestions", func() {
+				dsl.Default(false)
+				dsl.Example(true)
+			})
+			dsl.Attribute("recency_boost", dsl.Boolean, "Rank recently updated resources higher, their boost halving over the deployment's recency boost scale (30 days unless configured); affects relevance only, not the requested sort order", func() {
+				dsl.Default(false)
+				dsl.Example(true)
+			})
+			dsl.Attribute("template", dsl.String, "Name of a server-side search template whose query narrows the search", func() {
+				dsl.Pattern(`^[a-zA-Z0-9_-]+$`)
+				dsl.Example("active_committees")
+			})
+			dsl.Attribute("template_params", dsl.ArrayOf(dsl.String, func() {
+				dsl.Pattern(`^[a-zA-Z0-9_]+=`)
+			}), "Parameters of the search template, as name=value", func() {
+				dsl.Example([]string{"status=active"})
+			})
+			dsl.Attribute("profile", dsl.String, "Named search profile whose settings (sort, search scope, fuzziness, recency boost) apply unless overridden by explicit parameters", func() {
+				dsl.Example("directory")
+			})
+			dsl.Attribute("view", dsl.String, "Shape of the resource data: default returns it as indexed, public strips fields prefixed with _ and internal fields", func() {
+				dsl.Example("public")
+			})
+			dsl.Attribute("if_none_match", dsl.String, "ETag of a previously returned anonymous result, to revalidate it", func() {
+				dsl.Example(`"5d41402abc4b2a76b9719d911017c592"`)
+			})
+			dsl.Attribute("track_total_hits", dsl.String, "How accurately the total of an anonymous search is counted: true counts every hit, false disables counting, and a number counts hits exactly up to it; defaults to the deployment setting", func() {
+				dsl.Pattern(`^(true|false|[0-9]+)$`)
+				dsl.Example("true")
+			})
 			dsl.Required("bearer_token", "version")
 		})
 
 		dsl.Result(func() {
 			dsl.Attribute("resources", dsl.ArrayOf(Resource), "Resources found", func() {})
+			dsl.Attribute("total", dsl.Int, "Total number of resources found; only reported for anonymous searches, whose hits need no access check", func() {
+				dsl.Example(42)
+			})
+			dsl.Attribute("total_relation", dsl.String, "Whether total is exact (eq) or a lower bound (gte)", func() {
+				dsl.Enum("eq", "gte")
+				dsl.Example("eq")
+			})
 			dsl.Attribute("page_token", dsl.String, "Opaque token if more results are available", func() {
 				dsl.Example("****")
 			})
+			dsl.Attribute("suggestions", dsl.ArrayOf(dsl.String), "Alternative names for a name search that found nothing, when suggest_on_empty is set", func() {
+				dsl.Example([]string{"Governing Board"})
+			})
 			dsl.Attribute("cache_control", dsl.String, "Cache control header", func() {
 				dsl.Example("public, max-age=300")
 			})
+			dsl.Attribute("public_path", dsl.Boolean, "True when the anonymous public-only path served the result (only reported when enabled)", func() {
+				dsl.Example(true)
+			})
+			dsl.Attribute("degraded", dsl.Boolean, "True when the fallback search source served the result, as the primary one failed", func() {
+				dsl.Example(true)
+			})
+			dsl.Attribute("link", dsl.String, "RFC 8288 link to the next page, if more results are available", func() {
+				dsl.Example(`</query/resources?v=1&type=committee&page_token=****>; rel="next"`)
+			})
+			dsl.Attribute("etag", dsl.String, "Entity tag of the result; only set for anonymous results", func() {
+				dsl.Example(`"5d41402abc4b2a76b9719d911017c592"`)
+			})
+			dsl.Attribute("cache_status", dsl.String, "Set to \"not-modified\" when the If-None-Match ETag still matches", func() {
+				dsl.Enum("not-modified")
+				dsl.Example("not-modified")
+			})
 			dsl.Required("resources")
 		})
 
@@ -74,15 +183,47 @@ var _ = dsl.Service("query-svc", func() {
 			dsl.GET("/query/resources")
 			dsl.Param("version:v")
 			dsl.Param("name")
+			dsl.Param("name_exact")
 			dsl.Param("parent")
+			dsl.Param("parents")
+			dsl.Param("search_scope")
 			dsl.Param("type")
 			dsl.Param("tags")
+			dsl.Param("tags_min_match")
 			dsl.Param("tags_all")
+			dsl.Param("created_by")
+			dsl.Param("updated_by")
+			dsl.Param("transaction_id")
+			dsl.Param("exclude_id")
+			dsl.Param("include_score")
+			dsl.Param("include_child_counts")
+			dsl.Param("include_permissions")
+			dsl.Param("include_ancestry")
+			dsl.Param("collapse_by")
+			dsl.Param("wait_for_refresh")
+			dsl.Param("suggest_on_empty")
+			dsl.Param("recency_boost")
+			dsl.Param("profile")
+			dsl.Param("view")
+			dsl.Param("template")
+			dsl.Param("template_params")
 			dsl.Param("sort")
 			dsl.Param("page_token")
+			dsl.Param("track_total_hits")
 			dsl.Header("bearer_token:Authorization")
+			dsl.Header("if_none_match:If-None-Match")
+			dsl.Response(dsl.StatusNotModified, func() {
+				dsl.Tag("cache_status", "not-modified")
+				dsl.Header("cache_control:Cache-Control")
+				dsl.Header("etag:ETag")
+				dsl.Body(dsl.Empty)
+			})
 			dsl.Response(dsl.StatusOK, func() {
 				dsl.Header("cache_control:Cache-Control")
+				dsl.Header("public_path:X-Public-Path")
+				dsl.Header("degraded:X-Degraded")
+				dsl.Header("link:Link")
+				dsl.Header("etag:ETag")
 			})
 			dsl.Response("BadRequest", dsl.StatusBadRequest)
 			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
@@ -120,6 +261,10 @@ var _ = dsl.Service("query-svc", func() {
 			dsl.Attribute("tags_all", dsl.ArrayOf(dsl.String), "Tags to search with AND logic - matches resources that have all of these tags", func() {
 				dsl.Example([]string{"governance", "security"})
 			})
+			dsl.Attribute("include_relations", dsl.Boolean, "Break the count of private resources down by the relation granting access to them", func() {
+				dsl.Default(false)
+				dsl.Example(true)
+			})
 			dsl.Required("bearer_token", "version")
 		})
 
@@ -127,13 +272,28 @@ var _ = dsl.Service("query-svc", func() {
 			dsl.Attribute("count", dsl.UInt64, "Count of resources found", func() {
 				dsl.Example(1234)
 			})
+			dsl.Attribute("visible_count", dsl.UInt64, "Count of resources found that the principal may access, the same as count", func() {
+				dsl.Example(1234)
+			})
+			dsl.Attribute("total_count", dsl.UInt64, "Count of resources found before the access checks, including those the principal may not access (only reported to the allowed principals)", func() {
+				dsl.Example(1300)
+			})
 			dsl.Attribute("has_more", dsl.Boolean, "True if count is not guaranteed to be exhaustive: client should request a narrower query", func() {
 				dsl.Example(false)
 			})
 			dsl.Attribute("cache_control", dsl.String, "Cache control header", func() {
 				dsl.Example("public, max-age=300")
 			})
-			dsl.Required("count", "has_more")
+			dsl.Attribute("public_path", dsl.Boolean, "True when the anonymous public-only path served the result (only reported when enabled)", func() {
+				dsl.Example(true)
+			})
+			dsl.Attribute("degraded", dsl.Boolean, "True when the fallback search source served the result, as the primary one failed", func() {
+				dsl.Example(true)
+			})
+			dsl.Attribute("relation_counts", dsl.MapOf(dsl.String, dsl.UInt64), "Count of private resources by the relation granting access to them, when include_relations is set", func() {
+				dsl.Example(map[string]uint64{"viewer": 8, "member": 3})
+			})
+			dsl.Required("count", "visible_count", "has_more")
 		})
 
 		dsl.HTTP(func() {
@@ -144,9 +304,12 @@ var _ = dsl.Service("query-svc", func() {
 			dsl.Param("type")
 			dsl.Param("tags")
 			dsl.Param("tags_all")
+			dsl.Param("include_relations")
 			dsl.Header("bearer_token:Authorization")
 			dsl.Response(dsl.StatusOK, func() {
 				dsl.Header("cache_control:Cache-Control")
+				dsl.Header("public_path:X-Public-Path")
+				dsl.Header("degraded:X-Degraded")
 			})
 			dsl.Response("BadRequest", dsl.StatusBadRequest)
 			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
@@ -154,6 +317,231 @@ var _ = dsl.Service("query-svc", func() {
 		})
 	})
 
+	dsl.Method("query-resources-count-batch", func() {
+		dsl.Description("Count matching resources for several queries at once. A failing query does not fail the batch: its item reports the error instead.")
+
+		dsl.Security(JWTAuth)
+
+		dsl.Payload(func() {
+			dsl.Token("bearer_token", dsl.String, func() {
+				dsl.Description("JWT token issued by Heimdall")
+				dsl.Example("eyJhbGci...")
+			})
+			dsl.Attribute("version", dsl.String, "Version of the API", func() {
+				dsl.Enum("1")
+				dsl.Example("1")
+			})
+			dsl.Attribute("queries", dsl.ArrayOf(CountQuery), "Count queries", func() {
+				dsl.MinLength(1)
+				dsl.MaxLength(20)
+			})
+			dsl.Required("bearer_token", "version", "queries")
+		})
+
+		dsl.Result(func() {
+			dsl.Attribute("items", dsl.ArrayOf(CountItem), "Count outcomes, in the same order as the queries", func() {})
+			dsl.Required("items")
+		})
+
+		dsl.HTTP(func() {
+			dsl.POST("/query/resources/count/batch")
+			dsl.Param("version:v")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
+	dsl.Method("query-resources-facets", func() {
+		dsl.Description("Break the matching resources down by the values of one or more fields, counting only the resources the principal can access.")
+
+		dsl.Security(JWTAuth)
+
+		dsl.Payload(func() {
+			dsl.Token("bearer_token", dsl.String, func() {
+				dsl.Description("JWT token issued by Heimdall")
+				dsl.Example("eyJhbGci...")
+			})
+			dsl.Attribute("version", dsl.String, "Version of the API", func() {
+				dsl.Enum("1")
+				dsl.Example("1")
+			})
+			dsl.Attribute("facet", dsl.ArrayOf(dsl.String, func() {
+				dsl.Enum("type", "tags", "status")
+			}), "Fields to break the resources down by", func() {
+				dsl.Example([]string{"type", "tags"})
+				dsl.MinLength(1)
+			})
+			dsl.Attribute("name", dsl.String, "Resource name or alias; supports typeahead", func() {
+				dsl.Example("gov board")
+				dsl.MinLength(1)
+			})
+			dsl.Attribute("parent", dsl.String, "Parent (for navigation; varies by object type)", func() {
+				dsl.Example("project:123")
+			})
+			dsl.Attribute("type", dsl.String, "Resource type to search", func() {
+				dsl.Example("committee")
+			})
+			dsl.Attribute("tags", dsl.ArrayOf(dsl.String), "Tags to search with OR logic - matches resources with any of these tags", func() {
+				dsl.Example([]string{"active", "public"})
+			})
+			dsl.Attribute("tags_all", dsl.ArrayOf(dsl.String), "Tags to search with AND logic - matches resources that have all of these tags", func() {
+				dsl.Example([]string{"governance", "security"})
+			})
+			dsl.Attribute("include_total", dsl.Boolean, "Also count the matching resources the principal can access", func() {
+				dsl.Default(false)
+			})
+			dsl.Required("bearer_token", "version", "facet")
+		})
+
+		dsl.Result(func() {
+			dsl.Attribute("facets", dsl.MapOf(dsl.String, dsl.ArrayOf(FacetValue)), "Values of each requested field, by descending count", func() {
+				dsl.Example(map[string][]map[string]any{
+					"type": {{"value": "committee", "count": 12}, {"value": "meeting", "count": 4}},
+				})
+			})
+			dsl.Attribute("total", dsl.UInt64, "Number of matching resources the principal can access, when include_total is set", func() {
+				dsl.Example(16)
+			})
+			dsl.Attribute("total_relation", dsl.String, "Whether total is exact (eq) or a lower bound (gte), when include_total is set", func() {
+				dsl.Enum("eq", "gte")
+				dsl.Example("eq")
+			})
+			dsl.Attribute("cache_control", dsl.String, "Cache control header", func() {
+				dsl.Example("public, max-age=300")
+			})
+			dsl.Attribute("public_path", dsl.Boolean, "True when the anonymous public-only path served the result (only reported when enabled)", func() {
+				dsl.Example(true)
+			})
+			dsl.Required("facets")
+		})
+
+		dsl.HTTP(func() {
+			dsl.GET("/query/resources/facets")
+			dsl.Param("version:v")
+			dsl.Param("facet")
+			dsl.Param("name")
+			dsl.Param("parent")
+			dsl.Param("type")
+			dsl.Param("tags")
+			dsl.Param("tags_all")
+			dsl.Param("include_total")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK, func() {
+				dsl.Header("cache_control:Cache-Control")
+				dsl.Header("public_path:X-Public-Path")
+			})
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
+	dsl.Method("explain-resource", func() {
+		dsl.Description("Explain why a resource search does or doesn't return a resource. Only available to the principals configured for debugging.")
+
+		dsl.Security(JWTAuth)
+
+		dsl.Payload(func() {
+			dsl.Token("bearer_token", dsl.String, func() {
+				dsl.Description("JWT token issued by Heimdall")
+				dsl.Example("eyJhbGci...")
+			})
+			dsl.Attribute("version", dsl.String, "Version of the API", func() {
+				dsl.Enum("1")
+				dsl.Example("1")
+			})
+			dsl.Attribute("object_ref", dsl.String, "Reference of the resource to explain", func() {
+				dsl.Example("committee:123")
+				dsl.Pattern(`^[a-zA-Z]+:[a-zA-Z0-9_-]+$`)
+			})
+			dsl.Attribute("name", dsl.String, "Resource name or alias; supports typeahead", func() {
+				dsl.Example("gov board")
+				dsl.MinLength(1)
+			})
+			dsl.Attribute("parent", dsl.String, "Parent (for navigation; varies by object type)", func() {
+				dsl.Example("project:123")
+				dsl.Pattern(`^[a-zA-Z]+:[a-zA-Z0-9_-]+$`)
+			})
+			dsl.Attribute("type", dsl.String, "Resource type to search", func() {
+				dsl.Example("committee")
+			})
+			dsl.Attribute("tags", dsl.ArrayOf(dsl.String), "Tags to search with OR logic - matches resources with any of these tags", func() {
+				dsl.Example([]string{"active", "public"})
+			})
+			dsl.Attribute("tags_all", dsl.ArrayOf(dsl.String), "Tags to search with AND logic - matches resources that have all of these tags", func() {
+				dsl.Example([]string{"governance", "security"})
+			})
+			dsl.Attribute("created_by", dsl.String, "Principal who created the resource", func() {
+				dsl.Example("user:jdoe")
+			})
+			dsl.Required("bearer_token", "version", "object_ref")
+		})
+
+		dsl.Result(ResourceExplanation)
+
+		dsl.HTTP(func() {
+			dsl.GET("/query/resources/explain")
+			dsl.Param("version:v")
+			dsl.Param("object_ref")
+			dsl.Param("name")
+			dsl.Param("parent")
+			dsl.Param("type")
+			dsl.Param("tags")
+			dsl.Param("tags_all")
+			dsl.Param("created_by")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("NotFound", dsl.StatusNotFound)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
+	dsl.Method("check-tuples", func() {
+		dsl.Description("Check a list of access check tuples and return the answer of each, to debug access issues. Only available to the principals configured for debugging.")
+
+		dsl.Security(JWTAuth)
+
+		dsl.Payload(func() {
+			dsl.Token("bearer_token", dsl.String, func() {
+				dsl.Description("JWT token issued by Heimdall")
+				dsl.Example("eyJhbGci...")
+			})
+			dsl.Attribute("version", dsl.String, "Version of the API", func() {
+				dsl.Enum("1")
+				dsl.Example("1")
+			})
+			dsl.Attribute("tuples", dsl.ArrayOf(dsl.String), "Access check tuples, as <object>#<relation>@<user>", func() {
+				dsl.Example([]string{"committee:123#viewer@user:jdoe", "project:456#writer@user:jdoe"})
+				dsl.MinLength(1)
+				dsl.MaxLength(100)
+			})
+			dsl.Required("bearer_token", "version", "tuples")
+		})
+
+		dsl.Result(func() {
+			dsl.Attribute("results", dsl.MapOf(dsl.String, dsl.String), "Answer of the access checker for each tuple", func() {
+				dsl.Example(map[string]string{"committee:123#viewer@user:jdoe": "true", "project:456#writer@user:jdoe": "false"})
+			})
+			dsl.Required("results")
+		})
+
+		dsl.HTTP(func() {
+			dsl.POST("/query/access/check")
+			dsl.Param("version:v")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("NotFound", dsl.StatusNotFound)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
 	dsl.Method("query-orgs", func() {
 		dsl.Description("Locate a single organization by name or domain.")
 
@@ -176,6 +564,14 @@ var _ = dsl.Service("query-svc", func() {
 				dsl.Example("linuxfoundation.org")
 				dsl.Pattern(`^[a-zA-Z0-9][a-zA-Z0-9-_.]*[a-zA-Z0-9]*\.[a-zA-Z]{2,}$`)
 			})
+			dsl.Attribute("domains", dsl.ArrayOf(dsl.String), "Alternative organization domains or website URLs, any of which may match; the organization of the first one that has one is returned", func() {
+				dsl.Example([]string{"linuxfoundation.org", "linux.com"})
+				dsl.MaxLength(100)
+			})
+			dsl.Attribute("match_all", dsl.Boolean, "Require both name and domain to match the same organization", func() {
+				dsl.Default(false)
+				dsl.Example(true)
+			})
 			dsl.Required("bearer_token", "version")
 		})
 
@@ -186,6 +582,8 @@ var _ = dsl.Service("query-svc", func() {
 			dsl.Param("version:v")
 			dsl.Param("name")
 			dsl.Param("domain")
+			dsl.Param("domains")
+			dsl.Param("match_all")
 			dsl.Header("bearer_token:Authorization")
 			dsl.Response(dsl.StatusOK)
 			dsl.Response("BadRequest", dsl.StatusBadRequest)
@@ -195,6 +593,149 @@ var _ = dsl.Service("query-svc", func() {
 		})
 	})
 
+	dsl.Method("query-orgs-list", func() {
+		dsl.Description("List the organizations matching a name fragment or domain, ordered by relevance.")
+
+		dsl.Security(JWTAuth)
+
+		dsl.Payload(func() {
+			dsl.Token("bearer_token", dsl.String, func() {
+				dsl.Description("Token")
+				dsl.Example("eyJhbGci...")
+			})
+			dsl.Attribute("version", dsl.String, "Version of the API", func() {
+				dsl.Enum("1")
+				dsl.Example("1")
+			})
+			dsl.Attribute("name", dsl.String, "Organization name or name fragment", func() {
+				dsl.Example("Linux")
+				dsl.MinLength(1)
+			})
+			dsl.Attribute("domain", dsl.String, "Organization domain or website URL", func() {
+				dsl.Example("linuxfoundation.org")
+				dsl.Pattern(`^[a-zA-Z0-9][a-zA-Z0-9-_.]*[a-zA-Z0-9]*\.[a-zA-Z]{2,}$`)
+			})
+			dsl.Attribute("match_all", dsl.Boolean, "Require both name and domain to match the same organization", func() {
+				dsl.Default(false)
+				dsl.Example(true)
+			})
+			dsl.Attribute("page_token", dsl.String, "Opaque token for pagination", func() {
+				dsl.Example("****")
+			})
+			dsl.Required("bearer_token", "version")
+		})
+
+		dsl.Result(func() {
+			dsl.Attribute("organizations", dsl.ArrayOf(Organization), "Organizations found, ordered by relevance", func() {})
+			dsl.Attribute("page_token", dsl.String, "Opaque token if more results are available", func() {
+				dsl.Example("****")
+			})
+			dsl.Required("organizations")
+		})
+
+		dsl.HTTP(func() {
+			dsl.GET("/query/orgs/list")
+			dsl.Param("version:v")
+			dsl.Param("name")
+			dsl.Param("domain")
+			dsl.Param("match_all")
+			dsl.Param("page_token")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
+	dsl.Method("count-orgs", func() {
+		dsl.Description("Count the organizations matching a name fragment or domain, or all of them when neither is given.")
+
+		dsl.Security(JWTAuth)
+
+		dsl.Payload(func() {
+			dsl.Token("bearer_token", dsl.String, func() {
+				dsl.Description("Token")
+				dsl.Example("eyJhbGci...")
+			})
+			dsl.Attribute("version", dsl.String, "Version of the API", func() {
+				dsl.Enum("1")
+				dsl.Example("1")
+			})
+			dsl.Attribute("name", dsl.String, "Organization name or name fragment", func() {
+				dsl.Example("Linux")
+				dsl.MinLength(1)
+			})
+			dsl.Attribute("domain", dsl.String, "Organization domain or website URL", func() {
+				dsl.Example("linuxfoundation.org")
+				dsl.Pattern(`^[a-zA-Z0-9][a-zA-Z0-9-_.]*[a-zA-Z0-9]*\.[a-zA-Z]{2,}$`)
+			})
+			dsl.Attribute("match_all", dsl.Boolean, "Require both name and domain to match the same organization", func() {
+				dsl.Default(false)
+				dsl.Example(true)
+			})
+			dsl.Required("bearer_token", "version")
+		})
+
+		dsl.Result(func() {
+			dsl.Attribute("count", dsl.Int, "Number of organizations found", func() {
+				dsl.Example(42)
+			})
+			dsl.Required("count")
+		})
+
+		dsl.HTTP(func() {
+			dsl.GET("/query/orgs/count")
+			dsl.Param("version:v")
+			dsl.Param("name")
+			dsl.Param("domain")
+			dsl.Param("match_all")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
+	dsl.Method("resolve-orgs", func() {
+		dsl.Description("Resolve a batch of domains or website URLs to their organizations in one call.")
+
+		dsl.Security(JWTAuth)
+
+		dsl.Payload(func() {
+			dsl.Token("bearer_token", dsl.String, func() {
+				dsl.Description("Token")
+				dsl.Example("eyJhbGci...")
+			})
+			dsl.Attribute("version", dsl.String, "Version of the API", func() {
+				dsl.Enum("1")
+				dsl.Example("1")
+			})
+			dsl.Attribute("domains", dsl.ArrayOf(dsl.String), "Domains or website URLs to resolve", func() {
+				dsl.Example([]string{"linuxfoundation.org", "https://www.example.com/about"})
+				dsl.MinLength(1)
+				dsl.MaxLength(100)
+			})
+			dsl.Required("bearer_token", "version", "domains")
+		})
+
+		dsl.Result(func() {
+			dsl.Attribute("organizations", dsl.ArrayOf(ResolvedOrganization), "Resolution of each domain, in the same order as the request", func() {})
+			dsl.Required("organizations")
+		})
+
+		dsl.HTTP(func() {
+			dsl.POST("/query/orgs/resolve")
+			dsl.Param("version:v")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
 	dsl.Method("suggest-orgs", func() {
 		dsl.Description("Get organization suggestions for typeahead search based on a query.")
 
@@ -233,6 +774,140 @@ var _ = dsl.Service("query-svc", func() {
 		})
 	})
 
+	dsl.Method("unified-search", func() {
+		dsl.Description("Search resources and organizations in one call, e.g. for a global search bar.")
+
+		dsl.Security(JWTAuth)
+
+		dsl.Payload(func() {
+			dsl.Token("bearer_token", dsl.String, func() {
+				dsl.Description("Token")
+				dsl.Example("eyJhbGci...")
+			})
+			dsl.Attribute("version", dsl.String, "Version of the API", func() {
+				dsl.Enum("1")
+				dsl.Example("1")
+			})
+			dsl.Attribute("query", dsl.String, "Search query, matched against resource names and organizations", func() {
+				dsl.Example("linux")
+				dsl.MinLength(1)
+			})
+			dsl.Required("bearer_token", "version", "query")
+		})
+
+		dsl.Result(func() {
+			dsl.Attribute("resources", dsl.ArrayOf(Resource), "Resources found, limited to the section size", func() {})
+			dsl.Attribute("organizations", dsl.ArrayOf(OrganizationSuggestion), "Organizations suggested, limited to the section size", func() {})
+			dsl.Attribute("organizations_unavailable", dsl.Boolean, "True when the organization search failed and its section is empty", func() {
+				dsl.Example(false)
+			})
+			dsl.Required("resources", "organizations", "organizations_unavailable")
+		})
+
+		dsl.HTTP(func() {
+			dsl.GET("/query/search")
+			dsl.Param("version:v")
+			dsl.Param("query")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
+	dsl.Method("suggest", func() {
+		dsl.Description("Suggest resources and organizations matching a query in a single list ordered by relevance, e.g. for a typeahead.")
+
+		dsl.Security(JWTAuth)
+
+		dsl.Payload(func() {
+			dsl.Token("bearer_token", dsl.String, func() {
+				dsl.Description("Token")
+				dsl.Example("eyJhbGci...")
+			})
+			dsl.Attribute("version", dsl.String, "Version of the API", func() {
+				dsl.Enum("1")
+				dsl.Example("1")
+			})
+			dsl.Attribute("query", dsl.String, "Search query, matched against resource names and organizations", func() {
+				dsl.Example("linux")
+				dsl.MinLength(1)
+			})
+			dsl.Attribute("kind", dsl.ArrayOf(dsl.String, func() {
+				dsl.Enum("resource", "organization")
+			}), "Kinds of suggestions to return; may be repeated (default: all kinds)", func() {
+				dsl.Example([]string{"organization"})
+			})
+			dsl.Required("bearer_token", "version", "query")
+		})
+
+		dsl.Result(func() {
+			dsl.Attribute("suggestions", dsl.ArrayOf(Suggestion), "Suggestions of the requested kinds, by relevance", func() {})
+			dsl.Attribute("organizations_unavailable", dsl.Boolean, "True when the organization suggestions failed and are left out", func() {
+				dsl.Example(false)
+			})
+			dsl.Required("suggestions", "organizations_unavailable")
+		})
+
+		dsl.HTTP(func() {
+			dsl.GET("/query/suggest")
+			dsl.Param("version:v")
+			dsl.Param("query")
+			dsl.Param("kind")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
+	dsl.Method("flush-cache", func() {
+		dsl.Description("Clear the cached search results, e.g. after a reindex, and warm the cache up again with the configured queries. Only available to the cache admin principals.")
+		dsl.Meta("swagger:generate", "false")
+
+		dsl.Security(JWTAuth)
+
+		dsl.Payload(func() {
+			dsl.Token("bearer_token", dsl.String, func() {
+				dsl.Description("JWT token issued by Heimdall")
+				dsl.Example("eyJhbGci...")
+			})
+			dsl.Attribute("version", dsl.String, "Version of the API", func() {
+				dsl.Enum("1")
+				dsl.Example("1")
+			})
+			dsl.Attribute("warm", dsl.Boolean, "Run the configured warm-up queries after flushing", func() {
+				dsl.Default(true)
+				dsl.Example(true)
+			})
+			dsl.Required("bearer_token", "version")
+		})
+
+		dsl.Result(func() {
+			dsl.Attribute("flushed", dsl.Int, "Number of cached results cleared", func() {
+				dsl.Example(120)
+			})
+			dsl.Attribute("warmed", dsl.Int, "Number of warm-up queries cached again", func() {
+				dsl.Example(3)
+			})
+			dsl.Required("flushed", "warmed")
+		})
+
+		dsl.HTTP(func() {
+			dsl.POST("/cache/flush")
+			dsl.Param("version:v")
+			dsl.Param("warm")
+			dsl.Header("bearer_token:Authorization")
+			dsl.Response(dsl.StatusOK)
+			dsl.Response("BadRequest", dsl.StatusBadRequest)
+			dsl.Response("NotFound", dsl.StatusNotFound)
+			dsl.Response("InternalServerError", dsl.StatusInternalServerError)
+			dsl.Response("ServiceUnavailable", dsl.StatusServiceUnavailable)
+		})
+	})
+
 	dsl.Method("readyz", func() {
 		dsl.Description("Check if the service is able to take inbound requests.")
 		dsl.Meta("swagger:generate", "false")
@@ -20,9 +20,8 @@ var SortValues = []any{
 }
 
 var Sortable = dsl.Type("Sortable", func() {
-	dsl.Attribute("sort", dsl.String, "Sort order for results", func() {
+	dsl.Attribute("sort", dsl.String, "Sort order for results; defaults to the search profile sort, if any, or name_asc", func() {
 		dsl.Enum(SortValues...)
-		dsl.Default("name_asc")
 		dsl.Example("updated_desc")
 	})
 	dsl.Attribute("page_token", dsl.String, "Opaque token for pagination", func() {
@@ -39,6 +38,18 @@ var Resource = dsl.Type("Resource", func() {
 	dsl.Attribute("id", dsl.String, "Resource ID (within its resource collection)", func() {
 		dsl.Example("123")
 	})
+	dsl.Attribute("document_id", dsl.String, "ID of the search index document of the resource, which may differ from the resource ID", func() {
+		dsl.Example("committee:123")
+	})
+	dsl.Attribute("index", dsl.String, "Search index that served the resource; only reported in debug mode", func() {
+		dsl.Example("resources-v2")
+	})
+	dsl.Attribute("created_by", dsl.String, "Principal who created the resource, when indexed", func() {
+		dsl.Example("user:jdoe")
+	})
+	dsl.Attribute("updated_by", dsl.String, "Principal who last updated the resource, when indexed", func() {
+		dsl.Example("user:jdoe")
+	})
 	dsl.Attribute("data", dsl.Any, "Resource data snapshot", func() {
 		dsl.Example(CommitteeExampleStub{
 			ID:          "123",
@@ -46,6 +57,26 @@ var Resource = dsl.Type("Resource", func() {
 			Description: "a committee",
 		})
 	})
+	dsl.Attribute("score", dsl.Float64, "Relevance score assigned by the search backend; only returned when requested", func() {
+		dsl.Example(4.2)
+	})
+	dsl.Attribute("normalized_score", dsl.Float64, "Relevance score relative to the best match of the search, between 0 and 1; only returned when requested", func() {
+		dsl.Minimum(0)
+		dsl.Maximum(1)
+		dsl.Example(0.85)
+	})
+	dsl.Attribute("matched_tags", dsl.ArrayOf(dsl.String), "Requested tags the resource matched when filtering by tags; only returned to authenticated users", func() {
+		dsl.Example([]string{"active"})
+	})
+	dsl.Attribute("child_counts", dsl.MapOf(dsl.String, dsl.UInt64), "Number of accessible children of the resource, by child type; only returned when requested", func() {
+		dsl.Example(map[string]uint64{"committee": 12})
+	})
+	dsl.Attribute("permissions", dsl.MapOf(dsl.String, dsl.Boolean), "Whether the caller has each configured relation beyond viewing on the resource; only returned when requested", func() {
+		dsl.Example(map[string]bool{"edit": true})
+	})
+	dsl.Attribute("ancestry", dsl.ArrayOf(dsl.String), "References of the ancestors of the resource, as indexed; only returned when requested", func() {
+		dsl.Example([]string{"project:123", "committee:456"})
+	})
 })
 
 // BadRequestError is the DSL type for a bad request error.
@@ -98,11 +129,114 @@ var Organization = dsl.Type("Organization", func() {
 	dsl.Attribute("employees", dsl.String, "Employee count or range", func() {
 		dsl.Example("100-499")
 	})
+	dsl.Attribute("metadata", dsl.MapOf(dsl.String, dsl.Any), "Further fields of the organization, as provided by its source", func() {
+		dsl.Example(map[string]any{"website": "https://www.linuxfoundation.org", "legal_name": "The Linux Foundation"})
+	})
+})
+
+var ResolvedOrganization = dsl.Type("ResolvedOrganization", func() {
+	dsl.Description("The organization a domain resolved to, if any.")
+
+	dsl.Attribute("domain", dsl.String, "Requested domain, as given", func() {
+		dsl.Example("www.linuxfoundation.org")
+	})
+	dsl.Attribute("organization", Organization, "Organization of the domain; absent when the domain has none")
+	dsl.Required("domain")
+})
+
+var CountQuery = dsl.Type("CountQuery", func() {
+	dsl.Description("A single resource count query of a batch.")
+
+	dsl.Attribute("name", dsl.String, "Resource name or alias; supports typeahead", func() {
+		dsl.Example("gov board")
+		dsl.MinLength(1)
+	})
+	dsl.Attribute("parent", dsl.String, "Parent (for navigation; varies by object type)", func() {
+		dsl.Example("project:123")
+	})
+	dsl.Attribute("type", dsl.String, "Resource type to search", func() {
+		dsl.Example("committee")
+	})
+	dsl.Attribute("tags", dsl.ArrayOf(dsl.String), "Tags to search with OR logic - matches resources with any of these tags", func() {
+		dsl.Example([]string{"active", "public"})
+	})
+	dsl.Attribute("tags_all", dsl.ArrayOf(dsl.String), "Tags to search with AND logic - matches resources that have all of these tags", func() {
+		dsl.Example([]string{"governance", "security"})
+	})
+})
+
+var CountItemError = dsl.Type("CountItemError", func() {
+	dsl.Description("The error of a count query of a batch that failed.")
+
+	dsl.Attribute("code", dsl.String, "Error code", func() {
+		dsl.Enum("BadRequest", "NotFound", "InternalServerError", "ServiceUnavailable")
+		dsl.Example("ServiceUnavailable")
+	})
+	dsl.Attribute("message", dsl.String, "Error message", func() {
+		dsl.Example("search operation failed")
+	})
+	dsl.Required("code", "message")
+})
+
+var CountItem = dsl.Type("CountItem", func() {
+	dsl.Description("The outcome of a count query of a batch: the count, or the error when the query failed.")
+
+	dsl.Attribute("count", dsl.UInt64, "Count of resources found", func() {
+		dsl.Example(1234)
+	})
+	dsl.Attribute("has_more", dsl.Boolean, "True if count is not guaranteed to be exhaustive: client should request a narrower query", func() {
+		dsl.Example(false)
+	})
+	dsl.Attribute("error", CountItemError, "Error of the query, if it failed")
+})
+
+var FacetValue = dsl.Type("FacetValue", func() {
+	dsl.Description("The number of accessible resources with a value of a facet field.")
+
+	dsl.Attribute("value", dsl.String, "Value of the field", func() {
+		dsl.Example("committee")
+	})
+	dsl.Attribute("count", dsl.UInt64, "Count of resources with the value", func() {
+		dsl.Example(12)
+	})
+	dsl.Required("value", "count")
+})
+
+var ResourceExplanation = dsl.Type("ResourceExplanation", func() {
+	dsl.Description("Why a resource search does or doesn't return a resource.")
+
+	dsl.Attribute("object_ref", dsl.String, "Reference of the explained resource", func() {
+		dsl.Example("committee:123")
+	})
+	dsl.Attribute("stage", dsl.String, "Stage of the search that decided the outcome", func() {
+		dsl.Enum("not_found", "filtered_out", "access_denied", "returned")
+		dsl.Example("access_denied")
+	})
+	dsl.Attribute("reason", dsl.String, "Human readable explanation of the outcome", func() {
+		dsl.Example("the access check did not grant the resource")
+	})
+	dsl.Attribute("found", dsl.Boolean, "True if the resource is indexed", func() {
+		dsl.Example(true)
+	})
+	dsl.Attribute("matched_criteria", dsl.Boolean, "True if the resource matches the search criteria", func() {
+		dsl.Example(true)
+	})
+	dsl.Attribute("access_check_query", dsl.String, "Access check the resource needs, if any", func() {
+		dsl.Example("committee:123#viewer@user:jdoe")
+	})
+	dsl.Attribute("access_granted", dsl.Boolean, "True if the principal may see the resource", func() {
+		dsl.Example(false)
+	})
+	dsl.Required("object_ref", "stage", "reason", "found", "matched_criteria", "access_granted")
 })
 
 var OrganizationSuggestion = dsl.Type("OrganizationSuggestion", func() {
 	dsl.Description("An organization suggestion for the search.")
 
+	dsl.Attribute("kind", dsl.String, "Kind of the suggestion", func() {
+		dsl.Enum("organization")
+		dsl.Example("organization")
+	})
 	dsl.Attribute("name", dsl.String, "Organization name", func() {
 		dsl.Example("Linux Foundation")
 	})
@@ -112,7 +246,22 @@ var OrganizationSuggestion = dsl.Type("OrganizationSuggestion", func() {
 	dsl.Attribute("logo", dsl.String, "Organization logo URL", func() {
 		dsl.Example("https://example.com/logo.png")
 	})
-	dsl.Required("name", "domain")
+	dsl.Required("kind", "name", "domain")
+})
+
+var Suggestion = dsl.Type("Suggestion", func() {
+	dsl.Description("A suggestion of any kind: the attribute named after its kind holds what is suggested.")
+
+	dsl.Attribute("kind", dsl.String, "Kind of the suggestion", func() {
+		dsl.Enum("resource", "organization")
+		dsl.Example("organization")
+	})
+	dsl.Attribute("name", dsl.String, "Name to display for the suggestion", func() {
+		dsl.Example("Linux Foundation")
+	})
+	dsl.Attribute("resource", Resource, "Resource suggested, for resource suggestions")
+	dsl.Attribute("organization", OrganizationSuggestion, "Organization suggested, for organization suggestions")
+	dsl.Required("kind", "name")
 })
 
 // Define an example cached LFX resource for the nested "data" attribute for
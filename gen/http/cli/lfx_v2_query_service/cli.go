@@ -22,19 +22,27 @@ import (
 //
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() string {
-	return `query-svc (query-resources|query-resources-count|query-orgs|suggest-orgs|readyz|livez)
+	return `query-svc (query-resources|query-resources-count|query-resources-count-batch|query-resources-facets|explain-resource|check-tuples|query-orgs|query-orgs-list|count-orgs|resolve-orgs|suggest-orgs|unified-search|suggest|flush-cache|readyz|livez)
 `
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
-	return os.Args[0] + ` query-svc query-resources --version "1" --name "gov board" --parent "project:123" --type "committee" --tags '[
+	return os.Args[0] + ` query-svc query-resources --version "1" --name "gov board" --name-exact "Governing Board" --parent "project:123" --parents '[
+      "project:123",
+      "project:456"
+   ]' --search-scope "name" --type "committee" --tags '[
       "active",
       "public"
-   ]' --tags-all '[
+   ]' --tags-min-match 2 --tags-all '[
       "governance",
       "security"
-   ]' --sort "updated_desc" --page-token "****" --bearer-token "eyJhbGci..."` + "\n" +
+   ]' --created-by "user:jdoe" --updated-by "user:jdoe" --transaction-id "9a7c6b1e-2f4d-4c1a-8e3b-5d6f7a8b9c0d" --exclude-id '[
+      "123",
+      "456"
+   ]' --include-score true --include-child-counts true --include-permissions true --include-ancestry true --collapse-by "slug" --wait-for-refresh true --suggest-on-empty true --recency-boost true --profile "directory" --view "public" --template "active_committees" --template-params '[
+      "status=active"
+   ]' --sort "updated_desc" --page-token "****" --track-total-hits "true" --bearer-token "eyJhbGci..." --if-none-match "\"5d41402abc4b2a76b9719d911017c592\""` + "\n" +
 		""
 }
 
@@ -50,37 +58,130 @@ func ParseEndpoint(
 	var (
 		querySvcFlags = flag.NewFlagSet("query-svc", flag.ContinueOnError)
 
-		querySvcQueryResourcesFlags           = flag.NewFlagSet("query-resources", flag.ExitOnError)
-		querySvcQueryResourcesVersionFlag     = querySvcQueryResourcesFlags.String("version", "REQUIRED", "")
-		querySvcQueryResourcesNameFlag        = querySvcQueryResourcesFlags.String("name", "", "")
-		querySvcQueryResourcesParentFlag      = querySvcQueryResourcesFlags.String("parent", "", "")
-		querySvcQueryResourcesTypeFlag        = querySvcQueryResourcesFlags.String("type", "", "")
-		querySvcQueryResourcesTagsFlag        = querySvcQueryResourcesFlags.String("tags", "", "")
-		querySvcQueryResourcesTagsAllFlag     = querySvcQueryResourcesFlags.String("tags-all", "", "")
-		querySvcQueryResourcesSortFlag        = querySvcQueryResourcesFlags.String("sort", "name_asc", "")
-		querySvcQueryResourcesPageTokenFlag   = querySvcQueryResourcesFlags.String("page-token", "", "")
-		querySvcQueryResourcesBearerTokenFlag = querySvcQueryResourcesFlags.String("bearer-token", "REQUIRED", "")
-
-		querySvcQueryResourcesCountFlags           = flag.NewFlagSet("query-resources-count", flag.ExitOnError)
-		querySvcQueryResourcesCountVersionFlag     = querySvcQueryResourcesCountFlags.String("version", "REQUIRED", "")
-		querySvcQueryResourcesCountNameFlag        = querySvcQueryResourcesCountFlags.String("name", "", "")
-		querySvcQueryResourcesCountParentFlag      = querySvcQueryResourcesCountFlags.String("parent", "", "")
-		querySvcQueryResourcesCountTypeFlag        = querySvcQueryResourcesCountFlags.String("type", "", "")
-		querySvcQueryResourcesCountTagsFlag        = querySvcQueryResourcesCountFlags.String("tags", "", "")
-		querySvcQueryResourcesCountTagsAllFlag     = querySvcQueryResourcesCountFlags.String("tags-all", "", "")
-		querySvcQueryResourcesCountBearerTokenFlag = querySvcQueryResourcesCountFlags.String("bearer-token", "REQUIRED", "")
+		querySvcQueryResourcesFlags                  = flag.NewFlagSet("query-resources", flag.ExitOnError)
+		querySvcQueryResourcesVersionFlag            = querySvcQueryResourcesFlags.String("version", "REQUIRED", "")
+		querySvcQueryResourcesNameFlag               = querySvcQueryResourcesFlags.String("name", "", "")
+		querySvcQueryResourcesNameExactFlag          = querySvcQueryResourcesFlags.String("name-exact", "", "")
+		querySvcQueryResourcesParentFlag             = querySvcQueryResourcesFlags.String("parent", "", "")
+		querySvcQueryResourcesParentsFlag            = querySvcQueryResourcesFlags.String("parents", "", "")
+		querySvcQueryResourcesSearchScopeFlag        = querySvcQueryResourcesFlags.String("search-scope", "", "")
+		querySvcQueryResourcesTypeFlag               = querySvcQueryResourcesFlags.String("type", "", "")
+		querySvcQueryResourcesTagsFlag               = querySvcQueryResourcesFlags.String("tags", "", "")
+		querySvcQueryResourcesTagsMinMatchFlag       = querySvcQueryResourcesFlags.String("tags-min-match", "", "")
+		querySvcQueryResourcesTagsAllFlag            = querySvcQueryResourcesFlags.String("tags-all", "", "")
+		querySvcQueryResourcesCreatedByFlag          = querySvcQueryResourcesFlags.String("created-by", "", "")
+		querySvcQueryResourcesUpdatedByFlag          = querySvcQueryResourcesFlags.String("updated-by", "", "")
+		querySvcQueryResourcesTransactionIDFlag      = querySvcQueryResourcesFlags.String("transaction-id", "", "")
+		querySvcQueryResourcesExcludeIDFlag          = querySvcQueryResourcesFlags.String("exclude-id", "", "")
+		querySvcQueryResourcesIncludeScoreFlag       = querySvcQueryResourcesFlags.String("include-score", "", "")
+		querySvcQueryResourcesIncludeChildCountsFlag = querySvcQueryResourcesFlags.String("include-child-counts", "", "")
+		querySvcQueryResourcesIncludePermissionsFlag = querySvcQueryResourcesFlags.String("include-permissions", "", "")
+		querySvcQueryResourcesIncludeAncestryFlag    = querySvcQueryResourcesFlags.String("include-ancestry", "", "")
+		querySvcQueryResourcesCollapseByFlag         = querySvcQueryResourcesFlags.String("collapse-by", "", "")
+		querySvcQueryResourcesWaitForRefreshFlag     = querySvcQueryResourcesFlags.String("wait-for-refresh", "", "")
+		querySvcQueryResourcesSuggestOnEmptyFlag     = querySvcQueryResourcesFlags.String("suggest-on-empty", "", "")
+		querySvcQueryResourcesRecencyBoostFlag       = querySvcQueryResourcesFlags.String("recency-boost", "", "")
+		querySvcQueryResourcesProfileFlag            = querySvcQueryResourcesFlags.String("profile", "", "")
+		querySvcQueryResourcesViewFlag               = querySvcQueryResourcesFlags.String("view", "", "")
+		querySvcQueryResourcesTemplateFlag           = querySvcQueryResourcesFlags.String("template", "", "")
+		querySvcQueryResourcesTemplateParamsFlag     = querySvcQueryResourcesFlags.String("template-params", "", "")
+		querySvcQueryResourcesSortFlag               = querySvcQueryResourcesFlags.String("sort", "", "")
+		querySvcQueryResourcesPageTokenFlag          = querySvcQueryResourcesFlags.String("page-token", "", "")
+		querySvcQueryResourcesTrackTotalHitsFlag     = querySvcQueryResourcesFlags.String("track-total-hits", "", "")
+		querySvcQueryResourcesBearerTokenFlag        = querySvcQueryResourcesFlags.String("bearer-token", "REQUIRED", "")
+		querySvcQueryResourcesIfNoneMatchFlag        = querySvcQueryResourcesFlags.String("if-none-match", "", "")
+
+		querySvcQueryResourcesCountFlags                = flag.NewFlagSet("query-resources-count", flag.ExitOnError)
+		querySvcQueryResourcesCountVersionFlag          = querySvcQueryResourcesCountFlags.String("version", "REQUIRED", "")
+		querySvcQueryResourcesCountNameFlag             = querySvcQueryResourcesCountFlags.String("name", "", "")
+		querySvcQueryResourcesCountParentFlag           = querySvcQueryResourcesCountFlags.String("parent", "", "")
+		querySvcQueryResourcesCountTypeFlag             = querySvcQueryResourcesCountFlags.String("type", "", "")
+		querySvcQueryResourcesCountTagsFlag             = querySvcQueryResourcesCountFlags.String("tags", "", "")
+		querySvcQueryResourcesCountTagsAllFlag          = querySvcQueryResourcesCountFlags.String("tags-all", "", "")
+		querySvcQueryResourcesCountIncludeRelationsFlag = querySvcQueryResourcesCountFlags.String("include-relations", "", "")
+		querySvcQueryResourcesCountBearerTokenFlag      = querySvcQueryResourcesCountFlags.String("bearer-token", "REQUIRED", "")
+
+		querySvcQueryResourcesCountBatchFlags           = flag.NewFlagSet("query-resources-count-batch", flag.ExitOnError)
+		querySvcQueryResourcesCountBatchBodyFlag        = querySvcQueryResourcesCountBatchFlags.String("body", "REQUIRED", "")
+		querySvcQueryResourcesCountBatchVersionFlag     = querySvcQueryResourcesCountBatchFlags.String("version", "REQUIRED", "")
+		querySvcQueryResourcesCountBatchBearerTokenFlag = querySvcQueryResourcesCountBatchFlags.String("bearer-token", "REQUIRED", "")
+
+		querySvcQueryResourcesFacetsFlags            = flag.NewFlagSet("query-resources-facets", flag.ExitOnError)
+		querySvcQueryResourcesFacetsVersionFlag      = querySvcQueryResourcesFacetsFlags.String("version", "REQUIRED", "")
+		querySvcQueryResourcesFacetsFacetFlag        = querySvcQueryResourcesFacetsFlags.String("facet", "REQUIRED", "")
+		querySvcQueryResourcesFacetsNameFlag         = querySvcQueryResourcesFacetsFlags.String("name", "", "")
+		querySvcQueryResourcesFacetsParentFlag       = querySvcQueryResourcesFacetsFlags.String("parent", "", "")
+		querySvcQueryResourcesFacetsTypeFlag         = querySvcQueryResourcesFacetsFlags.String("type", "", "")
+		querySvcQueryResourcesFacetsTagsFlag         = querySvcQueryResourcesFacetsFlags.String("tags", "", "")
+		querySvcQueryResourcesFacetsTagsAllFlag      = querySvcQueryResourcesFacetsFlags.String("tags-all", "", "")
+		querySvcQueryResourcesFacetsIncludeTotalFlag = querySvcQueryResourcesFacetsFlags.String("include-total", "", "")
+		querySvcQueryResourcesFacetsBearerTokenFlag  = querySvcQueryResourcesFacetsFlags.String("bearer-token", "REQUIRED", "")
+
+		querySvcExplainResourceFlags           = flag.NewFlagSet("explain-resource", flag.ExitOnError)
+		querySvcExplainResourceVersionFlag     = querySvcExplainResourceFlags.String("version", "REQUIRED", "")
+		querySvcExplainResourceObjectRefFlag   = querySvcExplainResourceFlags.String("object-ref", "REQUIRED", "")
+		querySvcExplainResourceNameFlag        = querySvcExplainResourceFlags.String("name", "", "")
+		querySvcExplainResourceParentFlag      = querySvcExplainResourceFlags.String("parent", "", "")
+		querySvcExplainResourceTypeFlag        = querySvcExplainResourceFlags.String("type", "", "")
+		querySvcExplainResourceTagsFlag        = querySvcExplainResourceFlags.String("tags", "", "")
+		querySvcExplainResourceTagsAllFlag     = querySvcExplainResourceFlags.String("tags-all", "", "")
+		querySvcExplainResourceCreatedByFlag   = querySvcExplainResourceFlags.String("created-by", "", "")
+		querySvcExplainResourceBearerTokenFlag = querySvcExplainResourceFlags.String("bearer-token", "REQUIRED", "")
+
+		querySvcCheckTuplesFlags           = flag.NewFlagSet("check-tuples", flag.ExitOnError)
+		querySvcCheckTuplesBodyFlag        = querySvcCheckTuplesFlags.String("body", "REQUIRED", "")
+		querySvcCheckTuplesVersionFlag     = querySvcCheckTuplesFlags.String("version", "REQUIRED", "")
+		querySvcCheckTuplesBearerTokenFlag = querySvcCheckTuplesFlags.String("bearer-token", "REQUIRED", "")
 
 		querySvcQueryOrgsFlags           = flag.NewFlagSet("query-orgs", flag.ExitOnError)
 		querySvcQueryOrgsVersionFlag     = querySvcQueryOrgsFlags.String("version", "REQUIRED", "")
 		querySvcQueryOrgsNameFlag        = querySvcQueryOrgsFlags.String("name", "", "")
 		querySvcQueryOrgsDomainFlag      = querySvcQueryOrgsFlags.String("domain", "", "")
+		querySvcQueryOrgsDomainsFlag     = querySvcQueryOrgsFlags.String("domains", "", "")
+		querySvcQueryOrgsMatchAllFlag    = querySvcQueryOrgsFlags.String("match-all", "", "")
 		querySvcQueryOrgsBearerTokenFlag = querySvcQueryOrgsFlags.String("bearer-token", "REQUIRED", "")
 
+		querySvcQueryOrgsListFlags           = flag.NewFlagSet("query-orgs-list", flag.ExitOnError)
+		querySvcQueryOrgsListVersionFlag     = querySvcQueryOrgsListFlags.String("version", "REQUIRED", "")
+		querySvcQueryOrgsListNameFlag        = querySvcQueryOrgsListFlags.String("name", "", "")
+		querySvcQueryOrgsListDomainFlag      = querySvcQueryOrgsListFlags.String("domain", "", "")
+		querySvcQueryOrgsListMatchAllFlag    = querySvcQueryOrgsListFlags.String("match-all", "", "")
+		querySvcQueryOrgsListPageTokenFlag   = querySvcQueryOrgsListFlags.String("page-token", "", "")
+		querySvcQueryOrgsListBearerTokenFlag = querySvcQueryOrgsListFlags.String("bearer-token", "REQUIRED", "")
+
+		querySvcCountOrgsFlags           = flag.NewFlagSet("count-orgs", flag.ExitOnError)
+		querySvcCountOrgsVersionFlag     = querySvcCountOrgsFlags.String("version", "REQUIRED", "")
+		querySvcCountOrgsNameFlag        = querySvcCountOrgsFlags.String("name", "", "")
+		querySvcCountOrgsDomainFlag      = querySvcCountOrgsFlags.String("domain", "", "")
+		querySvcCountOrgsMatchAllFlag    = querySvcCountOrgsFlags.String("match-all", "", "")
+		querySvcCountOrgsBearerTokenFlag = querySvcCountOrgsFlags.String("bearer-token", "REQUIRED", "")
+
+		querySvcResolveOrgsFlags           = flag.NewFlagSet("resolve-orgs", flag.ExitOnError)
+		querySvcResolveOrgsBodyFlag        = querySvcResolveOrgsFlags.String("body", "REQUIRED", "")
+		querySvcResolveOrgsVersionFlag     = querySvcResolveOrgsFlags.String("version", "REQUIRED", "")
+		querySvcResolveOrgsBearerTokenFlag = querySvcResolveOrgsFlags.String("bearer-token", "REQUIRED", "")
+
 		querySvcSuggestOrgsFlags           = flag.NewFlagSet("suggest-orgs", flag.ExitOnError)
 		querySvcSuggestOrgsVersionFlag     = querySvcSuggestOrgsFlags.String("version", "REQUIRED", "")
 		querySvcSuggestOrgsQueryFlag       = querySvcSuggestOrgsFlags.String("query", "REQUIRED", "")
 		querySvcSuggestOrgsBearerTokenFlag = querySvcSuggestOrgsFlags.String("bearer-token", "REQUIRED", "")
 
+		querySvcUnifiedSearchFlags           = flag.NewFlagSet("unified-search", flag.ExitOnError)
+		querySvcUnifiedSearchVersionFlag     = querySvcUnifiedSearchFlags.String("version", "REQUIRED", "")
+		querySvcUnifiedSearchQueryFlag       = querySvcUnifiedSearchFlags.String("query", "REQUIRED", "")
+		querySvcUnifiedSearchBearerTokenFlag = querySvcUnifiedSearchFlags.String("bearer-token", "REQUIRED", "")
+
+		querySvcSuggestFlags           = flag.NewFlagSet("suggest", flag.ExitOnError)
+		querySvcSuggestVersionFlag     = querySvcSuggestFlags.String("version", "REQUIRED", "")
+		querySvcSuggestQueryFlag       = querySvcSuggestFlags.String("query", "REQUIRED", "")
+		querySvcSuggestKindFlag        = querySvcSuggestFlags.String("kind", "", "")
+		querySvcSuggestBearerTokenFlag = querySvcSuggestFlags.String("bearer-token", "REQUIRED", "")
+
+		querySvcFlushCacheFlags           = flag.NewFlagSet("flush-cache", flag.ExitOnError)
+		querySvcFlushCacheVersionFlag     = querySvcFlushCacheFlags.String("version", "REQUIRED", "")
+		querySvcFlushCacheWarmFlag        = querySvcFlushCacheFlags.String("warm", "true", "")
+		querySvcFlushCacheBearerTokenFlag = querySvcFlushCacheFlags.String("bearer-token", "REQUIRED", "")
+
 		querySvcReadyzFlags = flag.NewFlagSet("readyz", flag.ExitOnError)
 
 		querySvcLivezFlags = flag.NewFlagSet("livez", flag.ExitOnError)
@@ -88,8 +189,18 @@ func ParseEndpoint(
 	querySvcFlags.Usage = querySvcUsage
 	querySvcQueryResourcesFlags.Usage = querySvcQueryResourcesUsage
 	querySvcQueryResourcesCountFlags.Usage = querySvcQueryResourcesCountUsage
+	querySvcQueryResourcesCountBatchFlags.Usage = querySvcQueryResourcesCountBatchUsage
+	querySvcQueryResourcesFacetsFlags.Usage = querySvcQueryResourcesFacetsUsage
+	querySvcExplainResourceFlags.Usage = querySvcExplainResourceUsage
+	querySvcCheckTuplesFlags.Usage = querySvcCheckTuplesUsage
 	querySvcQueryOrgsFlags.Usage = querySvcQueryOrgsUsage
+	querySvcQueryOrgsListFlags.Usage = querySvcQueryOrgsListUsage
+	querySvcCountOrgsFlags.Usage = querySvcCountOrgsUsage
+	querySvcResolveOrgsFlags.Usage = querySvcResolveOrgsUsage
 	querySvcSuggestOrgsFlags.Usage = querySvcSuggestOrgsUsage
+	querySvcUnifiedSearchFlags.Usage = querySvcUnifiedSearchUsage
+	querySvcSuggestFlags.Usage = querySvcSuggestUsage
+	querySvcFlushCacheFlags.Usage = querySvcFlushCacheUsage
 	querySvcReadyzFlags.Usage = querySvcReadyzUsage
 	querySvcLivezFlags.Usage = querySvcLivezUsage
 
@@ -133,12 +244,42 @@ func ParseEndpoint(
 			case "query-resources-count":
 				epf = querySvcQueryResourcesCountFlags
 
+			case "query-resources-count-batch":
+				epf = querySvcQueryResourcesCountBatchFlags
+
+			case "query-resources-facets":
+				epf = querySvcQueryResourcesFacetsFlags
+
+			case "explain-resource":
+				epf = querySvcExplainResourceFlags
+
+			case "check-tuples":
+				epf = querySvcCheckTuplesFlags
+
 			case "query-orgs":
 				epf = querySvcQueryOrgsFlags
 
+			case "query-orgs-list":
+				epf = querySvcQueryOrgsListFlags
+
+			case "count-orgs":
+				epf = querySvcCountOrgsFlags
+
+			case "resolve-orgs":
+				epf = querySvcResolveOrgsFlags
+
 			case "suggest-orgs":
 				epf = querySvcSuggestOrgsFlags
 
+			case "unified-search":
+				epf = querySvcUnifiedSearchFlags
+
+			case "suggest":
+				epf = querySvcSuggestFlags
+
+			case "flush-cache":
+				epf = querySvcFlushCacheFlags
+
 			case "readyz":
 				epf = querySvcReadyzFlags
 
@@ -172,16 +313,46 @@ func ParseEndpoint(
 			switch epn {
 			case "query-resources":
 				endpoint = c.QueryResources()
-				data, err = querysvcc.BuildQueryResourcesPayload(*querySvcQueryResourcesVersionFlag, *querySvcQueryResourcesNameFlag, *querySvcQueryResourcesParentFlag, *querySvcQueryResourcesTypeFlag, *querySvcQueryResourcesTagsFlag, *querySvcQueryResourcesTagsAllFlag, *querySvcQueryResourcesSortFlag, *querySvcQueryResourcesPageTokenFlag, *querySvcQueryResourcesBearerTokenFlag)
+				data, err = querysvcc.BuildQueryResourcesPayload(*querySvcQueryResourcesVersionFlag, *querySvcQueryResourcesNameFlag, *querySvcQueryResourcesNameExactFlag, *querySvcQueryResourcesParentFlag, *querySvcQueryResourcesParentsFlag, *querySvcQueryResourcesSearchScopeFlag, *querySvcQueryResourcesTypeFlag, *querySvcQueryResourcesTagsFlag, *querySvcQueryResourcesTagsMinMatchFlag, *querySvcQueryResourcesTagsAllFlag, *querySvcQueryResourcesCreatedByFlag, *querySvcQueryResourcesUpdatedByFlag, *querySvcQueryResourcesTransactionIDFlag, *querySvcQueryResourcesExcludeIDFlag, *querySvcQueryResourcesIncludeScoreFlag, *querySvcQueryResourcesIncludeChildCountsFlag, *querySvcQueryResourcesIncludePermissionsFlag, *querySvcQueryResourcesIncludeAncestryFlag, *querySvcQueryResourcesCollapseByFlag, *querySvcQueryResourcesWaitForRefreshFlag, *querySvcQueryResourcesSuggestOnEmptyFlag, *querySvcQueryResourcesRecencyBoostFlag, *querySvcQueryResourcesProfileFlag, *querySvcQueryResourcesViewFlag, *querySvcQueryResourcesTemplateFlag, *querySvcQueryResourcesTemplateParamsFlag, *querySvcQueryResourcesSortFlag, *querySvcQueryResourcesPageTokenFlag, *querySvcQueryResourcesTrackTotalHitsFlag, *querySvcQueryResourcesBearerTokenFlag, *querySvcQueryResourcesIfNoneMatchFlag)
 			case "query-resources-count":
 				endpoint = c.QueryResourcesCount()
-				data, err = querysvcc.BuildQueryResourcesCountPayload(*querySvcQueryResourcesCountVersionFlag, *querySvcQueryResourcesCountNameFlag, *querySvcQueryResourcesCountParentFlag, *querySvcQueryResourcesCountTypeFlag, *querySvcQueryResourcesCountTagsFlag, *querySvcQueryResourcesCountTagsAllFlag, *querySvcQueryResourcesCountBearerTokenFlag)
+				data, err = querysvcc.BuildQueryResourcesCountPayload(*querySvcQueryResourcesCountVersionFlag, *querySvcQueryResourcesCountNameFlag, *querySvcQueryResourcesCountParentFlag, *querySvcQueryResourcesCountTypeFlag, *querySvcQueryResourcesCountTagsFlag, *querySvcQueryResourcesCountTagsAllFlag, *querySvcQueryResourcesCountIncludeRelationsFlag, *querySvcQueryResourcesCountBearerTokenFlag)
+			case "query-resources-count-batch":
+				endpoint = c.QueryResourcesCountBatch()
+				data, err = querysvcc.BuildQueryResourcesCountBatchPayload(*querySvcQueryResourcesCountBatchBodyFlag, *querySvcQueryResourcesCountBatchVersionFlag, *querySvcQueryResourcesCountBatchBearerTokenFlag)
+			case "query-resources-facets":
+				endpoint = c.QueryResourcesFacets()
+				data, err = querysvcc.BuildQueryResourcesFacetsPayload(*querySvcQueryResourcesFacetsVersionFlag, *querySvcQueryResourcesFacetsFacetFlag, *querySvcQueryResourcesFacetsNameFlag, *querySvcQueryResourcesFacetsParentFlag, *querySvcQueryResourcesFacetsTypeFlag, *querySvcQueryResourcesFacetsTagsFlag, *querySvcQueryResourcesFacetsTagsAllFlag, *querySvcQueryResourcesFacetsIncludeTotalFlag, *querySvcQueryResourcesFacetsBearerTokenFlag)
+			case "explain-resource":
+				endpoint = c.ExplainResource()
+				data, err = querysvcc.BuildExplainResourcePayload(*querySvcExplainResourceVersionFlag, *querySvcExplainResourceObjectRefFlag, *querySvcExplainResourceNameFlag, *querySvcExplainResourceParentFlag, *querySvcExplainResourceTypeFlag, *querySvcExplainResourceTagsFlag, *querySvcExplainResourceTagsAllFlag, *querySvcExplainResourceCreatedByFlag, *querySvcExplainResourceBearerTokenFlag)
+			case "check-tuples":
+				endpoint = c.CheckTuples()
+				data, err = querysvcc.BuildCheckTuplesPayload(*querySvcCheckTuplesBodyFlag, *querySvcCheckTuplesVersionFlag, *querySvcCheckTuplesBearerTokenFlag)
 			case "query-orgs":
 				endpoint = c.QueryOrgs()
-				data, err = querysvcc.BuildQueryOrgsPayload(*querySvcQueryOrgsVersionFlag, *querySvcQueryOrgsNameFlag, *querySvcQueryOrgsDomainFlag, *querySvcQueryOrgsBearerTokenFlag)
+				data, err = querysvcc.BuildQueryOrgsPayload(*querySvcQueryOrgsVersionFlag, *querySvcQueryOrgsNameFlag, *querySvcQueryOrgsDomainFlag, *querySvcQueryOrgsDomainsFlag, *querySvcQueryOrgsMatchAllFlag, *querySvcQueryOrgsBearerTokenFlag)
+			case "query-orgs-list":
+				endpoint = c.QueryOrgsList()
+				data, err = querysvcc.BuildQueryOrgsListPayload(*querySvcQueryOrgsListVersionFlag, *querySvcQueryOrgsListNameFlag, *querySvcQueryOrgsListDomainFlag, *querySvcQueryOrgsListMatchAllFlag, *querySvcQueryOrgsListPageTokenFlag, *querySvcQueryOrgsListBearerTokenFlag)
+			case "count-orgs":
+				endpoint = c.CountOrgs()
+				data, err = querysvcc.BuildCountOrgsPayload(*querySvcCountOrgsVersionFlag, *querySvcCountOrgsNameFlag, *querySvcCountOrgsDomainFlag, *querySvcCountOrgsMatchAllFlag, *querySvcCountOrgsBearerTokenFlag)
+			case "resolve-orgs":
+				endpoint = c.ResolveOrgs()
+				data, err = querysvcc.BuildResolveOrgsPayload(*querySvcResolveOrgsBodyFlag, *querySvcResolveOrgsVersionFlag, *querySvcResolveOrgsBearerTokenFlag)
 			case "suggest-orgs":
 				endpoint = c.SuggestOrgs()
 				data, err = querysvcc.BuildSuggestOrgsPayload(*querySvcSuggestOrgsVersionFlag, *querySvcSuggestOrgsQueryFlag, *querySvcSuggestOrgsBearerTokenFlag)
+			case "unified-search":
+				endpoint = c.UnifiedSearch()
+				data, err = querysvcc.BuildUnifiedSearchPayload(*querySvcUnifiedSearchVersionFlag, *querySvcUnifiedSearchQueryFlag, *querySvcUnifiedSearchBearerTokenFlag)
+			case "suggest":
+				endpoint = c.Suggest()
+				data, err = querysvcc.BuildSuggestPayload(*querySvcSuggestVersionFlag, *querySvcSuggestQueryFlag, *querySvcSuggestKindFlag, *querySvcSuggestBearerTokenFlag)
+			case "flush-cache":
+				endpoint = c.FlushCache()
+				data, err = querysvcc.BuildFlushCachePayload(*querySvcFlushCacheVersionFlag, *querySvcFlushCacheWarmFlag, *querySvcFlushCacheBearerTokenFlag)
 			case "readyz":
 				endpoint = c.Readyz()
 			case "livez":
@@ -206,8 +377,18 @@ Usage:
 COMMAND:
     query-resources: Locate resources by their type or parent, or use typeahead search to query resources by a display name or similar alias.
     query-resources-count: Count matching resources by query.
+    query-resources-count-batch: Count matching resources for several queries at once. A failing query does not fail the batch: its item reports the error instead.
+    query-resources-facets: Break the matching resources down by the values of one or more fields, counting only the resources the principal can access.
+    explain-resource: Explain why a resource search does or doesn't return a resource. Only available to the principals configured for debugging.
+    check-tuples: Check a list of access check tuples and return the answer of each, to debug access issues. Only available to the principals configured for debugging.
     query-orgs: Locate a single organization by name or domain.
+    query-orgs-list: List the organizations matching a name fragment or domain, ordered by relevance.
+    count-orgs: Count the organizations matching a name fragment or domain, or all of them when neither is given.
+    resolve-orgs: Resolve a batch of domains or website URLs to their organizations in one call.
     suggest-orgs: Get organization suggestions for typeahead search based on a query.
+    unified-search: Search resources and organizations in one call, e.g. for a global search bar.
+    suggest: Suggest resources and organizations matching a query in a single list ordered by relevance, e.g. for a typeahead.
+    flush-cache: Clear the cached search results, e.g. after a reindex, and warm the cache up again with the configured queries. Only available to the cache admin principals.
     readyz: Check if the service is able to take inbound requests.
     livez: Check if the service is alive.
 
@@ -216,32 +397,62 @@ Additional help:
 `, os.Args[0])
 }
 func querySvcQueryResourcesUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] query-svc query-resources -version STRING -name STRING -parent STRING -type STRING -tags JSON -tags-all JSON -sort STRING -page-token STRING -bearer-token STRING
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] query-svc query-resources -version STRING -name STRING -name-exact STRING -parent STRING -parents JSON -search-scope STRING -type STRING -tags JSON -tags-min-match INT -tags-all JSON -created-by STRING -updated-by STRING -transaction-id STRING -exclude-id JSON -include-score BOOL -include-child-counts BOOL -include-permissions BOOL -include-ancestry BOOL -collapse-by STRING -wait-for-refresh BOOL -suggest-on-empty BOOL -recency-boost BOOL -profile STRING -view STRING -template STRING -template-params JSON -sort STRING -page-token STRING -track-total-hits STRING -bearer-token STRING -if-none-match STRING
 
 Locate resources by their type or parent, or use typeahead search to query resources by a display name or similar alias.
     -version STRING: 
     -name STRING: 
+    -name-exact STRING: 
     -parent STRING: 
+    -parents JSON: 
+    -search-scope STRING: 
     -type STRING: 
     -tags JSON: 
+    -tags-min-match INT: 
     -tags-all JSON: 
+    -created-by STRING: 
+    -updated-by STRING: 
+    -transaction-id STRING: 
+    -exclude-id JSON: 
+    -include-score BOOL: 
+    -include-child-counts BOOL: 
+    -include-permissions BOOL: 
+    -include-ancestry BOOL: 
+    -collapse-by STRING: 
+    -wait-for-refresh BOOL: 
+    -suggest-on-empty BOOL: 
+    -recency-boost BOOL: 
+    -profile STRING: 
+    -view STRING: 
+    -template STRING: 
+    -template-params JSON: 
     -sort STRING: 
     -page-token STRING: 
+    -track-total-hits STRING: 
     -bearer-token STRING: 
+    -if-none-match STRING: 
 
 Example:
-    %[1]s query-svc query-resources --version "1" --name "gov board" --parent "project:123" --type "committee" --tags '[
+    %[1]s query-svc query-resources --version "1" --name "gov board" --name-exact "Governing Board" --parent "project:123" --parents '[
+      "project:123",
+      "project:456"
+   ]' --search-scope "name" --type "committee" --tags '[
       "active",
       "public"
-   ]' --tags-all '[
+   ]' --tags-min-match 2 --tags-all '[
       "governance",
       "security"
-   ]' --sort "updated_desc" --page-token "****" --bearer-token "eyJhbGci..."
+   ]' --created-by "user:jdoe" --updated-by "user:jdoe" --transaction-id "9a7c6b1e-2f4d-4c1a-8e3b-5d6f7a8b9c0d" --exclude-id '[
+      "123",
+      "456"
+   ]' --include-score true --include-child-counts true --include-permissions true --include-ancestry true --collapse-by "slug" --wait-for-refresh true --suggest-on-empty true --recency-boost true --profile "directory" --view "public" --template "active_committees" --template-params '[
+      "status=active"
+   ]' --sort "updated_desc" --page-token "****" --track-total-hits "true" --bearer-token "eyJhbGci..." --if-none-match "\"5d41402abc4b2a76b9719d911017c592\""
 `, os.Args[0])
 }
 
 func querySvcQueryResourcesCountUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] query-svc query-resources-count -version STRING -name STRING -parent STRING -type STRING -tags JSON -tags-all JSON -bearer-token STRING
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] query-svc query-resources-count -version STRING -name STRING -parent STRING -type STRING -tags JSON -tags-all JSON -include-relations BOOL -bearer-token STRING
 
 Count matching resources by query.
     -version STRING: 
@@ -250,6 +461,7 @@ Count matching resources by query.
     -type STRING: 
     -tags JSON: 
     -tags-all JSON: 
+    -include-relations BOOL: 
     -bearer-token STRING: 
 
 Example:
@@ -259,21 +471,201 @@ Example:
    ]' --tags-all '[
       "governance",
       "security"
-   ]' --bearer-token "eyJhbGci..."
+   ]' --include-relations true --bearer-token "eyJhbGci..."
+`, os.Args[0])
+}
+
+func querySvcQueryResourcesCountBatchUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] query-svc query-resources-count-batch -body JSON -version STRING -bearer-token STRING
+
+Count matching resources for several queries at once. A failing query does not fail the batch: its item reports the error instead.
+    -body JSON: 
+    -version STRING: 
+    -bearer-token STRING: 
+
+Example:
+    %[1]s query-svc query-resources-count-batch --body '{
+      "queries": [
+         {
+            "name": "gov board",
+            "parent": "project:123",
+            "tags": [
+               "active",
+               "public"
+            ],
+            "tags_all": [
+               "governance",
+               "security"
+            ],
+            "type": "committee"
+         },
+         {
+            "name": "gov board",
+            "parent": "project:123",
+            "tags": [
+               "active",
+               "public"
+            ],
+            "tags_all": [
+               "governance",
+               "security"
+            ],
+            "type": "committee"
+         },
+         {
+            "name": "gov board",
+            "parent": "project:123",
+            "tags": [
+               "active",
+               "public"
+            ],
+            "tags_all": [
+               "governance",
+               "security"
+            ],
+            "type": "committee"
+         }
+      ]
+   }' --version "1" --bearer-token "eyJhbGci..."
+`, os.Args[0])
+}
+
+func querySvcQueryResourcesFacetsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] query-svc query-resources-facets -version STRING -facet JSON -name STRING -parent STRING -type STRING -tags JSON -tags-all JSON -include-total BOOL -bearer-token STRING
+
+Break the matching resources down by the values of one or more fields, counting only the resources the principal can access.
+    -version STRING: 
+    -facet JSON: 
+    -name STRING: 
+    -parent STRING: 
+    -type STRING: 
+    -tags JSON: 
+    -tags-all JSON: 
+    -include-total BOOL: 
+    -bearer-token STRING: 
+
+Example:
+    %[1]s query-svc query-resources-facets --version "1" --facet '[
+      "type",
+      "tags"
+   ]' --name "gov board" --parent "project:123" --type "committee" --tags '[
+      "active",
+      "public"
+   ]' --tags-all '[
+      "governance",
+      "security"
+   ]' --include-total true --bearer-token "eyJhbGci..."
+`, os.Args[0])
+}
+
+func querySvcExplainResourceUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] query-svc explain-resource -version STRING -object-ref STRING -name STRING -parent STRING -type STRING -tags JSON -tags-all JSON -created-by STRING -bearer-token STRING
+
+Explain why a resource search does or doesn't return a resource. Only available to the principals configured for debugging.
+    -version STRING: 
+    -object-ref STRING: 
+    -name STRING: 
+    -parent STRING: 
+    -type STRING: 
+    -tags JSON: 
+    -tags-all JSON: 
+    -created-by STRING: 
+    -bearer-token STRING: 
+
+Example:
+    %[1]s query-svc explain-resource --version "1" --object-ref "committee:123" --name "gov board" --parent "project:123" --type "committee" --tags '[
+      "active",
+      "public"
+   ]' --tags-all '[
+      "governance",
+      "security"
+   ]' --created-by "user:jdoe" --bearer-token "eyJhbGci..."
+`, os.Args[0])
+}
+
+func querySvcCheckTuplesUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] query-svc check-tuples -body JSON -version STRING -bearer-token STRING
+
+Check a list of access check tuples and return the answer of each, to debug access issues. Only available to the principals configured for debugging.
+    -body JSON: 
+    -version STRING: 
+    -bearer-token STRING: 
+
+Example:
+    %[1]s query-svc check-tuples --body '{
+      "tuples": [
+         "committee:123#viewer@user:jdoe",
+         "project:456#writer@user:jdoe"
+      ]
+   }' --version "1" --bearer-token "eyJhbGci..."
 `, os.Args[0])
 }
 
 func querySvcQueryOrgsUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] query-svc query-orgs -version STRING -name STRING -domain STRING -bearer-token STRING
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] query-svc query-orgs -version STRING -name STRING -domain STRING -domains JSON -match-all BOOL -bearer-token STRING
 
 Locate a single organization by name or domain.
     -version STRING: 
     -name STRING: 
     -domain STRING: 
+    -domains JSON: 
+    -match-all BOOL: 
+    -bearer-token STRING: 
+
+Example:
+    %[1]s query-svc query-orgs --version "1" --name "The Linux Foundation" --domain "linuxfoundation.org" --domains '[
+      "linuxfoundation.org",
+      "linux.com"
+   ]' --match-all true --bearer-token "eyJhbGci..."
+`, os.Args[0])
+}
+
+func querySvcQueryOrgsListUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] query-svc query-orgs-list -version STRING -name STRING -domain STRING -match-all BOOL -page-token STRING -bearer-token STRING
+
+List the organizations matching a name fragment or domain, ordered by relevance.
+    -version STRING: 
+    -name STRING: 
+    -domain STRING: 
+    -match-all BOOL: 
+    -page-token STRING: 
+    -bearer-token STRING: 
+
+Example:
+    %[1]s query-svc query-orgs-list --version "1" --name "Linux" --domain "linuxfoundation.org" --match-all true --page-token "****" --bearer-token "eyJhbGci..."
+`, os.Args[0])
+}
+
+func querySvcCountOrgsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] query-svc count-orgs -version STRING -name STRING -domain STRING -match-all BOOL -bearer-token STRING
+
+Count the organizations matching a name fragment or domain, or all of them when neither is given.
+    -version STRING: 
+    -name STRING: 
+    -domain STRING: 
+    -match-all BOOL: 
+    -bearer-token STRING: 
+
+Example:
+    %[1]s query-svc count-orgs --version "1" --name "Linux" --domain "linuxfoundation.org" --match-all true --bearer-token "eyJhbGci..."
+`, os.Args[0])
+}
+
+func querySvcResolveOrgsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] query-svc resolve-orgs -body JSON -version STRING -bearer-token STRING
+
+Resolve a batch of domains or website URLs to their organizations in one call.
+    -body JSON: 
+    -version STRING: 
     -bearer-token STRING: 
 
 Example:
-    %[1]s query-svc query-orgs --version "1" --name "The Linux Foundation" --domain "linuxfoundation.org" --bearer-token "eyJhbGci..."
+    %[1]s query-svc resolve-orgs --body '{
+      "domains": [
+         "linuxfoundation.org",
+         "https://www.example.com/about"
+      ]
+   }' --version "1" --bearer-token "eyJhbGci..."
 `, os.Args[0])
 }
 
@@ -290,6 +682,48 @@ Example:
 `, os.Args[0])
 }
 
+func querySvcUnifiedSearchUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] query-svc unified-search -version STRING -query STRING -bearer-token STRING
+
+Search resources and organizations in one call, e.g. for a global search bar.
+    -version STRING: 
+    -query STRING: 
+    -bearer-token STRING: 
+
+Example:
+    %[1]s query-svc unified-search --version "1" --query "linux" --bearer-token "eyJhbGci..."
+`, os.Args[0])
+}
+
+func querySvcSuggestUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] query-svc suggest -version STRING -query STRING -kind JSON -bearer-token STRING
+
+Suggest resources and organizations matching a query in a single list ordered by relevance, e.g. for a typeahead.
+    -version STRING: 
+    -query STRING: 
+    -kind JSON: 
+    -bearer-token STRING: 
+
+Example:
+    %[1]s query-svc suggest --version "1" --query "linux" --kind '[
+      "organization"
+   ]' --bearer-token "eyJhbGci..."
+`, os.Args[0])
+}
+
+func querySvcFlushCacheUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] query-svc flush-cache -version STRING -warm BOOL -bearer-token STRING
+
+Clear the cached search results, e.g. after a reindex, and warm the cache up again with the configured queries. Only available to the cache admin principals.
+    -version STRING: 
+    -warm BOOL: 
+    -bearer-token STRING: 
+
+Example:
+    %[1]s query-svc flush-cache --version "1" --warm true --bearer-token "eyJhbGci..."
+`, os.Args[0])
+}
+
 func querySvcReadyzUsage() {
 	fmt.Fprintf(os.Stderr, `%[1]s [flags] query-svc readyz
 
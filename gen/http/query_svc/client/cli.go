@@ -10,6 +10,7 @@ package client
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"unicode/utf8"
 
 	querysvc "github.com/linuxfoundation/lfx-v2-query-service/gen/query_svc"
@@ -18,7 +19,7 @@ import (
 
 // BuildQueryResourcesPayload builds the payload for the query-svc
 // query-resources endpoint from CLI flags.
-func BuildQueryResourcesPayload(querySvcQueryResourcesVersion string, querySvcQueryResourcesName string, querySvcQueryResourcesParent string, querySvcQueryResourcesType string, querySvcQueryResourcesTags string, querySvcQueryResourcesTagsAll string, querySvcQueryResourcesSort string, querySvcQueryResourcesPageToken string, querySvcQueryResourcesBearerToken string) (*querysvc.QueryResourcesPayload, error) {
+func BuildQueryResourcesPayload(querySvcQueryResourcesVersion string, querySvcQueryResourcesName string, querySvcQueryResourcesNameExact string, querySvcQueryResourcesParent string, querySvcQueryResourcesParents string, querySvcQueryResourcesSearchScope string, querySvcQueryResourcesType string, querySvcQueryResourcesTags string, querySvcQueryResourcesTagsMinMatch string, querySvcQueryResourcesTagsAll string, querySvcQueryResourcesCreatedBy string, querySvcQueryResourcesUpdatedBy string, querySvcQueryResourcesTransactionID string, querySvcQueryResourcesExcludeID string, querySvcQueryResourcesIncludeScore string, querySvcQueryResourcesIncludeChildCounts string, querySvcQueryResourcesIncludePermissions string, querySvcQueryResourcesIncludeAncestry string, querySvcQueryResourcesCollapseBy string, querySvcQueryResourcesWaitForRefresh string, querySvcQueryResourcesSuggestOnEmpty string, querySvcQueryResourcesRecencyBoost string, querySvcQueryResourcesProfile string, querySvcQueryResourcesView string, querySvcQueryResourcesTemplate string, querySvcQueryResourcesTemplateParams string, querySvcQueryResourcesSort string, querySvcQueryResourcesPageToken string, querySvcQueryResourcesTrackTotalHits string, querySvcQueryResourcesBearerToken string, querySvcQueryResourcesIfNoneMatch string) (*querysvc.QueryResourcesPayload, error) {
 	var err error
 	var version string
 	{
@@ -42,6 +43,18 @@ func BuildQueryResourcesPayload(querySvcQueryResourcesVersion string, querySvcQu
 			}
 		}
 	}
+	var nameExact *string
+	{
+		if querySvcQueryResourcesNameExact != "" {
+			nameExact = &querySvcQueryResourcesNameExact
+			if utf8.RuneCountInString(*nameExact) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("name_exact", *nameExact, utf8.RuneCountInString(*nameExact), 1, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
 	var parent *string
 	{
 		if querySvcQueryResourcesParent != "" {
@@ -52,6 +65,33 @@ func BuildQueryResourcesPayload(querySvcQueryResourcesVersion string, querySvcQu
 			}
 		}
 	}
+	var parents []string
+	{
+		if querySvcQueryResourcesParents != "" {
+			err = json.Unmarshal([]byte(querySvcQueryResourcesParents), &parents)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for parents, \nerror: %s, \nexample of valid JSON:\n%s", err, "'[\n      \"project:123\",\n      \"project:456\"\n   ]'")
+			}
+			for _, e := range parents {
+				err = goa.MergeErrors(err, goa.ValidatePattern("parents[*]", e, "^[a-zA-Z]+:[a-zA-Z0-9_-]+$"))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var searchScope *string
+	{
+		if querySvcQueryResourcesSearchScope != "" {
+			searchScope = &querySvcQueryResourcesSearchScope
+			if !(*searchScope == "name" || *searchScope == "all") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("search_scope", *searchScope, []any{"name", "all"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
 	var type_ *string
 	{
 		if querySvcQueryResourcesType != "" {
@@ -67,6 +107,24 @@ func BuildQueryResourcesPayload(querySvcQueryResourcesVersion string, querySvcQu
 			}
 		}
 	}
+	var tagsMinMatch *int
+	{
+		if querySvcQueryResourcesTagsMinMatch != "" {
+			var v int64
+			v, err = strconv.ParseInt(querySvcQueryResourcesTagsMinMatch, 10, strconv.IntSize)
+			val := int(v)
+			tagsMinMatch = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for tagsMinMatch, must be INT")
+			}
+			if *tagsMinMatch < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("tags_min_match", *tagsMinMatch, 1, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
 	var tagsAll []string
 	{
 		if querySvcQueryResourcesTagsAll != "" {
@@ -76,12 +134,151 @@ func BuildQueryResourcesPayload(querySvcQueryResourcesVersion string, querySvcQu
 			}
 		}
 	}
-	var sort string
+	var createdBy *string
+	{
+		if querySvcQueryResourcesCreatedBy != "" {
+			createdBy = &querySvcQueryResourcesCreatedBy
+		}
+	}
+	var updatedBy *string
+	{
+		if querySvcQueryResourcesUpdatedBy != "" {
+			updatedBy = &querySvcQueryResourcesUpdatedBy
+		}
+	}
+	var transactionID *string
+	{
+		if querySvcQueryResourcesTransactionID != "" {
+			transactionID = &querySvcQueryResourcesTransactionID
+		}
+	}
+	var excludeID []string
+	{
+		if querySvcQueryResourcesExcludeID != "" {
+			err = json.Unmarshal([]byte(querySvcQueryResourcesExcludeID), &excludeID)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for excludeID, \nerror: %s, \nexample of valid JSON:\n%s", err, "'[\n      \"123\",\n      \"456\"\n   ]'")
+			}
+		}
+	}
+	var includeScore bool
+	{
+		if querySvcQueryResourcesIncludeScore != "" {
+			includeScore, err = strconv.ParseBool(querySvcQueryResourcesIncludeScore)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for includeScore, must be BOOL")
+			}
+		}
+	}
+	var includeChildCounts bool
+	{
+		if querySvcQueryResourcesIncludeChildCounts != "" {
+			includeChildCounts, err = strconv.ParseBool(querySvcQueryResourcesIncludeChildCounts)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for includeChildCounts, must be BOOL")
+			}
+		}
+	}
+	var includePermissions bool
+	{
+		if querySvcQueryResourcesIncludePermissions != "" {
+			includePermissions, err = strconv.ParseBool(querySvcQueryResourcesIncludePermissions)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for includePermissions, must be BOOL")
+			}
+		}
+	}
+	var includeAncestry bool
+	{
+		if querySvcQueryResourcesIncludeAncestry != "" {
+			includeAncestry, err = strconv.ParseBool(querySvcQueryResourcesIncludeAncestry)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for includeAncestry, must be BOOL")
+			}
+		}
+	}
+	var collapseBy *string
+	{
+		if querySvcQueryResourcesCollapseBy != "" {
+			collapseBy = &querySvcQueryResourcesCollapseBy
+			if !(*collapseBy == "object_ref" || *collapseBy == "slug") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("collapse_by", *collapseBy, []any{"object_ref", "slug"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var waitForRefresh bool
+	{
+		if querySvcQueryResourcesWaitForRefresh != "" {
+			waitForRefresh, err = strconv.ParseBool(querySvcQueryResourcesWaitForRefresh)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for waitForRefresh, must be BOOL")
+			}
+		}
+	}
+	var suggestOnEmpty bool
+	{
+		if querySvcQueryResourcesSuggestOnEmpty != "" {
+			suggestOnEmpty, err = strconv.ParseBool(querySvcQueryResourcesSuggestOnEmpty)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for suggestOnEmpty, must be BOOL")
+			}
+		}
+	}
+	var recencyBoost bool
+	{
+		if querySvcQueryResourcesRecencyBoost != "" {
+			recencyBoost, err = strconv.ParseBool(querySvcQueryResourcesRecencyBoost)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for recencyBoost, must be BOOL")
+			}
+		}
+	}
+	var profile *string
+	{
+		if querySvcQueryResourcesProfile != "" {
+			profile = &querySvcQueryResourcesProfile
+		}
+	}
+	var view *string
+	{
+		if querySvcQueryResourcesView != "" {
+			view = &querySvcQueryResourcesView
+		}
+	}
+	var template *string
+	{
+		if querySvcQueryResourcesTemplate != "" {
+			template = &querySvcQueryResourcesTemplate
+			err = goa.MergeErrors(err, goa.ValidatePattern("template", *template, "^[a-zA-Z0-9_-]+$"))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var templateParams []string
+	{
+		if querySvcQueryResourcesTemplateParams != "" {
+			err = json.Unmarshal([]byte(querySvcQueryResourcesTemplateParams), &templateParams)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for templateParams, \nerror: %s, \nexample of valid JSON:\n%s", err, "'[\n      \"status=active\"\n   ]'")
+			}
+			for _, e := range templateParams {
+				err = goa.MergeErrors(err, goa.ValidatePattern("template_params[*]", e, "^[a-zA-Z0-9_]+="))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var sort *string
 	{
 		if querySvcQueryResourcesSort != "" {
-			sort = querySvcQueryResourcesSort
-			if !(sort == "name_asc" || sort == "name_desc" || sort == "updated_asc" || sort == "updated_desc") {
-				err = goa.MergeErrors(err, goa.InvalidEnumValueError("sort", sort, []any{"name_asc", "name_desc", "updated_asc", "updated_desc"}))
+			sort = &querySvcQueryResourcesSort
+			if !(*sort == "name_asc" || *sort == "name_desc" || *sort == "updated_asc" || *sort == "updated_desc") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("sort", *sort, []any{"name_asc", "name_desc", "updated_asc", "updated_desc"}))
 			}
 			if err != nil {
 				return nil, err
@@ -94,27 +291,65 @@ func BuildQueryResourcesPayload(querySvcQueryResourcesVersion string, querySvcQu
 			pageToken = &querySvcQueryResourcesPageToken
 		}
 	}
+	var trackTotalHits *string
+	{
+		if querySvcQueryResourcesTrackTotalHits != "" {
+			trackTotalHits = &querySvcQueryResourcesTrackTotalHits
+			err = goa.MergeErrors(err, goa.ValidatePattern("track_total_hits", *trackTotalHits, "^(true|false|[0-9]+)$"))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
 	var bearerToken string
 	{
 		bearerToken = querySvcQueryResourcesBearerToken
 	}
+	var ifNoneMatch *string
+	{
+		if querySvcQueryResourcesIfNoneMatch != "" {
+			ifNoneMatch = &querySvcQueryResourcesIfNoneMatch
+		}
+	}
 	v := &querysvc.QueryResourcesPayload{}
 	v.Version = version
 	v.Name = name
+	v.NameExact = nameExact
 	v.Parent = parent
+	v.Parents = parents
+	v.SearchScope = searchScope
 	v.Type = type_
 	v.Tags = tags
+	v.TagsMinMatch = tagsMinMatch
 	v.TagsAll = tagsAll
+	v.CreatedBy = createdBy
+	v.UpdatedBy = updatedBy
+	v.TransactionID = transactionID
+	v.ExcludeID = excludeID
+	v.IncludeScore = includeScore
+	v.IncludeChildCounts = includeChildCounts
+	v.IncludePermissions = includePermissions
+	v.IncludeAncestry = includeAncestry
+	v.CollapseBy = collapseBy
+	v.WaitForRefresh = waitForRefresh
+	v.SuggestOnEmpty = suggestOnEmpty
+	v.RecencyBoost = recencyBoost
+	v.Profile = profile
+	v.View = view
+	v.Template = template
+	v.TemplateParams = templateParams
 	v.Sort = sort
 	v.PageToken = pageToken
+	v.TrackTotalHits = trackTotalHits
 	v.BearerToken = bearerToken
+	v.IfNoneMatch = ifNoneMatch
 
 	return v, nil
 }
 
 // BuildQueryResourcesCountPayload builds the payload for the query-svc
 // query-resources-count endpoint from CLI flags.
-func BuildQueryResourcesCountPayload(querySvcQueryResourcesCountVersion string, querySvcQueryResourcesCountName string, querySvcQueryResourcesCountParent string, querySvcQueryResourcesCountType string, querySvcQueryResourcesCountTags string, querySvcQueryResourcesCountTagsAll string, querySvcQueryResourcesCountBearerToken string) (*querysvc.QueryResourcesCountPayload, error) {
+func BuildQueryResourcesCountPayload(querySvcQueryResourcesCountVersion string, querySvcQueryResourcesCountName string, querySvcQueryResourcesCountParent string, querySvcQueryResourcesCountType string, querySvcQueryResourcesCountTags string, querySvcQueryResourcesCountTagsAll string, querySvcQueryResourcesCountIncludeRelations string, querySvcQueryResourcesCountBearerToken string) (*querysvc.QueryResourcesCountPayload, error) {
 	var err error
 	var version string
 	{
@@ -168,6 +403,15 @@ func BuildQueryResourcesCountPayload(querySvcQueryResourcesCountVersion string,
 			}
 		}
 	}
+	var includeRelations bool
+	{
+		if querySvcQueryResourcesCountIncludeRelations != "" {
+			includeRelations, err = strconv.ParseBool(querySvcQueryResourcesCountIncludeRelations)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for includeRelations, must be BOOL")
+			}
+		}
+	}
 	var bearerToken string
 	{
 		bearerToken = querySvcQueryResourcesCountBearerToken
@@ -179,18 +423,78 @@ func BuildQueryResourcesCountPayload(querySvcQueryResourcesCountVersion string,
 	v.Type = type_
 	v.Tags = tags
 	v.TagsAll = tagsAll
+	v.IncludeRelations = includeRelations
 	v.BearerToken = bearerToken
 
 	return v, nil
 }
 
-// BuildQueryOrgsPayload builds the payload for the query-svc query-orgs
-// endpoint from CLI flags.
-func BuildQueryOrgsPayload(querySvcQueryOrgsVersion string, querySvcQueryOrgsName string, querySvcQueryOrgsDomain string, querySvcQueryOrgsBearerToken string) (*querysvc.QueryOrgsPayload, error) {
+// BuildQueryResourcesCountBatchPayload builds the payload for the query-svc
+// query-resources-count-batch endpoint from CLI flags.
+func BuildQueryResourcesCountBatchPayload(querySvcQueryResourcesCountBatchBody string, querySvcQueryResourcesCountBatchVersion string, querySvcQueryResourcesCountBatchBearerToken string) (*querysvc.QueryResourcesCountBatchPayload, error) {
+	var err error
+	var body QueryResourcesCountBatchRequestBody
+	{
+		err = json.Unmarshal([]byte(querySvcQueryResourcesCountBatchBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"queries\": [\n         {\n            \"name\": \"gov board\",\n            \"parent\": \"project:123\",\n            \"tags\": [\n               \"active\",\n               \"public\"\n            ],\n            \"tags_all\": [\n               \"governance\",\n               \"security\"\n            ],\n            \"type\": \"committee\"\n         },\n         {\n            \"name\": \"gov board\",\n            \"parent\": \"project:123\",\n            \"tags\": [\n               \"active\",\n               \"public\"\n            ],\n            \"tags_all\": [\n               \"governance\",\n               \"security\"\n            ],\n            \"type\": \"committee\"\n         },\n         {\n            \"name\": \"gov board\",\n            \"parent\": \"project:123\",\n            \"tags\": [\n               \"active\",\n               \"public\"\n            ],\n            \"tags_all\": [\n               \"governance\",\n               \"security\"\n            ],\n            \"type\": \"committee\"\n         }\n      ]\n   }'")
+		}
+		if body.Queries == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("queries", "body"))
+		}
+		if len(body.Queries) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.queries", body.Queries, len(body.Queries), 1, true))
+		}
+		if len(body.Queries) > 20 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.queries", body.Queries, len(body.Queries), 20, false))
+		}
+		for _, e := range body.Queries {
+			if e != nil {
+				if err2 := ValidateCountQueryRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version string
+	{
+		version = querySvcQueryResourcesCountBatchVersion
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var bearerToken string
+	{
+		bearerToken = querySvcQueryResourcesCountBatchBearerToken
+	}
+	v := &querysvc.QueryResourcesCountBatchPayload{}
+	if body.Queries != nil {
+		v.Queries = make([]*querysvc.CountQuery, len(body.Queries))
+		for i, val := range body.Queries {
+			v.Queries[i] = marshalCountQueryRequestBodyToQuerysvcCountQuery(val)
+		}
+	} else {
+		v.Queries = []*querysvc.CountQuery{}
+	}
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildQueryResourcesFacetsPayload builds the payload for the query-svc
+// query-resources-facets endpoint from CLI flags.
+func BuildQueryResourcesFacetsPayload(querySvcQueryResourcesFacetsVersion string, querySvcQueryResourcesFacetsFacet string, querySvcQueryResourcesFacetsName string, querySvcQueryResourcesFacetsParent string, querySvcQueryResourcesFacetsType string, querySvcQueryResourcesFacetsTags string, querySvcQueryResourcesFacetsTagsAll string, querySvcQueryResourcesFacetsIncludeTotal string, querySvcQueryResourcesFacetsBearerToken string) (*querysvc.QueryResourcesFacetsPayload, error) {
 	var err error
 	var version string
 	{
-		version = querySvcQueryOrgsVersion
+		version = querySvcQueryResourcesFacetsVersion
 		if !(version == "1") {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
 		}
@@ -198,10 +502,28 @@ func BuildQueryOrgsPayload(querySvcQueryOrgsVersion string, querySvcQueryOrgsNam
 			return nil, err
 		}
 	}
+	var facet []string
+	{
+		err = json.Unmarshal([]byte(querySvcQueryResourcesFacetsFacet), &facet)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for facet, \nerror: %s, \nexample of valid JSON:\n%s", err, "'[\n      \"type\",\n      \"tags\"\n   ]'")
+		}
+		if len(facet) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("facet", facet, len(facet), 1, true))
+		}
+		for _, e := range facet {
+			if !(e == "type" || e == "tags" || e == "status") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("facet[*]", e, []any{"type", "tags", "status"}))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
 	var name *string
 	{
-		if querySvcQueryOrgsName != "" {
-			name = &querySvcQueryOrgsName
+		if querySvcQueryResourcesFacetsName != "" {
+			name = &querySvcQueryResourcesFacetsName
 			if utf8.RuneCountInString(*name) < 1 {
 				err = goa.MergeErrors(err, goa.InvalidLengthError("name", *name, utf8.RuneCountInString(*name), 1, true))
 			}
@@ -210,36 +532,70 @@ func BuildQueryOrgsPayload(querySvcQueryOrgsVersion string, querySvcQueryOrgsNam
 			}
 		}
 	}
-	var domain *string
+	var parent *string
 	{
-		if querySvcQueryOrgsDomain != "" {
-			domain = &querySvcQueryOrgsDomain
-			err = goa.MergeErrors(err, goa.ValidatePattern("domain", *domain, "^[a-zA-Z0-9][a-zA-Z0-9-_.]*[a-zA-Z0-9]*\\.[a-zA-Z]{2,}$"))
+		if querySvcQueryResourcesFacetsParent != "" {
+			parent = &querySvcQueryResourcesFacetsParent
+		}
+	}
+	var type_ *string
+	{
+		if querySvcQueryResourcesFacetsType != "" {
+			type_ = &querySvcQueryResourcesFacetsType
+		}
+	}
+	var tags []string
+	{
+		if querySvcQueryResourcesFacetsTags != "" {
+			err = json.Unmarshal([]byte(querySvcQueryResourcesFacetsTags), &tags)
 			if err != nil {
-				return nil, err
+				return nil, fmt.Errorf("invalid JSON for tags, \nerror: %s, \nexample of valid JSON:\n%s", err, "'[\n      \"active\",\n      \"public\"\n   ]'")
+			}
+		}
+	}
+	var tagsAll []string
+	{
+		if querySvcQueryResourcesFacetsTagsAll != "" {
+			err = json.Unmarshal([]byte(querySvcQueryResourcesFacetsTagsAll), &tagsAll)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for tagsAll, \nerror: %s, \nexample of valid JSON:\n%s", err, "'[\n      \"governance\",\n      \"security\"\n   ]'")
+			}
+		}
+	}
+	var includeTotal bool
+	{
+		if querySvcQueryResourcesFacetsIncludeTotal != "" {
+			includeTotal, err = strconv.ParseBool(querySvcQueryResourcesFacetsIncludeTotal)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for includeTotal, must be BOOL")
 			}
 		}
 	}
 	var bearerToken string
 	{
-		bearerToken = querySvcQueryOrgsBearerToken
+		bearerToken = querySvcQueryResourcesFacetsBearerToken
 	}
-	v := &querysvc.QueryOrgsPayload{}
+	v := &querysvc.QueryResourcesFacetsPayload{}
 	v.Version = version
+	v.Facet = facet
 	v.Name = name
-	v.Domain = domain
+	v.Parent = parent
+	v.Type = type_
+	v.Tags = tags
+	v.TagsAll = tagsAll
+	v.IncludeTotal = includeTotal
 	v.BearerToken = bearerToken
 
 	return v, nil
 }
 
-// BuildSuggestOrgsPayload builds the payload for the query-svc suggest-orgs
-// endpoint from CLI flags.
-func BuildSuggestOrgsPayload(querySvcSuggestOrgsVersion string, querySvcSuggestOrgsQuery string, querySvcSuggestOrgsBearerToken string) (*querysvc.SuggestOrgsPayload, error) {
+// BuildExplainResourcePayload builds the payload for the query-svc
+// explain-resource endpoint from CLI flags.
+func BuildExplainResourcePayload(querySvcExplainResourceVersion string, querySvcExplainResourceObjectRef string, querySvcExplainResourceName string, querySvcExplainResourceParent string, querySvcExplainResourceType string, querySvcExplainResourceTags string, querySvcExplainResourceTagsAll string, querySvcExplainResourceCreatedBy string, querySvcExplainResourceBearerToken string) (*querysvc.ExplainResourcePayload, error) {
 	var err error
 	var version string
 	{
-		version = querySvcSuggestOrgsVersion
+		version = querySvcExplainResourceVersion
 		if !(version == "1") {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
 		}
@@ -247,23 +603,544 @@ func BuildSuggestOrgsPayload(querySvcSuggestOrgsVersion string, querySvcSuggestO
 			return nil, err
 		}
 	}
-	var query string
+	var objectRef string
 	{
-		query = querySvcSuggestOrgsQuery
-		if utf8.RuneCountInString(query) < 1 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("query", query, utf8.RuneCountInString(query), 1, true))
-		}
+		objectRef = querySvcExplainResourceObjectRef
+		err = goa.MergeErrors(err, goa.ValidatePattern("object_ref", objectRef, "^[a-zA-Z]+:[a-zA-Z0-9_-]+$"))
 		if err != nil {
 			return nil, err
 		}
 	}
+	var name *string
+	{
+		if querySvcExplainResourceName != "" {
+			name = &querySvcExplainResourceName
+			if utf8.RuneCountInString(*name) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("name", *name, utf8.RuneCountInString(*name), 1, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var parent *string
+	{
+		if querySvcExplainResourceParent != "" {
+			parent = &querySvcExplainResourceParent
+			err = goa.MergeErrors(err, goa.ValidatePattern("parent", *parent, "^[a-zA-Z]+:[a-zA-Z0-9_-]+$"))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var type_ *string
+	{
+		if querySvcExplainResourceType != "" {
+			type_ = &querySvcExplainResourceType
+		}
+	}
+	var tags []string
+	{
+		if querySvcExplainResourceTags != "" {
+			err = json.Unmarshal([]byte(querySvcExplainResourceTags), &tags)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for tags, \nerror: %s, \nexample of valid JSON:\n%s", err, "'[\n      \"active\",\n      \"public\"\n   ]'")
+			}
+		}
+	}
+	var tagsAll []string
+	{
+		if querySvcExplainResourceTagsAll != "" {
+			err = json.Unmarshal([]byte(querySvcExplainResourceTagsAll), &tagsAll)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for tagsAll, \nerror: %s, \nexample of valid JSON:\n%s", err, "'[\n      \"governance\",\n      \"security\"\n   ]'")
+			}
+		}
+	}
+	var createdBy *string
+	{
+		if querySvcExplainResourceCreatedBy != "" {
+			createdBy = &querySvcExplainResourceCreatedBy
+		}
+	}
 	var bearerToken string
 	{
-		bearerToken = querySvcSuggestOrgsBearerToken
+		bearerToken = querySvcExplainResourceBearerToken
 	}
-	v := &querysvc.SuggestOrgsPayload{}
+	v := &querysvc.ExplainResourcePayload{}
 	v.Version = version
-	v.Query = query
+	v.ObjectRef = objectRef
+	v.Name = name
+	v.Parent = parent
+	v.Type = type_
+	v.Tags = tags
+	v.TagsAll = tagsAll
+	v.CreatedBy = createdBy
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildCheckTuplesPayload builds the payload for the query-svc check-tuples
+// endpoint from CLI flags.
+func BuildCheckTuplesPayload(querySvcCheckTuplesBody string, querySvcCheckTuplesVersion string, querySvcCheckTuplesBearerToken string) (*querysvc.CheckTuplesPayload, error) {
+	var err error
+	var body CheckTuplesRequestBody
+	{
+		err = json.Unmarshal([]byte(querySvcCheckTuplesBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"tuples\": [\n         \"committee:123#viewer@user:jdoe\",\n         \"project:456#writer@user:jdoe\"\n      ]\n   }'")
+		}
+		if body.Tuples == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("tuples", "body"))
+		}
+		if len(body.Tuples) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.tuples", body.Tuples, len(body.Tuples), 1, true))
+		}
+		if len(body.Tuples) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.tuples", body.Tuples, len(body.Tuples), 100, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version string
+	{
+		version = querySvcCheckTuplesVersion
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var bearerToken string
+	{
+		bearerToken = querySvcCheckTuplesBearerToken
+	}
+	v := &querysvc.CheckTuplesPayload{}
+	if body.Tuples != nil {
+		v.Tuples = make([]string, len(body.Tuples))
+		for i, val := range body.Tuples {
+			v.Tuples[i] = val
+		}
+	} else {
+		v.Tuples = []string{}
+	}
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildQueryOrgsPayload builds the payload for the query-svc query-orgs
+// endpoint from CLI flags.
+func BuildQueryOrgsPayload(querySvcQueryOrgsVersion string, querySvcQueryOrgsName string, querySvcQueryOrgsDomain string, querySvcQueryOrgsDomains string, querySvcQueryOrgsMatchAll string, querySvcQueryOrgsBearerToken string) (*querysvc.QueryOrgsPayload, error) {
+	var err error
+	var version string
+	{
+		version = querySvcQueryOrgsVersion
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var name *string
+	{
+		if querySvcQueryOrgsName != "" {
+			name = &querySvcQueryOrgsName
+			if utf8.RuneCountInString(*name) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("name", *name, utf8.RuneCountInString(*name), 1, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var domain *string
+	{
+		if querySvcQueryOrgsDomain != "" {
+			domain = &querySvcQueryOrgsDomain
+			err = goa.MergeErrors(err, goa.ValidatePattern("domain", *domain, "^[a-zA-Z0-9][a-zA-Z0-9-_.]*[a-zA-Z0-9]*\\.[a-zA-Z]{2,}$"))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var domains []string
+	{
+		if querySvcQueryOrgsDomains != "" {
+			err = json.Unmarshal([]byte(querySvcQueryOrgsDomains), &domains)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for domains, \nerror: %s, \nexample of valid JSON:\n%s", err, "'[\n      \"linuxfoundation.org\",\n      \"linux.com\"\n   ]'")
+			}
+			if len(domains) > 100 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("domains", domains, len(domains), 100, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var matchAll bool
+	{
+		if querySvcQueryOrgsMatchAll != "" {
+			matchAll, err = strconv.ParseBool(querySvcQueryOrgsMatchAll)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for matchAll, must be BOOL")
+			}
+		}
+	}
+	var bearerToken string
+	{
+		bearerToken = querySvcQueryOrgsBearerToken
+	}
+	v := &querysvc.QueryOrgsPayload{}
+	v.Version = version
+	v.Name = name
+	v.Domain = domain
+	v.Domains = domains
+	v.MatchAll = matchAll
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildQueryOrgsListPayload builds the payload for the query-svc
+// query-orgs-list endpoint from CLI flags.
+func BuildQueryOrgsListPayload(querySvcQueryOrgsListVersion string, querySvcQueryOrgsListName string, querySvcQueryOrgsListDomain string, querySvcQueryOrgsListMatchAll string, querySvcQueryOrgsListPageToken string, querySvcQueryOrgsListBearerToken string) (*querysvc.QueryOrgsListPayload, error) {
+	var err error
+	var version string
+	{
+		version = querySvcQueryOrgsListVersion
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var name *string
+	{
+		if querySvcQueryOrgsListName != "" {
+			name = &querySvcQueryOrgsListName
+			if utf8.RuneCountInString(*name) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("name", *name, utf8.RuneCountInString(*name), 1, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var domain *string
+	{
+		if querySvcQueryOrgsListDomain != "" {
+			domain = &querySvcQueryOrgsListDomain
+			err = goa.MergeErrors(err, goa.ValidatePattern("domain", *domain, "^[a-zA-Z0-9][a-zA-Z0-9-_.]*[a-zA-Z0-9]*\\.[a-zA-Z]{2,}$"))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var matchAll bool
+	{
+		if querySvcQueryOrgsListMatchAll != "" {
+			matchAll, err = strconv.ParseBool(querySvcQueryOrgsListMatchAll)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for matchAll, must be BOOL")
+			}
+		}
+	}
+	var pageToken *string
+	{
+		if querySvcQueryOrgsListPageToken != "" {
+			pageToken = &querySvcQueryOrgsListPageToken
+		}
+	}
+	var bearerToken string
+	{
+		bearerToken = querySvcQueryOrgsListBearerToken
+	}
+	v := &querysvc.QueryOrgsListPayload{}
+	v.Version = version
+	v.Name = name
+	v.Domain = domain
+	v.MatchAll = matchAll
+	v.PageToken = pageToken
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildCountOrgsPayload builds the payload for the query-svc count-orgs
+// endpoint from CLI flags.
+func BuildCountOrgsPayload(querySvcCountOrgsVersion string, querySvcCountOrgsName string, querySvcCountOrgsDomain string, querySvcCountOrgsMatchAll string, querySvcCountOrgsBearerToken string) (*querysvc.CountOrgsPayload, error) {
+	var err error
+	var version string
+	{
+		version = querySvcCountOrgsVersion
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var name *string
+	{
+		if querySvcCountOrgsName != "" {
+			name = &querySvcCountOrgsName
+			if utf8.RuneCountInString(*name) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("name", *name, utf8.RuneCountInString(*name), 1, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var domain *string
+	{
+		if querySvcCountOrgsDomain != "" {
+			domain = &querySvcCountOrgsDomain
+			err = goa.MergeErrors(err, goa.ValidatePattern("domain", *domain, "^[a-zA-Z0-9][a-zA-Z0-9-_.]*[a-zA-Z0-9]*\\.[a-zA-Z]{2,}$"))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var matchAll bool
+	{
+		if querySvcCountOrgsMatchAll != "" {
+			matchAll, err = strconv.ParseBool(querySvcCountOrgsMatchAll)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for matchAll, must be BOOL")
+			}
+		}
+	}
+	var bearerToken string
+	{
+		bearerToken = querySvcCountOrgsBearerToken
+	}
+	v := &querysvc.CountOrgsPayload{}
+	v.Version = version
+	v.Name = name
+	v.Domain = domain
+	v.MatchAll = matchAll
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildResolveOrgsPayload builds the payload for the query-svc resolve-orgs
+// endpoint from CLI flags.
+func BuildResolveOrgsPayload(querySvcResolveOrgsBody string, querySvcResolveOrgsVersion string, querySvcResolveOrgsBearerToken string) (*querysvc.ResolveOrgsPayload, error) {
+	var err error
+	var body ResolveOrgsRequestBody
+	{
+		err = json.Unmarshal([]byte(querySvcResolveOrgsBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"domains\": [\n         \"linuxfoundation.org\",\n         \"https://www.example.com/about\"\n      ]\n   }'")
+		}
+		if body.Domains == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("domains", "body"))
+		}
+		if len(body.Domains) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.domains", body.Domains, len(body.Domains), 1, true))
+		}
+		if len(body.Domains) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.domains", body.Domains, len(body.Domains), 100, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version string
+	{
+		version = querySvcResolveOrgsVersion
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var bearerToken string
+	{
+		bearerToken = querySvcResolveOrgsBearerToken
+	}
+	v := &querysvc.ResolveOrgsPayload{}
+	if body.Domains != nil {
+		v.Domains = make([]string, len(body.Domains))
+		for i, val := range body.Domains {
+			v.Domains[i] = val
+		}
+	} else {
+		v.Domains = []string{}
+	}
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildSuggestOrgsPayload builds the payload for the query-svc suggest-orgs
+// endpoint from CLI flags.
+func BuildSuggestOrgsPayload(querySvcSuggestOrgsVersion string, querySvcSuggestOrgsQuery string, querySvcSuggestOrgsBearerToken string) (*querysvc.SuggestOrgsPayload, error) {
+	var err error
+	var version string
+	{
+		version = querySvcSuggestOrgsVersion
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var query string
+	{
+		query = querySvcSuggestOrgsQuery
+		if utf8.RuneCountInString(query) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("query", query, utf8.RuneCountInString(query), 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var bearerToken string
+	{
+		bearerToken = querySvcSuggestOrgsBearerToken
+	}
+	v := &querysvc.SuggestOrgsPayload{}
+	v.Version = version
+	v.Query = query
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildUnifiedSearchPayload builds the payload for the query-svc
+// unified-search endpoint from CLI flags.
+func BuildUnifiedSearchPayload(querySvcUnifiedSearchVersion string, querySvcUnifiedSearchQuery string, querySvcUnifiedSearchBearerToken string) (*querysvc.UnifiedSearchPayload, error) {
+	var err error
+	var version string
+	{
+		version = querySvcUnifiedSearchVersion
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var query string
+	{
+		query = querySvcUnifiedSearchQuery
+		if utf8.RuneCountInString(query) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("query", query, utf8.RuneCountInString(query), 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var bearerToken string
+	{
+		bearerToken = querySvcUnifiedSearchBearerToken
+	}
+	v := &querysvc.UnifiedSearchPayload{}
+	v.Version = version
+	v.Query = query
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildSuggestPayload builds the payload for the query-svc suggest endpoint
+// from CLI flags.
+func BuildSuggestPayload(querySvcSuggestVersion string, querySvcSuggestQuery string, querySvcSuggestKind string, querySvcSuggestBearerToken string) (*querysvc.SuggestPayload, error) {
+	var err error
+	var version string
+	{
+		version = querySvcSuggestVersion
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var query string
+	{
+		query = querySvcSuggestQuery
+		if utf8.RuneCountInString(query) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("query", query, utf8.RuneCountInString(query), 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var kind []string
+	{
+		if querySvcSuggestKind != "" {
+			err = json.Unmarshal([]byte(querySvcSuggestKind), &kind)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for kind, \nerror: %s, \nexample of valid JSON:\n%s", err, "'[\n      \"organization\"\n   ]'")
+			}
+			for _, e := range kind {
+				if !(e == "resource" || e == "organization") {
+					err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind[*]", e, []any{"resource", "organization"}))
+				}
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken string
+	{
+		bearerToken = querySvcSuggestBearerToken
+	}
+	v := &querysvc.SuggestPayload{}
+	v.Version = version
+	v.Query = query
+	v.Kind = kind
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildFlushCachePayload builds the payload for the query-svc flush-cache
+// endpoint from CLI flags.
+func BuildFlushCachePayload(querySvcFlushCacheVersion string, querySvcFlushCacheWarm string, querySvcFlushCacheBearerToken string) (*querysvc.FlushCachePayload, error) {
+	var err error
+	var version string
+	{
+		version = querySvcFlushCacheVersion
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var warm bool
+	{
+		if querySvcFlushCacheWarm != "" {
+			warm, err = strconv.ParseBool(querySvcFlushCacheWarm)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for warm, must be BOOL")
+			}
+		}
+	}
+	var bearerToken string
+	{
+		bearerToken = querySvcFlushCacheBearerToken
+	}
+	v := &querysvc.FlushCachePayload{}
+	v.Version = version
+	v.Warm = warm
 	v.BearerToken = bearerToken
 
 	return v, nil
@@ -25,14 +25,54 @@ type Client struct {
 	// query-resources-count endpoint.
 	QueryResourcesCountDoer goahttp.Doer
 
+	// QueryResourcesCountBatch Doer is the HTTP client used to make requests to
+	// the query-resources-count-batch endpoint.
+	QueryResourcesCountBatchDoer goahttp.Doer
+
+	// QueryResourcesFacets Doer is the HTTP client used to make requests to the
+	// query-resources-facets endpoint.
+	QueryResourcesFacetsDoer goahttp.Doer
+
+	// ExplainResource Doer is the HTTP client used to make requests to the
+	// explain-resource endpoint.
+	ExplainResourceDoer goahttp.Doer
+
+	// CheckTuples Doer is the HTTP client used to make requests to the
+	// check-tuples endpoint.
+	CheckTuplesDoer goahttp.Doer
+
 	// QueryOrgs Doer is the HTTP client used to make requests to the query-orgs
 	// endpoint.
 	QueryOrgsDoer goahttp.Doer
 
+	// QueryOrgsList Doer is the HTTP client used to make requests to the
+	// query-orgs-list endpoint.
+	QueryOrgsListDoer goahttp.Doer
+
+	// CountOrgs Doer is the HTTP client used to make requests to the count-orgs
+	// endpoint.
+	CountOrgsDoer goahttp.Doer
+
+	// ResolveOrgs Doer is the HTTP client used to make requests to the
+	// resolve-orgs endpoint.
+	ResolveOrgsDoer goahttp.Doer
+
 	// SuggestOrgs Doer is the HTTP client used to make requests to the
 	// suggest-orgs endpoint.
 	SuggestOrgsDoer goahttp.Doer
 
+	// UnifiedSearch Doer is the HTTP client used to make requests to the
+	// unified-search endpoint.
+	UnifiedSearchDoer goahttp.Doer
+
+	// Suggest Doer is the HTTP client used to make requests to the suggest
+	// endpoint.
+	SuggestDoer goahttp.Doer
+
+	// FlushCache Doer is the HTTP client used to make requests to the flush-cache
+	// endpoint.
+	FlushCacheDoer goahttp.Doer
+
 	// Readyz Doer is the HTTP client used to make requests to the readyz endpoint.
 	ReadyzDoer goahttp.Doer
 
@@ -59,17 +99,27 @@ func NewClient(
 	restoreBody bool,
 ) *Client {
 	return &Client{
-		QueryResourcesDoer:      doer,
-		QueryResourcesCountDoer: doer,
-		QueryOrgsDoer:           doer,
-		SuggestOrgsDoer:         doer,
-		ReadyzDoer:              doer,
-		LivezDoer:               doer,
-		RestoreResponseBody:     restoreBody,
-		scheme:                  scheme,
-		host:                    host,
-		decoder:                 dec,
-		encoder:                 enc,
+		QueryResourcesDoer:           doer,
+		QueryResourcesCountDoer:      doer,
+		QueryResourcesCountBatchDoer: doer,
+		QueryResourcesFacetsDoer:     doer,
+		ExplainResourceDoer:          doer,
+		CheckTuplesDoer:              doer,
+		QueryOrgsDoer:                doer,
+		QueryOrgsListDoer:            doer,
+		CountOrgsDoer:                doer,
+		ResolveOrgsDoer:              doer,
+		SuggestOrgsDoer:              doer,
+		UnifiedSearchDoer:            doer,
+		SuggestDoer:                  doer,
+		FlushCacheDoer:               doer,
+		ReadyzDoer:                   doer,
+		LivezDoer:                    doer,
+		RestoreResponseBody:          restoreBody,
+		scheme:                       scheme,
+		host:                         host,
+		decoder:                      dec,
+		encoder:                      enc,
 	}
 }
 
@@ -121,6 +171,102 @@ func (c *Client) QueryResourcesCount() goa.Endpoint {
 	}
 }
 
+// QueryResourcesCountBatch returns an endpoint that makes HTTP requests to the
+// query-svc service query-resources-count-batch server.
+func (c *Client) QueryResourcesCountBatch() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeQueryResourcesCountBatchRequest(c.encoder)
+		decodeResponse = DecodeQueryResourcesCountBatchResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildQueryResourcesCountBatchRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.QueryResourcesCountBatchDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("query-svc", "query-resources-count-batch", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// QueryResourcesFacets returns an endpoint that makes HTTP requests to the
+// query-svc service query-resources-facets server.
+func (c *Client) QueryResourcesFacets() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeQueryResourcesFacetsRequest(c.encoder)
+		decodeResponse = DecodeQueryResourcesFacetsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildQueryResourcesFacetsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.QueryResourcesFacetsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("query-svc", "query-resources-facets", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ExplainResource returns an endpoint that makes HTTP requests to the
+// query-svc service explain-resource server.
+func (c *Client) ExplainResource() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeExplainResourceRequest(c.encoder)
+		decodeResponse = DecodeExplainResourceResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildExplainResourceRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ExplainResourceDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("query-svc", "explain-resource", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CheckTuples returns an endpoint that makes HTTP requests to the query-svc
+// service check-tuples server.
+func (c *Client) CheckTuples() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCheckTuplesRequest(c.encoder)
+		decodeResponse = DecodeCheckTuplesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCheckTuplesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CheckTuplesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("query-svc", "check-tuples", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // QueryOrgs returns an endpoint that makes HTTP requests to the query-svc
 // service query-orgs server.
 func (c *Client) QueryOrgs() goa.Endpoint {
@@ -145,6 +291,78 @@ func (c *Client) QueryOrgs() goa.Endpoint {
 	}
 }
 
+// QueryOrgsList returns an endpoint that makes HTTP requests to the query-svc
+// service query-orgs-list server.
+func (c *Client) QueryOrgsList() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeQueryOrgsListRequest(c.encoder)
+		decodeResponse = DecodeQueryOrgsListResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildQueryOrgsListRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.QueryOrgsListDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("query-svc", "query-orgs-list", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CountOrgs returns an endpoint that makes HTTP requests to the query-svc
+// service count-orgs server.
+func (c *Client) CountOrgs() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCountOrgsRequest(c.encoder)
+		decodeResponse = DecodeCountOrgsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCountOrgsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CountOrgsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("query-svc", "count-orgs", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ResolveOrgs returns an endpoint that makes HTTP requests to the query-svc
+// service resolve-orgs server.
+func (c *Client) ResolveOrgs() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeResolveOrgsRequest(c.encoder)
+		decodeResponse = DecodeResolveOrgsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildResolveOrgsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ResolveOrgsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("query-svc", "resolve-orgs", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // SuggestOrgs returns an endpoint that makes HTTP requests to the query-svc
 // service suggest-orgs server.
 func (c *Client) SuggestOrgs() goa.Endpoint {
@@ -169,6 +387,78 @@ func (c *Client) SuggestOrgs() goa.Endpoint {
 	}
 }
 
+// UnifiedSearch returns an endpoint that makes HTTP requests to the query-svc
+// service unified-search server.
+func (c *Client) UnifiedSearch() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUnifiedSearchRequest(c.encoder)
+		decodeResponse = DecodeUnifiedSearchResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUnifiedSearchRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UnifiedSearchDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("query-svc", "unified-search", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Suggest returns an endpoint that makes HTTP requests to the query-svc
+// service suggest server.
+func (c *Client) Suggest() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSuggestRequest(c.encoder)
+		decodeResponse = DecodeSuggestResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSuggestRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SuggestDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("query-svc", "suggest", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// FlushCache returns an endpoint that makes HTTP requests to the query-svc
+// service flush-cache server.
+func (c *Client) FlushCache() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeFlushCacheRequest(c.encoder)
+		decodeResponse = DecodeFlushCacheResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildFlushCacheRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.FlushCacheDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("query-svc", "flush-cache", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // Readyz returns an endpoint that makes HTTP requests to the query-svc service
 // readyz server.
 func (c *Client) Readyz() goa.Endpoint {
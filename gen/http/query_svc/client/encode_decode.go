@@ -10,13 +10,16 @@ package client
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 
 	querysvc "github.com/linuxfoundation/lfx-v2-query-service/gen/query_svc"
 	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // BuildQueryResourcesRequest instantiates a HTTP request object with method
@@ -50,27 +53,82 @@ func EncodeQueryResourcesRequest(encoder func(*http.Request) goahttp.Encoder) fu
 				req.Header.Set("Authorization", head)
 			}
 		}
+		if p.IfNoneMatch != nil {
+			head := *p.IfNoneMatch
+			req.Header.Set("If-None-Match", head)
+		}
 		values := req.URL.Query()
 		values.Add("v", p.Version)
 		if p.Name != nil {
 			values.Add("name", *p.Name)
 		}
+		if p.NameExact != nil {
+			values.Add("name_exact", *p.NameExact)
+		}
 		if p.Parent != nil {
 			values.Add("parent", *p.Parent)
 		}
+		for _, value := range p.Parents {
+			values.Add("parents", value)
+		}
+		if p.SearchScope != nil {
+			values.Add("search_scope", *p.SearchScope)
+		}
 		if p.Type != nil {
 			values.Add("type", *p.Type)
 		}
 		for _, value := range p.Tags {
 			values.Add("tags", value)
 		}
+		if p.TagsMinMatch != nil {
+			values.Add("tags_min_match", fmt.Sprintf("%v", *p.TagsMinMatch))
+		}
 		for _, value := range p.TagsAll {
 			values.Add("tags_all", value)
 		}
-		values.Add("sort", p.Sort)
+		if p.CreatedBy != nil {
+			values.Add("created_by", *p.CreatedBy)
+		}
+		if p.UpdatedBy != nil {
+			values.Add("updated_by", *p.UpdatedBy)
+		}
+		if p.TransactionID != nil {
+			values.Add("transaction_id", *p.TransactionID)
+		}
+		for _, value := range p.ExcludeID {
+			values.Add("exclude_id", value)
+		}
+		values.Add("include_score", fmt.Sprintf("%v", p.IncludeScore))
+		values.Add("include_child_counts", fmt.Sprintf("%v", p.IncludeChildCounts))
+		values.Add("include_permissions", fmt.Sprintf("%v", p.IncludePermissions))
+		values.Add("include_ancestry", fmt.Sprintf("%v", p.IncludeAncestry))
+		if p.CollapseBy != nil {
+			values.Add("collapse_by", *p.CollapseBy)
+		}
+		values.Add("wait_for_refresh", fmt.Sprintf("%v", p.WaitForRefresh))
+		values.Add("suggest_on_empty", fmt.Sprintf("%v", p.SuggestOnEmpty))
+		values.Add("recency_boost", fmt.Sprintf("%v", p.RecencyBoost))
+		if p.Profile != nil {
+			values.Add("profile", *p.Profile)
+		}
+		if p.View != nil {
+			values.Add("view", *p.View)
+		}
+		if p.Template != nil {
+			values.Add("template", *p.Template)
+		}
+		for _, value := range p.TemplateParams {
+			values.Add("template_params", value)
+		}
+		if p.Sort != nil {
+			values.Add("sort", *p.Sort)
+		}
 		if p.PageToken != nil {
 			values.Add("page_token", *p.PageToken)
 		}
+		if p.TrackTotalHits != nil {
+			values.Add("track_total_hits", *p.TrackTotalHits)
+		}
 		req.URL.RawQuery = values.Encode()
 		return nil
 	}
@@ -99,27 +157,79 @@ func DecodeQueryResourcesResponse(decoder func(*http.Response) goahttp.Decoder,
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
+		case http.StatusNotModified:
+			var (
+				cacheControl *string
+				etag         *string
+			)
+			cacheControlRaw := resp.Header.Get("Cache-Control")
+			if cacheControlRaw != "" {
+				cacheControl = &cacheControlRaw
+			}
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw != "" {
+				etag = &etagRaw
+			}
+			res := NewQueryResourcesResultNotModified(cacheControl, etag)
+			tmp := "not-modified"
+			res.CacheStatus = &tmp
+			return res, nil
 		case http.StatusOK:
 			var (
-				body QueryResourcesResponseBody
+				body QueryResourcesOKResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
 				return nil, goahttp.ErrDecodingError("query-svc", "query-resources", err)
 			}
-			err = ValidateQueryResourcesResponseBody(&body)
+			err = ValidateQueryResourcesOKResponseBody(&body)
 			if err != nil {
 				return nil, goahttp.ErrValidationError("query-svc", "query-resources", err)
 			}
 			var (
 				cacheControl *string
+				publicPath   *bool
+				degraded     *bool
+				link         *string
+				etag         *string
 			)
 			cacheControlRaw := resp.Header.Get("Cache-Control")
 			if cacheControlRaw != "" {
 				cacheControl = &cacheControlRaw
 			}
-			res := NewQueryResourcesResultOK(&body, cacheControl)
+			{
+				publicPathRaw := resp.Header.Get("X-Public-Path")
+				if publicPathRaw != "" {
+					v, err2 := strconv.ParseBool(publicPathRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("public_path", publicPathRaw, "boolean"))
+					}
+					publicPath = &v
+				}
+			}
+			{
+				degradedRaw := resp.Header.Get("X-Degraded")
+				if degradedRaw != "" {
+					v, err2 := strconv.ParseBool(degradedRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("degraded", degradedRaw, "boolean"))
+					}
+					degraded = &v
+				}
+			}
+			linkRaw := resp.Header.Get("Link")
+			if linkRaw != "" {
+				link = &linkRaw
+			}
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw != "" {
+				etag = &etagRaw
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "query-resources", err)
+			}
+			res := NewQueryResourcesResultOK(&body, cacheControl, publicPath, degraded, link, etag)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
@@ -219,6 +329,7 @@ func EncodeQueryResourcesCountRequest(encoder func(*http.Request) goahttp.Encode
 		for _, value := range p.TagsAll {
 			values.Add("tags_all", value)
 		}
+		values.Add("include_relations", fmt.Sprintf("%v", p.IncludeRelations))
 		req.URL.RawQuery = values.Encode()
 		return nil
 	}
@@ -262,12 +373,37 @@ func DecodeQueryResourcesCountResponse(decoder func(*http.Response) goahttp.Deco
 			}
 			var (
 				cacheControl *string
+				publicPath   *bool
+				degraded     *bool
 			)
 			cacheControlRaw := resp.Header.Get("Cache-Control")
 			if cacheControlRaw != "" {
 				cacheControl = &cacheControlRaw
 			}
-			res := NewQueryResourcesCountResultOK(&body, cacheControl)
+			{
+				publicPathRaw := resp.Header.Get("X-Public-Path")
+				if publicPathRaw != "" {
+					v, err2 := strconv.ParseBool(publicPathRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("public_path", publicPathRaw, "boolean"))
+					}
+					publicPath = &v
+				}
+			}
+			{
+				degradedRaw := resp.Header.Get("X-Degraded")
+				if degradedRaw != "" {
+					v, err2 := strconv.ParseBool(degradedRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("degraded", degradedRaw, "boolean"))
+					}
+					degraded = &v
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "query-resources-count", err)
+			}
+			res := NewQueryResourcesCountResultOK(&body, cacheControl, publicPath, degraded)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
@@ -318,13 +454,14 @@ func DecodeQueryResourcesCountResponse(decoder func(*http.Response) goahttp.Deco
 	}
 }
 
-// BuildQueryOrgsRequest instantiates a HTTP request object with method and
-// path set to call the "query-svc" service "query-orgs" endpoint
-func (c *Client) BuildQueryOrgsRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: QueryOrgsQuerySvcPath()}
-	req, err := http.NewRequest("GET", u.String(), nil)
+// BuildQueryResourcesCountBatchRequest instantiates a HTTP request object with
+// method and path set to call the "query-svc" service
+// "query-resources-count-batch" endpoint
+func (c *Client) BuildQueryResourcesCountBatchRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: QueryResourcesCountBatchQuerySvcPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("query-svc", "query-orgs", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("query-svc", "query-resources-count-batch", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -333,13 +470,13 @@ func (c *Client) BuildQueryOrgsRequest(ctx context.Context, v any) (*http.Reques
 	return req, nil
 }
 
-// EncodeQueryOrgsRequest returns an encoder for requests sent to the query-svc
-// query-orgs server.
-func EncodeQueryOrgsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeQueryResourcesCountBatchRequest returns an encoder for requests sent
+// to the query-svc query-resources-count-batch server.
+func EncodeQueryResourcesCountBatchRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*querysvc.QueryOrgsPayload)
+		p, ok := v.(*querysvc.QueryResourcesCountBatchPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("query-svc", "query-orgs", "*querysvc.QueryOrgsPayload", v)
+			return goahttp.ErrInvalidType("query-svc", "query-resources-count-batch", "*querysvc.QueryResourcesCountBatchPayload", v)
 		}
 		{
 			head := p.BearerToken
@@ -351,27 +488,24 @@ func EncodeQueryOrgsRequest(encoder func(*http.Request) goahttp.Encoder) func(*h
 		}
 		values := req.URL.Query()
 		values.Add("v", p.Version)
-		if p.Name != nil {
-			values.Add("name", *p.Name)
-		}
-		if p.Domain != nil {
-			values.Add("domain", *p.Domain)
-		}
 		req.URL.RawQuery = values.Encode()
+		body := NewQueryResourcesCountBatchRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("query-svc", "query-resources-count-batch", err)
+		}
 		return nil
 	}
 }
 
-// DecodeQueryOrgsResponse returns a decoder for responses returned by the
-// query-svc query-orgs endpoint. restoreBody controls whether the response
-// body should be restored after having been read.
-// DecodeQueryOrgsResponse may return the following errors:
+// DecodeQueryResourcesCountBatchResponse returns a decoder for responses
+// returned by the query-svc query-resources-count-batch endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeQueryResourcesCountBatchResponse may return the following errors:
 //   - "BadRequest" (type *querysvc.BadRequestError): http.StatusBadRequest
 //   - "InternalServerError" (type *querysvc.InternalServerError): http.StatusInternalServerError
-//   - "NotFound" (type *querysvc.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *querysvc.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeQueryOrgsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeQueryResourcesCountBatchResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -388,85 +522,76 @@ func DecodeQueryOrgsResponse(decoder func(*http.Response) goahttp.Decoder, resto
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body QueryOrgsResponseBody
+				body QueryResourcesCountBatchResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("query-svc", "query-orgs", err)
+				return nil, goahttp.ErrDecodingError("query-svc", "query-resources-count-batch", err)
 			}
-			res := NewQueryOrgsOrganizationOK(&body)
+			err = ValidateQueryResourcesCountBatchResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "query-resources-count-batch", err)
+			}
+			res := NewQueryResourcesCountBatchResultOK(&body)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
-				body QueryOrgsBadRequestResponseBody
+				body QueryResourcesCountBatchBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("query-svc", "query-orgs", err)
+				return nil, goahttp.ErrDecodingError("query-svc", "query-resources-count-batch", err)
 			}
-			err = ValidateQueryOrgsBadRequestResponseBody(&body)
+			err = ValidateQueryResourcesCountBatchBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("query-svc", "query-orgs", err)
+				return nil, goahttp.ErrValidationError("query-svc", "query-resources-count-batch", err)
 			}
-			return nil, NewQueryOrgsBadRequest(&body)
+			return nil, NewQueryResourcesCountBatchBadRequest(&body)
 		case http.StatusInternalServerError:
 			var (
-				body QueryOrgsInternalServerErrorResponseBody
-				err  error
-			)
-			err = decoder(resp).Decode(&body)
-			if err != nil {
-				return nil, goahttp.ErrDecodingError("query-svc", "query-orgs", err)
-			}
-			err = ValidateQueryOrgsInternalServerErrorResponseBody(&body)
-			if err != nil {
-				return nil, goahttp.ErrValidationError("query-svc", "query-orgs", err)
-			}
-			return nil, NewQueryOrgsInternalServerError(&body)
-		case http.StatusNotFound:
-			var (
-				body QueryOrgsNotFoundResponseBody
+				body QueryResourcesCountBatchInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("query-svc", "query-orgs", err)
+				return nil, goahttp.ErrDecodingError("query-svc", "query-resources-count-batch", err)
 			}
-			err = ValidateQueryOrgsNotFoundResponseBody(&body)
+			err = ValidateQueryResourcesCountBatchInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("query-svc", "query-orgs", err)
+				return nil, goahttp.ErrValidationError("query-svc", "query-resources-count-batch", err)
 			}
-			return nil, NewQueryOrgsNotFound(&body)
+			return nil, NewQueryResourcesCountBatchInternalServerError(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body QueryOrgsServiceUnavailableResponseBody
+				body QueryResourcesCountBatchServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("query-svc", "query-orgs", err)
+				return nil, goahttp.ErrDecodingError("query-svc", "query-resources-count-batch", err)
 			}
-			err = ValidateQueryOrgsServiceUnavailableResponseBody(&body)
+			err = ValidateQueryResourcesCountBatchServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("query-svc", "query-orgs", err)
+				return nil, goahttp.ErrValidationError("query-svc", "query-resources-count-batch", err)
 			}
-			return nil, NewQueryOrgsServiceUnavailable(&body)
+			return nil, NewQueryResourcesCountBatchServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("query-svc", "query-orgs", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("query-svc", "query-resources-count-batch", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildSuggestOrgsRequest instantiates a HTTP request object with method and
-// path set to call the "query-svc" service "suggest-orgs" endpoint
-func (c *Client) BuildSuggestOrgsRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SuggestOrgsQuerySvcPath()}
+// BuildQueryResourcesFacetsRequest instantiates a HTTP request object with
+// method and path set to call the "query-svc" service "query-resources-facets"
+// endpoint
+func (c *Client) BuildQueryResourcesFacetsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: QueryResourcesFacetsQuerySvcPath()}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("query-svc", "suggest-orgs", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("query-svc", "query-resources-facets", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -475,13 +600,13 @@ func (c *Client) BuildSuggestOrgsRequest(ctx context.Context, v any) (*http.Requ
 	return req, nil
 }
 
-// EncodeSuggestOrgsRequest returns an encoder for requests sent to the
-// query-svc suggest-orgs server.
-func EncodeSuggestOrgsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeQueryResourcesFacetsRequest returns an encoder for requests sent to
+// the query-svc query-resources-facets server.
+func EncodeQueryResourcesFacetsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*querysvc.SuggestOrgsPayload)
+		p, ok := v.(*querysvc.QueryResourcesFacetsPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("query-svc", "suggest-orgs", "*querysvc.SuggestOrgsPayload", v)
+			return goahttp.ErrInvalidType("query-svc", "query-resources-facets", "*querysvc.QueryResourcesFacetsPayload", v)
 		}
 		{
 			head := p.BearerToken
@@ -493,21 +618,39 @@ func EncodeSuggestOrgsRequest(encoder func(*http.Request) goahttp.Encoder) func(
 		}
 		values := req.URL.Query()
 		values.Add("v", p.Version)
-		values.Add("query", p.Query)
+		for _, value := range p.Facet {
+			values.Add("facet", value)
+		}
+		if p.Name != nil {
+			values.Add("name", *p.Name)
+		}
+		if p.Parent != nil {
+			values.Add("parent", *p.Parent)
+		}
+		if p.Type != nil {
+			values.Add("type", *p.Type)
+		}
+		for _, value := range p.Tags {
+			values.Add("tags", value)
+		}
+		for _, value := range p.TagsAll {
+			values.Add("tags_all", value)
+		}
+		values.Add("include_total", fmt.Sprintf("%v", p.IncludeTotal))
 		req.URL.RawQuery = values.Encode()
 		return nil
 	}
 }
 
-// DecodeSuggestOrgsResponse returns a decoder for responses returned by the
-// query-svc suggest-orgs endpoint. restoreBody controls whether the response
-// body should be restored after having been read.
-// DecodeSuggestOrgsResponse may return the following errors:
+// DecodeQueryResourcesFacetsResponse returns a decoder for responses returned
+// by the query-svc query-resources-facets endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeQueryResourcesFacetsResponse may return the following errors:
 //   - "BadRequest" (type *querysvc.BadRequestError): http.StatusBadRequest
 //   - "InternalServerError" (type *querysvc.InternalServerError): http.StatusInternalServerError
 //   - "ServiceUnavailable" (type *querysvc.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeSuggestOrgsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeQueryResourcesFacetsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -524,75 +667,96 @@ func DecodeSuggestOrgsResponse(decoder func(*http.Response) goahttp.Decoder, res
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body SuggestOrgsResponseBody
+				body QueryResourcesFacetsResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("query-svc", "suggest-orgs", err)
+				return nil, goahttp.ErrDecodingError("query-svc", "query-resources-facets", err)
 			}
-			err = ValidateSuggestOrgsResponseBody(&body)
+			err = ValidateQueryResourcesFacetsResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("query-svc", "suggest-orgs", err)
+				return nil, goahttp.ErrValidationError("query-svc", "query-resources-facets", err)
 			}
-			res := NewSuggestOrgsResultOK(&body)
+			var (
+				cacheControl *string
+				publicPath   *bool
+			)
+			cacheControlRaw := resp.Header.Get("Cache-Control")
+			if cacheControlRaw != "" {
+				cacheControl = &cacheControlRaw
+			}
+			{
+				publicPathRaw := resp.Header.Get("X-Public-Path")
+				if publicPathRaw != "" {
+					v, err2 := strconv.ParseBool(publicPathRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("public_path", publicPathRaw, "boolean"))
+					}
+					publicPath = &v
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "query-resources-facets", err)
+			}
+			res := NewQueryResourcesFacetsResultOK(&body, cacheControl, publicPath)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
-				body SuggestOrgsBadRequestResponseBody
+				body QueryResourcesFacetsBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("query-svc", "suggest-orgs", err)
+				return nil, goahttp.ErrDecodingError("query-svc", "query-resources-facets", err)
 			}
-			err = ValidateSuggestOrgsBadRequestResponseBody(&body)
+			err = ValidateQueryResourcesFacetsBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("query-svc", "suggest-orgs", err)
+				return nil, goahttp.ErrValidationError("query-svc", "query-resources-facets", err)
 			}
-			return nil, NewSuggestOrgsBadRequest(&body)
+			return nil, NewQueryResourcesFacetsBadRequest(&body)
 		case http.StatusInternalServerError:
 			var (
-				body SuggestOrgsInternalServerErrorResponseBody
+				body QueryResourcesFacetsInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("query-svc", "suggest-orgs", err)
+				return nil, goahttp.ErrDecodingError("query-svc", "query-resources-facets", err)
 			}
-			err = ValidateSuggestOrgsInternalServerErrorResponseBody(&body)
+			err = ValidateQueryResourcesFacetsInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("query-svc", "suggest-orgs", err)
+				return nil, goahttp.ErrValidationError("query-svc", "query-resources-facets", err)
 			}
-			return nil, NewSuggestOrgsInternalServerError(&body)
+			return nil, NewQueryResourcesFacetsInternalServerError(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body SuggestOrgsServiceUnavailableResponseBody
+				body QueryResourcesFacetsServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("query-svc", "suggest-orgs", err)
+				return nil, goahttp.ErrDecodingError("query-svc", "query-resources-facets", err)
 			}
-			err = ValidateSuggestOrgsServiceUnavailableResponseBody(&body)
+			err = ValidateQueryResourcesFacetsServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("query-svc", "suggest-orgs", err)
+				return nil, goahttp.ErrValidationError("query-svc", "query-resources-facets", err)
 			}
-			return nil, NewSuggestOrgsServiceUnavailable(&body)
+			return nil, NewQueryResourcesFacetsServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("query-svc", "suggest-orgs", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("query-svc", "query-resources-facets", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildReadyzRequest instantiates a HTTP request object with method and path
-// set to call the "query-svc" service "readyz" endpoint
-func (c *Client) BuildReadyzRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ReadyzQuerySvcPath()}
+// BuildExplainResourceRequest instantiates a HTTP request object with method
+// and path set to call the "query-svc" service "explain-resource" endpoint
+func (c *Client) BuildExplainResourceRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ExplainResourceQuerySvcPath()}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("query-svc", "readyz", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("query-svc", "explain-resource", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -601,13 +765,58 @@ func (c *Client) BuildReadyzRequest(ctx context.Context, v any) (*http.Request,
 	return req, nil
 }
 
-// DecodeReadyzResponse returns a decoder for responses returned by the
-// query-svc readyz endpoint. restoreBody controls whether the response body
-// should be restored after having been read.
-// DecodeReadyzResponse may return the following errors:
-//   - "NotReady" (type *goa.ServiceError): http.StatusServiceUnavailable
+// EncodeExplainResourceRequest returns an encoder for requests sent to the
+// query-svc explain-resource server.
+func EncodeExplainResourceRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*querysvc.ExplainResourcePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("query-svc", "explain-resource", "*querysvc.ExplainResourcePayload", v)
+		}
+		{
+			head := p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("v", p.Version)
+		values.Add("object_ref", p.ObjectRef)
+		if p.Name != nil {
+			values.Add("name", *p.Name)
+		}
+		if p.Parent != nil {
+			values.Add("parent", *p.Parent)
+		}
+		if p.Type != nil {
+			values.Add("type", *p.Type)
+		}
+		for _, value := range p.Tags {
+			values.Add("tags", value)
+		}
+		for _, value := range p.TagsAll {
+			values.Add("tags_all", value)
+		}
+		if p.CreatedBy != nil {
+			values.Add("created_by", *p.CreatedBy)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeExplainResourceResponse returns a decoder for responses returned by
+// the query-svc explain-resource endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeExplainResourceResponse may return the following errors:
+//   - "BadRequest" (type *querysvc.BadRequestError): http.StatusBadRequest
+//   - "InternalServerError" (type *querysvc.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *querysvc.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *querysvc.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeReadyzResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeExplainResourceResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -624,42 +833,1364 @@ func DecodeReadyzResponse(decoder func(*http.Response) goahttp.Decoder, restoreB
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body []byte
+				body ExplainResourceResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("query-svc", "readyz", err)
+				return nil, goahttp.ErrDecodingError("query-svc", "explain-resource", err)
 			}
-			return body, nil
+			err = ValidateExplainResourceResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "explain-resource", err)
+			}
+			res := NewExplainResourceResourceExplanationOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ExplainResourceBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "explain-resource", err)
+			}
+			err = ValidateExplainResourceBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "explain-resource", err)
+			}
+			return nil, NewExplainResourceBadRequest(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ExplainResourceInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "explain-resource", err)
+			}
+			err = ValidateExplainResourceInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "explain-resource", err)
+			}
+			return nil, NewExplainResourceInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body ExplainResourceNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "explain-resource", err)
+			}
+			err = ValidateExplainResourceNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "explain-resource", err)
+			}
+			return nil, NewExplainResourceNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body ReadyzNotReadyResponseBody
+				body ExplainResourceServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("query-svc", "readyz", err)
+				return nil, goahttp.ErrDecodingError("query-svc", "explain-resource", err)
 			}
-			err = ValidateReadyzNotReadyResponseBody(&body)
+			err = ValidateExplainResourceServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("query-svc", "readyz", err)
+				return nil, goahttp.ErrValidationError("query-svc", "explain-resource", err)
 			}
-			return nil, NewReadyzNotReady(&body)
+			return nil, NewExplainResourceServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("query-svc", "readyz", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("query-svc", "explain-resource", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildLivezRequest instantiates a HTTP request object with method and path
-// set to call the "query-svc" service "livez" endpoint
-func (c *Client) BuildLivezRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: LivezQuerySvcPath()}
-	req, err := http.NewRequest("GET", u.String(), nil)
+// BuildCheckTuplesRequest instantiates a HTTP request object with method and
+// path set to call the "query-svc" service "check-tuples" endpoint
+func (c *Client) BuildCheckTuplesRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CheckTuplesQuerySvcPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("query-svc", "livez", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("query-svc", "check-tuples", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCheckTuplesRequest returns an encoder for requests sent to the
+// query-svc check-tuples server.
+func EncodeCheckTuplesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*querysvc.CheckTuplesPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("query-svc", "check-tuples", "*querysvc.CheckTuplesPayload", v)
+		}
+		{
+			head := p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("v", p.Version)
+		req.URL.RawQuery = values.Encode()
+		body := NewCheckTuplesRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("query-svc", "check-tuples", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCheckTuplesResponse returns a decoder for responses returned by the
+// query-svc check-tuples endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeCheckTuplesResponse may return the following errors:
+//   - "BadRequest" (type *querysvc.BadRequestError): http.StatusBadRequest
+//   - "InternalServerError" (type *querysvc.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *querysvc.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *querysvc.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeCheckTuplesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body CheckTuplesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "check-tuples", err)
+			}
+			err = ValidateCheckTuplesResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "check-tuples", err)
+			}
+			res := NewCheckTuplesResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body CheckTuplesBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "check-tuples", err)
+			}
+			err = ValidateCheckTuplesBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "check-tuples", err)
+			}
+			return nil, NewCheckTuplesBadRequest(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CheckTuplesInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "check-tuples", err)
+			}
+			err = ValidateCheckTuplesInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "check-tuples", err)
+			}
+			return nil, NewCheckTuplesInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body CheckTuplesNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "check-tuples", err)
+			}
+			err = ValidateCheckTuplesNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "check-tuples", err)
+			}
+			return nil, NewCheckTuplesNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body CheckTuplesServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "check-tuples", err)
+			}
+			err = ValidateCheckTuplesServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "check-tuples", err)
+			}
+			return nil, NewCheckTuplesServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("query-svc", "check-tuples", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildQueryOrgsRequest instantiates a HTTP request object with method and
+// path set to call the "query-svc" service "query-orgs" endpoint
+func (c *Client) BuildQueryOrgsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: QueryOrgsQuerySvcPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("query-svc", "query-orgs", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeQueryOrgsRequest returns an encoder for requests sent to the query-svc
+// query-orgs server.
+func EncodeQueryOrgsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*querysvc.QueryOrgsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("query-svc", "query-orgs", "*querysvc.QueryOrgsPayload", v)
+		}
+		{
+			head := p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("v", p.Version)
+		if p.Name != nil {
+			values.Add("name", *p.Name)
+		}
+		if p.Domain != nil {
+			values.Add("domain", *p.Domain)
+		}
+		for _, value := range p.Domains {
+			values.Add("domains", value)
+		}
+		values.Add("match_all", fmt.Sprintf("%v", p.MatchAll))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeQueryOrgsResponse returns a decoder for responses returned by the
+// query-svc query-orgs endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeQueryOrgsResponse may return the following errors:
+//   - "BadRequest" (type *querysvc.BadRequestError): http.StatusBadRequest
+//   - "InternalServerError" (type *querysvc.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *querysvc.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *querysvc.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeQueryOrgsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body QueryOrgsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "query-orgs", err)
+			}
+			res := NewQueryOrgsOrganizationOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body QueryOrgsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "query-orgs", err)
+			}
+			err = ValidateQueryOrgsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "query-orgs", err)
+			}
+			return nil, NewQueryOrgsBadRequest(&body)
+		case http.StatusInternalServerError:
+			var (
+				body QueryOrgsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "query-orgs", err)
+			}
+			err = ValidateQueryOrgsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "query-orgs", err)
+			}
+			return nil, NewQueryOrgsInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body QueryOrgsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "query-orgs", err)
+			}
+			err = ValidateQueryOrgsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "query-orgs", err)
+			}
+			return nil, NewQueryOrgsNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body QueryOrgsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "query-orgs", err)
+			}
+			err = ValidateQueryOrgsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "query-orgs", err)
+			}
+			return nil, NewQueryOrgsServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("query-svc", "query-orgs", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildQueryOrgsListRequest instantiates a HTTP request object with method and
+// path set to call the "query-svc" service "query-orgs-list" endpoint
+func (c *Client) BuildQueryOrgsListRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: QueryOrgsListQuerySvcPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("query-svc", "query-orgs-list", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeQueryOrgsListRequest returns an encoder for requests sent to the
+// query-svc query-orgs-list server.
+func EncodeQueryOrgsListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*querysvc.QueryOrgsListPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("query-svc", "query-orgs-list", "*querysvc.QueryOrgsListPayload", v)
+		}
+		{
+			head := p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("v", p.Version)
+		if p.Name != nil {
+			values.Add("name", *p.Name)
+		}
+		if p.Domain != nil {
+			values.Add("domain", *p.Domain)
+		}
+		values.Add("match_all", fmt.Sprintf("%v", p.MatchAll))
+		if p.PageToken != nil {
+			values.Add("page_token", *p.PageToken)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeQueryOrgsListResponse returns a decoder for responses returned by the
+// query-svc query-orgs-list endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeQueryOrgsListResponse may return the following errors:
+//   - "BadRequest" (type *querysvc.BadRequestError): http.StatusBadRequest
+//   - "InternalServerError" (type *querysvc.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *querysvc.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeQueryOrgsListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body QueryOrgsListResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "query-orgs-list", err)
+			}
+			err = ValidateQueryOrgsListResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "query-orgs-list", err)
+			}
+			res := NewQueryOrgsListResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body QueryOrgsListBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "query-orgs-list", err)
+			}
+			err = ValidateQueryOrgsListBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "query-orgs-list", err)
+			}
+			return nil, NewQueryOrgsListBadRequest(&body)
+		case http.StatusInternalServerError:
+			var (
+				body QueryOrgsListInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "query-orgs-list", err)
+			}
+			err = ValidateQueryOrgsListInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "query-orgs-list", err)
+			}
+			return nil, NewQueryOrgsListInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body QueryOrgsListServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "query-orgs-list", err)
+			}
+			err = ValidateQueryOrgsListServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "query-orgs-list", err)
+			}
+			return nil, NewQueryOrgsListServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("query-svc", "query-orgs-list", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCountOrgsRequest instantiates a HTTP request object with method and
+// path set to call the "query-svc" service "count-orgs" endpoint
+func (c *Client) BuildCountOrgsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CountOrgsQuerySvcPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("query-svc", "count-orgs", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCountOrgsRequest returns an encoder for requests sent to the query-svc
+// count-orgs server.
+func EncodeCountOrgsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*querysvc.CountOrgsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("query-svc", "count-orgs", "*querysvc.CountOrgsPayload", v)
+		}
+		{
+			head := p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("v", p.Version)
+		if p.Name != nil {
+			values.Add("name", *p.Name)
+		}
+		if p.Domain != nil {
+			values.Add("domain", *p.Domain)
+		}
+		values.Add("match_all", fmt.Sprintf("%v", p.MatchAll))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeCountOrgsResponse returns a decoder for responses returned by the
+// query-svc count-orgs endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeCountOrgsResponse may return the following errors:
+//   - "BadRequest" (type *querysvc.BadRequestError): http.StatusBadRequest
+//   - "InternalServerError" (type *querysvc.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *querysvc.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeCountOrgsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body CountOrgsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "count-orgs", err)
+			}
+			err = ValidateCountOrgsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "count-orgs", err)
+			}
+			res := NewCountOrgsResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body CountOrgsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "count-orgs", err)
+			}
+			err = ValidateCountOrgsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "count-orgs", err)
+			}
+			return nil, NewCountOrgsBadRequest(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CountOrgsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "count-orgs", err)
+			}
+			err = ValidateCountOrgsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "count-orgs", err)
+			}
+			return nil, NewCountOrgsInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body CountOrgsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "count-orgs", err)
+			}
+			err = ValidateCountOrgsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "count-orgs", err)
+			}
+			return nil, NewCountOrgsServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("query-svc", "count-orgs", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildResolveOrgsRequest instantiates a HTTP request object with method and
+// path set to call the "query-svc" service "resolve-orgs" endpoint
+func (c *Client) BuildResolveOrgsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ResolveOrgsQuerySvcPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("query-svc", "resolve-orgs", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeResolveOrgsRequest returns an encoder for requests sent to the
+// query-svc resolve-orgs server.
+func EncodeResolveOrgsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*querysvc.ResolveOrgsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("query-svc", "resolve-orgs", "*querysvc.ResolveOrgsPayload", v)
+		}
+		{
+			head := p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("v", p.Version)
+		req.URL.RawQuery = values.Encode()
+		body := NewResolveOrgsRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("query-svc", "resolve-orgs", err)
+		}
+		return nil
+	}
+}
+
+// DecodeResolveOrgsResponse returns a decoder for responses returned by the
+// query-svc resolve-orgs endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeResolveOrgsResponse may return the following errors:
+//   - "BadRequest" (type *querysvc.BadRequestError): http.StatusBadRequest
+//   - "InternalServerError" (type *querysvc.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *querysvc.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeResolveOrgsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ResolveOrgsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "resolve-orgs", err)
+			}
+			err = ValidateResolveOrgsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "resolve-orgs", err)
+			}
+			res := NewResolveOrgsResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ResolveOrgsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "resolve-orgs", err)
+			}
+			err = ValidateResolveOrgsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "resolve-orgs", err)
+			}
+			return nil, NewResolveOrgsBadRequest(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ResolveOrgsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "resolve-orgs", err)
+			}
+			err = ValidateResolveOrgsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "resolve-orgs", err)
+			}
+			return nil, NewResolveOrgsInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body ResolveOrgsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "resolve-orgs", err)
+			}
+			err = ValidateResolveOrgsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "resolve-orgs", err)
+			}
+			return nil, NewResolveOrgsServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("query-svc", "resolve-orgs", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildSuggestOrgsRequest instantiates a HTTP request object with method and
+// path set to call the "query-svc" service "suggest-orgs" endpoint
+func (c *Client) BuildSuggestOrgsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SuggestOrgsQuerySvcPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("query-svc", "suggest-orgs", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSuggestOrgsRequest returns an encoder for requests sent to the
+// query-svc suggest-orgs server.
+func EncodeSuggestOrgsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*querysvc.SuggestOrgsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("query-svc", "suggest-orgs", "*querysvc.SuggestOrgsPayload", v)
+		}
+		{
+			head := p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("v", p.Version)
+		values.Add("query", p.Query)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeSuggestOrgsResponse returns a decoder for responses returned by the
+// query-svc suggest-orgs endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeSuggestOrgsResponse may return the following errors:
+//   - "BadRequest" (type *querysvc.BadRequestError): http.StatusBadRequest
+//   - "InternalServerError" (type *querysvc.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *querysvc.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeSuggestOrgsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body SuggestOrgsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "suggest-orgs", err)
+			}
+			err = ValidateSuggestOrgsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "suggest-orgs", err)
+			}
+			res := NewSuggestOrgsResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body SuggestOrgsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "suggest-orgs", err)
+			}
+			err = ValidateSuggestOrgsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "suggest-orgs", err)
+			}
+			return nil, NewSuggestOrgsBadRequest(&body)
+		case http.StatusInternalServerError:
+			var (
+				body SuggestOrgsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "suggest-orgs", err)
+			}
+			err = ValidateSuggestOrgsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "suggest-orgs", err)
+			}
+			return nil, NewSuggestOrgsInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body SuggestOrgsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "suggest-orgs", err)
+			}
+			err = ValidateSuggestOrgsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "suggest-orgs", err)
+			}
+			return nil, NewSuggestOrgsServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("query-svc", "suggest-orgs", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUnifiedSearchRequest instantiates a HTTP request object with method and
+// path set to call the "query-svc" service "unified-search" endpoint
+func (c *Client) BuildUnifiedSearchRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UnifiedSearchQuerySvcPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("query-svc", "unified-search", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUnifiedSearchRequest returns an encoder for requests sent to the
+// query-svc unified-search server.
+func EncodeUnifiedSearchRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*querysvc.UnifiedSearchPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("query-svc", "unified-search", "*querysvc.UnifiedSearchPayload", v)
+		}
+		{
+			head := p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("v", p.Version)
+		values.Add("query", p.Query)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeUnifiedSearchResponse returns a decoder for responses returned by the
+// query-svc unified-search endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeUnifiedSearchResponse may return the following errors:
+//   - "BadRequest" (type *querysvc.BadRequestError): http.StatusBadRequest
+//   - "InternalServerError" (type *querysvc.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *querysvc.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeUnifiedSearchResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UnifiedSearchResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "unified-search", err)
+			}
+			err = ValidateUnifiedSearchResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "unified-search", err)
+			}
+			res := NewUnifiedSearchResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body UnifiedSearchBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "unified-search", err)
+			}
+			err = ValidateUnifiedSearchBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "unified-search", err)
+			}
+			return nil, NewUnifiedSearchBadRequest(&body)
+		case http.StatusInternalServerError:
+			var (
+				body UnifiedSearchInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "unified-search", err)
+			}
+			err = ValidateUnifiedSearchInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "unified-search", err)
+			}
+			return nil, NewUnifiedSearchInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body UnifiedSearchServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "unified-search", err)
+			}
+			err = ValidateUnifiedSearchServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "unified-search", err)
+			}
+			return nil, NewUnifiedSearchServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("query-svc", "unified-search", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildSuggestRequest instantiates a HTTP request object with method and path
+// set to call the "query-svc" service "suggest" endpoint
+func (c *Client) BuildSuggestRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SuggestQuerySvcPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("query-svc", "suggest", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSuggestRequest returns an encoder for requests sent to the query-svc
+// suggest server.
+func EncodeSuggestRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*querysvc.SuggestPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("query-svc", "suggest", "*querysvc.SuggestPayload", v)
+		}
+		{
+			head := p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("v", p.Version)
+		values.Add("query", p.Query)
+		for _, value := range p.Kind {
+			values.Add("kind", value)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeSuggestResponse returns a decoder for responses returned by the
+// query-svc suggest endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeSuggestResponse may return the following errors:
+//   - "BadRequest" (type *querysvc.BadRequestError): http.StatusBadRequest
+//   - "InternalServerError" (type *querysvc.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *querysvc.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeSuggestResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body SuggestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "suggest", err)
+			}
+			err = ValidateSuggestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "suggest", err)
+			}
+			res := NewSuggestResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body SuggestBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "suggest", err)
+			}
+			err = ValidateSuggestBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "suggest", err)
+			}
+			return nil, NewSuggestBadRequest(&body)
+		case http.StatusInternalServerError:
+			var (
+				body SuggestInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "suggest", err)
+			}
+			err = ValidateSuggestInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "suggest", err)
+			}
+			return nil, NewSuggestInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body SuggestServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "suggest", err)
+			}
+			err = ValidateSuggestServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "suggest", err)
+			}
+			return nil, NewSuggestServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("query-svc", "suggest", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildFlushCacheRequest instantiates a HTTP request object with method and
+// path set to call the "query-svc" service "flush-cache" endpoint
+func (c *Client) BuildFlushCacheRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: FlushCacheQuerySvcPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("query-svc", "flush-cache", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeFlushCacheRequest returns an encoder for requests sent to the
+// query-svc flush-cache server.
+func EncodeFlushCacheRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*querysvc.FlushCachePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("query-svc", "flush-cache", "*querysvc.FlushCachePayload", v)
+		}
+		{
+			head := p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("v", p.Version)
+		values.Add("warm", fmt.Sprintf("%v", p.Warm))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeFlushCacheResponse returns a decoder for responses returned by the
+// query-svc flush-cache endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeFlushCacheResponse may return the following errors:
+//   - "BadRequest" (type *querysvc.BadRequestError): http.StatusBadRequest
+//   - "InternalServerError" (type *querysvc.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *querysvc.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *querysvc.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeFlushCacheResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body FlushCacheResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "flush-cache", err)
+			}
+			err = ValidateFlushCacheResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "flush-cache", err)
+			}
+			res := NewFlushCacheResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body FlushCacheBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "flush-cache", err)
+			}
+			err = ValidateFlushCacheBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "flush-cache", err)
+			}
+			return nil, NewFlushCacheBadRequest(&body)
+		case http.StatusInternalServerError:
+			var (
+				body FlushCacheInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "flush-cache", err)
+			}
+			err = ValidateFlushCacheInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "flush-cache", err)
+			}
+			return nil, NewFlushCacheInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body FlushCacheNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "flush-cache", err)
+			}
+			err = ValidateFlushCacheNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "flush-cache", err)
+			}
+			return nil, NewFlushCacheNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body FlushCacheServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "flush-cache", err)
+			}
+			err = ValidateFlushCacheServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "flush-cache", err)
+			}
+			return nil, NewFlushCacheServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("query-svc", "flush-cache", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildReadyzRequest instantiates a HTTP request object with method and path
+// set to call the "query-svc" service "readyz" endpoint
+func (c *Client) BuildReadyzRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ReadyzQuerySvcPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("query-svc", "readyz", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeReadyzResponse returns a decoder for responses returned by the
+// query-svc readyz endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeReadyzResponse may return the following errors:
+//   - "NotReady" (type *goa.ServiceError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeReadyzResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body []byte
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "readyz", err)
+			}
+			return body, nil
+		case http.StatusServiceUnavailable:
+			var (
+				body ReadyzNotReadyResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("query-svc", "readyz", err)
+			}
+			err = ValidateReadyzNotReadyResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("query-svc", "readyz", err)
+			}
+			return nil, NewReadyzNotReady(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("query-svc", "readyz", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildLivezRequest instantiates a HTTP request object with method and path
+// set to call the "query-svc" service "livez" endpoint
+func (c *Client) BuildLivezRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: LivezQuerySvcPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("query-svc", "livez", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -707,9 +2238,167 @@ func DecodeLivezResponse(decoder func(*http.Response) goahttp.Decoder, restoreBo
 // *querysvc.Resource from a value of type *ResourceResponseBody.
 func unmarshalResourceResponseBodyToQuerysvcResource(v *ResourceResponseBody) *querysvc.Resource {
 	res := &querysvc.Resource{
-		Type: v.Type,
-		ID:   v.ID,
-		Data: v.Data,
+		Type:            v.Type,
+		ID:              v.ID,
+		DocumentID:      v.DocumentID,
+		Index:           v.Index,
+		CreatedBy:       v.CreatedBy,
+		UpdatedBy:       v.UpdatedBy,
+		Data:            v.Data,
+		Score:           v.Score,
+		NormalizedScore: v.NormalizedScore,
+	}
+	if v.MatchedTags != nil {
+		res.MatchedTags = make([]string, len(v.MatchedTags))
+		for i, val := range v.MatchedTags {
+			res.MatchedTags[i] = val
+		}
+	}
+	if v.ChildCounts != nil {
+		res.ChildCounts = make(map[string]uint64, len(v.ChildCounts))
+		for key, val := range v.ChildCounts {
+			tk := key
+			tv := val
+			res.ChildCounts[tk] = tv
+		}
+	}
+	if v.Permissions != nil {
+		res.Permissions = make(map[string]bool, len(v.Permissions))
+		for key, val := range v.Permissions {
+			tk := key
+			tv := val
+			res.Permissions[tk] = tv
+		}
+	}
+	if v.Ancestry != nil {
+		res.Ancestry = make([]string, len(v.Ancestry))
+		for i, val := range v.Ancestry {
+			res.Ancestry[i] = val
+		}
+	}
+
+	return res
+}
+
+// marshalQuerysvcCountQueryToCountQueryRequestBody builds a value of type
+// *CountQueryRequestBody from a value of type *querysvc.CountQuery.
+func marshalQuerysvcCountQueryToCountQueryRequestBody(v *querysvc.CountQuery) *CountQueryRequestBody {
+	res := &CountQueryRequestBody{
+		Name:   v.Name,
+		Parent: v.Parent,
+		Type:   v.Type,
+	}
+	if v.Tags != nil {
+		res.Tags = make([]string, len(v.Tags))
+		for i, val := range v.Tags {
+			res.Tags[i] = val
+		}
+	}
+	if v.TagsAll != nil {
+		res.TagsAll = make([]string, len(v.TagsAll))
+		for i, val := range v.TagsAll {
+			res.TagsAll[i] = val
+		}
+	}
+
+	return res
+}
+
+// marshalCountQueryRequestBodyToQuerysvcCountQuery builds a value of type
+// *querysvc.CountQuery from a value of type *CountQueryRequestBody.
+func marshalCountQueryRequestBodyToQuerysvcCountQuery(v *CountQueryRequestBody) *querysvc.CountQuery {
+	res := &querysvc.CountQuery{
+		Name:   v.Name,
+		Parent: v.Parent,
+		Type:   v.Type,
+	}
+	if v.Tags != nil {
+		res.Tags = make([]string, len(v.Tags))
+		for i, val := range v.Tags {
+			res.Tags[i] = val
+		}
+	}
+	if v.TagsAll != nil {
+		res.TagsAll = make([]string, len(v.TagsAll))
+		for i, val := range v.TagsAll {
+			res.TagsAll[i] = val
+		}
+	}
+
+	return res
+}
+
+// unmarshalCountItemResponseBodyToQuerysvcCountItem builds a value of type
+// *querysvc.CountItem from a value of type *CountItemResponseBody.
+func unmarshalCountItemResponseBodyToQuerysvcCountItem(v *CountItemResponseBody) *querysvc.CountItem {
+	res := &querysvc.CountItem{
+		Count:   v.Count,
+		HasMore: v.HasMore,
+	}
+	if v.Error != nil {
+		res.Error = unmarshalCountItemErrorResponseBodyToQuerysvcCountItemError(v.Error)
+	}
+
+	return res
+}
+
+// unmarshalCountItemErrorResponseBodyToQuerysvcCountItemError builds a value
+// of type *querysvc.CountItemError from a value of type
+// *CountItemErrorResponseBody.
+func unmarshalCountItemErrorResponseBodyToQuerysvcCountItemError(v *CountItemErrorResponseBody) *querysvc.CountItemError {
+	if v == nil {
+		return nil
+	}
+	res := &querysvc.CountItemError{
+		Code:    *v.Code,
+		Message: *v.Message,
+	}
+
+	return res
+}
+
+// unmarshalFacetValueResponseBodyToQuerysvcFacetValue builds a value of type
+// *querysvc.FacetValue from a value of type *FacetValueResponseBody.
+func unmarshalFacetValueResponseBodyToQuerysvcFacetValue(v *FacetValueResponseBody) *querysvc.FacetValue {
+	res := &querysvc.FacetValue{
+		Value: *v.Value,
+		Count: *v.Count,
+	}
+
+	return res
+}
+
+// unmarshalOrganizationResponseBodyToQuerysvcOrganization builds a value of
+// type *querysvc.Organization from a value of type *OrganizationResponseBody.
+func unmarshalOrganizationResponseBodyToQuerysvcOrganization(v *OrganizationResponseBody) *querysvc.Organization {
+	res := &querysvc.Organization{
+		Name:      v.Name,
+		Domain:    v.Domain,
+		Industry:  v.Industry,
+		Sector:    v.Sector,
+		Employees: v.Employees,
+	}
+	if v.Metadata != nil {
+		res.Metadata = make(map[string]any, len(v.Metadata))
+		for key, val := range v.Metadata {
+			tk := key
+			tv := val
+			res.Metadata[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// unmarshalResolvedOrganizationResponseBodyToQuerysvcResolvedOrganization
+// builds a value of type *querysvc.ResolvedOrganization from a value of type
+// *ResolvedOrganizationResponseBody.
+func unmarshalResolvedOrganizationResponseBodyToQuerysvcResolvedOrganization(v *ResolvedOrganizationResponseBody) *querysvc.ResolvedOrganization {
+	res := &querysvc.ResolvedOrganization{
+		Domain: *v.Domain,
+	}
+	if v.Organization != nil {
+		res.Organization = unmarshalOrganizationResponseBodyToQuerysvcOrganization(v.Organization)
 	}
 
 	return res
@@ -720,6 +2409,7 @@ func unmarshalResourceResponseBodyToQuerysvcResource(v *ResourceResponseBody) *q
 // *OrganizationSuggestionResponseBody.
 func unmarshalOrganizationSuggestionResponseBodyToQuerysvcOrganizationSuggestion(v *OrganizationSuggestionResponseBody) *querysvc.OrganizationSuggestion {
 	res := &querysvc.OrganizationSuggestion{
+		Kind:   *v.Kind,
 		Name:   *v.Name,
 		Domain: *v.Domain,
 		Logo:   v.Logo,
@@ -727,3 +2417,20 @@ func unmarshalOrganizationSuggestionResponseBodyToQuerysvcOrganizationSuggestion
 
 	return res
 }
+
+// unmarshalSuggestionResponseBodyToQuerysvcSuggestion builds a value of type
+// *querysvc.Suggestion from a value of type *SuggestionResponseBody.
+func unmarshalSuggestionResponseBodyToQuerysvcSuggestion(v *SuggestionResponseBody) *querysvc.Suggestion {
+	res := &querysvc.Suggestion{
+		Kind: *v.Kind,
+		Name: *v.Name,
+	}
+	if v.Resource != nil {
+		res.Resource = unmarshalResourceResponseBodyToQuerysvcResource(v.Resource)
+	}
+	if v.Organization != nil {
+		res.Organization = unmarshalOrganizationSuggestionResponseBodyToQuerysvcOrganizationSuggestion(v.Organization)
+	}
+
+	return res
+}
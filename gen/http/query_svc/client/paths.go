@@ -17,16 +17,66 @@ func QueryResourcesCountQuerySvcPath() string {
 	return "/query/resources/count"
 }
 
+// QueryResourcesCountBatchQuerySvcPath returns the URL path to the query-svc service query-resources-count-batch HTTP endpoint.
+func QueryResourcesCountBatchQuerySvcPath() string {
+	return "/query/resources/count/batch"
+}
+
+// QueryResourcesFacetsQuerySvcPath returns the URL path to the query-svc service query-resources-facets HTTP endpoint.
+func QueryResourcesFacetsQuerySvcPath() string {
+	return "/query/resources/facets"
+}
+
+// ExplainResourceQuerySvcPath returns the URL path to the query-svc service explain-resource HTTP endpoint.
+func ExplainResourceQuerySvcPath() string {
+	return "/query/resources/explain"
+}
+
+// CheckTuplesQuerySvcPath returns the URL path to the query-svc service check-tuples HTTP endpoint.
+func CheckTuplesQuerySvcPath() string {
+	return "/query/access/check"
+}
+
 // QueryOrgsQuerySvcPath returns the URL path to the query-svc service query-orgs HTTP endpoint.
 func QueryOrgsQuerySvcPath() string {
 	return "/query/orgs"
 }
 
+// QueryOrgsListQuerySvcPath returns the URL path to the query-svc service query-orgs-list HTTP endpoint.
+func QueryOrgsListQuerySvcPath() string {
+	return "/query/orgs/list"
+}
+
+// CountOrgsQuerySvcPath returns the URL path to the query-svc service count-orgs HTTP endpoint.
+func CountOrgsQuerySvcPath() string {
+	return "/query/orgs/count"
+}
+
+// ResolveOrgsQuerySvcPath returns the URL path to the query-svc service resolve-orgs HTTP endpoint.
+func ResolveOrgsQuerySvcPath() string {
+	return "/query/orgs/resolve"
+}
+
 // SuggestOrgsQuerySvcPath returns the URL path to the query-svc service suggest-orgs HTTP endpoint.
 func SuggestOrgsQuerySvcPath() string {
 	return "/query/orgs/suggest"
 }
 
+// UnifiedSearchQuerySvcPath returns the URL path to the query-svc service unified-search HTTP endpoint.
+func UnifiedSearchQuerySvcPath() string {
+	return "/query/search"
+}
+
+// SuggestQuerySvcPath returns the URL path to the query-svc service suggest HTTP endpoint.
+func SuggestQuerySvcPath() string {
+	return "/query/suggest"
+}
+
+// FlushCacheQuerySvcPath returns the URL path to the query-svc service flush-cache HTTP endpoint.
+func FlushCacheQuerySvcPath() string {
+	return "/cache/flush"
+}
+
 // ReadyzQuerySvcPath returns the URL path to the query-svc service readyz HTTP endpoint.
 func ReadyzQuerySvcPath() string {
 	return "/readyz"
@@ -8,17 +8,50 @@
 package client
 
 import (
+	"unicode/utf8"
+
 	querysvc "github.com/linuxfoundation/lfx-v2-query-service/gen/query_svc"
 	goa "goa.design/goa/v3/pkg"
 )
 
-// QueryResourcesResponseBody is the type of the "query-svc" service
+// QueryResourcesCountBatchRequestBody is the type of the "query-svc" service
+// "query-resources-count-batch" endpoint HTTP request body.
+type QueryResourcesCountBatchRequestBody struct {
+	// Count queries
+	Queries []*CountQueryRequestBody `form:"queries" json:"queries" xml:"queries"`
+}
+
+// CheckTuplesRequestBody is the type of the "query-svc" service "check-tuples"
+// endpoint HTTP request body.
+type CheckTuplesRequestBody struct {
+	// Access check tuples, as <object>#<relation>@<user>
+	Tuples []string `form:"tuples" json:"tuples" xml:"tuples"`
+}
+
+// ResolveOrgsRequestBody is the type of the "query-svc" service "resolve-orgs"
+// endpoint HTTP request body.
+type ResolveOrgsRequestBody struct {
+	// Domains or website URLs to resolve
+	Domains []string `form:"domains" json:"domains" xml:"domains"`
+}
+
+// QueryResourcesOKResponseBody is the type of the "query-svc" service
 // "query-resources" endpoint HTTP response body.
-type QueryResourcesResponseBody struct {
+type QueryResourcesOKResponseBody struct {
 	// Resources found
 	Resources []*ResourceResponseBody `form:"resources,omitempty" json:"resources,omitempty" xml:"resources,omitempty"`
+	// Total number of resources found; only reported for anonymous searches, whose
+	// hits need no access check
+	Total *int `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+	// Whether total is exact (eq) or a lower bound (gte)
+	TotalRelation *string `form:"total_relation,omitempty" json:"total_relation,omitempty" xml:"total_relation,omitempty"`
 	// Opaque token if more results are available
 	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty" xml:"page_token,omitempty"`
+	// Alternative names for a name search that found nothing, when
+	// suggest_on_empty is set
+	Suggestions []string `form:"suggestions,omitempty" json:"suggestions,omitempty" xml:"suggestions,omitempty"`
+	// Set to "not-modified" when the If-None-Match ETag still matches
+	CacheStatus *string `form:"cache_status,omitempty" json:"cache_status,omitempty" xml:"cache_status,omitempty"`
 }
 
 // QueryResourcesCountResponseBody is the type of the "query-svc" service
@@ -26,9 +59,62 @@ type QueryResourcesResponseBody struct {
 type QueryResourcesCountResponseBody struct {
 	// Count of resources found
 	Count *uint64 `form:"count,omitempty" json:"count,omitempty" xml:"count,omitempty"`
+	// Count of resources found that the principal may access, the same as count
+	VisibleCount *uint64 `form:"visible_count,omitempty" json:"visible_count,omitempty" xml:"visible_count,omitempty"`
+	// Count of resources found before the access checks, including those the
+	// principal may not access (only reported to the allowed principals)
+	TotalCount *uint64 `form:"total_count,omitempty" json:"total_count,omitempty" xml:"total_count,omitempty"`
 	// True if count is not guaranteed to be exhaustive: client should request a
 	// narrower query
 	HasMore *bool `form:"has_more,omitempty" json:"has_more,omitempty" xml:"has_more,omitempty"`
+	// Count of private resources by the relation granting access to them, when
+	// include_relations is set
+	RelationCounts map[string]uint64 `form:"relation_counts,omitempty" json:"relation_counts,omitempty" xml:"relation_counts,omitempty"`
+}
+
+// QueryResourcesCountBatchResponseBody is the type of the "query-svc" service
+// "query-resources-count-batch" endpoint HTTP response body.
+type QueryResourcesCountBatchResponseBody struct {
+	// Count outcomes, in the same order as the queries
+	Items []*CountItemResponseBody `form:"items,omitempty" json:"items,omitempty" xml:"items,omitempty"`
+}
+
+// QueryResourcesFacetsResponseBody is the type of the "query-svc" service
+// "query-resources-facets" endpoint HTTP response body.
+type QueryResourcesFacetsResponseBody struct {
+	// Values of each requested field, by descending count
+	Facets map[string][]*FacetValueResponseBody `form:"facets,omitempty" json:"facets,omitempty" xml:"facets,omitempty"`
+	// Number of matching resources the principal can access, when include_total is
+	// set
+	Total *uint64 `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+	// Whether total is exact (eq) or a lower bound (gte), when include_total is set
+	TotalRelation *string `form:"total_relation,omitempty" json:"total_relation,omitempty" xml:"total_relation,omitempty"`
+}
+
+// ExplainResourceResponseBody is the type of the "query-svc" service
+// "explain-resource" endpoint HTTP response body.
+type ExplainResourceResponseBody struct {
+	// Reference of the explained resource
+	ObjectRef *string `form:"object_ref,omitempty" json:"object_ref,omitempty" xml:"object_ref,omitempty"`
+	// Stage of the search that decided the outcome
+	Stage *string `form:"stage,omitempty" json:"stage,omitempty" xml:"stage,omitempty"`
+	// Human readable explanation of the outcome
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// True if the resource is indexed
+	Found *bool `form:"found,omitempty" json:"found,omitempty" xml:"found,omitempty"`
+	// True if the resource matches the search criteria
+	MatchedCriteria *bool `form:"matched_criteria,omitempty" json:"matched_criteria,omitempty" xml:"matched_criteria,omitempty"`
+	// Access check the resource needs, if any
+	AccessCheckQuery *string `form:"access_check_query,omitempty" json:"access_check_query,omitempty" xml:"access_check_query,omitempty"`
+	// True if the principal may see the resource
+	AccessGranted *bool `form:"access_granted,omitempty" json:"access_granted,omitempty" xml:"access_granted,omitempty"`
+}
+
+// CheckTuplesResponseBody is the type of the "query-svc" service
+// "check-tuples" endpoint HTTP response body.
+type CheckTuplesResponseBody struct {
+	// Answer of the access checker for each tuple
+	Results map[string]string `form:"results,omitempty" json:"results,omitempty" xml:"results,omitempty"`
 }
 
 // QueryOrgsResponseBody is the type of the "query-svc" service "query-orgs"
@@ -44,6 +130,31 @@ type QueryOrgsResponseBody struct {
 	Sector *string `form:"sector,omitempty" json:"sector,omitempty" xml:"sector,omitempty"`
 	// Employee count or range
 	Employees *string `form:"employees,omitempty" json:"employees,omitempty" xml:"employees,omitempty"`
+	// Further fields of the organization, as provided by its source
+	Metadata map[string]any `form:"metadata,omitempty" json:"metadata,omitempty" xml:"metadata,omitempty"`
+}
+
+// QueryOrgsListResponseBody is the type of the "query-svc" service
+// "query-orgs-list" endpoint HTTP response body.
+type QueryOrgsListResponseBody struct {
+	// Organizations found, ordered by relevance
+	Organizations []*OrganizationResponseBody `form:"organizations,omitempty" json:"organizations,omitempty" xml:"organizations,omitempty"`
+	// Opaque token if more results are available
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty" xml:"page_token,omitempty"`
+}
+
+// CountOrgsResponseBody is the type of the "query-svc" service "count-orgs"
+// endpoint HTTP response body.
+type CountOrgsResponseBody struct {
+	// Number of organizations found
+	Count *int `form:"count,omitempty" json:"count,omitempty" xml:"count,omitempty"`
+}
+
+// ResolveOrgsResponseBody is the type of the "query-svc" service
+// "resolve-orgs" endpoint HTTP response body.
+type ResolveOrgsResponseBody struct {
+	// Resolution of each domain, in the same order as the request
+	Organizations []*ResolvedOrganizationResponseBody `form:"organizations,omitempty" json:"organizations,omitempty" xml:"organizations,omitempty"`
 }
 
 // SuggestOrgsResponseBody is the type of the "query-svc" service
@@ -53,6 +164,35 @@ type SuggestOrgsResponseBody struct {
 	Suggestions []*OrganizationSuggestionResponseBody `form:"suggestions,omitempty" json:"suggestions,omitempty" xml:"suggestions,omitempty"`
 }
 
+// UnifiedSearchResponseBody is the type of the "query-svc" service
+// "unified-search" endpoint HTTP response body.
+type UnifiedSearchResponseBody struct {
+	// Resources found, limited to the section size
+	Resources []*ResourceResponseBody `form:"resources,omitempty" json:"resources,omitempty" xml:"resources,omitempty"`
+	// Organizations suggested, limited to the section size
+	Organizations []*OrganizationSuggestionResponseBody `form:"organizations,omitempty" json:"organizations,omitempty" xml:"organizations,omitempty"`
+	// True when the organization search failed and its section is empty
+	OrganizationsUnavailable *bool `form:"organizations_unavailable,omitempty" json:"organizations_unavailable,omitempty" xml:"organizations_unavailable,omitempty"`
+}
+
+// SuggestResponseBody is the type of the "query-svc" service "suggest"
+// endpoint HTTP response body.
+type SuggestResponseBody struct {
+	// Suggestions of the requested kinds, by relevance
+	Suggestions []*SuggestionResponseBody `form:"suggestions,omitempty" json:"suggestions,omitempty" xml:"suggestions,omitempty"`
+	// True when the organization suggestions failed and are left out
+	OrganizationsUnavailable *bool `form:"organizations_unavailable,omitempty" json:"organizations_unavailable,omitempty" xml:"organizations_unavailable,omitempty"`
+}
+
+// FlushCacheResponseBody is the type of the "query-svc" service "flush-cache"
+// endpoint HTTP response body.
+type FlushCacheResponseBody struct {
+	// Number of cached results cleared
+	Flushed *int `form:"flushed,omitempty" json:"flushed,omitempty" xml:"flushed,omitempty"`
+	// Number of warm-up queries cached again
+	Warmed *int `form:"warmed,omitempty" json:"warmed,omitempty" xml:"warmed,omitempty"`
+}
+
 // QueryResourcesBadRequestResponseBody is the type of the "query-svc" service
 // "query-resources" endpoint HTTP response body for the "BadRequest" error.
 type QueryResourcesBadRequestResponseBody struct {
@@ -100,6 +240,114 @@ type QueryResourcesCountServiceUnavailableResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
+// QueryResourcesCountBatchBadRequestResponseBody is the type of the
+// "query-svc" service "query-resources-count-batch" endpoint HTTP response
+// body for the "BadRequest" error.
+type QueryResourcesCountBatchBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// QueryResourcesCountBatchInternalServerErrorResponseBody is the type of the
+// "query-svc" service "query-resources-count-batch" endpoint HTTP response
+// body for the "InternalServerError" error.
+type QueryResourcesCountBatchInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// QueryResourcesCountBatchServiceUnavailableResponseBody is the type of the
+// "query-svc" service "query-resources-count-batch" endpoint HTTP response
+// body for the "ServiceUnavailable" error.
+type QueryResourcesCountBatchServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// QueryResourcesFacetsBadRequestResponseBody is the type of the "query-svc"
+// service "query-resources-facets" endpoint HTTP response body for the
+// "BadRequest" error.
+type QueryResourcesFacetsBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// QueryResourcesFacetsInternalServerErrorResponseBody is the type of the
+// "query-svc" service "query-resources-facets" endpoint HTTP response body for
+// the "InternalServerError" error.
+type QueryResourcesFacetsInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// QueryResourcesFacetsServiceUnavailableResponseBody is the type of the
+// "query-svc" service "query-resources-facets" endpoint HTTP response body for
+// the "ServiceUnavailable" error.
+type QueryResourcesFacetsServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ExplainResourceBadRequestResponseBody is the type of the "query-svc" service
+// "explain-resource" endpoint HTTP response body for the "BadRequest" error.
+type ExplainResourceBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ExplainResourceInternalServerErrorResponseBody is the type of the
+// "query-svc" service "explain-resource" endpoint HTTP response body for the
+// "InternalServerError" error.
+type ExplainResourceInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ExplainResourceNotFoundResponseBody is the type of the "query-svc" service
+// "explain-resource" endpoint HTTP response body for the "NotFound" error.
+type ExplainResourceNotFoundResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ExplainResourceServiceUnavailableResponseBody is the type of the "query-svc"
+// service "explain-resource" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type ExplainResourceServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CheckTuplesBadRequestResponseBody is the type of the "query-svc" service
+// "check-tuples" endpoint HTTP response body for the "BadRequest" error.
+type CheckTuplesBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CheckTuplesInternalServerErrorResponseBody is the type of the "query-svc"
+// service "check-tuples" endpoint HTTP response body for the
+// "InternalServerError" error.
+type CheckTuplesInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CheckTuplesNotFoundResponseBody is the type of the "query-svc" service
+// "check-tuples" endpoint HTTP response body for the "NotFound" error.
+type CheckTuplesNotFoundResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CheckTuplesServiceUnavailableResponseBody is the type of the "query-svc"
+// service "check-tuples" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type CheckTuplesServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
 // QueryOrgsBadRequestResponseBody is the type of the "query-svc" service
 // "query-orgs" endpoint HTTP response body for the "BadRequest" error.
 type QueryOrgsBadRequestResponseBody struct {
@@ -130,6 +378,75 @@ type QueryOrgsServiceUnavailableResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
+// QueryOrgsListBadRequestResponseBody is the type of the "query-svc" service
+// "query-orgs-list" endpoint HTTP response body for the "BadRequest" error.
+type QueryOrgsListBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// QueryOrgsListInternalServerErrorResponseBody is the type of the "query-svc"
+// service "query-orgs-list" endpoint HTTP response body for the
+// "InternalServerError" error.
+type QueryOrgsListInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// QueryOrgsListServiceUnavailableResponseBody is the type of the "query-svc"
+// service "query-orgs-list" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type QueryOrgsListServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CountOrgsBadRequestResponseBody is the type of the "query-svc" service
+// "count-orgs" endpoint HTTP response body for the "BadRequest" error.
+type CountOrgsBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CountOrgsInternalServerErrorResponseBody is the type of the "query-svc"
+// service "count-orgs" endpoint HTTP response body for the
+// "InternalServerError" error.
+type CountOrgsInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CountOrgsServiceUnavailableResponseBody is the type of the "query-svc"
+// service "count-orgs" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type CountOrgsServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ResolveOrgsBadRequestResponseBody is the type of the "query-svc" service
+// "resolve-orgs" endpoint HTTP response body for the "BadRequest" error.
+type ResolveOrgsBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ResolveOrgsInternalServerErrorResponseBody is the type of the "query-svc"
+// service "resolve-orgs" endpoint HTTP response body for the
+// "InternalServerError" error.
+type ResolveOrgsInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ResolveOrgsServiceUnavailableResponseBody is the type of the "query-svc"
+// service "resolve-orgs" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type ResolveOrgsServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
 // SuggestOrgsBadRequestResponseBody is the type of the "query-svc" service
 // "suggest-orgs" endpoint HTTP response body for the "BadRequest" error.
 type SuggestOrgsBadRequestResponseBody struct {
@@ -153,6 +470,81 @@ type SuggestOrgsServiceUnavailableResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
+// UnifiedSearchBadRequestResponseBody is the type of the "query-svc" service
+// "unified-search" endpoint HTTP response body for the "BadRequest" error.
+type UnifiedSearchBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UnifiedSearchInternalServerErrorResponseBody is the type of the "query-svc"
+// service "unified-search" endpoint HTTP response body for the
+// "InternalServerError" error.
+type UnifiedSearchInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UnifiedSearchServiceUnavailableResponseBody is the type of the "query-svc"
+// service "unified-search" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type UnifiedSearchServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// SuggestBadRequestResponseBody is the type of the "query-svc" service
+// "suggest" endpoint HTTP response body for the "BadRequest" error.
+type SuggestBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// SuggestInternalServerErrorResponseBody is the type of the "query-svc"
+// service "suggest" endpoint HTTP response body for the "InternalServerError"
+// error.
+type SuggestInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// SuggestServiceUnavailableResponseBody is the type of the "query-svc" service
+// "suggest" endpoint HTTP response body for the "ServiceUnavailable" error.
+type SuggestServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// FlushCacheBadRequestResponseBody is the type of the "query-svc" service
+// "flush-cache" endpoint HTTP response body for the "BadRequest" error.
+type FlushCacheBadRequestResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// FlushCacheInternalServerErrorResponseBody is the type of the "query-svc"
+// service "flush-cache" endpoint HTTP response body for the
+// "InternalServerError" error.
+type FlushCacheInternalServerErrorResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// FlushCacheNotFoundResponseBody is the type of the "query-svc" service
+// "flush-cache" endpoint HTTP response body for the "NotFound" error.
+type FlushCacheNotFoundResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// FlushCacheServiceUnavailableResponseBody is the type of the "query-svc"
+// service "flush-cache" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type FlushCacheServiceUnavailableResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
 // ReadyzNotReadyResponseBody is the type of the "query-svc" service "readyz"
 // endpoint HTTP response body for the "NotReady" error.
 type ReadyzNotReadyResponseBody struct {
@@ -177,13 +569,107 @@ type ResourceResponseBody struct {
 	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
 	// Resource ID (within its resource collection)
 	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// ID of the search index document of the resource, which may differ from the
+	// resource ID
+	DocumentID *string `form:"document_id,omitempty" json:"document_id,omitempty" xml:"document_id,omitempty"`
+	// Search index that served the resource; only reported in debug mode
+	Index *string `form:"index,omitempty" json:"index,omitempty" xml:"index,omitempty"`
+	// Principal who created the resource, when indexed
+	CreatedBy *string `form:"created_by,omitempty" json:"created_by,omitempty" xml:"created_by,omitempty"`
+	// Principal who last updated the resource, when indexed
+	UpdatedBy *string `form:"updated_by,omitempty" json:"updated_by,omitempty" xml:"updated_by,omitempty"`
 	// Resource data snapshot
 	Data any `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+	// Relevance score assigned by the search backend; only returned when requested
+	Score *float64 `form:"score,omitempty" json:"score,omitempty" xml:"score,omitempty"`
+	// Relevance score relative to the best match of the search, between 0 and 1;
+	// only returned when requested
+	NormalizedScore *float64 `form:"normalized_score,omitempty" json:"normalized_score,omitempty" xml:"normalized_score,omitempty"`
+	// Requested tags the resource matched when filtering by tags; only returned to
+	// authenticated users
+	MatchedTags []string `form:"matched_tags,omitempty" json:"matched_tags,omitempty" xml:"matched_tags,omitempty"`
+	// Number of accessible children of the resource, by child type; only returned
+	// when requested
+	ChildCounts map[string]uint64 `form:"child_counts,omitempty" json:"child_counts,omitempty" xml:"child_counts,omitempty"`
+	// Whether the caller has each configured relation beyond viewing on the
+	// resource; only returned when requested
+	Permissions map[string]bool `form:"permissions,omitempty" json:"permissions,omitempty" xml:"permissions,omitempty"`
+	// References of the ancestors of the resource, as indexed; only returned when
+	// requested
+	Ancestry []string `form:"ancestry,omitempty" json:"ancestry,omitempty" xml:"ancestry,omitempty"`
+}
+
+// CountQueryRequestBody is used to define fields on request body types.
+type CountQueryRequestBody struct {
+	// Resource name or alias; supports typeahead
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Parent (for navigation; varies by object type)
+	Parent *string `form:"parent,omitempty" json:"parent,omitempty" xml:"parent,omitempty"`
+	// Resource type to search
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Tags to search with OR logic - matches resources with any of these tags
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+	// Tags to search with AND logic - matches resources that have all of these tags
+	TagsAll []string `form:"tags_all,omitempty" json:"tags_all,omitempty" xml:"tags_all,omitempty"`
+}
+
+// CountItemResponseBody is used to define fields on response body types.
+type CountItemResponseBody struct {
+	// Count of resources found
+	Count *uint64 `form:"count,omitempty" json:"count,omitempty" xml:"count,omitempty"`
+	// True if count is not guaranteed to be exhaustive: client should request a
+	// narrower query
+	HasMore *bool `form:"has_more,omitempty" json:"has_more,omitempty" xml:"has_more,omitempty"`
+	// Error of the query, if it failed
+	Error *CountItemErrorResponseBody `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// CountItemErrorResponseBody is used to define fields on response body types.
+type CountItemErrorResponseBody struct {
+	// Error code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// FacetValueResponseBody is used to define fields on response body types.
+type FacetValueResponseBody struct {
+	// Value of the field
+	Value *string `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+	// Count of resources with the value
+	Count *uint64 `form:"count,omitempty" json:"count,omitempty" xml:"count,omitempty"`
+}
+
+// OrganizationResponseBody is used to define fields on response body types.
+type OrganizationResponseBody struct {
+	// Organization name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Organization domain
+	Domain *string `form:"domain,omitempty" json:"domain,omitempty" xml:"domain,omitempty"`
+	// Organization industry classification
+	Industry *string `form:"industry,omitempty" json:"industry,omitempty" xml:"industry,omitempty"`
+	// Business sector classification
+	Sector *string `form:"sector,omitempty" json:"sector,omitempty" xml:"sector,omitempty"`
+	// Employee count or range
+	Employees *string `form:"employees,omitempty" json:"employees,omitempty" xml:"employees,omitempty"`
+	// Further fields of the organization, as provided by its source
+	Metadata map[string]any `form:"metadata,omitempty" json:"metadata,omitempty" xml:"metadata,omitempty"`
+}
+
+// ResolvedOrganizationResponseBody is used to define fields on response body
+// types.
+type ResolvedOrganizationResponseBody struct {
+	// Requested domain, as given
+	Domain *string `form:"domain,omitempty" json:"domain,omitempty" xml:"domain,omitempty"`
+	// Organization of the domain; absent when the domain has none
+	Organization *OrganizationResponseBody `form:"organization,omitempty" json:"organization,omitempty" xml:"organization,omitempty"`
 }
 
 // OrganizationSuggestionResponseBody is used to define fields on response body
 // types.
 type OrganizationSuggestionResponseBody struct {
+	// Kind of the suggestion
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
 	// Organization name
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// Organization domain
@@ -192,17 +678,98 @@ type OrganizationSuggestionResponseBody struct {
 	Logo *string `form:"logo,omitempty" json:"logo,omitempty" xml:"logo,omitempty"`
 }
 
+// SuggestionResponseBody is used to define fields on response body types.
+type SuggestionResponseBody struct {
+	// Kind of the suggestion
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// Name to display for the suggestion
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Resource suggested, for resource suggestions
+	Resource *ResourceResponseBody `form:"resource,omitempty" json:"resource,omitempty" xml:"resource,omitempty"`
+	// Organization suggested, for organization suggestions
+	Organization *OrganizationSuggestionResponseBody `form:"organization,omitempty" json:"organization,omitempty" xml:"organization,omitempty"`
+}
+
+// NewQueryResourcesCountBatchRequestBody builds the HTTP request body from the
+// payload of the "query-resources-count-batch" endpoint of the "query-svc"
+// service.
+func NewQueryResourcesCountBatchRequestBody(p *querysvc.QueryResourcesCountBatchPayload) *QueryResourcesCountBatchRequestBody {
+	body := &QueryResourcesCountBatchRequestBody{}
+	if p.Queries != nil {
+		body.Queries = make([]*CountQueryRequestBody, len(p.Queries))
+		for i, val := range p.Queries {
+			body.Queries[i] = marshalQuerysvcCountQueryToCountQueryRequestBody(val)
+		}
+	} else {
+		body.Queries = []*CountQueryRequestBody{}
+	}
+	return body
+}
+
+// NewCheckTuplesRequestBody builds the HTTP request body from the payload of
+// the "check-tuples" endpoint of the "query-svc" service.
+func NewCheckTuplesRequestBody(p *querysvc.CheckTuplesPayload) *CheckTuplesRequestBody {
+	body := &CheckTuplesRequestBody{}
+	if p.Tuples != nil {
+		body.Tuples = make([]string, len(p.Tuples))
+		for i, val := range p.Tuples {
+			body.Tuples[i] = val
+		}
+	} else {
+		body.Tuples = []string{}
+	}
+	return body
+}
+
+// NewResolveOrgsRequestBody builds the HTTP request body from the payload of
+// the "resolve-orgs" endpoint of the "query-svc" service.
+func NewResolveOrgsRequestBody(p *querysvc.ResolveOrgsPayload) *ResolveOrgsRequestBody {
+	body := &ResolveOrgsRequestBody{}
+	if p.Domains != nil {
+		body.Domains = make([]string, len(p.Domains))
+		for i, val := range p.Domains {
+			body.Domains[i] = val
+		}
+	} else {
+		body.Domains = []string{}
+	}
+	return body
+}
+
+// NewQueryResourcesResultNotModified builds a "query-svc" service
+// "query-resources" endpoint result from a HTTP "NotModified" response.
+func NewQueryResourcesResultNotModified(cacheControl *string, etag *string) *querysvc.QueryResourcesResult {
+	v := &querysvc.QueryResourcesResult{}
+	v.CacheControl = cacheControl
+	v.Etag = etag
+
+	return v
+}
+
 // NewQueryResourcesResultOK builds a "query-svc" service "query-resources"
 // endpoint result from a HTTP "OK" response.
-func NewQueryResourcesResultOK(body *QueryResourcesResponseBody, cacheControl *string) *querysvc.QueryResourcesResult {
+func NewQueryResourcesResultOK(body *QueryResourcesOKResponseBody, cacheControl *string, publicPath *bool, degraded *bool, link *string, etag *string) *querysvc.QueryResourcesResult {
 	v := &querysvc.QueryResourcesResult{
-		PageToken: body.PageToken,
+		Total:         body.Total,
+		TotalRelation: body.TotalRelation,
+		PageToken:     body.PageToken,
+		CacheStatus:   body.CacheStatus,
 	}
 	v.Resources = make([]*querysvc.Resource, len(body.Resources))
 	for i, val := range body.Resources {
 		v.Resources[i] = unmarshalResourceResponseBodyToQuerysvcResource(val)
 	}
+	if body.Suggestions != nil {
+		v.Suggestions = make([]string, len(body.Suggestions))
+		for i, val := range body.Suggestions {
+			v.Suggestions[i] = val
+		}
+	}
 	v.CacheControl = cacheControl
+	v.PublicPath = publicPath
+	v.Degraded = degraded
+	v.Link = link
+	v.Etag = etag
 
 	return v
 }
@@ -239,12 +806,24 @@ func NewQueryResourcesServiceUnavailable(body *QueryResourcesServiceUnavailableR
 
 // NewQueryResourcesCountResultOK builds a "query-svc" service
 // "query-resources-count" endpoint result from a HTTP "OK" response.
-func NewQueryResourcesCountResultOK(body *QueryResourcesCountResponseBody, cacheControl *string) *querysvc.QueryResourcesCountResult {
+func NewQueryResourcesCountResultOK(body *QueryResourcesCountResponseBody, cacheControl *string, publicPath *bool, degraded *bool) *querysvc.QueryResourcesCountResult {
 	v := &querysvc.QueryResourcesCountResult{
-		Count:   *body.Count,
-		HasMore: *body.HasMore,
+		Count:        *body.Count,
+		VisibleCount: *body.VisibleCount,
+		TotalCount:   body.TotalCount,
+		HasMore:      *body.HasMore,
+	}
+	if body.RelationCounts != nil {
+		v.RelationCounts = make(map[string]uint64, len(body.RelationCounts))
+		for key, val := range body.RelationCounts {
+			tk := key
+			tv := val
+			v.RelationCounts[tk] = tv
+		}
 	}
 	v.CacheControl = cacheControl
+	v.PublicPath = publicPath
+	v.Degraded = degraded
 
 	return v
 }
@@ -279,23 +858,21 @@ func NewQueryResourcesCountServiceUnavailable(body *QueryResourcesCountServiceUn
 	return v
 }
 
-// NewQueryOrgsOrganizationOK builds a "query-svc" service "query-orgs"
-// endpoint result from a HTTP "OK" response.
-func NewQueryOrgsOrganizationOK(body *QueryOrgsResponseBody) *querysvc.Organization {
-	v := &querysvc.Organization{
-		Name:      body.Name,
-		Domain:    body.Domain,
-		Industry:  body.Industry,
-		Sector:    body.Sector,
-		Employees: body.Employees,
+// NewQueryResourcesCountBatchResultOK builds a "query-svc" service
+// "query-resources-count-batch" endpoint result from a HTTP "OK" response.
+func NewQueryResourcesCountBatchResultOK(body *QueryResourcesCountBatchResponseBody) *querysvc.QueryResourcesCountBatchResult {
+	v := &querysvc.QueryResourcesCountBatchResult{}
+	v.Items = make([]*querysvc.CountItem, len(body.Items))
+	for i, val := range body.Items {
+		v.Items[i] = unmarshalCountItemResponseBodyToQuerysvcCountItem(val)
 	}
 
 	return v
 }
 
-// NewQueryOrgsBadRequest builds a query-svc service query-orgs endpoint
-// BadRequest error.
-func NewQueryOrgsBadRequest(body *QueryOrgsBadRequestResponseBody) *querysvc.BadRequestError {
+// NewQueryResourcesCountBatchBadRequest builds a query-svc service
+// query-resources-count-batch endpoint BadRequest error.
+func NewQueryResourcesCountBatchBadRequest(body *QueryResourcesCountBatchBadRequestResponseBody) *querysvc.BadRequestError {
 	v := &querysvc.BadRequestError{
 		Message: *body.Message,
 	}
@@ -303,8 +880,222 @@ func NewQueryOrgsBadRequest(body *QueryOrgsBadRequestResponseBody) *querysvc.Bad
 	return v
 }
 
-// NewQueryOrgsInternalServerError builds a query-svc service query-orgs
-// endpoint InternalServerError error.
+// NewQueryResourcesCountBatchInternalServerError builds a query-svc service
+// query-resources-count-batch endpoint InternalServerError error.
+func NewQueryResourcesCountBatchInternalServerError(body *QueryResourcesCountBatchInternalServerErrorResponseBody) *querysvc.InternalServerError {
+	v := &querysvc.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewQueryResourcesCountBatchServiceUnavailable builds a query-svc service
+// query-resources-count-batch endpoint ServiceUnavailable error.
+func NewQueryResourcesCountBatchServiceUnavailable(body *QueryResourcesCountBatchServiceUnavailableResponseBody) *querysvc.ServiceUnavailableError {
+	v := &querysvc.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewQueryResourcesFacetsResultOK builds a "query-svc" service
+// "query-resources-facets" endpoint result from a HTTP "OK" response.
+func NewQueryResourcesFacetsResultOK(body *QueryResourcesFacetsResponseBody, cacheControl *string, publicPath *bool) *querysvc.QueryResourcesFacetsResult {
+	v := &querysvc.QueryResourcesFacetsResult{
+		Total:         body.Total,
+		TotalRelation: body.TotalRelation,
+	}
+	v.Facets = make(map[string][]*querysvc.FacetValue, len(body.Facets))
+	for key, val := range body.Facets {
+		tk := key
+		tv := make([]*querysvc.FacetValue, len(val))
+		for i, val := range val {
+			tv[i] = unmarshalFacetValueResponseBodyToQuerysvcFacetValue(val)
+		}
+		v.Facets[tk] = tv
+	}
+	v.CacheControl = cacheControl
+	v.PublicPath = publicPath
+
+	return v
+}
+
+// NewQueryResourcesFacetsBadRequest builds a query-svc service
+// query-resources-facets endpoint BadRequest error.
+func NewQueryResourcesFacetsBadRequest(body *QueryResourcesFacetsBadRequestResponseBody) *querysvc.BadRequestError {
+	v := &querysvc.BadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewQueryResourcesFacetsInternalServerError builds a query-svc service
+// query-resources-facets endpoint InternalServerError error.
+func NewQueryResourcesFacetsInternalServerError(body *QueryResourcesFacetsInternalServerErrorResponseBody) *querysvc.InternalServerError {
+	v := &querysvc.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewQueryResourcesFacetsServiceUnavailable builds a query-svc service
+// query-resources-facets endpoint ServiceUnavailable error.
+func NewQueryResourcesFacetsServiceUnavailable(body *QueryResourcesFacetsServiceUnavailableResponseBody) *querysvc.ServiceUnavailableError {
+	v := &querysvc.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewExplainResourceResourceExplanationOK builds a "query-svc" service
+// "explain-resource" endpoint result from a HTTP "OK" response.
+func NewExplainResourceResourceExplanationOK(body *ExplainResourceResponseBody) *querysvc.ResourceExplanation {
+	v := &querysvc.ResourceExplanation{
+		ObjectRef:        *body.ObjectRef,
+		Stage:            *body.Stage,
+		Reason:           *body.Reason,
+		Found:            *body.Found,
+		MatchedCriteria:  *body.MatchedCriteria,
+		AccessCheckQuery: body.AccessCheckQuery,
+		AccessGranted:    *body.AccessGranted,
+	}
+
+	return v
+}
+
+// NewExplainResourceBadRequest builds a query-svc service explain-resource
+// endpoint BadRequest error.
+func NewExplainResourceBadRequest(body *ExplainResourceBadRequestResponseBody) *querysvc.BadRequestError {
+	v := &querysvc.BadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewExplainResourceInternalServerError builds a query-svc service
+// explain-resource endpoint InternalServerError error.
+func NewExplainResourceInternalServerError(body *ExplainResourceInternalServerErrorResponseBody) *querysvc.InternalServerError {
+	v := &querysvc.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewExplainResourceNotFound builds a query-svc service explain-resource
+// endpoint NotFound error.
+func NewExplainResourceNotFound(body *ExplainResourceNotFoundResponseBody) *querysvc.NotFoundError {
+	v := &querysvc.NotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewExplainResourceServiceUnavailable builds a query-svc service
+// explain-resource endpoint ServiceUnavailable error.
+func NewExplainResourceServiceUnavailable(body *ExplainResourceServiceUnavailableResponseBody) *querysvc.ServiceUnavailableError {
+	v := &querysvc.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCheckTuplesResultOK builds a "query-svc" service "check-tuples" endpoint
+// result from a HTTP "OK" response.
+func NewCheckTuplesResultOK(body *CheckTuplesResponseBody) *querysvc.CheckTuplesResult {
+	v := &querysvc.CheckTuplesResult{}
+	v.Results = make(map[string]string, len(body.Results))
+	for key, val := range body.Results {
+		tk := key
+		tv := val
+		v.Results[tk] = tv
+	}
+
+	return v
+}
+
+// NewCheckTuplesBadRequest builds a query-svc service check-tuples endpoint
+// BadRequest error.
+func NewCheckTuplesBadRequest(body *CheckTuplesBadRequestResponseBody) *querysvc.BadRequestError {
+	v := &querysvc.BadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCheckTuplesInternalServerError builds a query-svc service check-tuples
+// endpoint InternalServerError error.
+func NewCheckTuplesInternalServerError(body *CheckTuplesInternalServerErrorResponseBody) *querysvc.InternalServerError {
+	v := &querysvc.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCheckTuplesNotFound builds a query-svc service check-tuples endpoint
+// NotFound error.
+func NewCheckTuplesNotFound(body *CheckTuplesNotFoundResponseBody) *querysvc.NotFoundError {
+	v := &querysvc.NotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCheckTuplesServiceUnavailable builds a query-svc service check-tuples
+// endpoint ServiceUnavailable error.
+func NewCheckTuplesServiceUnavailable(body *CheckTuplesServiceUnavailableResponseBody) *querysvc.ServiceUnavailableError {
+	v := &querysvc.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewQueryOrgsOrganizationOK builds a "query-svc" service "query-orgs"
+// endpoint result from a HTTP "OK" response.
+func NewQueryOrgsOrganizationOK(body *QueryOrgsResponseBody) *querysvc.Organization {
+	v := &querysvc.Organization{
+		Name:      body.Name,
+		Domain:    body.Domain,
+		Industry:  body.Industry,
+		Sector:    body.Sector,
+		Employees: body.Employees,
+	}
+	if body.Metadata != nil {
+		v.Metadata = make(map[string]any, len(body.Metadata))
+		for key, val := range body.Metadata {
+			tk := key
+			tv := val
+			v.Metadata[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewQueryOrgsBadRequest builds a query-svc service query-orgs endpoint
+// BadRequest error.
+func NewQueryOrgsBadRequest(body *QueryOrgsBadRequestResponseBody) *querysvc.BadRequestError {
+	v := &querysvc.BadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewQueryOrgsInternalServerError builds a query-svc service query-orgs
+// endpoint InternalServerError error.
 func NewQueryOrgsInternalServerError(body *QueryOrgsInternalServerErrorResponseBody) *querysvc.InternalServerError {
 	v := &querysvc.InternalServerError{
 		Message: *body.Message,
@@ -333,6 +1124,132 @@ func NewQueryOrgsServiceUnavailable(body *QueryOrgsServiceUnavailableResponseBod
 	return v
 }
 
+// NewQueryOrgsListResultOK builds a "query-svc" service "query-orgs-list"
+// endpoint result from a HTTP "OK" response.
+func NewQueryOrgsListResultOK(body *QueryOrgsListResponseBody) *querysvc.QueryOrgsListResult {
+	v := &querysvc.QueryOrgsListResult{
+		PageToken: body.PageToken,
+	}
+	v.Organizations = make([]*querysvc.Organization, len(body.Organizations))
+	for i, val := range body.Organizations {
+		v.Organizations[i] = unmarshalOrganizationResponseBodyToQuerysvcOrganization(val)
+	}
+
+	return v
+}
+
+// NewQueryOrgsListBadRequest builds a query-svc service query-orgs-list
+// endpoint BadRequest error.
+func NewQueryOrgsListBadRequest(body *QueryOrgsListBadRequestResponseBody) *querysvc.BadRequestError {
+	v := &querysvc.BadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewQueryOrgsListInternalServerError builds a query-svc service
+// query-orgs-list endpoint InternalServerError error.
+func NewQueryOrgsListInternalServerError(body *QueryOrgsListInternalServerErrorResponseBody) *querysvc.InternalServerError {
+	v := &querysvc.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewQueryOrgsListServiceUnavailable builds a query-svc service
+// query-orgs-list endpoint ServiceUnavailable error.
+func NewQueryOrgsListServiceUnavailable(body *QueryOrgsListServiceUnavailableResponseBody) *querysvc.ServiceUnavailableError {
+	v := &querysvc.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCountOrgsResultOK builds a "query-svc" service "count-orgs" endpoint
+// result from a HTTP "OK" response.
+func NewCountOrgsResultOK(body *CountOrgsResponseBody) *querysvc.CountOrgsResult {
+	v := &querysvc.CountOrgsResult{
+		Count: *body.Count,
+	}
+
+	return v
+}
+
+// NewCountOrgsBadRequest builds a query-svc service count-orgs endpoint
+// BadRequest error.
+func NewCountOrgsBadRequest(body *CountOrgsBadRequestResponseBody) *querysvc.BadRequestError {
+	v := &querysvc.BadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCountOrgsInternalServerError builds a query-svc service count-orgs
+// endpoint InternalServerError error.
+func NewCountOrgsInternalServerError(body *CountOrgsInternalServerErrorResponseBody) *querysvc.InternalServerError {
+	v := &querysvc.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCountOrgsServiceUnavailable builds a query-svc service count-orgs
+// endpoint ServiceUnavailable error.
+func NewCountOrgsServiceUnavailable(body *CountOrgsServiceUnavailableResponseBody) *querysvc.ServiceUnavailableError {
+	v := &querysvc.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewResolveOrgsResultOK builds a "query-svc" service "resolve-orgs" endpoint
+// result from a HTTP "OK" response.
+func NewResolveOrgsResultOK(body *ResolveOrgsResponseBody) *querysvc.ResolveOrgsResult {
+	v := &querysvc.ResolveOrgsResult{}
+	v.Organizations = make([]*querysvc.ResolvedOrganization, len(body.Organizations))
+	for i, val := range body.Organizations {
+		v.Organizations[i] = unmarshalResolvedOrganizationResponseBodyToQuerysvcResolvedOrganization(val)
+	}
+
+	return v
+}
+
+// NewResolveOrgsBadRequest builds a query-svc service resolve-orgs endpoint
+// BadRequest error.
+func NewResolveOrgsBadRequest(body *ResolveOrgsBadRequestResponseBody) *querysvc.BadRequestError {
+	v := &querysvc.BadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewResolveOrgsInternalServerError builds a query-svc service resolve-orgs
+// endpoint InternalServerError error.
+func NewResolveOrgsInternalServerError(body *ResolveOrgsInternalServerErrorResponseBody) *querysvc.InternalServerError {
+	v := &querysvc.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewResolveOrgsServiceUnavailable builds a query-svc service resolve-orgs
+// endpoint ServiceUnavailable error.
+func NewResolveOrgsServiceUnavailable(body *ResolveOrgsServiceUnavailableResponseBody) *querysvc.ServiceUnavailableError {
+	v := &querysvc.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
 // NewSuggestOrgsResultOK builds a "query-svc" service "suggest-orgs" endpoint
 // result from a HTTP "OK" response.
 func NewSuggestOrgsResultOK(body *SuggestOrgsResponseBody) *querysvc.SuggestOrgsResult {
@@ -351,130 +1268,585 @@ func NewSuggestOrgsBadRequest(body *SuggestOrgsBadRequestResponseBody) *querysvc
 	v := &querysvc.BadRequestError{
 		Message: *body.Message,
 	}
-
-	return v
+
+	return v
+}
+
+// NewSuggestOrgsInternalServerError builds a query-svc service suggest-orgs
+// endpoint InternalServerError error.
+func NewSuggestOrgsInternalServerError(body *SuggestOrgsInternalServerErrorResponseBody) *querysvc.InternalServerError {
+	v := &querysvc.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewSuggestOrgsServiceUnavailable builds a query-svc service suggest-orgs
+// endpoint ServiceUnavailable error.
+func NewSuggestOrgsServiceUnavailable(body *SuggestOrgsServiceUnavailableResponseBody) *querysvc.ServiceUnavailableError {
+	v := &querysvc.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUnifiedSearchResultOK builds a "query-svc" service "unified-search"
+// endpoint result from a HTTP "OK" response.
+func NewUnifiedSearchResultOK(body *UnifiedSearchResponseBody) *querysvc.UnifiedSearchResult {
+	v := &querysvc.UnifiedSearchResult{
+		OrganizationsUnavailable: *body.OrganizationsUnavailable,
+	}
+	v.Resources = make([]*querysvc.Resource, len(body.Resources))
+	for i, val := range body.Resources {
+		v.Resources[i] = unmarshalResourceResponseBodyToQuerysvcResource(val)
+	}
+	v.Organizations = make([]*querysvc.OrganizationSuggestion, len(body.Organizations))
+	for i, val := range body.Organizations {
+		v.Organizations[i] = unmarshalOrganizationSuggestionResponseBodyToQuerysvcOrganizationSuggestion(val)
+	}
+
+	return v
+}
+
+// NewUnifiedSearchBadRequest builds a query-svc service unified-search
+// endpoint BadRequest error.
+func NewUnifiedSearchBadRequest(body *UnifiedSearchBadRequestResponseBody) *querysvc.BadRequestError {
+	v := &querysvc.BadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUnifiedSearchInternalServerError builds a query-svc service
+// unified-search endpoint InternalServerError error.
+func NewUnifiedSearchInternalServerError(body *UnifiedSearchInternalServerErrorResponseBody) *querysvc.InternalServerError {
+	v := &querysvc.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUnifiedSearchServiceUnavailable builds a query-svc service unified-search
+// endpoint ServiceUnavailable error.
+func NewUnifiedSearchServiceUnavailable(body *UnifiedSearchServiceUnavailableResponseBody) *querysvc.ServiceUnavailableError {
+	v := &querysvc.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewSuggestResultOK builds a "query-svc" service "suggest" endpoint result
+// from a HTTP "OK" response.
+func NewSuggestResultOK(body *SuggestResponseBody) *querysvc.SuggestResult {
+	v := &querysvc.SuggestResult{
+		OrganizationsUnavailable: *body.OrganizationsUnavailable,
+	}
+	v.Suggestions = make([]*querysvc.Suggestion, len(body.Suggestions))
+	for i, val := range body.Suggestions {
+		v.Suggestions[i] = unmarshalSuggestionResponseBodyToQuerysvcSuggestion(val)
+	}
+
+	return v
+}
+
+// NewSuggestBadRequest builds a query-svc service suggest endpoint BadRequest
+// error.
+func NewSuggestBadRequest(body *SuggestBadRequestResponseBody) *querysvc.BadRequestError {
+	v := &querysvc.BadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewSuggestInternalServerError builds a query-svc service suggest endpoint
+// InternalServerError error.
+func NewSuggestInternalServerError(body *SuggestInternalServerErrorResponseBody) *querysvc.InternalServerError {
+	v := &querysvc.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewSuggestServiceUnavailable builds a query-svc service suggest endpoint
+// ServiceUnavailable error.
+func NewSuggestServiceUnavailable(body *SuggestServiceUnavailableResponseBody) *querysvc.ServiceUnavailableError {
+	v := &querysvc.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewFlushCacheResultOK builds a "query-svc" service "flush-cache" endpoint
+// result from a HTTP "OK" response.
+func NewFlushCacheResultOK(body *FlushCacheResponseBody) *querysvc.FlushCacheResult {
+	v := &querysvc.FlushCacheResult{
+		Flushed: *body.Flushed,
+		Warmed:  *body.Warmed,
+	}
+
+	return v
+}
+
+// NewFlushCacheBadRequest builds a query-svc service flush-cache endpoint
+// BadRequest error.
+func NewFlushCacheBadRequest(body *FlushCacheBadRequestResponseBody) *querysvc.BadRequestError {
+	v := &querysvc.BadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewFlushCacheInternalServerError builds a query-svc service flush-cache
+// endpoint InternalServerError error.
+func NewFlushCacheInternalServerError(body *FlushCacheInternalServerErrorResponseBody) *querysvc.InternalServerError {
+	v := &querysvc.InternalServerError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewFlushCacheNotFound builds a query-svc service flush-cache endpoint
+// NotFound error.
+func NewFlushCacheNotFound(body *FlushCacheNotFoundResponseBody) *querysvc.NotFoundError {
+	v := &querysvc.NotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewFlushCacheServiceUnavailable builds a query-svc service flush-cache
+// endpoint ServiceUnavailable error.
+func NewFlushCacheServiceUnavailable(body *FlushCacheServiceUnavailableResponseBody) *querysvc.ServiceUnavailableError {
+	v := &querysvc.ServiceUnavailableError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewReadyzNotReady builds a query-svc service readyz endpoint NotReady error.
+func NewReadyzNotReady(body *ReadyzNotReadyResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// ValidateQueryResourcesOKResponseBody runs the validations defined on
+// Query-ResourcesOKResponseBody
+func ValidateQueryResourcesOKResponseBody(body *QueryResourcesOKResponseBody) (err error) {
+	if body.Resources == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("resources", "body"))
+	}
+	for _, e := range body.Resources {
+		if e != nil {
+			if err2 := ValidateResourceResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.TotalRelation != nil {
+		if !(*body.TotalRelation == "eq" || *body.TotalRelation == "gte") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.total_relation", *body.TotalRelation, []any{"eq", "gte"}))
+		}
+	}
+	if body.CacheStatus != nil {
+		if !(*body.CacheStatus == "not-modified") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.cache_status", *body.CacheStatus, []any{"not-modified"}))
+		}
+	}
+	return
+}
+
+// ValidateQueryResourcesCountResponseBody runs the validations defined on
+// Query-Resources-CountResponseBody
+func ValidateQueryResourcesCountResponseBody(body *QueryResourcesCountResponseBody) (err error) {
+	if body.Count == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("count", "body"))
+	}
+	if body.VisibleCount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("visible_count", "body"))
+	}
+	if body.HasMore == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("has_more", "body"))
+	}
+	return
+}
+
+// ValidateQueryResourcesCountBatchResponseBody runs the validations defined on
+// Query-Resources-Count-BatchResponseBody
+func ValidateQueryResourcesCountBatchResponseBody(body *QueryResourcesCountBatchResponseBody) (err error) {
+	if body.Items == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("items", "body"))
+	}
+	for _, e := range body.Items {
+		if e != nil {
+			if err2 := ValidateCountItemResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateQueryResourcesFacetsResponseBody runs the validations defined on
+// Query-Resources-FacetsResponseBody
+func ValidateQueryResourcesFacetsResponseBody(body *QueryResourcesFacetsResponseBody) (err error) {
+	if body.Facets == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("facets", "body"))
+	}
+	if body.TotalRelation != nil {
+		if !(*body.TotalRelation == "eq" || *body.TotalRelation == "gte") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.total_relation", *body.TotalRelation, []any{"eq", "gte"}))
+		}
+	}
+	return
+}
+
+// ValidateExplainResourceResponseBody runs the validations defined on
+// Explain-ResourceResponseBody
+func ValidateExplainResourceResponseBody(body *ExplainResourceResponseBody) (err error) {
+	if body.ObjectRef == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("object_ref", "body"))
+	}
+	if body.Stage == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("stage", "body"))
+	}
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	if body.Found == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("found", "body"))
+	}
+	if body.MatchedCriteria == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("matched_criteria", "body"))
+	}
+	if body.AccessGranted == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("access_granted", "body"))
+	}
+	if body.Stage != nil {
+		if !(*body.Stage == "not_found" || *body.Stage == "filtered_out" || *body.Stage == "access_denied" || *body.Stage == "returned") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.stage", *body.Stage, []any{"not_found", "filtered_out", "access_denied", "returned"}))
+		}
+	}
+	return
+}
+
+// ValidateCheckTuplesResponseBody runs the validations defined on
+// Check-TuplesResponseBody
+func ValidateCheckTuplesResponseBody(body *CheckTuplesResponseBody) (err error) {
+	if body.Results == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("results", "body"))
+	}
+	return
+}
+
+// ValidateQueryOrgsListResponseBody runs the validations defined on
+// Query-Orgs-ListResponseBody
+func ValidateQueryOrgsListResponseBody(body *QueryOrgsListResponseBody) (err error) {
+	if body.Organizations == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("organizations", "body"))
+	}
+	return
+}
+
+// ValidateCountOrgsResponseBody runs the validations defined on
+// Count-OrgsResponseBody
+func ValidateCountOrgsResponseBody(body *CountOrgsResponseBody) (err error) {
+	if body.Count == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("count", "body"))
+	}
+	return
+}
+
+// ValidateResolveOrgsResponseBody runs the validations defined on
+// Resolve-OrgsResponseBody
+func ValidateResolveOrgsResponseBody(body *ResolveOrgsResponseBody) (err error) {
+	if body.Organizations == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("organizations", "body"))
+	}
+	for _, e := range body.Organizations {
+		if e != nil {
+			if err2 := ValidateResolvedOrganizationResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateSuggestOrgsResponseBody runs the validations defined on
+// Suggest-OrgsResponseBody
+func ValidateSuggestOrgsResponseBody(body *SuggestOrgsResponseBody) (err error) {
+	if body.Suggestions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("suggestions", "body"))
+	}
+	for _, e := range body.Suggestions {
+		if e != nil {
+			if err2 := ValidateOrganizationSuggestionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateUnifiedSearchResponseBody runs the validations defined on
+// Unified-SearchResponseBody
+func ValidateUnifiedSearchResponseBody(body *UnifiedSearchResponseBody) (err error) {
+	if body.Resources == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("resources", "body"))
+	}
+	if body.Organizations == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("organizations", "body"))
+	}
+	if body.OrganizationsUnavailable == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("organizations_unavailable", "body"))
+	}
+	for _, e := range body.Resources {
+		if e != nil {
+			if err2 := ValidateResourceResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Organizations {
+		if e != nil {
+			if err2 := ValidateOrganizationSuggestionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateSuggestResponseBody runs the validations defined on
+// SuggestResponseBody
+func ValidateSuggestResponseBody(body *SuggestResponseBody) (err error) {
+	if body.Suggestions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("suggestions", "body"))
+	}
+	if body.OrganizationsUnavailable == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("organizations_unavailable", "body"))
+	}
+	for _, e := range body.Suggestions {
+		if e != nil {
+			if err2 := ValidateSuggestionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateFlushCacheResponseBody runs the validations defined on
+// Flush-CacheResponseBody
+func ValidateFlushCacheResponseBody(body *FlushCacheResponseBody) (err error) {
+	if body.Flushed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("flushed", "body"))
+	}
+	if body.Warmed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("warmed", "body"))
+	}
+	return
+}
+
+// ValidateQueryResourcesBadRequestResponseBody runs the validations defined on
+// query-resources_BadRequest_response_body
+func ValidateQueryResourcesBadRequestResponseBody(body *QueryResourcesBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateQueryResourcesInternalServerErrorResponseBody runs the validations
+// defined on query-resources_InternalServerError_response_body
+func ValidateQueryResourcesInternalServerErrorResponseBody(body *QueryResourcesInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateQueryResourcesServiceUnavailableResponseBody runs the validations
+// defined on query-resources_ServiceUnavailable_response_body
+func ValidateQueryResourcesServiceUnavailableResponseBody(body *QueryResourcesServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateQueryResourcesCountBadRequestResponseBody runs the validations
+// defined on query-resources-count_BadRequest_response_body
+func ValidateQueryResourcesCountBadRequestResponseBody(body *QueryResourcesCountBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateQueryResourcesCountInternalServerErrorResponseBody runs the
+// validations defined on
+// query-resources-count_InternalServerError_response_body
+func ValidateQueryResourcesCountInternalServerErrorResponseBody(body *QueryResourcesCountInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateQueryResourcesCountServiceUnavailableResponseBody runs the
+// validations defined on query-resources-count_ServiceUnavailable_response_body
+func ValidateQueryResourcesCountServiceUnavailableResponseBody(body *QueryResourcesCountServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateQueryResourcesCountBatchBadRequestResponseBody runs the validations
+// defined on query-resources-count-batch_BadRequest_response_body
+func ValidateQueryResourcesCountBatchBadRequestResponseBody(body *QueryResourcesCountBatchBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateQueryResourcesCountBatchInternalServerErrorResponseBody runs the
+// validations defined on
+// query-resources-count-batch_InternalServerError_response_body
+func ValidateQueryResourcesCountBatchInternalServerErrorResponseBody(body *QueryResourcesCountBatchInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewSuggestOrgsInternalServerError builds a query-svc service suggest-orgs
-// endpoint InternalServerError error.
-func NewSuggestOrgsInternalServerError(body *SuggestOrgsInternalServerErrorResponseBody) *querysvc.InternalServerError {
-	v := &querysvc.InternalServerError{
-		Message: *body.Message,
+// ValidateQueryResourcesCountBatchServiceUnavailableResponseBody runs the
+// validations defined on
+// query-resources-count-batch_ServiceUnavailable_response_body
+func ValidateQueryResourcesCountBatchServiceUnavailableResponseBody(body *QueryResourcesCountBatchServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-
-	return v
+	return
 }
 
-// NewSuggestOrgsServiceUnavailable builds a query-svc service suggest-orgs
-// endpoint ServiceUnavailable error.
-func NewSuggestOrgsServiceUnavailable(body *SuggestOrgsServiceUnavailableResponseBody) *querysvc.ServiceUnavailableError {
-	v := &querysvc.ServiceUnavailableError{
-		Message: *body.Message,
+// ValidateQueryResourcesFacetsBadRequestResponseBody runs the validations
+// defined on query-resources-facets_BadRequest_response_body
+func ValidateQueryResourcesFacetsBadRequestResponseBody(body *QueryResourcesFacetsBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-
-	return v
+	return
 }
 
-// NewReadyzNotReady builds a query-svc service readyz endpoint NotReady error.
-func NewReadyzNotReady(body *ReadyzNotReadyResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateQueryResourcesFacetsInternalServerErrorResponseBody runs the
+// validations defined on
+// query-resources-facets_InternalServerError_response_body
+func ValidateQueryResourcesFacetsInternalServerErrorResponseBody(body *QueryResourcesFacetsInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-
-	return v
+	return
 }
 
-// ValidateQueryResourcesResponseBody runs the validations defined on
-// Query-ResourcesResponseBody
-func ValidateQueryResourcesResponseBody(body *QueryResourcesResponseBody) (err error) {
-	if body.Resources == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("resources", "body"))
+// ValidateQueryResourcesFacetsServiceUnavailableResponseBody runs the
+// validations defined on
+// query-resources-facets_ServiceUnavailable_response_body
+func ValidateQueryResourcesFacetsServiceUnavailableResponseBody(body *QueryResourcesFacetsServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateQueryResourcesCountResponseBody runs the validations defined on
-// Query-Resources-CountResponseBody
-func ValidateQueryResourcesCountResponseBody(body *QueryResourcesCountResponseBody) (err error) {
-	if body.Count == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("count", "body"))
-	}
-	if body.HasMore == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("has_more", "body"))
+// ValidateExplainResourceBadRequestResponseBody runs the validations defined
+// on explain-resource_BadRequest_response_body
+func ValidateExplainResourceBadRequestResponseBody(body *ExplainResourceBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateSuggestOrgsResponseBody runs the validations defined on
-// Suggest-OrgsResponseBody
-func ValidateSuggestOrgsResponseBody(body *SuggestOrgsResponseBody) (err error) {
-	if body.Suggestions == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("suggestions", "body"))
-	}
-	for _, e := range body.Suggestions {
-		if e != nil {
-			if err2 := ValidateOrganizationSuggestionResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+// ValidateExplainResourceInternalServerErrorResponseBody runs the validations
+// defined on explain-resource_InternalServerError_response_body
+func ValidateExplainResourceInternalServerErrorResponseBody(body *ExplainResourceInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateQueryResourcesBadRequestResponseBody runs the validations defined on
-// query-resources_BadRequest_response_body
-func ValidateQueryResourcesBadRequestResponseBody(body *QueryResourcesBadRequestResponseBody) (err error) {
+// ValidateExplainResourceNotFoundResponseBody runs the validations defined on
+// explain-resource_NotFound_response_body
+func ValidateExplainResourceNotFoundResponseBody(body *ExplainResourceNotFoundResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateQueryResourcesInternalServerErrorResponseBody runs the validations
-// defined on query-resources_InternalServerError_response_body
-func ValidateQueryResourcesInternalServerErrorResponseBody(body *QueryResourcesInternalServerErrorResponseBody) (err error) {
+// ValidateExplainResourceServiceUnavailableResponseBody runs the validations
+// defined on explain-resource_ServiceUnavailable_response_body
+func ValidateExplainResourceServiceUnavailableResponseBody(body *ExplainResourceServiceUnavailableResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateQueryResourcesServiceUnavailableResponseBody runs the validations
-// defined on query-resources_ServiceUnavailable_response_body
-func ValidateQueryResourcesServiceUnavailableResponseBody(body *QueryResourcesServiceUnavailableResponseBody) (err error) {
+// ValidateCheckTuplesBadRequestResponseBody runs the validations defined on
+// check-tuples_BadRequest_response_body
+func ValidateCheckTuplesBadRequestResponseBody(body *CheckTuplesBadRequestResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateQueryResourcesCountBadRequestResponseBody runs the validations
-// defined on query-resources-count_BadRequest_response_body
-func ValidateQueryResourcesCountBadRequestResponseBody(body *QueryResourcesCountBadRequestResponseBody) (err error) {
+// ValidateCheckTuplesInternalServerErrorResponseBody runs the validations
+// defined on check-tuples_InternalServerError_response_body
+func ValidateCheckTuplesInternalServerErrorResponseBody(body *CheckTuplesInternalServerErrorResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateQueryResourcesCountInternalServerErrorResponseBody runs the
-// validations defined on
-// query-resources-count_InternalServerError_response_body
-func ValidateQueryResourcesCountInternalServerErrorResponseBody(body *QueryResourcesCountInternalServerErrorResponseBody) (err error) {
+// ValidateCheckTuplesNotFoundResponseBody runs the validations defined on
+// check-tuples_NotFound_response_body
+func ValidateCheckTuplesNotFoundResponseBody(body *CheckTuplesNotFoundResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateQueryResourcesCountServiceUnavailableResponseBody runs the
-// validations defined on query-resources-count_ServiceUnavailable_response_body
-func ValidateQueryResourcesCountServiceUnavailableResponseBody(body *QueryResourcesCountServiceUnavailableResponseBody) (err error) {
+// ValidateCheckTuplesServiceUnavailableResponseBody runs the validations
+// defined on check-tuples_ServiceUnavailable_response_body
+func ValidateCheckTuplesServiceUnavailableResponseBody(body *CheckTuplesServiceUnavailableResponseBody) (err error) {
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
@@ -517,6 +1889,87 @@ func ValidateQueryOrgsServiceUnavailableResponseBody(body *QueryOrgsServiceUnava
 	return
 }
 
+// ValidateQueryOrgsListBadRequestResponseBody runs the validations defined on
+// query-orgs-list_BadRequest_response_body
+func ValidateQueryOrgsListBadRequestResponseBody(body *QueryOrgsListBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateQueryOrgsListInternalServerErrorResponseBody runs the validations
+// defined on query-orgs-list_InternalServerError_response_body
+func ValidateQueryOrgsListInternalServerErrorResponseBody(body *QueryOrgsListInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateQueryOrgsListServiceUnavailableResponseBody runs the validations
+// defined on query-orgs-list_ServiceUnavailable_response_body
+func ValidateQueryOrgsListServiceUnavailableResponseBody(body *QueryOrgsListServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCountOrgsBadRequestResponseBody runs the validations defined on
+// count-orgs_BadRequest_response_body
+func ValidateCountOrgsBadRequestResponseBody(body *CountOrgsBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCountOrgsInternalServerErrorResponseBody runs the validations
+// defined on count-orgs_InternalServerError_response_body
+func ValidateCountOrgsInternalServerErrorResponseBody(body *CountOrgsInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCountOrgsServiceUnavailableResponseBody runs the validations defined
+// on count-orgs_ServiceUnavailable_response_body
+func ValidateCountOrgsServiceUnavailableResponseBody(body *CountOrgsServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateResolveOrgsBadRequestResponseBody runs the validations defined on
+// resolve-orgs_BadRequest_response_body
+func ValidateResolveOrgsBadRequestResponseBody(body *ResolveOrgsBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateResolveOrgsInternalServerErrorResponseBody runs the validations
+// defined on resolve-orgs_InternalServerError_response_body
+func ValidateResolveOrgsInternalServerErrorResponseBody(body *ResolveOrgsInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateResolveOrgsServiceUnavailableResponseBody runs the validations
+// defined on resolve-orgs_ServiceUnavailable_response_body
+func ValidateResolveOrgsServiceUnavailableResponseBody(body *ResolveOrgsServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
 // ValidateSuggestOrgsBadRequestResponseBody runs the validations defined on
 // suggest-orgs_BadRequest_response_body
 func ValidateSuggestOrgsBadRequestResponseBody(body *SuggestOrgsBadRequestResponseBody) (err error) {
@@ -544,6 +1997,96 @@ func ValidateSuggestOrgsServiceUnavailableResponseBody(body *SuggestOrgsServiceU
 	return
 }
 
+// ValidateUnifiedSearchBadRequestResponseBody runs the validations defined on
+// unified-search_BadRequest_response_body
+func ValidateUnifiedSearchBadRequestResponseBody(body *UnifiedSearchBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUnifiedSearchInternalServerErrorResponseBody runs the validations
+// defined on unified-search_InternalServerError_response_body
+func ValidateUnifiedSearchInternalServerErrorResponseBody(body *UnifiedSearchInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUnifiedSearchServiceUnavailableResponseBody runs the validations
+// defined on unified-search_ServiceUnavailable_response_body
+func ValidateUnifiedSearchServiceUnavailableResponseBody(body *UnifiedSearchServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateSuggestBadRequestResponseBody runs the validations defined on
+// suggest_BadRequest_response_body
+func ValidateSuggestBadRequestResponseBody(body *SuggestBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateSuggestInternalServerErrorResponseBody runs the validations defined
+// on suggest_InternalServerError_response_body
+func ValidateSuggestInternalServerErrorResponseBody(body *SuggestInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateSuggestServiceUnavailableResponseBody runs the validations defined
+// on suggest_ServiceUnavailable_response_body
+func ValidateSuggestServiceUnavailableResponseBody(body *SuggestServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateFlushCacheBadRequestResponseBody runs the validations defined on
+// flush-cache_BadRequest_response_body
+func ValidateFlushCacheBadRequestResponseBody(body *FlushCacheBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateFlushCacheInternalServerErrorResponseBody runs the validations
+// defined on flush-cache_InternalServerError_response_body
+func ValidateFlushCacheInternalServerErrorResponseBody(body *FlushCacheInternalServerErrorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateFlushCacheNotFoundResponseBody runs the validations defined on
+// flush-cache_NotFound_response_body
+func ValidateFlushCacheNotFoundResponseBody(body *FlushCacheNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateFlushCacheServiceUnavailableResponseBody runs the validations
+// defined on flush-cache_ServiceUnavailable_response_body
+func ValidateFlushCacheServiceUnavailableResponseBody(body *FlushCacheServiceUnavailableResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
 // ValidateReadyzNotReadyResponseBody runs the validations defined on
 // readyz_NotReady_response_body
 func ValidateReadyzNotReadyResponseBody(body *ReadyzNotReadyResponseBody) (err error) {
@@ -568,14 +2111,125 @@ func ValidateReadyzNotReadyResponseBody(body *ReadyzNotReadyResponseBody) (err e
 	return
 }
 
+// ValidateResourceResponseBody runs the validations defined on
+// ResourceResponseBody
+func ValidateResourceResponseBody(body *ResourceResponseBody) (err error) {
+	if body.NormalizedScore != nil {
+		if *body.NormalizedScore < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.normalized_score", *body.NormalizedScore, 0, true))
+		}
+	}
+	if body.NormalizedScore != nil {
+		if *body.NormalizedScore > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.normalized_score", *body.NormalizedScore, 1, false))
+		}
+	}
+	return
+}
+
+// ValidateCountQueryRequestBody runs the validations defined on
+// CountQueryRequestBody
+func ValidateCountQueryRequestBody(body *CountQueryRequestBody) (err error) {
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 1, true))
+		}
+	}
+	return
+}
+
+// ValidateCountItemResponseBody runs the validations defined on
+// CountItemResponseBody
+func ValidateCountItemResponseBody(body *CountItemResponseBody) (err error) {
+	if body.Error != nil {
+		if err2 := ValidateCountItemErrorResponseBody(body.Error); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateCountItemErrorResponseBody runs the validations defined on
+// CountItemErrorResponseBody
+func ValidateCountItemErrorResponseBody(body *CountItemErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Code != nil {
+		if !(*body.Code == "BadRequest" || *body.Code == "NotFound" || *body.Code == "InternalServerError" || *body.Code == "ServiceUnavailable") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.code", *body.Code, []any{"BadRequest", "NotFound", "InternalServerError", "ServiceUnavailable"}))
+		}
+	}
+	return
+}
+
+// ValidateFacetValueResponseBody runs the validations defined on
+// FacetValueResponseBody
+func ValidateFacetValueResponseBody(body *FacetValueResponseBody) (err error) {
+	if body.Value == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("value", "body"))
+	}
+	if body.Count == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("count", "body"))
+	}
+	return
+}
+
+// ValidateResolvedOrganizationResponseBody runs the validations defined on
+// ResolvedOrganizationResponseBody
+func ValidateResolvedOrganizationResponseBody(body *ResolvedOrganizationResponseBody) (err error) {
+	if body.Domain == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("domain", "body"))
+	}
+	return
+}
+
 // ValidateOrganizationSuggestionResponseBody runs the validations defined on
 // OrganizationSuggestionResponseBody
 func ValidateOrganizationSuggestionResponseBody(body *OrganizationSuggestionResponseBody) (err error) {
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
 	if body.Domain == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("domain", "body"))
 	}
+	if body.Kind != nil {
+		if !(*body.Kind == "organization") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", *body.Kind, []any{"organization"}))
+		}
+	}
+	return
+}
+
+// ValidateSuggestionResponseBody runs the validations defined on
+// SuggestionResponseBody
+func ValidateSuggestionResponseBody(body *SuggestionResponseBody) (err error) {
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Kind != nil {
+		if !(*body.Kind == "resource" || *body.Kind == "organization") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", *body.Kind, []any{"resource", "organization"}))
+		}
+	}
+	if body.Resource != nil {
+		if err2 := ValidateResourceResponseBody(body.Resource); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Organization != nil {
+		if err2 := ValidateOrganizationSuggestionResponseBody(body.Organization); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
 	return
 }
@@ -10,7 +10,9 @@ package server
 import (
 	"context"
 	"errors"
+	"io"
 	"net/http"
+	"strconv"
 	"strings"
 	"unicode/utf8"
 
@@ -24,11 +26,33 @@ import (
 func EncodeQueryResourcesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
 		res, _ := v.(*querysvc.QueryResourcesResult)
+		if res.CacheStatus != nil && *res.CacheStatus == "not-modified" {
+			w.Header().Set("Cache-Control", *res.CacheControl)
+			w.Header().Set("Etag", *res.Etag)
+			w.WriteHeader(http.StatusNotModified)
+			return nil
+		}
 		enc := encoder(ctx, w)
-		body := NewQueryResourcesResponseBody(res)
+		body := NewQueryResourcesOKResponseBody(res)
 		if res.CacheControl != nil {
 			w.Header().Set("Cache-Control", *res.CacheControl)
 		}
+		if res.PublicPath != nil {
+			val := res.PublicPath
+			publicPaths := strconv.FormatBool(*val)
+			w.Header().Set("X-Public-Path", publicPaths)
+		}
+		if res.Degraded != nil {
+			val := res.Degraded
+			degradeds := strconv.FormatBool(*val)
+			w.Header().Set("X-Degraded", degradeds)
+		}
+		if res.Link != nil {
+			w.Header().Set("Link", *res.Link)
+		}
+		if res.Etag != nil {
+			w.Header().Set("Etag", *res.Etag)
+		}
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
@@ -39,16 +63,38 @@ func EncodeQueryResourcesResponse(encoder func(context.Context, http.ResponseWri
 func DecodeQueryResourcesRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
-			version     string
-			name        *string
-			parent      *string
-			type_       *string
-			tags        []string
-			tagsAll     []string
-			sort        string
-			pageToken   *string
-			bearerToken string
-			err         error
+			version            string
+			name               *string
+			nameExact          *string
+			parent             *string
+			parents            []string
+			searchScope        *string
+			type_              *string
+			tags               []string
+			tagsMinMatch       *int
+			tagsAll            []string
+			createdBy          *string
+			updatedBy          *string
+			transactionID      *string
+			excludeID          []string
+			includeScore       bool
+			includeChildCounts bool
+			includePermissions bool
+			includeAncestry    bool
+			collapseBy         *string
+			waitForRefresh     bool
+			suggestOnEmpty     bool
+			recencyBoost       bool
+			profile            *string
+			view               *string
+			template           *string
+			templateParams     []string
+			sort               *string
+			pageToken          *string
+			trackTotalHits     *string
+			bearerToken        string
+			ifNoneMatch        *string
+			err                error
 		)
 		qp := r.URL.Query()
 		version = qp.Get("v")
@@ -67,6 +113,15 @@ func DecodeQueryResourcesRequest(mux goahttp.Muxer, decoder func(*http.Request)
 				err = goa.MergeErrors(err, goa.InvalidLengthError("name", *name, utf8.RuneCountInString(*name), 1, true))
 			}
 		}
+		nameExactRaw := qp.Get("name_exact")
+		if nameExactRaw != "" {
+			nameExact = &nameExactRaw
+		}
+		if nameExact != nil {
+			if utf8.RuneCountInString(*nameExact) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("name_exact", *nameExact, utf8.RuneCountInString(*nameExact), 1, true))
+			}
+		}
 		parentRaw := qp.Get("parent")
 		if parentRaw != "" {
 			parent = &parentRaw
@@ -74,33 +129,184 @@ func DecodeQueryResourcesRequest(mux goahttp.Muxer, decoder func(*http.Request)
 		if parent != nil {
 			err = goa.MergeErrors(err, goa.ValidatePattern("parent", *parent, "^[a-zA-Z]+:[a-zA-Z0-9_-]+$"))
 		}
+		parents = qp["parents"]
+		for _, e := range parents {
+			err = goa.MergeErrors(err, goa.ValidatePattern("parents[*]", e, "^[a-zA-Z]+:[a-zA-Z0-9_-]+$"))
+		}
+		searchScopeRaw := qp.Get("search_scope")
+		if searchScopeRaw != "" {
+			searchScope = &searchScopeRaw
+		}
+		if searchScope != nil {
+			if !(*searchScope == "name" || *searchScope == "all") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("search_scope", *searchScope, []any{"name", "all"}))
+			}
+		}
 		type_Raw := qp.Get("type")
 		if type_Raw != "" {
 			type_ = &type_Raw
 		}
 		tags = qp["tags"]
+		{
+			tagsMinMatchRaw := qp.Get("tags_min_match")
+			if tagsMinMatchRaw != "" {
+				v, err2 := strconv.ParseInt(tagsMinMatchRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("tags_min_match", tagsMinMatchRaw, "integer"))
+				}
+				pv := int(v)
+				tagsMinMatch = &pv
+			}
+		}
+		if tagsMinMatch != nil {
+			if *tagsMinMatch < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("tags_min_match", *tagsMinMatch, 1, true))
+			}
+		}
 		tagsAll = qp["tags_all"]
+		createdByRaw := qp.Get("created_by")
+		if createdByRaw != "" {
+			createdBy = &createdByRaw
+		}
+		updatedByRaw := qp.Get("updated_by")
+		if updatedByRaw != "" {
+			updatedBy = &updatedByRaw
+		}
+		transactionIDRaw := qp.Get("transaction_id")
+		if transactionIDRaw != "" {
+			transactionID = &transactionIDRaw
+		}
+		excludeID = qp["exclude_id"]
+		{
+			includeScoreRaw := qp.Get("include_score")
+			if includeScoreRaw != "" {
+				v, err2 := strconv.ParseBool(includeScoreRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("include_score", includeScoreRaw, "boolean"))
+				}
+				includeScore = v
+			}
+		}
+		{
+			includeChildCountsRaw := qp.Get("include_child_counts")
+			if includeChildCountsRaw != "" {
+				v, err2 := strconv.ParseBool(includeChildCountsRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("include_child_counts", includeChildCountsRaw, "boolean"))
+				}
+				includeChildCounts = v
+			}
+		}
+		{
+			includePermissionsRaw := qp.Get("include_permissions")
+			if includePermissionsRaw != "" {
+				v, err2 := strconv.ParseBool(includePermissionsRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("include_permissions", includePermissionsRaw, "boolean"))
+				}
+				includePermissions = v
+			}
+		}
+		{
+			includeAncestryRaw := qp.Get("include_ancestry")
+			if includeAncestryRaw != "" {
+				v, err2 := strconv.ParseBool(includeAncestryRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("include_ancestry", includeAncestryRaw, "boolean"))
+				}
+				includeAncestry = v
+			}
+		}
+		collapseByRaw := qp.Get("collapse_by")
+		if collapseByRaw != "" {
+			collapseBy = &collapseByRaw
+		}
+		if collapseBy != nil {
+			if !(*collapseBy == "object_ref" || *collapseBy == "slug") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("collapse_by", *collapseBy, []any{"object_ref", "slug"}))
+			}
+		}
+		{
+			waitForRefreshRaw := qp.Get("wait_for_refresh")
+			if waitForRefreshRaw != "" {
+				v, err2 := strconv.ParseBool(waitForRefreshRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("wait_for_refresh", waitForRefreshRaw, "boolean"))
+				}
+				waitForRefresh = v
+			}
+		}
+		{
+			suggestOnEmptyRaw := qp.Get("suggest_on_empty")
+			if suggestOnEmptyRaw != "" {
+				v, err2 := strconv.ParseBool(suggestOnEmptyRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("suggest_on_empty", suggestOnEmptyRaw, "boolean"))
+				}
+				suggestOnEmpty = v
+			}
+		}
+		{
+			recencyBoostRaw := qp.Get("recency_boost")
+			if recencyBoostRaw != "" {
+				v, err2 := strconv.ParseBool(recencyBoostRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("recency_boost", recencyBoostRaw, "boolean"))
+				}
+				recencyBoost = v
+			}
+		}
+		profileRaw := qp.Get("profile")
+		if profileRaw != "" {
+			profile = &profileRaw
+		}
+		viewRaw := qp.Get("view")
+		if viewRaw != "" {
+			view = &viewRaw
+		}
+		templateRaw := qp.Get("template")
+		if templateRaw != "" {
+			template = &templateRaw
+		}
+		if template != nil {
+			err = goa.MergeErrors(err, goa.ValidatePattern("template", *template, "^[a-zA-Z0-9_-]+$"))
+		}
+		templateParams = qp["template_params"]
+		for _, e := range templateParams {
+			err = goa.MergeErrors(err, goa.ValidatePattern("template_params[*]", e, "^[a-zA-Z0-9_]+="))
+		}
 		sortRaw := qp.Get("sort")
 		if sortRaw != "" {
-			sort = sortRaw
-		} else {
-			sort = "name_asc"
+			sort = &sortRaw
 		}
-		if !(sort == "name_asc" || sort == "name_desc" || sort == "updated_asc" || sort == "updated_desc") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("sort", sort, []any{"name_asc", "name_desc", "updated_asc", "updated_desc"}))
+		if sort != nil {
+			if !(*sort == "name_asc" || *sort == "name_desc" || *sort == "updated_asc" || *sort == "updated_desc") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("sort", *sort, []any{"name_asc", "name_desc", "updated_asc", "updated_desc"}))
+			}
 		}
 		pageTokenRaw := qp.Get("page_token")
 		if pageTokenRaw != "" {
 			pageToken = &pageTokenRaw
 		}
+		trackTotalHitsRaw := qp.Get("track_total_hits")
+		if trackTotalHitsRaw != "" {
+			trackTotalHits = &trackTotalHitsRaw
+		}
+		if trackTotalHits != nil {
+			err = goa.MergeErrors(err, goa.ValidatePattern("track_total_hits", *trackTotalHits, "^(true|false|[0-9]+)$"))
+		}
 		bearerToken = r.Header.Get("Authorization")
 		if bearerToken == "" {
 			err = goa.MergeErrors(err, goa.MissingFieldError("bearer_token", "header"))
 		}
+		ifNoneMatchRaw := r.Header.Get("If-None-Match")
+		if ifNoneMatchRaw != "" {
+			ifNoneMatch = &ifNoneMatchRaw
+		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewQueryResourcesPayload(version, name, parent, type_, tags, tagsAll, sort, pageToken, bearerToken)
+		payload := NewQueryResourcesPayload(version, name, nameExact, parent, parents, searchScope, type_, tags, tagsMinMatch, tagsAll, createdBy, updatedBy, transactionID, excludeID, includeScore, includeChildCounts, includePermissions, includeAncestry, collapseBy, waitForRefresh, suggestOnEmpty, recencyBoost, profile, view, template, templateParams, sort, pageToken, trackTotalHits, bearerToken, ifNoneMatch)
 		if strings.Contains(payload.BearerToken, " ") {
 			// Remove authorization scheme prefix (e.g. "Bearer")
 			cred := strings.SplitN(payload.BearerToken, " ", 2)[1]
@@ -176,6 +382,16 @@ func EncodeQueryResourcesCountResponse(encoder func(context.Context, http.Respon
 		if res.CacheControl != nil {
 			w.Header().Set("Cache-Control", *res.CacheControl)
 		}
+		if res.PublicPath != nil {
+			val := res.PublicPath
+			publicPaths := strconv.FormatBool(*val)
+			w.Header().Set("X-Public-Path", publicPaths)
+		}
+		if res.Degraded != nil {
+			val := res.Degraded
+			degradeds := strconv.FormatBool(*val)
+			w.Header().Set("X-Degraded", degradeds)
+		}
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
@@ -186,14 +402,15 @@ func EncodeQueryResourcesCountResponse(encoder func(context.Context, http.Respon
 func DecodeQueryResourcesCountRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
-			version     string
-			name        *string
-			parent      *string
-			type_       *string
-			tags        []string
-			tagsAll     []string
-			bearerToken string
-			err         error
+			version          string
+			name             *string
+			parent           *string
+			type_            *string
+			tags             []string
+			tagsAll          []string
+			includeRelations bool
+			bearerToken      string
+			err              error
 		)
 		qp := r.URL.Query()
 		version = qp.Get("v")
@@ -222,6 +439,16 @@ func DecodeQueryResourcesCountRequest(mux goahttp.Muxer, decoder func(*http.Requ
 		}
 		tags = qp["tags"]
 		tagsAll = qp["tags_all"]
+		{
+			includeRelationsRaw := qp.Get("include_relations")
+			if includeRelationsRaw != "" {
+				v, err2 := strconv.ParseBool(includeRelationsRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("include_relations", includeRelationsRaw, "boolean"))
+				}
+				includeRelations = v
+			}
+		}
 		bearerToken = r.Header.Get("Authorization")
 		if bearerToken == "" {
 			err = goa.MergeErrors(err, goa.MissingFieldError("bearer_token", "header"))
@@ -229,7 +456,7 @@ func DecodeQueryResourcesCountRequest(mux goahttp.Muxer, decoder func(*http.Requ
 		if err != nil {
 			return nil, err
 		}
-		payload := NewQueryResourcesCountPayload(version, name, parent, type_, tags, tagsAll, bearerToken)
+		payload := NewQueryResourcesCountPayload(version, name, parent, type_, tags, tagsAll, includeRelations, bearerToken)
 		if strings.Contains(payload.BearerToken, " ") {
 			// Remove authorization scheme prefix (e.g. "Bearer")
 			cred := strings.SplitN(payload.BearerToken, " ", 2)[1]
@@ -295,53 +522,53 @@ func EncodeQueryResourcesCountError(encoder func(context.Context, http.ResponseW
 	}
 }
 
-// EncodeQueryOrgsResponse returns an encoder for responses returned by the
-// query-svc query-orgs endpoint.
-func EncodeQueryOrgsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeQueryResourcesCountBatchResponse returns an encoder for responses
+// returned by the query-svc query-resources-count-batch endpoint.
+func EncodeQueryResourcesCountBatchResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*querysvc.Organization)
+		res, _ := v.(*querysvc.QueryResourcesCountBatchResult)
 		enc := encoder(ctx, w)
-		body := NewQueryOrgsResponseBody(res)
+		body := NewQueryResourcesCountBatchResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeQueryOrgsRequest returns a decoder for requests sent to the query-svc
-// query-orgs endpoint.
-func DecodeQueryOrgsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+// DecodeQueryResourcesCountBatchRequest returns a decoder for requests sent to
+// the query-svc query-resources-count-batch endpoint.
+func DecodeQueryResourcesCountBatchRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
+		var (
+			body QueryResourcesCountBatchRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateQueryResourcesCountBatchRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
 		var (
 			version     string
-			name        *string
-			domain      *string
 			bearerToken string
-			err         error
 		)
-		qp := r.URL.Query()
-		version = qp.Get("v")
+		version = r.URL.Query().Get("v")
 		if version == "" {
 			err = goa.MergeErrors(err, goa.MissingFieldError("version", "query string"))
 		}
 		if !(version == "1") {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
 		}
-		nameRaw := qp.Get("name")
-		if nameRaw != "" {
-			name = &nameRaw
-		}
-		if name != nil {
-			if utf8.RuneCountInString(*name) < 1 {
-				err = goa.MergeErrors(err, goa.InvalidLengthError("name", *name, utf8.RuneCountInString(*name), 1, true))
-			}
-		}
-		domainRaw := qp.Get("domain")
-		if domainRaw != "" {
-			domain = &domainRaw
-		}
-		if domain != nil {
-			err = goa.MergeErrors(err, goa.ValidatePattern("domain", *domain, "^[a-zA-Z0-9][a-zA-Z0-9-_.]*[a-zA-Z0-9]*\\.[a-zA-Z]{2,}$"))
-		}
 		bearerToken = r.Header.Get("Authorization")
 		if bearerToken == "" {
 			err = goa.MergeErrors(err, goa.MissingFieldError("bearer_token", "header"))
@@ -349,7 +576,7 @@ func DecodeQueryOrgsRequest(mux goahttp.Muxer, decoder func(*http.Request) goaht
 		if err != nil {
 			return nil, err
 		}
-		payload := NewQueryOrgsPayload(version, name, domain, bearerToken)
+		payload := NewQueryResourcesCountBatchPayload(&body, version, bearerToken)
 		if strings.Contains(payload.BearerToken, " ") {
 			// Remove authorization scheme prefix (e.g. "Bearer")
 			cred := strings.SplitN(payload.BearerToken, " ", 2)[1]
@@ -360,9 +587,9 @@ func DecodeQueryOrgsRequest(mux goahttp.Muxer, decoder func(*http.Request) goaht
 	}
 }
 
-// EncodeQueryOrgsError returns an encoder for errors returned by the
-// query-orgs query-svc endpoint.
-func EncodeQueryOrgsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeQueryResourcesCountBatchError returns an encoder for errors returned
+// by the query-resources-count-batch query-svc endpoint.
+func EncodeQueryResourcesCountBatchError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -378,7 +605,7 @@ func EncodeQueryOrgsError(encoder func(context.Context, http.ResponseWriter) goa
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewQueryOrgsBadRequestResponseBody(res)
+				body = NewQueryResourcesCountBatchBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -391,24 +618,11 @@ func EncodeQueryOrgsError(encoder func(context.Context, http.ResponseWriter) goa
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewQueryOrgsInternalServerErrorResponseBody(res)
+				body = NewQueryResourcesCountBatchInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
 			return enc.Encode(body)
-		case "NotFound":
-			var res *querysvc.NotFoundError
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			var body any
-			if formatter != nil {
-				body = formatter(ctx, res)
-			} else {
-				body = NewQueryOrgsNotFoundResponseBody(res)
-			}
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusNotFound)
-			return enc.Encode(body)
 		case "ServiceUnavailable":
 			var res *querysvc.ServiceUnavailableError
 			errors.As(v, &res)
@@ -417,7 +631,7 @@ func EncodeQueryOrgsError(encoder func(context.Context, http.ResponseWriter) goa
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewQueryOrgsServiceUnavailableResponseBody(res)
+				body = NewQueryResourcesCountBatchServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -428,27 +642,41 @@ func EncodeQueryOrgsError(encoder func(context.Context, http.ResponseWriter) goa
 	}
 }
 
-// EncodeSuggestOrgsResponse returns an encoder for responses returned by the
-// query-svc suggest-orgs endpoint.
-func EncodeSuggestOrgsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeQueryResourcesFacetsResponse returns an encoder for responses returned
+// by the query-svc query-resources-facets endpoint.
+func EncodeQueryResourcesFacetsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*querysvc.SuggestOrgsResult)
+		res, _ := v.(*querysvc.QueryResourcesFacetsResult)
 		enc := encoder(ctx, w)
-		body := NewSuggestOrgsResponseBody(res)
+		body := NewQueryResourcesFacetsResponseBody(res)
+		if res.CacheControl != nil {
+			w.Header().Set("Cache-Control", *res.CacheControl)
+		}
+		if res.PublicPath != nil {
+			val := res.PublicPath
+			publicPaths := strconv.FormatBool(*val)
+			w.Header().Set("X-Public-Path", publicPaths)
+		}
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeSuggestOrgsRequest returns a decoder for requests sent to the
-// query-svc suggest-orgs endpoint.
-func DecodeSuggestOrgsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+// DecodeQueryResourcesFacetsRequest returns a decoder for requests sent to the
+// query-svc query-resources-facets endpoint.
+func DecodeQueryResourcesFacetsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
-			version     string
-			query       string
-			bearerToken string
-			err         error
+			version      string
+			facet        []string
+			name         *string
+			parent       *string
+			type_        *string
+			tags         []string
+			tagsAll      []string
+			includeTotal bool
+			bearerToken  string
+			err          error
 		)
 		qp := r.URL.Query()
 		version = qp.Get("v")
@@ -458,12 +686,46 @@ func DecodeSuggestOrgsRequest(mux goahttp.Muxer, decoder func(*http.Request) goa
 		if !(version == "1") {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
 		}
-		query = qp.Get("query")
-		if query == "" {
-			err = goa.MergeErrors(err, goa.MissingFieldError("query", "query string"))
+		facet = qp["facet"]
+		if facet == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("facet", "query string"))
 		}
-		if utf8.RuneCountInString(query) < 1 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("query", query, utf8.RuneCountInString(query), 1, true))
+		if len(facet) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("facet", facet, len(facet), 1, true))
+		}
+		for _, e := range facet {
+			if !(e == "type" || e == "tags" || e == "status") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("facet[*]", e, []any{"type", "tags", "status"}))
+			}
+		}
+		nameRaw := qp.Get("name")
+		if nameRaw != "" {
+			name = &nameRaw
+		}
+		if name != nil {
+			if utf8.RuneCountInString(*name) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("name", *name, utf8.RuneCountInString(*name), 1, true))
+			}
+		}
+		parentRaw := qp.Get("parent")
+		if parentRaw != "" {
+			parent = &parentRaw
+		}
+		type_Raw := qp.Get("type")
+		if type_Raw != "" {
+			type_ = &type_Raw
+		}
+		tags = qp["tags"]
+		tagsAll = qp["tags_all"]
+		{
+			includeTotalRaw := qp.Get("include_total")
+			if includeTotalRaw != "" {
+				v, err2 := strconv.ParseBool(includeTotalRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("include_total", includeTotalRaw, "boolean"))
+				}
+				includeTotal = v
+			}
 		}
 		bearerToken = r.Header.Get("Authorization")
 		if bearerToken == "" {
@@ -472,7 +734,7 @@ func DecodeSuggestOrgsRequest(mux goahttp.Muxer, decoder func(*http.Request) goa
 		if err != nil {
 			return nil, err
 		}
-		payload := NewSuggestOrgsPayload(version, query, bearerToken)
+		payload := NewQueryResourcesFacetsPayload(version, facet, name, parent, type_, tags, tagsAll, includeTotal, bearerToken)
 		if strings.Contains(payload.BearerToken, " ") {
 			// Remove authorization scheme prefix (e.g. "Bearer")
 			cred := strings.SplitN(payload.BearerToken, " ", 2)[1]
@@ -483,9 +745,9 @@ func DecodeSuggestOrgsRequest(mux goahttp.Muxer, decoder func(*http.Request) goa
 	}
 }
 
-// EncodeSuggestOrgsError returns an encoder for errors returned by the
-// suggest-orgs query-svc endpoint.
-func EncodeSuggestOrgsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeQueryResourcesFacetsError returns an encoder for errors returned by
+// the query-resources-facets query-svc endpoint.
+func EncodeQueryResourcesFacetsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -501,7 +763,7 @@ func EncodeSuggestOrgsError(encoder func(context.Context, http.ResponseWriter) g
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewSuggestOrgsBadRequestResponseBody(res)
+				body = NewQueryResourcesFacetsBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -514,7 +776,7 @@ func EncodeSuggestOrgsError(encoder func(context.Context, http.ResponseWriter) g
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewSuggestOrgsInternalServerErrorResponseBody(res)
+				body = NewQueryResourcesFacetsInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -527,7 +789,7 @@ func EncodeSuggestOrgsError(encoder func(context.Context, http.ResponseWriter) g
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewSuggestOrgsServiceUnavailableResponseBody(res)
+				body = NewQueryResourcesFacetsServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -538,22 +800,94 @@ func EncodeSuggestOrgsError(encoder func(context.Context, http.ResponseWriter) g
 	}
 }
 
-// EncodeReadyzResponse returns an encoder for responses returned by the
-// query-svc readyz endpoint.
-func EncodeReadyzResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeExplainResourceResponse returns an encoder for responses returned by
+// the query-svc explain-resource endpoint.
+func EncodeExplainResourceResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.([]byte)
-		ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "text/plain")
+		res, _ := v.(*querysvc.ResourceExplanation)
 		enc := encoder(ctx, w)
-		body := res
+		body := NewExplainResourceResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// EncodeReadyzError returns an encoder for errors returned by the readyz
-// query-svc endpoint.
-func EncodeReadyzError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// DecodeExplainResourceRequest returns a decoder for requests sent to the
+// query-svc explain-resource endpoint.
+func DecodeExplainResourceRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			version     string
+			objectRef   string
+			name        *string
+			parent      *string
+			type_       *string
+			tags        []string
+			tagsAll     []string
+			createdBy   *string
+			bearerToken string
+			err         error
+		)
+		qp := r.URL.Query()
+		version = qp.Get("v")
+		if version == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("version", "query string"))
+		}
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		objectRef = qp.Get("object_ref")
+		if objectRef == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("object_ref", "query string"))
+		}
+		err = goa.MergeErrors(err, goa.ValidatePattern("object_ref", objectRef, "^[a-zA-Z]+:[a-zA-Z0-9_-]+$"))
+		nameRaw := qp.Get("name")
+		if nameRaw != "" {
+			name = &nameRaw
+		}
+		if name != nil {
+			if utf8.RuneCountInString(*name) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("name", *name, utf8.RuneCountInString(*name), 1, true))
+			}
+		}
+		parentRaw := qp.Get("parent")
+		if parentRaw != "" {
+			parent = &parentRaw
+		}
+		if parent != nil {
+			err = goa.MergeErrors(err, goa.ValidatePattern("parent", *parent, "^[a-zA-Z]+:[a-zA-Z0-9_-]+$"))
+		}
+		type_Raw := qp.Get("type")
+		if type_Raw != "" {
+			type_ = &type_Raw
+		}
+		tags = qp["tags"]
+		tagsAll = qp["tags_all"]
+		createdByRaw := qp.Get("created_by")
+		if createdByRaw != "" {
+			createdBy = &createdByRaw
+		}
+		bearerToken = r.Header.Get("Authorization")
+		if bearerToken == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("bearer_token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewExplainResourcePayload(version, objectRef, name, parent, type_, tags, tagsAll, createdBy, bearerToken)
+		if strings.Contains(payload.BearerToken, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.BearerToken, " ", 2)[1]
+			payload.BearerToken = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeExplainResourceError returns an encoder for errors returned by the
+// explain-resource query-svc endpoint.
+func EncodeExplainResourceError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -561,23 +895,1238 @@ func EncodeReadyzError(encoder func(context.Context, http.ResponseWriter) goahtt
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
-		case "NotReady":
-			var res *goa.ServiceError
+		case "BadRequest":
+			var res *querysvc.BadRequestError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewReadyzNotReadyResponseBody(res)
+				body = NewExplainResourceBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusServiceUnavailable)
+			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
-		default:
-			return encodeError(ctx, w, v)
-		}
-	}
+		case "InternalServerError":
+			var res *querysvc.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewExplainResourceInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *querysvc.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewExplainResourceNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *querysvc.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewExplainResourceServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeCheckTuplesResponse returns an encoder for responses returned by the
+// query-svc check-tuples endpoint.
+func EncodeCheckTuplesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*querysvc.CheckTuplesResult)
+		enc := encoder(ctx, w)
+		body := NewCheckTuplesResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCheckTuplesRequest returns a decoder for requests sent to the
+// query-svc check-tuples endpoint.
+func DecodeCheckTuplesRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body CheckTuplesRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateCheckTuplesRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			version     string
+			bearerToken string
+		)
+		version = r.URL.Query().Get("v")
+		if version == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("version", "query string"))
+		}
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		bearerToken = r.Header.Get("Authorization")
+		if bearerToken == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("bearer_token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCheckTuplesPayload(&body, version, bearerToken)
+		if strings.Contains(payload.BearerToken, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.BearerToken, " ", 2)[1]
+			payload.BearerToken = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeCheckTuplesError returns an encoder for errors returned by the
+// check-tuples query-svc endpoint.
+func EncodeCheckTuplesError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *querysvc.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCheckTuplesBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *querysvc.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCheckTuplesInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *querysvc.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCheckTuplesNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *querysvc.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCheckTuplesServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeQueryOrgsResponse returns an encoder for responses returned by the
+// query-svc query-orgs endpoint.
+func EncodeQueryOrgsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*querysvc.Organization)
+		enc := encoder(ctx, w)
+		body := NewQueryOrgsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeQueryOrgsRequest returns a decoder for requests sent to the query-svc
+// query-orgs endpoint.
+func DecodeQueryOrgsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			version     string
+			name        *string
+			domain      *string
+			domains     []string
+			matchAll    bool
+			bearerToken string
+			err         error
+		)
+		qp := r.URL.Query()
+		version = qp.Get("v")
+		if version == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("version", "query string"))
+		}
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		nameRaw := qp.Get("name")
+		if nameRaw != "" {
+			name = &nameRaw
+		}
+		if name != nil {
+			if utf8.RuneCountInString(*name) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("name", *name, utf8.RuneCountInString(*name), 1, true))
+			}
+		}
+		domainRaw := qp.Get("domain")
+		if domainRaw != "" {
+			domain = &domainRaw
+		}
+		if domain != nil {
+			err = goa.MergeErrors(err, goa.ValidatePattern("domain", *domain, "^[a-zA-Z0-9][a-zA-Z0-9-_.]*[a-zA-Z0-9]*\\.[a-zA-Z]{2,}$"))
+		}
+		domains = qp["domains"]
+		if len(domains) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("domains", domains, len(domains), 100, false))
+		}
+		{
+			matchAllRaw := qp.Get("match_all")
+			if matchAllRaw != "" {
+				v, err2 := strconv.ParseBool(matchAllRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("match_all", matchAllRaw, "boolean"))
+				}
+				matchAll = v
+			}
+		}
+		bearerToken = r.Header.Get("Authorization")
+		if bearerToken == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("bearer_token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewQueryOrgsPayload(version, name, domain, domains, matchAll, bearerToken)
+		if strings.Contains(payload.BearerToken, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.BearerToken, " ", 2)[1]
+			payload.BearerToken = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeQueryOrgsError returns an encoder for errors returned by the
+// query-orgs query-svc endpoint.
+func EncodeQueryOrgsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *querysvc.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewQueryOrgsBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *querysvc.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewQueryOrgsInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *querysvc.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewQueryOrgsNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *querysvc.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewQueryOrgsServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeQueryOrgsListResponse returns an encoder for responses returned by the
+// query-svc query-orgs-list endpoint.
+func EncodeQueryOrgsListResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*querysvc.QueryOrgsListResult)
+		enc := encoder(ctx, w)
+		body := NewQueryOrgsListResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeQueryOrgsListRequest returns a decoder for requests sent to the
+// query-svc query-orgs-list endpoint.
+func DecodeQueryOrgsListRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			version     string
+			name        *string
+			domain      *string
+			matchAll    bool
+			pageToken   *string
+			bearerToken string
+			err         error
+		)
+		qp := r.URL.Query()
+		version = qp.Get("v")
+		if version == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("version", "query string"))
+		}
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		nameRaw := qp.Get("name")
+		if nameRaw != "" {
+			name = &nameRaw
+		}
+		if name != nil {
+			if utf8.RuneCountInString(*name) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("name", *name, utf8.RuneCountInString(*name), 1, true))
+			}
+		}
+		domainRaw := qp.Get("domain")
+		if domainRaw != "" {
+			domain = &domainRaw
+		}
+		if domain != nil {
+			err = goa.MergeErrors(err, goa.ValidatePattern("domain", *domain, "^[a-zA-Z0-9][a-zA-Z0-9-_.]*[a-zA-Z0-9]*\\.[a-zA-Z]{2,}$"))
+		}
+		{
+			matchAllRaw := qp.Get("match_all")
+			if matchAllRaw != "" {
+				v, err2 := strconv.ParseBool(matchAllRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("match_all", matchAllRaw, "boolean"))
+				}
+				matchAll = v
+			}
+		}
+		pageTokenRaw := qp.Get("page_token")
+		if pageTokenRaw != "" {
+			pageToken = &pageTokenRaw
+		}
+		bearerToken = r.Header.Get("Authorization")
+		if bearerToken == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("bearer_token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewQueryOrgsListPayload(version, name, domain, matchAll, pageToken, bearerToken)
+		if strings.Contains(payload.BearerToken, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.BearerToken, " ", 2)[1]
+			payload.BearerToken = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeQueryOrgsListError returns an encoder for errors returned by the
+// query-orgs-list query-svc endpoint.
+func EncodeQueryOrgsListError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *querysvc.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewQueryOrgsListBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *querysvc.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewQueryOrgsListInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *querysvc.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewQueryOrgsListServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeCountOrgsResponse returns an encoder for responses returned by the
+// query-svc count-orgs endpoint.
+func EncodeCountOrgsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*querysvc.CountOrgsResult)
+		enc := encoder(ctx, w)
+		body := NewCountOrgsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCountOrgsRequest returns a decoder for requests sent to the query-svc
+// count-orgs endpoint.
+func DecodeCountOrgsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			version     string
+			name        *string
+			domain      *string
+			matchAll    bool
+			bearerToken string
+			err         error
+		)
+		qp := r.URL.Query()
+		version = qp.Get("v")
+		if version == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("version", "query string"))
+		}
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		nameRaw := qp.Get("name")
+		if nameRaw != "" {
+			name = &nameRaw
+		}
+		if name != nil {
+			if utf8.RuneCountInString(*name) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("name", *name, utf8.RuneCountInString(*name), 1, true))
+			}
+		}
+		domainRaw := qp.Get("domain")
+		if domainRaw != "" {
+			domain = &domainRaw
+		}
+		if domain != nil {
+			err = goa.MergeErrors(err, goa.ValidatePattern("domain", *domain, "^[a-zA-Z0-9][a-zA-Z0-9-_.]*[a-zA-Z0-9]*\\.[a-zA-Z]{2,}$"))
+		}
+		{
+			matchAllRaw := qp.Get("match_all")
+			if matchAllRaw != "" {
+				v, err2 := strconv.ParseBool(matchAllRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("match_all", matchAllRaw, "boolean"))
+				}
+				matchAll = v
+			}
+		}
+		bearerToken = r.Header.Get("Authorization")
+		if bearerToken == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("bearer_token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCountOrgsPayload(version, name, domain, matchAll, bearerToken)
+		if strings.Contains(payload.BearerToken, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.BearerToken, " ", 2)[1]
+			payload.BearerToken = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeCountOrgsError returns an encoder for errors returned by the
+// count-orgs query-svc endpoint.
+func EncodeCountOrgsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *querysvc.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCountOrgsBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *querysvc.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCountOrgsInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *querysvc.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCountOrgsServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeResolveOrgsResponse returns an encoder for responses returned by the
+// query-svc resolve-orgs endpoint.
+func EncodeResolveOrgsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*querysvc.ResolveOrgsResult)
+		enc := encoder(ctx, w)
+		body := NewResolveOrgsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeResolveOrgsRequest returns a decoder for requests sent to the
+// query-svc resolve-orgs endpoint.
+func DecodeResolveOrgsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body ResolveOrgsRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateResolveOrgsRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			version     string
+			bearerToken string
+		)
+		version = r.URL.Query().Get("v")
+		if version == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("version", "query string"))
+		}
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		bearerToken = r.Header.Get("Authorization")
+		if bearerToken == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("bearer_token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewResolveOrgsPayload(&body, version, bearerToken)
+		if strings.Contains(payload.BearerToken, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.BearerToken, " ", 2)[1]
+			payload.BearerToken = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeResolveOrgsError returns an encoder for errors returned by the
+// resolve-orgs query-svc endpoint.
+func EncodeResolveOrgsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *querysvc.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewResolveOrgsBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *querysvc.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewResolveOrgsInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *querysvc.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewResolveOrgsServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeSuggestOrgsResponse returns an encoder for responses returned by the
+// query-svc suggest-orgs endpoint.
+func EncodeSuggestOrgsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*querysvc.SuggestOrgsResult)
+		enc := encoder(ctx, w)
+		body := NewSuggestOrgsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeSuggestOrgsRequest returns a decoder for requests sent to the
+// query-svc suggest-orgs endpoint.
+func DecodeSuggestOrgsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			version     string
+			query       string
+			bearerToken string
+			err         error
+		)
+		qp := r.URL.Query()
+		version = qp.Get("v")
+		if version == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("version", "query string"))
+		}
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		query = qp.Get("query")
+		if query == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("query", "query string"))
+		}
+		if utf8.RuneCountInString(query) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("query", query, utf8.RuneCountInString(query), 1, true))
+		}
+		bearerToken = r.Header.Get("Authorization")
+		if bearerToken == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("bearer_token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewSuggestOrgsPayload(version, query, bearerToken)
+		if strings.Contains(payload.BearerToken, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.BearerToken, " ", 2)[1]
+			payload.BearerToken = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeSuggestOrgsError returns an encoder for errors returned by the
+// suggest-orgs query-svc endpoint.
+func EncodeSuggestOrgsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *querysvc.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSuggestOrgsBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *querysvc.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSuggestOrgsInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *querysvc.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSuggestOrgsServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeUnifiedSearchResponse returns an encoder for responses returned by the
+// query-svc unified-search endpoint.
+func EncodeUnifiedSearchResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*querysvc.UnifiedSearchResult)
+		enc := encoder(ctx, w)
+		body := NewUnifiedSearchResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeUnifiedSearchRequest returns a decoder for requests sent to the
+// query-svc unified-search endpoint.
+func DecodeUnifiedSearchRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			version     string
+			query       string
+			bearerToken string
+			err         error
+		)
+		qp := r.URL.Query()
+		version = qp.Get("v")
+		if version == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("version", "query string"))
+		}
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		query = qp.Get("query")
+		if query == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("query", "query string"))
+		}
+		if utf8.RuneCountInString(query) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("query", query, utf8.RuneCountInString(query), 1, true))
+		}
+		bearerToken = r.Header.Get("Authorization")
+		if bearerToken == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("bearer_token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewUnifiedSearchPayload(version, query, bearerToken)
+		if strings.Contains(payload.BearerToken, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.BearerToken, " ", 2)[1]
+			payload.BearerToken = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeUnifiedSearchError returns an encoder for errors returned by the
+// unified-search query-svc endpoint.
+func EncodeUnifiedSearchError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *querysvc.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUnifiedSearchBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *querysvc.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUnifiedSearchInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *querysvc.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUnifiedSearchServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeSuggestResponse returns an encoder for responses returned by the
+// query-svc suggest endpoint.
+func EncodeSuggestResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*querysvc.SuggestResult)
+		enc := encoder(ctx, w)
+		body := NewSuggestResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeSuggestRequest returns a decoder for requests sent to the query-svc
+// suggest endpoint.
+func DecodeSuggestRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			version     string
+			query       string
+			kind        []string
+			bearerToken string
+			err         error
+		)
+		qp := r.URL.Query()
+		version = qp.Get("v")
+		if version == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("version", "query string"))
+		}
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		query = qp.Get("query")
+		if query == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("query", "query string"))
+		}
+		if utf8.RuneCountInString(query) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("query", query, utf8.RuneCountInString(query), 1, true))
+		}
+		kind = qp["kind"]
+		for _, e := range kind {
+			if !(e == "resource" || e == "organization") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind[*]", e, []any{"resource", "organization"}))
+			}
+		}
+		bearerToken = r.Header.Get("Authorization")
+		if bearerToken == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("bearer_token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewSuggestPayload(version, query, kind, bearerToken)
+		if strings.Contains(payload.BearerToken, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.BearerToken, " ", 2)[1]
+			payload.BearerToken = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeSuggestError returns an encoder for errors returned by the suggest
+// query-svc endpoint.
+func EncodeSuggestError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *querysvc.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSuggestBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *querysvc.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSuggestInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *querysvc.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSuggestServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeFlushCacheResponse returns an encoder for responses returned by the
+// query-svc flush-cache endpoint.
+func EncodeFlushCacheResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*querysvc.FlushCacheResult)
+		enc := encoder(ctx, w)
+		body := NewFlushCacheResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeFlushCacheRequest returns a decoder for requests sent to the query-svc
+// flush-cache endpoint.
+func DecodeFlushCacheRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			version     string
+			warm        bool
+			bearerToken string
+			err         error
+		)
+		qp := r.URL.Query()
+		version = qp.Get("v")
+		if version == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("version", "query string"))
+		}
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		{
+			warmRaw := qp.Get("warm")
+			if warmRaw == "" {
+				warm = true
+			} else {
+				v, err2 := strconv.ParseBool(warmRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("warm", warmRaw, "boolean"))
+				}
+				warm = v
+			}
+		}
+		bearerToken = r.Header.Get("Authorization")
+		if bearerToken == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("bearer_token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewFlushCachePayload(version, warm, bearerToken)
+		if strings.Contains(payload.BearerToken, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.BearerToken, " ", 2)[1]
+			payload.BearerToken = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeFlushCacheError returns an encoder for errors returned by the
+// flush-cache query-svc endpoint.
+func EncodeFlushCacheError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *querysvc.BadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewFlushCacheBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *querysvc.InternalServerError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewFlushCacheInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *querysvc.NotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewFlushCacheNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *querysvc.ServiceUnavailableError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewFlushCacheServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeReadyzResponse returns an encoder for responses returned by the
+// query-svc readyz endpoint.
+func EncodeReadyzResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]byte)
+		ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "text/plain")
+		enc := encoder(ctx, w)
+		body := res
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// EncodeReadyzError returns an encoder for errors returned by the readyz
+// query-svc endpoint.
+func EncodeReadyzError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "NotReady":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewReadyzNotReadyResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
 }
 
 // EncodeLivezResponse returns an encoder for responses returned by the
@@ -597,9 +2146,143 @@ func EncodeLivezResponse(encoder func(context.Context, http.ResponseWriter) goah
 // *ResourceResponseBody from a value of type *querysvc.Resource.
 func marshalQuerysvcResourceToResourceResponseBody(v *querysvc.Resource) *ResourceResponseBody {
 	res := &ResourceResponseBody{
-		Type: v.Type,
-		ID:   v.ID,
-		Data: v.Data,
+		Type:            v.Type,
+		ID:              v.ID,
+		DocumentID:      v.DocumentID,
+		Index:           v.Index,
+		CreatedBy:       v.CreatedBy,
+		UpdatedBy:       v.UpdatedBy,
+		Data:            v.Data,
+		Score:           v.Score,
+		NormalizedScore: v.NormalizedScore,
+	}
+	if v.MatchedTags != nil {
+		res.MatchedTags = make([]string, len(v.MatchedTags))
+		for i, val := range v.MatchedTags {
+			res.MatchedTags[i] = val
+		}
+	}
+	if v.ChildCounts != nil {
+		res.ChildCounts = make(map[string]uint64, len(v.ChildCounts))
+		for key, val := range v.ChildCounts {
+			tk := key
+			tv := val
+			res.ChildCounts[tk] = tv
+		}
+	}
+	if v.Permissions != nil {
+		res.Permissions = make(map[string]bool, len(v.Permissions))
+		for key, val := range v.Permissions {
+			tk := key
+			tv := val
+			res.Permissions[tk] = tv
+		}
+	}
+	if v.Ancestry != nil {
+		res.Ancestry = make([]string, len(v.Ancestry))
+		for i, val := range v.Ancestry {
+			res.Ancestry[i] = val
+		}
+	}
+
+	return res
+}
+
+// unmarshalCountQueryRequestBodyToQuerysvcCountQuery builds a value of type
+// *querysvc.CountQuery from a value of type *CountQueryRequestBody.
+func unmarshalCountQueryRequestBodyToQuerysvcCountQuery(v *CountQueryRequestBody) *querysvc.CountQuery {
+	res := &querysvc.CountQuery{
+		Name:   v.Name,
+		Parent: v.Parent,
+		Type:   v.Type,
+	}
+	if v.Tags != nil {
+		res.Tags = make([]string, len(v.Tags))
+		for i, val := range v.Tags {
+			res.Tags[i] = val
+		}
+	}
+	if v.TagsAll != nil {
+		res.TagsAll = make([]string, len(v.TagsAll))
+		for i, val := range v.TagsAll {
+			res.TagsAll[i] = val
+		}
+	}
+
+	return res
+}
+
+// marshalQuerysvcCountItemToCountItemResponseBody builds a value of type
+// *CountItemResponseBody from a value of type *querysvc.CountItem.
+func marshalQuerysvcCountItemToCountItemResponseBody(v *querysvc.CountItem) *CountItemResponseBody {
+	res := &CountItemResponseBody{
+		Count:   v.Count,
+		HasMore: v.HasMore,
+	}
+	if v.Error != nil {
+		res.Error = marshalQuerysvcCountItemErrorToCountItemErrorResponseBody(v.Error)
+	}
+
+	return res
+}
+
+// marshalQuerysvcCountItemErrorToCountItemErrorResponseBody builds a value of
+// type *CountItemErrorResponseBody from a value of type
+// *querysvc.CountItemError.
+func marshalQuerysvcCountItemErrorToCountItemErrorResponseBody(v *querysvc.CountItemError) *CountItemErrorResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &CountItemErrorResponseBody{
+		Code:    v.Code,
+		Message: v.Message,
+	}
+
+	return res
+}
+
+// marshalQuerysvcFacetValueToFacetValueResponseBody builds a value of type
+// *FacetValueResponseBody from a value of type *querysvc.FacetValue.
+func marshalQuerysvcFacetValueToFacetValueResponseBody(v *querysvc.FacetValue) *FacetValueResponseBody {
+	res := &FacetValueResponseBody{
+		Value: v.Value,
+		Count: v.Count,
+	}
+
+	return res
+}
+
+// marshalQuerysvcOrganizationToOrganizationResponseBody builds a value of type
+// *OrganizationResponseBody from a value of type *querysvc.Organization.
+func marshalQuerysvcOrganizationToOrganizationResponseBody(v *querysvc.Organization) *OrganizationResponseBody {
+	res := &OrganizationResponseBody{
+		Name:      v.Name,
+		Domain:    v.Domain,
+		Industry:  v.Industry,
+		Sector:    v.Sector,
+		Employees: v.Employees,
+	}
+	if v.Metadata != nil {
+		res.Metadata = make(map[string]any, len(v.Metadata))
+		for key, val := range v.Metadata {
+			tk := key
+			tv := val
+			res.Metadata[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// marshalQuerysvcResolvedOrganizationToResolvedOrganizationResponseBody builds
+// a value of type *ResolvedOrganizationResponseBody from a value of type
+// *querysvc.ResolvedOrganization.
+func marshalQuerysvcResolvedOrganizationToResolvedOrganizationResponseBody(v *querysvc.ResolvedOrganization) *ResolvedOrganizationResponseBody {
+	res := &ResolvedOrganizationResponseBody{
+		Domain: v.Domain,
+	}
+	if v.Organization != nil {
+		res.Organization = marshalQuerysvcOrganizationToOrganizationResponseBody(v.Organization)
 	}
 
 	return res
@@ -610,6 +2293,7 @@ func marshalQuerysvcResourceToResourceResponseBody(v *querysvc.Resource) *Resour
 // type *querysvc.OrganizationSuggestion.
 func marshalQuerysvcOrganizationSuggestionToOrganizationSuggestionResponseBody(v *querysvc.OrganizationSuggestion) *OrganizationSuggestionResponseBody {
 	res := &OrganizationSuggestionResponseBody{
+		Kind:   v.Kind,
 		Name:   v.Name,
 		Domain: v.Domain,
 		Logo:   v.Logo,
@@ -617,3 +2301,20 @@ func marshalQuerysvcOrganizationSuggestionToOrganizationSuggestionResponseBody(v
 
 	return res
 }
+
+// marshalQuerysvcSuggestionToSuggestionResponseBody builds a value of type
+// *SuggestionResponseBody from a value of type *querysvc.Suggestion.
+func marshalQuerysvcSuggestionToSuggestionResponseBody(v *querysvc.Suggestion) *SuggestionResponseBody {
+	res := &SuggestionResponseBody{
+		Kind: v.Kind,
+		Name: v.Name,
+	}
+	if v.Resource != nil {
+		res.Resource = marshalQuerysvcResourceToResourceResponseBody(v.Resource)
+	}
+	if v.Organization != nil {
+		res.Organization = marshalQuerysvcOrganizationSuggestionToOrganizationSuggestionResponseBody(v.Organization)
+	}
+
+	return res
+}
@@ -19,17 +19,27 @@ import (
 
 // Server lists the query-svc service endpoint HTTP handlers.
 type Server struct {
-	Mounts              []*MountPoint
-	QueryResources      http.Handler
-	QueryResourcesCount http.Handler
-	QueryOrgs           http.Handler
-	SuggestOrgs         http.Handler
-	Readyz              http.Handler
-	Livez               http.Handler
-	GenHTTPOpenapiJSON  http.Handler
-	GenHTTPOpenapiYaml  http.Handler
-	GenHTTPOpenapi3JSON http.Handler
-	GenHTTPOpenapi3Yaml http.Handler
+	Mounts                   []*MountPoint
+	QueryResources           http.Handler
+	QueryResourcesCount      http.Handler
+	QueryResourcesCountBatch http.Handler
+	QueryResourcesFacets     http.Handler
+	ExplainResource          http.Handler
+	CheckTuples              http.Handler
+	QueryOrgs                http.Handler
+	QueryOrgsList            http.Handler
+	CountOrgs                http.Handler
+	ResolveOrgs              http.Handler
+	SuggestOrgs              http.Handler
+	UnifiedSearch            http.Handler
+	Suggest                  http.Handler
+	FlushCache               http.Handler
+	Readyz                   http.Handler
+	Livez                    http.Handler
+	GenHTTPOpenapiJSON       http.Handler
+	GenHTTPOpenapiYaml       http.Handler
+	GenHTTPOpenapi3JSON      http.Handler
+	GenHTTPOpenapi3Yaml      http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -81,8 +91,18 @@ func New(
 		Mounts: []*MountPoint{
 			{"QueryResources", "GET", "/query/resources"},
 			{"QueryResourcesCount", "GET", "/query/resources/count"},
+			{"QueryResourcesCountBatch", "POST", "/query/resources/count/batch"},
+			{"QueryResourcesFacets", "GET", "/query/resources/facets"},
+			{"ExplainResource", "GET", "/query/resources/explain"},
+			{"CheckTuples", "POST", "/query/access/check"},
 			{"QueryOrgs", "GET", "/query/orgs"},
+			{"QueryOrgsList", "GET", "/query/orgs/list"},
+			{"CountOrgs", "GET", "/query/orgs/count"},
+			{"ResolveOrgs", "POST", "/query/orgs/resolve"},
 			{"SuggestOrgs", "GET", "/query/orgs/suggest"},
+			{"UnifiedSearch", "GET", "/query/search"},
+			{"Suggest", "GET", "/query/suggest"},
+			{"FlushCache", "POST", "/cache/flush"},
 			{"Readyz", "GET", "/readyz"},
 			{"Livez", "GET", "/livez"},
 			{"Serve gen/http/openapi.json", "GET", "/_query/openapi.json"},
@@ -90,16 +110,26 @@ func New(
 			{"Serve gen/http/openapi3.json", "GET", "/_query/openapi3.json"},
 			{"Serve gen/http/openapi3.yaml", "GET", "/_query/openapi3.yaml"},
 		},
-		QueryResources:      NewQueryResourcesHandler(e.QueryResources, mux, decoder, encoder, errhandler, formatter),
-		QueryResourcesCount: NewQueryResourcesCountHandler(e.QueryResourcesCount, mux, decoder, encoder, errhandler, formatter),
-		QueryOrgs:           NewQueryOrgsHandler(e.QueryOrgs, mux, decoder, encoder, errhandler, formatter),
-		SuggestOrgs:         NewSuggestOrgsHandler(e.SuggestOrgs, mux, decoder, encoder, errhandler, formatter),
-		Readyz:              NewReadyzHandler(e.Readyz, mux, decoder, encoder, errhandler, formatter),
-		Livez:               NewLivezHandler(e.Livez, mux, decoder, encoder, errhandler, formatter),
-		GenHTTPOpenapiJSON:  http.FileServer(fileSystemGenHTTPOpenapiJSON),
-		GenHTTPOpenapiYaml:  http.FileServer(fileSystemGenHTTPOpenapiYaml),
-		GenHTTPOpenapi3JSON: http.FileServer(fileSystemGenHTTPOpenapi3JSON),
-		GenHTTPOpenapi3Yaml: http.FileServer(fileSystemGenHTTPOpenapi3Yaml),
+		QueryResources:           NewQueryResourcesHandler(e.QueryResources, mux, decoder, encoder, errhandler, formatter),
+		QueryResourcesCount:      NewQueryResourcesCountHandler(e.QueryResourcesCount, mux, decoder, encoder, errhandler, formatter),
+		QueryResourcesCountBatch: NewQueryResourcesCountBatchHandler(e.QueryResourcesCountBatch, mux, decoder, encoder, errhandler, formatter),
+		QueryResourcesFacets:     NewQueryResourcesFacetsHandler(e.QueryResourcesFacets, mux, decoder, encoder, errhandler, formatter),
+		ExplainResource:          NewExplainResourceHandler(e.ExplainResource, mux, decoder, encoder, errhandler, formatter),
+		CheckTuples:              NewCheckTuplesHandler(e.CheckTuples, mux, decoder, encoder, errhandler, formatter),
+		QueryOrgs:                NewQueryOrgsHandler(e.QueryOrgs, mux, decoder, encoder, errhandler, formatter),
+		QueryOrgsList:            NewQueryOrgsListHandler(e.QueryOrgsList, mux, decoder, encoder, errhandler, formatter),
+		CountOrgs:                NewCountOrgsHandler(e.CountOrgs, mux, decoder, encoder, errhandler, formatter),
+		ResolveOrgs:              NewResolveOrgsHandler(e.ResolveOrgs, mux, decoder, encoder, errhandler, formatter),
+		SuggestOrgs:              NewSuggestOrgsHandler(e.SuggestOrgs, mux, decoder, encoder, errhandler, formatter),
+		UnifiedSearch:            NewUnifiedSearchHandler(e.UnifiedSearch, mux, decoder, encoder, errhandler, formatter),
+		Suggest:                  NewSuggestHandler(e.Suggest, mux, decoder, encoder, errhandler, formatter),
+		FlushCache:               NewFlushCacheHandler(e.FlushCache, mux, decoder, encoder, errhandler, formatter),
+		Readyz:                   NewReadyzHandler(e.Readyz, mux, decoder, encoder, errhandler, formatter),
+		Livez:                    NewLivezHandler(e.Livez, mux, decoder, encoder, errhandler, formatter),
+		GenHTTPOpenapiJSON:       http.FileServer(fileSystemGenHTTPOpenapiJSON),
+		GenHTTPOpenapiYaml:       http.FileServer(fileSystemGenHTTPOpenapiYaml),
+		GenHTTPOpenapi3JSON:      http.FileServer(fileSystemGenHTTPOpenapi3JSON),
+		GenHTTPOpenapi3Yaml:      http.FileServer(fileSystemGenHTTPOpenapi3Yaml),
 	}
 }
 
@@ -110,8 +140,18 @@ func (s *Server) Service() string { return "query-svc" }
 func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.QueryResources = m(s.QueryResources)
 	s.QueryResourcesCount = m(s.QueryResourcesCount)
+	s.QueryResourcesCountBatch = m(s.QueryResourcesCountBatch)
+	s.QueryResourcesFacets = m(s.QueryResourcesFacets)
+	s.ExplainResource = m(s.ExplainResource)
+	s.CheckTuples = m(s.CheckTuples)
 	s.QueryOrgs = m(s.QueryOrgs)
+	s.QueryOrgsList = m(s.QueryOrgsList)
+	s.CountOrgs = m(s.CountOrgs)
+	s.ResolveOrgs = m(s.ResolveOrgs)
 	s.SuggestOrgs = m(s.SuggestOrgs)
+	s.UnifiedSearch = m(s.UnifiedSearch)
+	s.Suggest = m(s.Suggest)
+	s.FlushCache = m(s.FlushCache)
 	s.Readyz = m(s.Readyz)
 	s.Livez = m(s.Livez)
 }
@@ -123,8 +163,18 @@ func (s *Server) MethodNames() []string { return querysvc.MethodNames[:] }
 func Mount(mux goahttp.Muxer, h *Server) {
 	MountQueryResourcesHandler(mux, h.QueryResources)
 	MountQueryResourcesCountHandler(mux, h.QueryResourcesCount)
+	MountQueryResourcesCountBatchHandler(mux, h.QueryResourcesCountBatch)
+	MountQueryResourcesFacetsHandler(mux, h.QueryResourcesFacets)
+	MountExplainResourceHandler(mux, h.ExplainResource)
+	MountCheckTuplesHandler(mux, h.CheckTuples)
 	MountQueryOrgsHandler(mux, h.QueryOrgs)
+	MountQueryOrgsListHandler(mux, h.QueryOrgsList)
+	MountCountOrgsHandler(mux, h.CountOrgs)
+	MountResolveOrgsHandler(mux, h.ResolveOrgs)
 	MountSuggestOrgsHandler(mux, h.SuggestOrgs)
+	MountUnifiedSearchHandler(mux, h.UnifiedSearch)
+	MountSuggestHandler(mux, h.Suggest)
+	MountFlushCacheHandler(mux, h.FlushCache)
 	MountReadyzHandler(mux, h.Readyz)
 	MountLivezHandler(mux, h.Livez)
 	MountGenHTTPOpenapiJSON(mux, http.StripPrefix("/_query", h.GenHTTPOpenapiJSON))
@@ -240,6 +290,211 @@ func NewQueryResourcesCountHandler(
 	})
 }
 
+// MountQueryResourcesCountBatchHandler configures the mux to serve the
+// "query-svc" service "query-resources-count-batch" endpoint.
+func MountQueryResourcesCountBatchHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/query/resources/count/batch", f)
+}
+
+// NewQueryResourcesCountBatchHandler creates a HTTP handler which loads the
+// HTTP request and calls the "query-svc" service "query-resources-count-batch"
+// endpoint.
+func NewQueryResourcesCountBatchHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeQueryResourcesCountBatchRequest(mux, decoder)
+		encodeResponse = EncodeQueryResourcesCountBatchResponse(encoder)
+		encodeError    = EncodeQueryResourcesCountBatchError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "query-resources-count-batch")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "query-svc")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountQueryResourcesFacetsHandler configures the mux to serve the "query-svc"
+// service "query-resources-facets" endpoint.
+func MountQueryResourcesFacetsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/query/resources/facets", f)
+}
+
+// NewQueryResourcesFacetsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "query-svc" service "query-resources-facets" endpoint.
+func NewQueryResourcesFacetsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeQueryResourcesFacetsRequest(mux, decoder)
+		encodeResponse = EncodeQueryResourcesFacetsResponse(encoder)
+		encodeError    = EncodeQueryResourcesFacetsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "query-resources-facets")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "query-svc")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountExplainResourceHandler configures the mux to serve the "query-svc"
+// service "explain-resource" endpoint.
+func MountExplainResourceHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/query/resources/explain", f)
+}
+
+// NewExplainResourceHandler creates a HTTP handler which loads the HTTP
+// request and calls the "query-svc" service "explain-resource" endpoint.
+func NewExplainResourceHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeExplainResourceRequest(mux, decoder)
+		encodeResponse = EncodeExplainResourceResponse(encoder)
+		encodeError    = EncodeExplainResourceError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "explain-resource")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "query-svc")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountCheckTuplesHandler configures the mux to serve the "query-svc" service
+// "check-tuples" endpoint.
+func MountCheckTuplesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/query/access/check", f)
+}
+
+// NewCheckTuplesHandler creates a HTTP handler which loads the HTTP request
+// and calls the "query-svc" service "check-tuples" endpoint.
+func NewCheckTuplesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCheckTuplesRequest(mux, decoder)
+		encodeResponse = EncodeCheckTuplesResponse(encoder)
+		encodeError    = EncodeCheckTuplesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "check-tuples")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "query-svc")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
 // MountQueryOrgsHandler configures the mux to serve the "query-svc" service
 // "query-orgs" endpoint.
 func MountQueryOrgsHandler(mux goahttp.Muxer, h http.Handler) {
@@ -291,6 +546,159 @@ func NewQueryOrgsHandler(
 	})
 }
 
+// MountQueryOrgsListHandler configures the mux to serve the "query-svc"
+// service "query-orgs-list" endpoint.
+func MountQueryOrgsListHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/query/orgs/list", f)
+}
+
+// NewQueryOrgsListHandler creates a HTTP handler which loads the HTTP request
+// and calls the "query-svc" service "query-orgs-list" endpoint.
+func NewQueryOrgsListHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeQueryOrgsListRequest(mux, decoder)
+		encodeResponse = EncodeQueryOrgsListResponse(encoder)
+		encodeError    = EncodeQueryOrgsListError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "query-orgs-list")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "query-svc")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountCountOrgsHandler configures the mux to serve the "query-svc" service
+// "count-orgs" endpoint.
+func MountCountOrgsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/query/orgs/count", f)
+}
+
+// NewCountOrgsHandler creates a HTTP handler which loads the HTTP request and
+// calls the "query-svc" service "count-orgs" endpoint.
+func NewCountOrgsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCountOrgsRequest(mux, decoder)
+		encodeResponse = EncodeCountOrgsResponse(encoder)
+		encodeError    = EncodeCountOrgsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "count-orgs")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "query-svc")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountResolveOrgsHandler configures the mux to serve the "query-svc" service
+// "resolve-orgs" endpoint.
+func MountResolveOrgsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/query/orgs/resolve", f)
+}
+
+// NewResolveOrgsHandler creates a HTTP handler which loads the HTTP request
+// and calls the "query-svc" service "resolve-orgs" endpoint.
+func NewResolveOrgsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeResolveOrgsRequest(mux, decoder)
+		encodeResponse = EncodeResolveOrgsResponse(encoder)
+		encodeError    = EncodeResolveOrgsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "resolve-orgs")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "query-svc")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
 // MountSuggestOrgsHandler configures the mux to serve the "query-svc" service
 // "suggest-orgs" endpoint.
 func MountSuggestOrgsHandler(mux goahttp.Muxer, h http.Handler) {
@@ -342,6 +750,159 @@ func NewSuggestOrgsHandler(
 	})
 }
 
+// MountUnifiedSearchHandler configures the mux to serve the "query-svc"
+// service "unified-search" endpoint.
+func MountUnifiedSearchHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/query/search", f)
+}
+
+// NewUnifiedSearchHandler creates a HTTP handler which loads the HTTP request
+// and calls the "query-svc" service "unified-search" endpoint.
+func NewUnifiedSearchHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUnifiedSearchRequest(mux, decoder)
+		encodeResponse = EncodeUnifiedSearchResponse(encoder)
+		encodeError    = EncodeUnifiedSearchError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "unified-search")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "query-svc")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountSuggestHandler configures the mux to serve the "query-svc" service
+// "suggest" endpoint.
+func MountSuggestHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/query/suggest", f)
+}
+
+// NewSuggestHandler creates a HTTP handler which loads the HTTP request and
+// calls the "query-svc" service "suggest" endpoint.
+func NewSuggestHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeSuggestRequest(mux, decoder)
+		encodeResponse = EncodeSuggestResponse(encoder)
+		encodeError    = EncodeSuggestError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "suggest")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "query-svc")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountFlushCacheHandler configures the mux to serve the "query-svc" service
+// "flush-cache" endpoint.
+func MountFlushCacheHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/cache/flush", f)
+}
+
+// NewFlushCacheHandler creates a HTTP handler which loads the HTTP request and
+// calls the "query-svc" service "flush-cache" endpoint.
+func NewFlushCacheHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeFlushCacheRequest(mux, decoder)
+		encodeResponse = EncodeFlushCacheResponse(encoder)
+		encodeError    = EncodeFlushCacheError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "flush-cache")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "query-svc")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
 // MountReadyzHandler configures the mux to serve the "query-svc" service
 // "readyz" endpoint.
 func MountReadyzHandler(mux goahttp.Muxer, h http.Handler) {
@@ -8,17 +8,50 @@
 package server
 
 import (
+	"unicode/utf8"
+
 	querysvc "github.com/linuxfoundation/lfx-v2-query-service/gen/query_svc"
 	goa "goa.design/goa/v3/pkg"
 )
 
-// QueryResourcesResponseBody is the type of the "query-svc" service
+// QueryResourcesCountBatchRequestBody is the type of the "query-svc" service
+// "query-resources-count-batch" endpoint HTTP request body.
+type QueryResourcesCountBatchRequestBody struct {
+	// Count queries
+	Queries []*CountQueryRequestBody `form:"queries,omitempty" json:"queries,omitempty" xml:"queries,omitempty"`
+}
+
+// CheckTuplesRequestBody is the type of the "query-svc" service "check-tuples"
+// endpoint HTTP request body.
+type CheckTuplesRequestBody struct {
+	// Access check tuples, as <object>#<relation>@<user>
+	Tuples []string `form:"tuples,omitempty" json:"tuples,omitempty" xml:"tuples,omitempty"`
+}
+
+// ResolveOrgsRequestBody is the type of the "query-svc" service "resolve-orgs"
+// endpoint HTTP request body.
+type ResolveOrgsRequestBody struct {
+	// Domains or website URLs to resolve
+	Domains []string `form:"domains,omitempty" json:"domains,omitempty" xml:"domains,omitempty"`
+}
+
+// QueryResourcesOKResponseBody is the type of the "query-svc" service
 // "query-resources" endpoint HTTP response body.
-type QueryResourcesResponseBody struct {
+type QueryResourcesOKResponseBody struct {
 	// Resources found
 	Resources []*ResourceResponseBody `form:"resources" json:"resources" xml:"resources"`
+	// Total number of resources found; only reported for anonymous searches, whose
+	// hits need no access check
+	Total *int `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+	// Whether total is exact (eq) or a lower bound (gte)
+	TotalRelation *string `form:"total_relation,omitempty" json:"total_relation,omitempty" xml:"total_relation,omitempty"`
 	// Opaque token if more results are available
 	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty" xml:"page_token,omitempty"`
+	// Alternative names for a name search that found nothing, when
+	// suggest_on_empty is set
+	Suggestions []string `form:"suggestions,omitempty" json:"suggestions,omitempty" xml:"suggestions,omitempty"`
+	// Set to "not-modified" when the If-None-Match ETag still matches
+	CacheStatus *string `form:"cache_status,omitempty" json:"cache_status,omitempty" xml:"cache_status,omitempty"`
 }
 
 // QueryResourcesCountResponseBody is the type of the "query-svc" service
@@ -26,9 +59,62 @@ type QueryResourcesResponseBody struct {
 type QueryResourcesCountResponseBody struct {
 	// Count of resources found
 	Count uint64 `form:"count" json:"count" xml:"count"`
+	// Count of resources found that the principal may access, the same as count
+	VisibleCount uint64 `form:"visible_count" json:"visible_count" xml:"visible_count"`
+	// Count of resources found before the access checks, including those the
+	// principal may not access (only reported to the allowed principals)
+	TotalCount *uint64 `form:"total_count,omitempty" json:"total_count,omitempty" xml:"total_count,omitempty"`
 	// True if count is not guaranteed to be exhaustive: client should request a
 	// narrower query
 	HasMore bool `form:"has_more" json:"has_more" xml:"has_more"`
+	// Count of private resources by the relation granting access to them, when
+	// include_relations is set
+	RelationCounts map[string]uint64 `form:"relation_counts,omitempty" json:"relation_counts,omitempty" xml:"relation_counts,omitempty"`
+}
+
+// QueryResourcesCountBatchResponseBody is the type of the "query-svc" service
+// "query-resources-count-batch" endpoint HTTP response body.
+type QueryResourcesCountBatchResponseBody struct {
+	// Count outcomes, in the same order as the queries
+	Items []*CountItemResponseBody `form:"items" json:"items" xml:"items"`
+}
+
+// QueryResourcesFacetsResponseBody is the type of the "query-svc" service
+// "query-resources-facets" endpoint HTTP response body.
+type QueryResourcesFacetsResponseBody struct {
+	// Values of each requested field, by descending count
+	Facets map[string][]*FacetValueResponseBody `form:"facets" json:"facets" xml:"facets"`
+	// Number of matching resources the principal can access, when include_total is
+	// set
+	Total *uint64 `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+	// Whether total is exact (eq) or a lower bound (gte), when include_total is set
+	TotalRelation *string `form:"total_relation,omitempty" json:"total_relation,omitempty" xml:"total_relation,omitempty"`
+}
+
+// ExplainResourceResponseBody is the type of the "query-svc" service
+// "explain-resource" endpoint HTTP response body.
+type ExplainResourceResponseBody struct {
+	// Reference of the explained resource
+	ObjectRef string `form:"object_ref" json:"object_ref" xml:"object_ref"`
+	// Stage of the search that decided the outcome
+	Stage string `form:"stage" json:"stage" xml:"stage"`
+	// Human readable explanation of the outcome
+	Reason string `form:"reason" json:"reason" xml:"reason"`
+	// True if the resource is indexed
+	Found bool `form:"found" json:"found" xml:"found"`
+	// True if the resource matches the search criteria
+	MatchedCriteria bool `form:"matched_criteria" json:"matched_criteria" xml:"matched_criteria"`
+	// Access check the resource needs, if any
+	AccessCheckQuery *string `form:"access_check_query,omitempty" json:"access_check_query,omitempty" xml:"access_check_query,omitempty"`
+	// True if the principal may see the resource
+	AccessGranted bool `form:"access_granted" json:"access_granted" xml:"access_granted"`
+}
+
+// CheckTuplesResponseBody is the type of the "query-svc" service
+// "check-tuples" endpoint HTTP response body.
+type CheckTuplesResponseBody struct {
+	// Answer of the access checker for each tuple
+	Results map[string]string `form:"results" json:"results" xml:"results"`
 }
 
 // QueryOrgsResponseBody is the type of the "query-svc" service "query-orgs"
@@ -44,6 +130,31 @@ type QueryOrgsResponseBody struct {
 	Sector *string `form:"sector,omitempty" json:"sector,omitempty" xml:"sector,omitempty"`
 	// Employee count or range
 	Employees *string `form:"employees,omitempty" json:"employees,omitempty" xml:"employees,omitempty"`
+	// Further fields of the organization, as provided by its source
+	Metadata map[string]any `form:"metadata,omitempty" json:"metadata,omitempty" xml:"metadata,omitempty"`
+}
+
+// QueryOrgsListResponseBody is the type of the "query-svc" service
+// "query-orgs-list" endpoint HTTP response body.
+type QueryOrgsListResponseBody struct {
+	// Organizations found, ordered by relevance
+	Organizations []*OrganizationResponseBody `form:"organizations" json:"organizations" xml:"organizations"`
+	// Opaque token if more results are available
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty" xml:"page_token,omitempty"`
+}
+
+// CountOrgsResponseBody is the type of the "query-svc" service "count-orgs"
+// endpoint HTTP response body.
+type CountOrgsResponseBody struct {
+	// Number of organizations found
+	Count int `form:"count" json:"count" xml:"count"`
+}
+
+// ResolveOrgsResponseBody is the type of the "query-svc" service
+// "resolve-orgs" endpoint HTTP response body.
+type ResolveOrgsResponseBody struct {
+	// Resolution of each domain, in the same order as the request
+	Organizations []*ResolvedOrganizationResponseBody `form:"organizations" json:"organizations" xml:"organizations"`
 }
 
 // SuggestOrgsResponseBody is the type of the "query-svc" service
@@ -53,6 +164,35 @@ type SuggestOrgsResponseBody struct {
 	Suggestions []*OrganizationSuggestionResponseBody `form:"suggestions" json:"suggestions" xml:"suggestions"`
 }
 
+// UnifiedSearchResponseBody is the type of the "query-svc" service
+// "unified-search" endpoint HTTP response body.
+type UnifiedSearchResponseBody struct {
+	// Resources found, limited to the section size
+	Resources []*ResourceResponseBody `form:"resources" json:"resources" xml:"resources"`
+	// Organizations suggested, limited to the section size
+	Organizations []*OrganizationSuggestionResponseBody `form:"organizations" json:"organizations" xml:"organizations"`
+	// True when the organization search failed and its section is empty
+	OrganizationsUnavailable bool `form:"organizations_unavailable" json:"organizations_unavailable" xml:"organizations_unavailable"`
+}
+
+// SuggestResponseBody is the type of the "query-svc" service "suggest"
+// endpoint HTTP response body.
+type SuggestResponseBody struct {
+	// Suggestions of the requested kinds, by relevance
+	Suggestions []*SuggestionResponseBody `form:"suggestions" json:"suggestions" xml:"suggestions"`
+	// True when the organization suggestions failed and are left out
+	OrganizationsUnavailable bool `form:"organizations_unavailable" json:"organizations_unavailable" xml:"organizations_unavailable"`
+}
+
+// FlushCacheResponseBody is the type of the "query-svc" service "flush-cache"
+// endpoint HTTP response body.
+type FlushCacheResponseBody struct {
+	// Number of cached results cleared
+	Flushed int `form:"flushed" json:"flushed" xml:"flushed"`
+	// Number of warm-up queries cached again
+	Warmed int `form:"warmed" json:"warmed" xml:"warmed"`
+}
+
 // QueryResourcesBadRequestResponseBody is the type of the "query-svc" service
 // "query-resources" endpoint HTTP response body for the "BadRequest" error.
 type QueryResourcesBadRequestResponseBody struct {
@@ -100,6 +240,114 @@ type QueryResourcesCountServiceUnavailableResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
+// QueryResourcesCountBatchBadRequestResponseBody is the type of the
+// "query-svc" service "query-resources-count-batch" endpoint HTTP response
+// body for the "BadRequest" error.
+type QueryResourcesCountBatchBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// QueryResourcesCountBatchInternalServerErrorResponseBody is the type of the
+// "query-svc" service "query-resources-count-batch" endpoint HTTP response
+// body for the "InternalServerError" error.
+type QueryResourcesCountBatchInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// QueryResourcesCountBatchServiceUnavailableResponseBody is the type of the
+// "query-svc" service "query-resources-count-batch" endpoint HTTP response
+// body for the "ServiceUnavailable" error.
+type QueryResourcesCountBatchServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// QueryResourcesFacetsBadRequestResponseBody is the type of the "query-svc"
+// service "query-resources-facets" endpoint HTTP response body for the
+// "BadRequest" error.
+type QueryResourcesFacetsBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// QueryResourcesFacetsInternalServerErrorResponseBody is the type of the
+// "query-svc" service "query-resources-facets" endpoint HTTP response body for
+// the "InternalServerError" error.
+type QueryResourcesFacetsInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// QueryResourcesFacetsServiceUnavailableResponseBody is the type of the
+// "query-svc" service "query-resources-facets" endpoint HTTP response body for
+// the "ServiceUnavailable" error.
+type QueryResourcesFacetsServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ExplainResourceBadRequestResponseBody is the type of the "query-svc" service
+// "explain-resource" endpoint HTTP response body for the "BadRequest" error.
+type ExplainResourceBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ExplainResourceInternalServerErrorResponseBody is the type of the
+// "query-svc" service "explain-resource" endpoint HTTP response body for the
+// "InternalServerError" error.
+type ExplainResourceInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ExplainResourceNotFoundResponseBody is the type of the "query-svc" service
+// "explain-resource" endpoint HTTP response body for the "NotFound" error.
+type ExplainResourceNotFoundResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ExplainResourceServiceUnavailableResponseBody is the type of the "query-svc"
+// service "explain-resource" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type ExplainResourceServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CheckTuplesBadRequestResponseBody is the type of the "query-svc" service
+// "check-tuples" endpoint HTTP response body for the "BadRequest" error.
+type CheckTuplesBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CheckTuplesInternalServerErrorResponseBody is the type of the "query-svc"
+// service "check-tuples" endpoint HTTP response body for the
+// "InternalServerError" error.
+type CheckTuplesInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CheckTuplesNotFoundResponseBody is the type of the "query-svc" service
+// "check-tuples" endpoint HTTP response body for the "NotFound" error.
+type CheckTuplesNotFoundResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CheckTuplesServiceUnavailableResponseBody is the type of the "query-svc"
+// service "check-tuples" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type CheckTuplesServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
 // QueryOrgsBadRequestResponseBody is the type of the "query-svc" service
 // "query-orgs" endpoint HTTP response body for the "BadRequest" error.
 type QueryOrgsBadRequestResponseBody struct {
@@ -130,6 +378,75 @@ type QueryOrgsServiceUnavailableResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
+// QueryOrgsListBadRequestResponseBody is the type of the "query-svc" service
+// "query-orgs-list" endpoint HTTP response body for the "BadRequest" error.
+type QueryOrgsListBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// QueryOrgsListInternalServerErrorResponseBody is the type of the "query-svc"
+// service "query-orgs-list" endpoint HTTP response body for the
+// "InternalServerError" error.
+type QueryOrgsListInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// QueryOrgsListServiceUnavailableResponseBody is the type of the "query-svc"
+// service "query-orgs-list" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type QueryOrgsListServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CountOrgsBadRequestResponseBody is the type of the "query-svc" service
+// "count-orgs" endpoint HTTP response body for the "BadRequest" error.
+type CountOrgsBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CountOrgsInternalServerErrorResponseBody is the type of the "query-svc"
+// service "count-orgs" endpoint HTTP response body for the
+// "InternalServerError" error.
+type CountOrgsInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CountOrgsServiceUnavailableResponseBody is the type of the "query-svc"
+// service "count-orgs" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type CountOrgsServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ResolveOrgsBadRequestResponseBody is the type of the "query-svc" service
+// "resolve-orgs" endpoint HTTP response body for the "BadRequest" error.
+type ResolveOrgsBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ResolveOrgsInternalServerErrorResponseBody is the type of the "query-svc"
+// service "resolve-orgs" endpoint HTTP response body for the
+// "InternalServerError" error.
+type ResolveOrgsInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ResolveOrgsServiceUnavailableResponseBody is the type of the "query-svc"
+// service "resolve-orgs" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type ResolveOrgsServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
 // SuggestOrgsBadRequestResponseBody is the type of the "query-svc" service
 // "suggest-orgs" endpoint HTTP response body for the "BadRequest" error.
 type SuggestOrgsBadRequestResponseBody struct {
@@ -153,6 +470,81 @@ type SuggestOrgsServiceUnavailableResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
+// UnifiedSearchBadRequestResponseBody is the type of the "query-svc" service
+// "unified-search" endpoint HTTP response body for the "BadRequest" error.
+type UnifiedSearchBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UnifiedSearchInternalServerErrorResponseBody is the type of the "query-svc"
+// service "unified-search" endpoint HTTP response body for the
+// "InternalServerError" error.
+type UnifiedSearchInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UnifiedSearchServiceUnavailableResponseBody is the type of the "query-svc"
+// service "unified-search" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type UnifiedSearchServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// SuggestBadRequestResponseBody is the type of the "query-svc" service
+// "suggest" endpoint HTTP response body for the "BadRequest" error.
+type SuggestBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// SuggestInternalServerErrorResponseBody is the type of the "query-svc"
+// service "suggest" endpoint HTTP response body for the "InternalServerError"
+// error.
+type SuggestInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// SuggestServiceUnavailableResponseBody is the type of the "query-svc" service
+// "suggest" endpoint HTTP response body for the "ServiceUnavailable" error.
+type SuggestServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// FlushCacheBadRequestResponseBody is the type of the "query-svc" service
+// "flush-cache" endpoint HTTP response body for the "BadRequest" error.
+type FlushCacheBadRequestResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// FlushCacheInternalServerErrorResponseBody is the type of the "query-svc"
+// service "flush-cache" endpoint HTTP response body for the
+// "InternalServerError" error.
+type FlushCacheInternalServerErrorResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// FlushCacheNotFoundResponseBody is the type of the "query-svc" service
+// "flush-cache" endpoint HTTP response body for the "NotFound" error.
+type FlushCacheNotFoundResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// FlushCacheServiceUnavailableResponseBody is the type of the "query-svc"
+// service "flush-cache" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type FlushCacheServiceUnavailableResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
 // ReadyzNotReadyResponseBody is the type of the "query-svc" service "readyz"
 // endpoint HTTP response body for the "NotReady" error.
 type ReadyzNotReadyResponseBody struct {
@@ -177,13 +569,93 @@ type ResourceResponseBody struct {
 	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
 	// Resource ID (within its resource collection)
 	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// ID of the search index document of the resource, which may differ from the
+	// resource ID
+	DocumentID *string `form:"document_id,omitempty" json:"document_id,omitempty" xml:"document_id,omitempty"`
+	// Search index that served the resource; only reported in debug mode
+	Index *string `form:"index,omitempty" json:"index,omitempty" xml:"index,omitempty"`
+	// Principal who created the resource, when indexed
+	CreatedBy *string `form:"created_by,omitempty" json:"created_by,omitempty" xml:"created_by,omitempty"`
+	// Principal who last updated the resource, when indexed
+	UpdatedBy *string `form:"updated_by,omitempty" json:"updated_by,omitempty" xml:"updated_by,omitempty"`
 	// Resource data snapshot
 	Data any `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+	// Relevance score assigned by the search backend; only returned when requested
+	Score *float64 `form:"score,omitempty" json:"score,omitempty" xml:"score,omitempty"`
+	// Relevance score relative to the best match of the search, between 0 and 1;
+	// only returned when requested
+	NormalizedScore *float64 `form:"normalized_score,omitempty" json:"normalized_score,omitempty" xml:"normalized_score,omitempty"`
+	// Requested tags the resource matched when filtering by tags; only returned to
+	// authenticated users
+	MatchedTags []string `form:"matched_tags,omitempty" json:"matched_tags,omitempty" xml:"matched_tags,omitempty"`
+	// Number of accessible children of the resource, by child type; only returned
+	// when requested
+	ChildCounts map[string]uint64 `form:"child_counts,omitempty" json:"child_counts,omitempty" xml:"child_counts,omitempty"`
+	// Whether the caller has each configured relation beyond viewing on the
+	// resource; only returned when requested
+	Permissions map[string]bool `form:"permissions,omitempty" json:"permissions,omitempty" xml:"permissions,omitempty"`
+	// References of the ancestors of the resource, as indexed; only returned when
+	// requested
+	Ancestry []string `form:"ancestry,omitempty" json:"ancestry,omitempty" xml:"ancestry,omitempty"`
+}
+
+// CountItemResponseBody is used to define fields on response body types.
+type CountItemResponseBody struct {
+	// Count of resources found
+	Count *uint64 `form:"count,omitempty" json:"count,omitempty" xml:"count,omitempty"`
+	// True if count is not guaranteed to be exhaustive: client should request a
+	// narrower query
+	HasMore *bool `form:"has_more,omitempty" json:"has_more,omitempty" xml:"has_more,omitempty"`
+	// Error of the query, if it failed
+	Error *CountItemErrorResponseBody `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// CountItemErrorResponseBody is used to define fields on response body types.
+type CountItemErrorResponseBody struct {
+	// Error code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// FacetValueResponseBody is used to define fields on response body types.
+type FacetValueResponseBody struct {
+	// Value of the field
+	Value string `form:"value" json:"value" xml:"value"`
+	// Count of resources with the value
+	Count uint64 `form:"count" json:"count" xml:"count"`
+}
+
+// OrganizationResponseBody is used to define fields on response body types.
+type OrganizationResponseBody struct {
+	// Organization name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Organization domain
+	Domain *string `form:"domain,omitempty" json:"domain,omitempty" xml:"domain,omitempty"`
+	// Organization industry classification
+	Industry *string `form:"industry,omitempty" json:"industry,omitempty" xml:"industry,omitempty"`
+	// Business sector classification
+	Sector *string `form:"sector,omitempty" json:"sector,omitempty" xml:"sector,omitempty"`
+	// Employee count or range
+	Employees *string `form:"employees,omitempty" json:"employees,omitempty" xml:"employees,omitempty"`
+	// Further fields of the organization, as provided by its source
+	Metadata map[string]any `form:"metadata,omitempty" json:"metadata,omitempty" xml:"metadata,omitempty"`
+}
+
+// ResolvedOrganizationResponseBody is used to define fields on response body
+// types.
+type ResolvedOrganizationResponseBody struct {
+	// Requested domain, as given
+	Domain string `form:"domain" json:"domain" xml:"domain"`
+	// Organization of the domain; absent when the domain has none
+	Organization *OrganizationResponseBody `form:"organization,omitempty" json:"organization,omitempty" xml:"organization,omitempty"`
 }
 
 // OrganizationSuggestionResponseBody is used to define fields on response body
 // types.
 type OrganizationSuggestionResponseBody struct {
+	// Kind of the suggestion
+	Kind string `form:"kind" json:"kind" xml:"kind"`
 	// Organization name
 	Name string `form:"name" json:"name" xml:"name"`
 	// Organization domain
@@ -192,11 +664,40 @@ type OrganizationSuggestionResponseBody struct {
 	Logo *string `form:"logo,omitempty" json:"logo,omitempty" xml:"logo,omitempty"`
 }
 
-// NewQueryResourcesResponseBody builds the HTTP response body from the result
-// of the "query-resources" endpoint of the "query-svc" service.
-func NewQueryResourcesResponseBody(res *querysvc.QueryResourcesResult) *QueryResourcesResponseBody {
-	body := &QueryResourcesResponseBody{
-		PageToken: res.PageToken,
+// SuggestionResponseBody is used to define fields on response body types.
+type SuggestionResponseBody struct {
+	// Kind of the suggestion
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// Name to display for the suggestion
+	Name string `form:"name" json:"name" xml:"name"`
+	// Resource suggested, for resource suggestions
+	Resource *ResourceResponseBody `form:"resource,omitempty" json:"resource,omitempty" xml:"resource,omitempty"`
+	// Organization suggested, for organization suggestions
+	Organization *OrganizationSuggestionResponseBody `form:"organization,omitempty" json:"organization,omitempty" xml:"organization,omitempty"`
+}
+
+// CountQueryRequestBody is used to define fields on request body types.
+type CountQueryRequestBody struct {
+	// Resource name or alias; supports typeahead
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Parent (for navigation; varies by object type)
+	Parent *string `form:"parent,omitempty" json:"parent,omitempty" xml:"parent,omitempty"`
+	// Resource type to search
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Tags to search with OR logic - matches resources with any of these tags
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+	// Tags to search with AND logic - matches resources that have all of these tags
+	TagsAll []string `form:"tags_all,omitempty" json:"tags_all,omitempty" xml:"tags_all,omitempty"`
+}
+
+// NewQueryResourcesOKResponseBody builds the HTTP response body from the
+// result of the "query-resources" endpoint of the "query-svc" service.
+func NewQueryResourcesOKResponseBody(res *querysvc.QueryResourcesResult) *QueryResourcesOKResponseBody {
+	body := &QueryResourcesOKResponseBody{
+		Total:         res.Total,
+		TotalRelation: res.TotalRelation,
+		PageToken:     res.PageToken,
+		CacheStatus:   res.CacheStatus,
 	}
 	if res.Resources != nil {
 		body.Resources = make([]*ResourceResponseBody, len(res.Resources))
@@ -206,6 +707,12 @@ func NewQueryResourcesResponseBody(res *querysvc.QueryResourcesResult) *QueryRes
 	} else {
 		body.Resources = []*ResourceResponseBody{}
 	}
+	if res.Suggestions != nil {
+		body.Suggestions = make([]string, len(res.Suggestions))
+		for i, val := range res.Suggestions {
+			body.Suggestions[i] = val
+		}
+	}
 	return body
 }
 
@@ -213,53 +720,231 @@ func NewQueryResourcesResponseBody(res *querysvc.QueryResourcesResult) *QueryRes
 // result of the "query-resources-count" endpoint of the "query-svc" service.
 func NewQueryResourcesCountResponseBody(res *querysvc.QueryResourcesCountResult) *QueryResourcesCountResponseBody {
 	body := &QueryResourcesCountResponseBody{
-		Count:   res.Count,
-		HasMore: res.HasMore,
+		Count:        res.Count,
+		VisibleCount: res.VisibleCount,
+		TotalCount:   res.TotalCount,
+		HasMore:      res.HasMore,
+	}
+	if res.RelationCounts != nil {
+		body.RelationCounts = make(map[string]uint64, len(res.RelationCounts))
+		for key, val := range res.RelationCounts {
+			tk := key
+			tv := val
+			body.RelationCounts[tk] = tv
+		}
 	}
 	return body
 }
 
-// NewQueryOrgsResponseBody builds the HTTP response body from the result of
-// the "query-orgs" endpoint of the "query-svc" service.
-func NewQueryOrgsResponseBody(res *querysvc.Organization) *QueryOrgsResponseBody {
-	body := &QueryOrgsResponseBody{
-		Name:      res.Name,
-		Domain:    res.Domain,
-		Industry:  res.Industry,
-		Sector:    res.Sector,
-		Employees: res.Employees,
+// NewQueryResourcesCountBatchResponseBody builds the HTTP response body from
+// the result of the "query-resources-count-batch" endpoint of the "query-svc"
+// service.
+func NewQueryResourcesCountBatchResponseBody(res *querysvc.QueryResourcesCountBatchResult) *QueryResourcesCountBatchResponseBody {
+	body := &QueryResourcesCountBatchResponseBody{}
+	if res.Items != nil {
+		body.Items = make([]*CountItemResponseBody, len(res.Items))
+		for i, val := range res.Items {
+			body.Items[i] = marshalQuerysvcCountItemToCountItemResponseBody(val)
+		}
+	} else {
+		body.Items = []*CountItemResponseBody{}
 	}
 	return body
 }
 
-// NewSuggestOrgsResponseBody builds the HTTP response body from the result of
-// the "suggest-orgs" endpoint of the "query-svc" service.
-func NewSuggestOrgsResponseBody(res *querysvc.SuggestOrgsResult) *SuggestOrgsResponseBody {
-	body := &SuggestOrgsResponseBody{}
-	if res.Suggestions != nil {
-		body.Suggestions = make([]*OrganizationSuggestionResponseBody, len(res.Suggestions))
-		for i, val := range res.Suggestions {
-			body.Suggestions[i] = marshalQuerysvcOrganizationSuggestionToOrganizationSuggestionResponseBody(val)
+// NewQueryResourcesFacetsResponseBody builds the HTTP response body from the
+// result of the "query-resources-facets" endpoint of the "query-svc" service.
+func NewQueryResourcesFacetsResponseBody(res *querysvc.QueryResourcesFacetsResult) *QueryResourcesFacetsResponseBody {
+	body := &QueryResourcesFacetsResponseBody{
+		Total:         res.Total,
+		TotalRelation: res.TotalRelation,
+	}
+	if res.Facets != nil {
+		body.Facets = make(map[string][]*FacetValueResponseBody, len(res.Facets))
+		for key, val := range res.Facets {
+			tk := key
+			tv := make([]*FacetValueResponseBody, len(val))
+			for i, val := range val {
+				tv[i] = marshalQuerysvcFacetValueToFacetValueResponseBody(val)
+			}
+			body.Facets[tk] = tv
 		}
-	} else {
-		body.Suggestions = []*OrganizationSuggestionResponseBody{}
 	}
 	return body
 }
 
-// NewQueryResourcesBadRequestResponseBody builds the HTTP response body from
-// the result of the "query-resources" endpoint of the "query-svc" service.
-func NewQueryResourcesBadRequestResponseBody(res *querysvc.BadRequestError) *QueryResourcesBadRequestResponseBody {
-	body := &QueryResourcesBadRequestResponseBody{
-		Message: res.Message,
+// NewExplainResourceResponseBody builds the HTTP response body from the result
+// of the "explain-resource" endpoint of the "query-svc" service.
+func NewExplainResourceResponseBody(res *querysvc.ResourceExplanation) *ExplainResourceResponseBody {
+	body := &ExplainResourceResponseBody{
+		ObjectRef:        res.ObjectRef,
+		Stage:            res.Stage,
+		Reason:           res.Reason,
+		Found:            res.Found,
+		MatchedCriteria:  res.MatchedCriteria,
+		AccessCheckQuery: res.AccessCheckQuery,
+		AccessGranted:    res.AccessGranted,
 	}
 	return body
 }
 
-// NewQueryResourcesInternalServerErrorResponseBody builds the HTTP response
-// body from the result of the "query-resources" endpoint of the "query-svc"
-// service.
-func NewQueryResourcesInternalServerErrorResponseBody(res *querysvc.InternalServerError) *QueryResourcesInternalServerErrorResponseBody {
+// NewCheckTuplesResponseBody builds the HTTP response body from the result of
+// the "check-tuples" endpoint of the "query-svc" service.
+func NewCheckTuplesResponseBody(res *querysvc.CheckTuplesResult) *CheckTuplesResponseBody {
+	body := &CheckTuplesResponseBody{}
+	if res.Results != nil {
+		body.Results = make(map[string]string, len(res.Results))
+		for key, val := range res.Results {
+			tk := key
+			tv := val
+			body.Results[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewQueryOrgsResponseBody builds the HTTP response body from the result of
+// the "query-orgs" endpoint of the "query-svc" service.
+func NewQueryOrgsResponseBody(res *querysvc.Organization) *QueryOrgsResponseBody {
+	body := &QueryOrgsResponseBody{
+		Name:      res.Name,
+		Domain:    res.Domain,
+		Industry:  res.Industry,
+		Sector:    res.Sector,
+		Employees: res.Employees,
+	}
+	if res.Metadata != nil {
+		body.Metadata = make(map[string]any, len(res.Metadata))
+		for key, val := range res.Metadata {
+			tk := key
+			tv := val
+			body.Metadata[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewQueryOrgsListResponseBody builds the HTTP response body from the result
+// of the "query-orgs-list" endpoint of the "query-svc" service.
+func NewQueryOrgsListResponseBody(res *querysvc.QueryOrgsListResult) *QueryOrgsListResponseBody {
+	body := &QueryOrgsListResponseBody{
+		PageToken: res.PageToken,
+	}
+	if res.Organizations != nil {
+		body.Organizations = make([]*OrganizationResponseBody, len(res.Organizations))
+		for i, val := range res.Organizations {
+			body.Organizations[i] = marshalQuerysvcOrganizationToOrganizationResponseBody(val)
+		}
+	} else {
+		body.Organizations = []*OrganizationResponseBody{}
+	}
+	return body
+}
+
+// NewCountOrgsResponseBody builds the HTTP response body from the result of
+// the "count-orgs" endpoint of the "query-svc" service.
+func NewCountOrgsResponseBody(res *querysvc.CountOrgsResult) *CountOrgsResponseBody {
+	body := &CountOrgsResponseBody{
+		Count: res.Count,
+	}
+	return body
+}
+
+// NewResolveOrgsResponseBody builds the HTTP response body from the result of
+// the "resolve-orgs" endpoint of the "query-svc" service.
+func NewResolveOrgsResponseBody(res *querysvc.ResolveOrgsResult) *ResolveOrgsResponseBody {
+	body := &ResolveOrgsResponseBody{}
+	if res.Organizations != nil {
+		body.Organizations = make([]*ResolvedOrganizationResponseBody, len(res.Organizations))
+		for i, val := range res.Organizations {
+			body.Organizations[i] = marshalQuerysvcResolvedOrganizationToResolvedOrganizationResponseBody(val)
+		}
+	} else {
+		body.Organizations = []*ResolvedOrganizationResponseBody{}
+	}
+	return body
+}
+
+// NewSuggestOrgsResponseBody builds the HTTP response body from the result of
+// the "suggest-orgs" endpoint of the "query-svc" service.
+func NewSuggestOrgsResponseBody(res *querysvc.SuggestOrgsResult) *SuggestOrgsResponseBody {
+	body := &SuggestOrgsResponseBody{}
+	if res.Suggestions != nil {
+		body.Suggestions = make([]*OrganizationSuggestionResponseBody, len(res.Suggestions))
+		for i, val := range res.Suggestions {
+			body.Suggestions[i] = marshalQuerysvcOrganizationSuggestionToOrganizationSuggestionResponseBody(val)
+		}
+	} else {
+		body.Suggestions = []*OrganizationSuggestionResponseBody{}
+	}
+	return body
+}
+
+// NewUnifiedSearchResponseBody builds the HTTP response body from the result
+// of the "unified-search" endpoint of the "query-svc" service.
+func NewUnifiedSearchResponseBody(res *querysvc.UnifiedSearchResult) *UnifiedSearchResponseBody {
+	body := &UnifiedSearchResponseBody{
+		OrganizationsUnavailable: res.OrganizationsUnavailable,
+	}
+	if res.Resources != nil {
+		body.Resources = make([]*ResourceResponseBody, len(res.Resources))
+		for i, val := range res.Resources {
+			body.Resources[i] = marshalQuerysvcResourceToResourceResponseBody(val)
+		}
+	} else {
+		body.Resources = []*ResourceResponseBody{}
+	}
+	if res.Organizations != nil {
+		body.Organizations = make([]*OrganizationSuggestionResponseBody, len(res.Organizations))
+		for i, val := range res.Organizations {
+			body.Organizations[i] = marshalQuerysvcOrganizationSuggestionToOrganizationSuggestionResponseBody(val)
+		}
+	} else {
+		body.Organizations = []*OrganizationSuggestionResponseBody{}
+	}
+	return body
+}
+
+// NewSuggestResponseBody builds the HTTP response body from the result of the
+// "suggest" endpoint of the "query-svc" service.
+func NewSuggestResponseBody(res *querysvc.SuggestResult) *SuggestResponseBody {
+	body := &SuggestResponseBody{
+		OrganizationsUnavailable: res.OrganizationsUnavailable,
+	}
+	if res.Suggestions != nil {
+		body.Suggestions = make([]*SuggestionResponseBody, len(res.Suggestions))
+		for i, val := range res.Suggestions {
+			body.Suggestions[i] = marshalQuerysvcSuggestionToSuggestionResponseBody(val)
+		}
+	} else {
+		body.Suggestions = []*SuggestionResponseBody{}
+	}
+	return body
+}
+
+// NewFlushCacheResponseBody builds the HTTP response body from the result of
+// the "flush-cache" endpoint of the "query-svc" service.
+func NewFlushCacheResponseBody(res *querysvc.FlushCacheResult) *FlushCacheResponseBody {
+	body := &FlushCacheResponseBody{
+		Flushed: res.Flushed,
+		Warmed:  res.Warmed,
+	}
+	return body
+}
+
+// NewQueryResourcesBadRequestResponseBody builds the HTTP response body from
+// the result of the "query-resources" endpoint of the "query-svc" service.
+func NewQueryResourcesBadRequestResponseBody(res *querysvc.BadRequestError) *QueryResourcesBadRequestResponseBody {
+	body := &QueryResourcesBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewQueryResourcesInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "query-resources" endpoint of the "query-svc"
+// service.
+func NewQueryResourcesInternalServerErrorResponseBody(res *querysvc.InternalServerError) *QueryResourcesInternalServerErrorResponseBody {
 	body := &QueryResourcesInternalServerErrorResponseBody{
 		Message: res.Message,
 	}
@@ -306,6 +991,140 @@ func NewQueryResourcesCountServiceUnavailableResponseBody(res *querysvc.ServiceU
 	return body
 }
 
+// NewQueryResourcesCountBatchBadRequestResponseBody builds the HTTP response
+// body from the result of the "query-resources-count-batch" endpoint of the
+// "query-svc" service.
+func NewQueryResourcesCountBatchBadRequestResponseBody(res *querysvc.BadRequestError) *QueryResourcesCountBatchBadRequestResponseBody {
+	body := &QueryResourcesCountBatchBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewQueryResourcesCountBatchInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "query-resources-count-batch" endpoint
+// of the "query-svc" service.
+func NewQueryResourcesCountBatchInternalServerErrorResponseBody(res *querysvc.InternalServerError) *QueryResourcesCountBatchInternalServerErrorResponseBody {
+	body := &QueryResourcesCountBatchInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewQueryResourcesCountBatchServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "query-resources-count-batch" endpoint
+// of the "query-svc" service.
+func NewQueryResourcesCountBatchServiceUnavailableResponseBody(res *querysvc.ServiceUnavailableError) *QueryResourcesCountBatchServiceUnavailableResponseBody {
+	body := &QueryResourcesCountBatchServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewQueryResourcesFacetsBadRequestResponseBody builds the HTTP response body
+// from the result of the "query-resources-facets" endpoint of the "query-svc"
+// service.
+func NewQueryResourcesFacetsBadRequestResponseBody(res *querysvc.BadRequestError) *QueryResourcesFacetsBadRequestResponseBody {
+	body := &QueryResourcesFacetsBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewQueryResourcesFacetsInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "query-resources-facets" endpoint of
+// the "query-svc" service.
+func NewQueryResourcesFacetsInternalServerErrorResponseBody(res *querysvc.InternalServerError) *QueryResourcesFacetsInternalServerErrorResponseBody {
+	body := &QueryResourcesFacetsInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewQueryResourcesFacetsServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "query-resources-facets" endpoint of
+// the "query-svc" service.
+func NewQueryResourcesFacetsServiceUnavailableResponseBody(res *querysvc.ServiceUnavailableError) *QueryResourcesFacetsServiceUnavailableResponseBody {
+	body := &QueryResourcesFacetsServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewExplainResourceBadRequestResponseBody builds the HTTP response body from
+// the result of the "explain-resource" endpoint of the "query-svc" service.
+func NewExplainResourceBadRequestResponseBody(res *querysvc.BadRequestError) *ExplainResourceBadRequestResponseBody {
+	body := &ExplainResourceBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewExplainResourceInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "explain-resource" endpoint of the "query-svc"
+// service.
+func NewExplainResourceInternalServerErrorResponseBody(res *querysvc.InternalServerError) *ExplainResourceInternalServerErrorResponseBody {
+	body := &ExplainResourceInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewExplainResourceNotFoundResponseBody builds the HTTP response body from
+// the result of the "explain-resource" endpoint of the "query-svc" service.
+func NewExplainResourceNotFoundResponseBody(res *querysvc.NotFoundError) *ExplainResourceNotFoundResponseBody {
+	body := &ExplainResourceNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewExplainResourceServiceUnavailableResponseBody builds the HTTP response
+// body from the result of the "explain-resource" endpoint of the "query-svc"
+// service.
+func NewExplainResourceServiceUnavailableResponseBody(res *querysvc.ServiceUnavailableError) *ExplainResourceServiceUnavailableResponseBody {
+	body := &ExplainResourceServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCheckTuplesBadRequestResponseBody builds the HTTP response body from the
+// result of the "check-tuples" endpoint of the "query-svc" service.
+func NewCheckTuplesBadRequestResponseBody(res *querysvc.BadRequestError) *CheckTuplesBadRequestResponseBody {
+	body := &CheckTuplesBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCheckTuplesInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "check-tuples" endpoint of the "query-svc" service.
+func NewCheckTuplesInternalServerErrorResponseBody(res *querysvc.InternalServerError) *CheckTuplesInternalServerErrorResponseBody {
+	body := &CheckTuplesInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCheckTuplesNotFoundResponseBody builds the HTTP response body from the
+// result of the "check-tuples" endpoint of the "query-svc" service.
+func NewCheckTuplesNotFoundResponseBody(res *querysvc.NotFoundError) *CheckTuplesNotFoundResponseBody {
+	body := &CheckTuplesNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCheckTuplesServiceUnavailableResponseBody builds the HTTP response body
+// from the result of the "check-tuples" endpoint of the "query-svc" service.
+func NewCheckTuplesServiceUnavailableResponseBody(res *querysvc.ServiceUnavailableError) *CheckTuplesServiceUnavailableResponseBody {
+	body := &CheckTuplesServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
 // NewQueryOrgsBadRequestResponseBody builds the HTTP response body from the
 // result of the "query-orgs" endpoint of the "query-svc" service.
 func NewQueryOrgsBadRequestResponseBody(res *querysvc.BadRequestError) *QueryOrgsBadRequestResponseBody {
@@ -342,6 +1161,88 @@ func NewQueryOrgsServiceUnavailableResponseBody(res *querysvc.ServiceUnavailable
 	return body
 }
 
+// NewQueryOrgsListBadRequestResponseBody builds the HTTP response body from
+// the result of the "query-orgs-list" endpoint of the "query-svc" service.
+func NewQueryOrgsListBadRequestResponseBody(res *querysvc.BadRequestError) *QueryOrgsListBadRequestResponseBody {
+	body := &QueryOrgsListBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewQueryOrgsListInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "query-orgs-list" endpoint of the "query-svc"
+// service.
+func NewQueryOrgsListInternalServerErrorResponseBody(res *querysvc.InternalServerError) *QueryOrgsListInternalServerErrorResponseBody {
+	body := &QueryOrgsListInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewQueryOrgsListServiceUnavailableResponseBody builds the HTTP response body
+// from the result of the "query-orgs-list" endpoint of the "query-svc" service.
+func NewQueryOrgsListServiceUnavailableResponseBody(res *querysvc.ServiceUnavailableError) *QueryOrgsListServiceUnavailableResponseBody {
+	body := &QueryOrgsListServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCountOrgsBadRequestResponseBody builds the HTTP response body from the
+// result of the "count-orgs" endpoint of the "query-svc" service.
+func NewCountOrgsBadRequestResponseBody(res *querysvc.BadRequestError) *CountOrgsBadRequestResponseBody {
+	body := &CountOrgsBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCountOrgsInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "count-orgs" endpoint of the "query-svc" service.
+func NewCountOrgsInternalServerErrorResponseBody(res *querysvc.InternalServerError) *CountOrgsInternalServerErrorResponseBody {
+	body := &CountOrgsInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCountOrgsServiceUnavailableResponseBody builds the HTTP response body
+// from the result of the "count-orgs" endpoint of the "query-svc" service.
+func NewCountOrgsServiceUnavailableResponseBody(res *querysvc.ServiceUnavailableError) *CountOrgsServiceUnavailableResponseBody {
+	body := &CountOrgsServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewResolveOrgsBadRequestResponseBody builds the HTTP response body from the
+// result of the "resolve-orgs" endpoint of the "query-svc" service.
+func NewResolveOrgsBadRequestResponseBody(res *querysvc.BadRequestError) *ResolveOrgsBadRequestResponseBody {
+	body := &ResolveOrgsBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewResolveOrgsInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "resolve-orgs" endpoint of the "query-svc" service.
+func NewResolveOrgsInternalServerErrorResponseBody(res *querysvc.InternalServerError) *ResolveOrgsInternalServerErrorResponseBody {
+	body := &ResolveOrgsInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewResolveOrgsServiceUnavailableResponseBody builds the HTTP response body
+// from the result of the "resolve-orgs" endpoint of the "query-svc" service.
+func NewResolveOrgsServiceUnavailableResponseBody(res *querysvc.ServiceUnavailableError) *ResolveOrgsServiceUnavailableResponseBody {
+	body := &ResolveOrgsServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
 // NewSuggestOrgsBadRequestResponseBody builds the HTTP response body from the
 // result of the "suggest-orgs" endpoint of the "query-svc" service.
 func NewSuggestOrgsBadRequestResponseBody(res *querysvc.BadRequestError) *SuggestOrgsBadRequestResponseBody {
@@ -369,6 +1270,97 @@ func NewSuggestOrgsServiceUnavailableResponseBody(res *querysvc.ServiceUnavailab
 	return body
 }
 
+// NewUnifiedSearchBadRequestResponseBody builds the HTTP response body from
+// the result of the "unified-search" endpoint of the "query-svc" service.
+func NewUnifiedSearchBadRequestResponseBody(res *querysvc.BadRequestError) *UnifiedSearchBadRequestResponseBody {
+	body := &UnifiedSearchBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUnifiedSearchInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "unified-search" endpoint of the "query-svc"
+// service.
+func NewUnifiedSearchInternalServerErrorResponseBody(res *querysvc.InternalServerError) *UnifiedSearchInternalServerErrorResponseBody {
+	body := &UnifiedSearchInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUnifiedSearchServiceUnavailableResponseBody builds the HTTP response body
+// from the result of the "unified-search" endpoint of the "query-svc" service.
+func NewUnifiedSearchServiceUnavailableResponseBody(res *querysvc.ServiceUnavailableError) *UnifiedSearchServiceUnavailableResponseBody {
+	body := &UnifiedSearchServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewSuggestBadRequestResponseBody builds the HTTP response body from the
+// result of the "suggest" endpoint of the "query-svc" service.
+func NewSuggestBadRequestResponseBody(res *querysvc.BadRequestError) *SuggestBadRequestResponseBody {
+	body := &SuggestBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewSuggestInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "suggest" endpoint of the "query-svc" service.
+func NewSuggestInternalServerErrorResponseBody(res *querysvc.InternalServerError) *SuggestInternalServerErrorResponseBody {
+	body := &SuggestInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewSuggestServiceUnavailableResponseBody builds the HTTP response body from
+// the result of the "suggest" endpoint of the "query-svc" service.
+func NewSuggestServiceUnavailableResponseBody(res *querysvc.ServiceUnavailableError) *SuggestServiceUnavailableResponseBody {
+	body := &SuggestServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewFlushCacheBadRequestResponseBody builds the HTTP response body from the
+// result of the "flush-cache" endpoint of the "query-svc" service.
+func NewFlushCacheBadRequestResponseBody(res *querysvc.BadRequestError) *FlushCacheBadRequestResponseBody {
+	body := &FlushCacheBadRequestResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewFlushCacheInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "flush-cache" endpoint of the "query-svc" service.
+func NewFlushCacheInternalServerErrorResponseBody(res *querysvc.InternalServerError) *FlushCacheInternalServerErrorResponseBody {
+	body := &FlushCacheInternalServerErrorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewFlushCacheNotFoundResponseBody builds the HTTP response body from the
+// result of the "flush-cache" endpoint of the "query-svc" service.
+func NewFlushCacheNotFoundResponseBody(res *querysvc.NotFoundError) *FlushCacheNotFoundResponseBody {
+	body := &FlushCacheNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewFlushCacheServiceUnavailableResponseBody builds the HTTP response body
+// from the result of the "flush-cache" endpoint of the "query-svc" service.
+func NewFlushCacheServiceUnavailableResponseBody(res *querysvc.ServiceUnavailableError) *FlushCacheServiceUnavailableResponseBody {
+	body := &FlushCacheServiceUnavailableResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
 // NewReadyzNotReadyResponseBody builds the HTTP response body from the result
 // of the "readyz" endpoint of the "query-svc" service.
 func NewReadyzNotReadyResponseBody(res *goa.ServiceError) *ReadyzNotReadyResponseBody {
@@ -385,24 +1377,46 @@ func NewReadyzNotReadyResponseBody(res *goa.ServiceError) *ReadyzNotReadyRespons
 
 // NewQueryResourcesPayload builds a query-svc service query-resources endpoint
 // payload.
-func NewQueryResourcesPayload(version string, name *string, parent *string, type_ *string, tags []string, tagsAll []string, sort string, pageToken *string, bearerToken string) *querysvc.QueryResourcesPayload {
+func NewQueryResourcesPayload(version string, name *string, nameExact *string, parent *string, parents []string, searchScope *string, type_ *string, tags []string, tagsMinMatch *int, tagsAll []string, createdBy *string, updatedBy *string, transactionID *string, excludeID []string, includeScore bool, includeChildCounts bool, includePermissions bool, includeAncestry bool, collapseBy *string, waitForRefresh bool, suggestOnEmpty bool, recencyBoost bool, profile *string, view *string, template *string, templateParams []string, sort *string, pageToken *string, trackTotalHits *string, bearerToken string, ifNoneMatch *string) *querysvc.QueryResourcesPayload {
 	v := &querysvc.QueryResourcesPayload{}
 	v.Version = version
 	v.Name = name
+	v.NameExact = nameExact
 	v.Parent = parent
+	v.Parents = parents
+	v.SearchScope = searchScope
 	v.Type = type_
 	v.Tags = tags
+	v.TagsMinMatch = tagsMinMatch
 	v.TagsAll = tagsAll
+	v.CreatedBy = createdBy
+	v.UpdatedBy = updatedBy
+	v.TransactionID = transactionID
+	v.ExcludeID = excludeID
+	v.IncludeScore = includeScore
+	v.IncludeChildCounts = includeChildCounts
+	v.IncludePermissions = includePermissions
+	v.IncludeAncestry = includeAncestry
+	v.CollapseBy = collapseBy
+	v.WaitForRefresh = waitForRefresh
+	v.SuggestOnEmpty = suggestOnEmpty
+	v.RecencyBoost = recencyBoost
+	v.Profile = profile
+	v.View = view
+	v.Template = template
+	v.TemplateParams = templateParams
 	v.Sort = sort
 	v.PageToken = pageToken
+	v.TrackTotalHits = trackTotalHits
 	v.BearerToken = bearerToken
+	v.IfNoneMatch = ifNoneMatch
 
 	return v
 }
 
 // NewQueryResourcesCountPayload builds a query-svc service
 // query-resources-count endpoint payload.
-func NewQueryResourcesCountPayload(version string, name *string, parent *string, type_ *string, tags []string, tagsAll []string, bearerToken string) *querysvc.QueryResourcesCountPayload {
+func NewQueryResourcesCountPayload(version string, name *string, parent *string, type_ *string, tags []string, tagsAll []string, includeRelations bool, bearerToken string) *querysvc.QueryResourcesCountPayload {
 	v := &querysvc.QueryResourcesCountPayload{}
 	v.Version = version
 	v.Name = name
@@ -410,17 +1424,122 @@ func NewQueryResourcesCountPayload(version string, name *string, parent *string,
 	v.Type = type_
 	v.Tags = tags
 	v.TagsAll = tagsAll
+	v.IncludeRelations = includeRelations
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// NewQueryResourcesCountBatchPayload builds a query-svc service
+// query-resources-count-batch endpoint payload.
+func NewQueryResourcesCountBatchPayload(body *QueryResourcesCountBatchRequestBody, version string, bearerToken string) *querysvc.QueryResourcesCountBatchPayload {
+	v := &querysvc.QueryResourcesCountBatchPayload{}
+	v.Queries = make([]*querysvc.CountQuery, len(body.Queries))
+	for i, val := range body.Queries {
+		v.Queries[i] = unmarshalCountQueryRequestBodyToQuerysvcCountQuery(val)
+	}
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// NewQueryResourcesFacetsPayload builds a query-svc service
+// query-resources-facets endpoint payload.
+func NewQueryResourcesFacetsPayload(version string, facet []string, name *string, parent *string, type_ *string, tags []string, tagsAll []string, includeTotal bool, bearerToken string) *querysvc.QueryResourcesFacetsPayload {
+	v := &querysvc.QueryResourcesFacetsPayload{}
+	v.Version = version
+	v.Facet = facet
+	v.Name = name
+	v.Parent = parent
+	v.Type = type_
+	v.Tags = tags
+	v.TagsAll = tagsAll
+	v.IncludeTotal = includeTotal
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// NewExplainResourcePayload builds a query-svc service explain-resource
+// endpoint payload.
+func NewExplainResourcePayload(version string, objectRef string, name *string, parent *string, type_ *string, tags []string, tagsAll []string, createdBy *string, bearerToken string) *querysvc.ExplainResourcePayload {
+	v := &querysvc.ExplainResourcePayload{}
+	v.Version = version
+	v.ObjectRef = objectRef
+	v.Name = name
+	v.Parent = parent
+	v.Type = type_
+	v.Tags = tags
+	v.TagsAll = tagsAll
+	v.CreatedBy = createdBy
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// NewCheckTuplesPayload builds a query-svc service check-tuples endpoint
+// payload.
+func NewCheckTuplesPayload(body *CheckTuplesRequestBody, version string, bearerToken string) *querysvc.CheckTuplesPayload {
+	v := &querysvc.CheckTuplesPayload{}
+	v.Tuples = make([]string, len(body.Tuples))
+	for i, val := range body.Tuples {
+		v.Tuples[i] = val
+	}
+	v.Version = version
 	v.BearerToken = bearerToken
 
 	return v
 }
 
 // NewQueryOrgsPayload builds a query-svc service query-orgs endpoint payload.
-func NewQueryOrgsPayload(version string, name *string, domain *string, bearerToken string) *querysvc.QueryOrgsPayload {
+func NewQueryOrgsPayload(version string, name *string, domain *string, domains []string, matchAll bool, bearerToken string) *querysvc.QueryOrgsPayload {
 	v := &querysvc.QueryOrgsPayload{}
 	v.Version = version
 	v.Name = name
 	v.Domain = domain
+	v.Domains = domains
+	v.MatchAll = matchAll
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// NewQueryOrgsListPayload builds a query-svc service query-orgs-list endpoint
+// payload.
+func NewQueryOrgsListPayload(version string, name *string, domain *string, matchAll bool, pageToken *string, bearerToken string) *querysvc.QueryOrgsListPayload {
+	v := &querysvc.QueryOrgsListPayload{}
+	v.Version = version
+	v.Name = name
+	v.Domain = domain
+	v.MatchAll = matchAll
+	v.PageToken = pageToken
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// NewCountOrgsPayload builds a query-svc service count-orgs endpoint payload.
+func NewCountOrgsPayload(version string, name *string, domain *string, matchAll bool, bearerToken string) *querysvc.CountOrgsPayload {
+	v := &querysvc.CountOrgsPayload{}
+	v.Version = version
+	v.Name = name
+	v.Domain = domain
+	v.MatchAll = matchAll
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// NewResolveOrgsPayload builds a query-svc service resolve-orgs endpoint
+// payload.
+func NewResolveOrgsPayload(body *ResolveOrgsRequestBody, version string, bearerToken string) *querysvc.ResolveOrgsPayload {
+	v := &querysvc.ResolveOrgsPayload{}
+	v.Domains = make([]string, len(body.Domains))
+	for i, val := range body.Domains {
+		v.Domains[i] = val
+	}
+	v.Version = version
 	v.BearerToken = bearerToken
 
 	return v
@@ -436,3 +1555,98 @@ func NewSuggestOrgsPayload(version string, query string, bearerToken string) *qu
 
 	return v
 }
+
+// NewUnifiedSearchPayload builds a query-svc service unified-search endpoint
+// payload.
+func NewUnifiedSearchPayload(version string, query string, bearerToken string) *querysvc.UnifiedSearchPayload {
+	v := &querysvc.UnifiedSearchPayload{}
+	v.Version = version
+	v.Query = query
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// NewSuggestPayload builds a query-svc service suggest endpoint payload.
+func NewSuggestPayload(version string, query string, kind []string, bearerToken string) *querysvc.SuggestPayload {
+	v := &querysvc.SuggestPayload{}
+	v.Version = version
+	v.Query = query
+	v.Kind = kind
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// NewFlushCachePayload builds a query-svc service flush-cache endpoint payload.
+func NewFlushCachePayload(version string, warm bool, bearerToken string) *querysvc.FlushCachePayload {
+	v := &querysvc.FlushCachePayload{}
+	v.Version = version
+	v.Warm = warm
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// ValidateQueryResourcesCountBatchRequestBody runs the validations defined on
+// Query-Resources-Count-BatchRequestBody
+func ValidateQueryResourcesCountBatchRequestBody(body *QueryResourcesCountBatchRequestBody) (err error) {
+	if body.Queries == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("queries", "body"))
+	}
+	if len(body.Queries) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.queries", body.Queries, len(body.Queries), 1, true))
+	}
+	if len(body.Queries) > 20 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.queries", body.Queries, len(body.Queries), 20, false))
+	}
+	for _, e := range body.Queries {
+		if e != nil {
+			if err2 := ValidateCountQueryRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateCheckTuplesRequestBody runs the validations defined on
+// Check-TuplesRequestBody
+func ValidateCheckTuplesRequestBody(body *CheckTuplesRequestBody) (err error) {
+	if body.Tuples == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tuples", "body"))
+	}
+	if len(body.Tuples) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.tuples", body.Tuples, len(body.Tuples), 1, true))
+	}
+	if len(body.Tuples) > 100 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.tuples", body.Tuples, len(body.Tuples), 100, false))
+	}
+	return
+}
+
+// ValidateResolveOrgsRequestBody runs the validations defined on
+// Resolve-OrgsRequestBody
+func ValidateResolveOrgsRequestBody(body *ResolveOrgsRequestBody) (err error) {
+	if body.Domains == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("domains", "body"))
+	}
+	if len(body.Domains) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.domains", body.Domains, len(body.Domains), 1, true))
+	}
+	if len(body.Domains) > 100 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.domains", body.Domains, len(body.Domains), 100, false))
+	}
+	return
+}
+
+// ValidateCountQueryRequestBody runs the validations defined on
+// CountQueryRequestBody
+func ValidateCountQueryRequestBody(body *CountQueryRequestBody) (err error) {
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 1, true))
+		}
+	}
+	return
+}
@@ -15,23 +15,43 @@ import (
 
 // Client is the "query-svc" service client.
 type Client struct {
-	QueryResourcesEndpoint      goa.Endpoint
-	QueryResourcesCountEndpoint goa.Endpoint
-	QueryOrgsEndpoint           goa.Endpoint
-	SuggestOrgsEndpoint         goa.Endpoint
-	ReadyzEndpoint              goa.Endpoint
-	LivezEndpoint               goa.Endpoint
+	QueryResourcesEndpoint           goa.Endpoint
+	QueryResourcesCountEndpoint      goa.Endpoint
+	QueryResourcesCountBatchEndpoint goa.Endpoint
+	QueryResourcesFacetsEndpoint     goa.Endpoint
+	ExplainResourceEndpoint          goa.Endpoint
+	CheckTuplesEndpoint              goa.Endpoint
+	QueryOrgsEndpoint                goa.Endpoint
+	QueryOrgsListEndpoint            goa.Endpoint
+	CountOrgsEndpoint                goa.Endpoint
+	ResolveOrgsEndpoint              goa.Endpoint
+	SuggestOrgsEndpoint              goa.Endpoint
+	UnifiedSearchEndpoint            goa.Endpoint
+	SuggestEndpoint                  goa.Endpoint
+	FlushCacheEndpoint               goa.Endpoint
+	ReadyzEndpoint                   goa.Endpoint
+	LivezEndpoint                    goa.Endpoint
 }
 
 // NewClient initializes a "query-svc" service client given the endpoints.
-func NewClient(queryResources, queryResourcesCount, queryOrgs, suggestOrgs, readyz, livez goa.Endpoint) *Client {
+func NewClient(queryResources, queryResourcesCount, queryResourcesCountBatch, queryResourcesFacets, explainResource, checkTuples, queryOrgs, queryOrgsList, countOrgs, resolveOrgs, suggestOrgs, unifiedSearch, suggest, flushCache, readyz, livez goa.Endpoint) *Client {
 	return &Client{
-		QueryResourcesEndpoint:      queryResources,
-		QueryResourcesCountEndpoint: queryResourcesCount,
-		QueryOrgsEndpoint:           queryOrgs,
-		SuggestOrgsEndpoint:         suggestOrgs,
-		ReadyzEndpoint:              readyz,
-		LivezEndpoint:               livez,
+		QueryResourcesEndpoint:           queryResources,
+		QueryResourcesCountEndpoint:      queryResourcesCount,
+		QueryResourcesCountBatchEndpoint: queryResourcesCountBatch,
+		QueryResourcesFacetsEndpoint:     queryResourcesFacets,
+		ExplainResourceEndpoint:          explainResource,
+		CheckTuplesEndpoint:              checkTuples,
+		QueryOrgsEndpoint:                queryOrgs,
+		QueryOrgsListEndpoint:            queryOrgsList,
+		CountOrgsEndpoint:                countOrgs,
+		ResolveOrgsEndpoint:              resolveOrgs,
+		SuggestOrgsEndpoint:              suggestOrgs,
+		UnifiedSearchEndpoint:            unifiedSearch,
+		SuggestEndpoint:                  suggest,
+		FlushCacheEndpoint:               flushCache,
+		ReadyzEndpoint:                   readyz,
+		LivezEndpoint:                    livez,
 	}
 }
 
@@ -69,6 +89,73 @@ func (c *Client) QueryResourcesCount(ctx context.Context, p *QueryResourcesCount
 	return ires.(*QueryResourcesCountResult), nil
 }
 
+// QueryResourcesCountBatch calls the "query-resources-count-batch" endpoint of
+// the "query-svc" service.
+// QueryResourcesCountBatch may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) QueryResourcesCountBatch(ctx context.Context, p *QueryResourcesCountBatchPayload) (res *QueryResourcesCountBatchResult, err error) {
+	var ires any
+	ires, err = c.QueryResourcesCountBatchEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*QueryResourcesCountBatchResult), nil
+}
+
+// QueryResourcesFacets calls the "query-resources-facets" endpoint of the
+// "query-svc" service.
+// QueryResourcesFacets may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) QueryResourcesFacets(ctx context.Context, p *QueryResourcesFacetsPayload) (res *QueryResourcesFacetsResult, err error) {
+	var ires any
+	ires, err = c.QueryResourcesFacetsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*QueryResourcesFacetsResult), nil
+}
+
+// ExplainResource calls the "explain-resource" endpoint of the "query-svc"
+// service.
+// ExplainResource may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ExplainResource(ctx context.Context, p *ExplainResourcePayload) (res *ResourceExplanation, err error) {
+	var ires any
+	ires, err = c.ExplainResourceEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ResourceExplanation), nil
+}
+
+// CheckTuples calls the "check-tuples" endpoint of the "query-svc" service.
+// CheckTuples may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) CheckTuples(ctx context.Context, p *CheckTuplesPayload) (res *CheckTuplesResult, err error) {
+	var ires any
+	ires, err = c.CheckTuplesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CheckTuplesResult), nil
+}
+
 // QueryOrgs calls the "query-orgs" endpoint of the "query-svc" service.
 // QueryOrgs may return the following errors:
 //   - "BadRequest" (type *BadRequestError): Bad request
@@ -85,6 +172,55 @@ func (c *Client) QueryOrgs(ctx context.Context, p *QueryOrgsPayload) (res *Organ
 	return ires.(*Organization), nil
 }
 
+// QueryOrgsList calls the "query-orgs-list" endpoint of the "query-svc"
+// service.
+// QueryOrgsList may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) QueryOrgsList(ctx context.Context, p *QueryOrgsListPayload) (res *QueryOrgsListResult, err error) {
+	var ires any
+	ires, err = c.QueryOrgsListEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*QueryOrgsListResult), nil
+}
+
+// CountOrgs calls the "count-orgs" endpoint of the "query-svc" service.
+// CountOrgs may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) CountOrgs(ctx context.Context, p *CountOrgsPayload) (res *CountOrgsResult, err error) {
+	var ires any
+	ires, err = c.CountOrgsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CountOrgsResult), nil
+}
+
+// ResolveOrgs calls the "resolve-orgs" endpoint of the "query-svc" service.
+// ResolveOrgs may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ResolveOrgs(ctx context.Context, p *ResolveOrgsPayload) (res *ResolveOrgsResult, err error) {
+	var ires any
+	ires, err = c.ResolveOrgsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ResolveOrgsResult), nil
+}
+
 // SuggestOrgs calls the "suggest-orgs" endpoint of the "query-svc" service.
 // SuggestOrgs may return the following errors:
 //   - "BadRequest" (type *BadRequestError): Bad request
@@ -101,6 +237,54 @@ func (c *Client) SuggestOrgs(ctx context.Context, p *SuggestOrgsPayload) (res *S
 	return ires.(*SuggestOrgsResult), nil
 }
 
+// UnifiedSearch calls the "unified-search" endpoint of the "query-svc" service.
+// UnifiedSearch may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) UnifiedSearch(ctx context.Context, p *UnifiedSearchPayload) (res *UnifiedSearchResult, err error) {
+	var ires any
+	ires, err = c.UnifiedSearchEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*UnifiedSearchResult), nil
+}
+
+// Suggest calls the "suggest" endpoint of the "query-svc" service.
+// Suggest may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) Suggest(ctx context.Context, p *SuggestPayload) (res *SuggestResult, err error) {
+	var ires any
+	ires, err = c.SuggestEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SuggestResult), nil
+}
+
+// FlushCache calls the "flush-cache" endpoint of the "query-svc" service.
+// FlushCache may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) FlushCache(ctx context.Context, p *FlushCachePayload) (res *FlushCacheResult, err error) {
+	var ires any
+	ires, err = c.FlushCacheEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*FlushCacheResult), nil
+}
+
 // Readyz calls the "readyz" endpoint of the "query-svc" service.
 // Readyz may return the following errors:
 //   - "NotReady" (type *goa.ServiceError): Service is not ready yet
@@ -16,12 +16,22 @@ import (
 
 // Endpoints wraps the "query-svc" service endpoints.
 type Endpoints struct {
-	QueryResources      goa.Endpoint
-	QueryResourcesCount goa.Endpoint
-	QueryOrgs           goa.Endpoint
-	SuggestOrgs         goa.Endpoint
-	Readyz              goa.Endpoint
-	Livez               goa.Endpoint
+	QueryResources           goa.Endpoint
+	QueryResourcesCount      goa.Endpoint
+	QueryResourcesCountBatch goa.Endpoint
+	QueryResourcesFacets     goa.Endpoint
+	ExplainResource          goa.Endpoint
+	CheckTuples              goa.Endpoint
+	QueryOrgs                goa.Endpoint
+	QueryOrgsList            goa.Endpoint
+	CountOrgs                goa.Endpoint
+	ResolveOrgs              goa.Endpoint
+	SuggestOrgs              goa.Endpoint
+	UnifiedSearch            goa.Endpoint
+	Suggest                  goa.Endpoint
+	FlushCache               goa.Endpoint
+	Readyz                   goa.Endpoint
+	Livez                    goa.Endpoint
 }
 
 // NewEndpoints wraps the methods of the "query-svc" service with endpoints.
@@ -29,12 +39,22 @@ func NewEndpoints(s Service) *Endpoints {
 	// Casting service to Auther interface
 	a := s.(Auther)
 	return &Endpoints{
-		QueryResources:      NewQueryResourcesEndpoint(s, a.JWTAuth),
-		QueryResourcesCount: NewQueryResourcesCountEndpoint(s, a.JWTAuth),
-		QueryOrgs:           NewQueryOrgsEndpoint(s, a.JWTAuth),
-		SuggestOrgs:         NewSuggestOrgsEndpoint(s, a.JWTAuth),
-		Readyz:              NewReadyzEndpoint(s),
-		Livez:               NewLivezEndpoint(s),
+		QueryResources:           NewQueryResourcesEndpoint(s, a.JWTAuth),
+		QueryResourcesCount:      NewQueryResourcesCountEndpoint(s, a.JWTAuth),
+		QueryResourcesCountBatch: NewQueryResourcesCountBatchEndpoint(s, a.JWTAuth),
+		QueryResourcesFacets:     NewQueryResourcesFacetsEndpoint(s, a.JWTAuth),
+		ExplainResource:          NewExplainResourceEndpoint(s, a.JWTAuth),
+		CheckTuples:              NewCheckTuplesEndpoint(s, a.JWTAuth),
+		QueryOrgs:                NewQueryOrgsEndpoint(s, a.JWTAuth),
+		QueryOrgsList:            NewQueryOrgsListEndpoint(s, a.JWTAuth),
+		CountOrgs:                NewCountOrgsEndpoint(s, a.JWTAuth),
+		ResolveOrgs:              NewResolveOrgsEndpoint(s, a.JWTAuth),
+		SuggestOrgs:              NewSuggestOrgsEndpoint(s, a.JWTAuth),
+		UnifiedSearch:            NewUnifiedSearchEndpoint(s, a.JWTAuth),
+		Suggest:                  NewSuggestEndpoint(s, a.JWTAuth),
+		FlushCache:               NewFlushCacheEndpoint(s, a.JWTAuth),
+		Readyz:                   NewReadyzEndpoint(s),
+		Livez:                    NewLivezEndpoint(s),
 	}
 }
 
@@ -42,8 +62,18 @@ func NewEndpoints(s Service) *Endpoints {
 func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.QueryResources = m(e.QueryResources)
 	e.QueryResourcesCount = m(e.QueryResourcesCount)
+	e.QueryResourcesCountBatch = m(e.QueryResourcesCountBatch)
+	e.QueryResourcesFacets = m(e.QueryResourcesFacets)
+	e.ExplainResource = m(e.ExplainResource)
+	e.CheckTuples = m(e.CheckTuples)
 	e.QueryOrgs = m(e.QueryOrgs)
+	e.QueryOrgsList = m(e.QueryOrgsList)
+	e.CountOrgs = m(e.CountOrgs)
+	e.ResolveOrgs = m(e.ResolveOrgs)
 	e.SuggestOrgs = m(e.SuggestOrgs)
+	e.UnifiedSearch = m(e.UnifiedSearch)
+	e.Suggest = m(e.Suggest)
+	e.FlushCache = m(e.FlushCache)
 	e.Readyz = m(e.Readyz)
 	e.Livez = m(e.Livez)
 }
@@ -86,6 +116,82 @@ func NewQueryResourcesCountEndpoint(s Service, authJWTFn security.AuthJWTFunc) g
 	}
 }
 
+// NewQueryResourcesCountBatchEndpoint returns an endpoint function that calls
+// the method "query-resources-count-batch" of service "query-svc".
+func NewQueryResourcesCountBatchEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*QueryResourcesCountBatchPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authJWTFn(ctx, p.BearerToken, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.QueryResourcesCountBatch(ctx, p)
+	}
+}
+
+// NewQueryResourcesFacetsEndpoint returns an endpoint function that calls the
+// method "query-resources-facets" of service "query-svc".
+func NewQueryResourcesFacetsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*QueryResourcesFacetsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authJWTFn(ctx, p.BearerToken, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.QueryResourcesFacets(ctx, p)
+	}
+}
+
+// NewExplainResourceEndpoint returns an endpoint function that calls the
+// method "explain-resource" of service "query-svc".
+func NewExplainResourceEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ExplainResourcePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authJWTFn(ctx, p.BearerToken, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ExplainResource(ctx, p)
+	}
+}
+
+// NewCheckTuplesEndpoint returns an endpoint function that calls the method
+// "check-tuples" of service "query-svc".
+func NewCheckTuplesEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CheckTuplesPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authJWTFn(ctx, p.BearerToken, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.CheckTuples(ctx, p)
+	}
+}
+
 // NewQueryOrgsEndpoint returns an endpoint function that calls the method
 // "query-orgs" of service "query-svc".
 func NewQueryOrgsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
@@ -105,6 +211,63 @@ func NewQueryOrgsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoin
 	}
 }
 
+// NewQueryOrgsListEndpoint returns an endpoint function that calls the method
+// "query-orgs-list" of service "query-svc".
+func NewQueryOrgsListEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*QueryOrgsListPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authJWTFn(ctx, p.BearerToken, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.QueryOrgsList(ctx, p)
+	}
+}
+
+// NewCountOrgsEndpoint returns an endpoint function that calls the method
+// "count-orgs" of service "query-svc".
+func NewCountOrgsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CountOrgsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authJWTFn(ctx, p.BearerToken, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.CountOrgs(ctx, p)
+	}
+}
+
+// NewResolveOrgsEndpoint returns an endpoint function that calls the method
+// "resolve-orgs" of service "query-svc".
+func NewResolveOrgsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ResolveOrgsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authJWTFn(ctx, p.BearerToken, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ResolveOrgs(ctx, p)
+	}
+}
+
 // NewSuggestOrgsEndpoint returns an endpoint function that calls the method
 // "suggest-orgs" of service "query-svc".
 func NewSuggestOrgsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
@@ -124,6 +287,63 @@ func NewSuggestOrgsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpo
 	}
 }
 
+// NewUnifiedSearchEndpoint returns an endpoint function that calls the method
+// "unified-search" of service "query-svc".
+func NewUnifiedSearchEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UnifiedSearchPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authJWTFn(ctx, p.BearerToken, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.UnifiedSearch(ctx, p)
+	}
+}
+
+// NewSuggestEndpoint returns an endpoint function that calls the method
+// "suggest" of service "query-svc".
+func NewSuggestEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SuggestPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authJWTFn(ctx, p.BearerToken, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.Suggest(ctx, p)
+	}
+}
+
+// NewFlushCacheEndpoint returns an endpoint function that calls the method
+// "flush-cache" of service "query-svc".
+func NewFlushCacheEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*FlushCachePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authJWTFn(ctx, p.BearerToken, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.FlushCache(ctx, p)
+	}
+}
+
 // NewReadyzEndpoint returns an endpoint function that calls the method
 // "readyz" of service "query-svc".
 func NewReadyzEndpoint(s Service) goa.Endpoint {
@@ -21,10 +21,40 @@ type Service interface {
 	QueryResources(context.Context, *QueryResourcesPayload) (res *QueryResourcesResult, err error)
 	// Count matching resources by query.
 	QueryResourcesCount(context.Context, *QueryResourcesCountPayload) (res *QueryResourcesCountResult, err error)
+	// Count matching resources for several queries at once. A failing query does
+	// not fail the batch: its item reports the error instead.
+	QueryResourcesCountBatch(context.Context, *QueryResourcesCountBatchPayload) (res *QueryResourcesCountBatchResult, err error)
+	// Break the matching resources down by the values of one or more fields,
+	// counting only the resources the principal can access.
+	QueryResourcesFacets(context.Context, *QueryResourcesFacetsPayload) (res *QueryResourcesFacetsResult, err error)
+	// Explain why a resource search does or doesn't return a resource. Only
+	// available to the principals configured for debugging.
+	ExplainResource(context.Context, *ExplainResourcePayload) (res *ResourceExplanation, err error)
+	// Check a list of access check tuples and return the answer of each, to debug
+	// access issues. Only available to the principals configured for debugging.
+	CheckTuples(context.Context, *CheckTuplesPayload) (res *CheckTuplesResult, err error)
 	// Locate a single organization by name or domain.
 	QueryOrgs(context.Context, *QueryOrgsPayload) (res *Organization, err error)
+	// List the organizations matching a name fragment or domain, ordered by
+	// relevance.
+	QueryOrgsList(context.Context, *QueryOrgsListPayload) (res *QueryOrgsListResult, err error)
+	// Count the organizations matching a name fragment or domain, or all of them
+	// when neither is given.
+	CountOrgs(context.Context, *CountOrgsPayload) (res *CountOrgsResult, err error)
+	// Resolve a batch of domains or website URLs to their organizations in one
+	// call.
+	ResolveOrgs(context.Context, *ResolveOrgsPayload) (res *ResolveOrgsResult, err error)
 	// Get organization suggestions for typeahead search based on a query.
 	SuggestOrgs(context.Context, *SuggestOrgsPayload) (res *SuggestOrgsResult, err error)
+	// Search resources and organizations in one call, e.g. for a global search bar.
+	UnifiedSearch(context.Context, *UnifiedSearchPayload) (res *UnifiedSearchResult, err error)
+	// Suggest resources and organizations matching a query in a single list
+	// ordered by relevance, e.g. for a typeahead.
+	Suggest(context.Context, *SuggestPayload) (res *SuggestResult, err error)
+	// Clear the cached search results, e.g. after a reindex, and warm the cache up
+	// again with the configured queries. Only available to the cache admin
+	// principals.
+	FlushCache(context.Context, *FlushCachePayload) (res *FlushCacheResult, err error)
 	// Check if the service is able to take inbound requests.
 	Readyz(context.Context) (res []byte, err error)
 	// Check if the service is alive.
@@ -51,13 +81,138 @@ const ServiceName = "query-svc"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [6]string{"query-resources", "query-resources-count", "query-orgs", "suggest-orgs", "readyz", "livez"}
+var MethodNames = [16]string{"query-resources", "query-resources-count", "query-resources-count-batch", "query-resources-facets", "explain-resource", "check-tuples", "query-orgs", "query-orgs-list", "count-orgs", "resolve-orgs", "suggest-orgs", "unified-search", "suggest", "flush-cache", "readyz", "livez"}
 
 type BadRequestError struct {
 	// Error message
 	Message string
 }
 
+// CheckTuplesPayload is the payload type of the query-svc service check-tuples
+// method.
+type CheckTuplesPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken string
+	// Version of the API
+	Version string
+	// Access check tuples, as <object>#<relation>@<user>
+	Tuples []string
+}
+
+// CheckTuplesResult is the result type of the query-svc service check-tuples
+// method.
+type CheckTuplesResult struct {
+	// Answer of the access checker for each tuple
+	Results map[string]string
+}
+
+// The outcome of a count query of a batch: the count, or the error when the
+// query failed.
+type CountItem struct {
+	// Count of resources found
+	Count *uint64
+	// True if count is not guaranteed to be exhaustive: client should request a
+	// narrower query
+	HasMore *bool
+	// Error of the query, if it failed
+	Error *CountItemError
+}
+
+// The error of a count query of a batch that failed.
+type CountItemError struct {
+	// Error code
+	Code string
+	// Error message
+	Message string
+}
+
+// CountOrgsPayload is the payload type of the query-svc service count-orgs
+// method.
+type CountOrgsPayload struct {
+	// Token
+	BearerToken string
+	// Version of the API
+	Version string
+	// Organization name or name fragment
+	Name *string
+	// Organization domain or website URL
+	Domain *string
+	// Require both name and domain to match the same organization
+	MatchAll bool
+}
+
+// CountOrgsResult is the result type of the query-svc service count-orgs
+// method.
+type CountOrgsResult struct {
+	// Number of organizations found
+	Count int
+}
+
+// A single resource count query of a batch.
+type CountQuery struct {
+	// Resource name or alias; supports typeahead
+	Name *string
+	// Parent (for navigation; varies by object type)
+	Parent *string
+	// Resource type to search
+	Type *string
+	// Tags to search with OR logic - matches resources with any of these tags
+	Tags []string
+	// Tags to search with AND logic - matches resources that have all of these tags
+	TagsAll []string
+}
+
+// ExplainResourcePayload is the payload type of the query-svc service
+// explain-resource method.
+type ExplainResourcePayload struct {
+	// JWT token issued by Heimdall
+	BearerToken string
+	// Version of the API
+	Version string
+	// Reference of the resource to explain
+	ObjectRef string
+	// Resource name or alias; supports typeahead
+	Name *string
+	// Parent (for navigation; varies by object type)
+	Parent *string
+	// Resource type to search
+	Type *string
+	// Tags to search with OR logic - matches resources with any of these tags
+	Tags []string
+	// Tags to search with AND logic - matches resources that have all of these tags
+	TagsAll []string
+	// Principal who created the resource
+	CreatedBy *string
+}
+
+// The number of accessible resources with a value of a facet field.
+type FacetValue struct {
+	// Value of the field
+	Value string
+	// Count of resources with the value
+	Count uint64
+}
+
+// FlushCachePayload is the payload type of the query-svc service flush-cache
+// method.
+type FlushCachePayload struct {
+	// JWT token issued by Heimdall
+	BearerToken string
+	// Version of the API
+	Version string
+	// Run the configured warm-up queries after flushing
+	Warm bool
+}
+
+// FlushCacheResult is the result type of the query-svc service flush-cache
+// method.
+type FlushCacheResult struct {
+	// Number of cached results cleared
+	Flushed int
+	// Number of warm-up queries cached again
+	Warmed int
+}
+
 type InternalServerError struct {
 	// Error message
 	Message string
@@ -80,10 +235,14 @@ type Organization struct {
 	Sector *string
 	// Employee count or range
 	Employees *string
+	// Further fields of the organization, as provided by its source
+	Metadata map[string]any
 }
 
 // An organization suggestion for the search.
 type OrganizationSuggestion struct {
+	// Kind of the suggestion
+	Kind string
 	// Organization name
 	Name string
 	// Organization domain
@@ -92,6 +251,32 @@ type OrganizationSuggestion struct {
 	Logo *string
 }
 
+// QueryOrgsListPayload is the payload type of the query-svc service
+// query-orgs-list method.
+type QueryOrgsListPayload struct {
+	// Token
+	BearerToken string
+	// Version of the API
+	Version string
+	// Organization name or name fragment
+	Name *string
+	// Organization domain or website URL
+	Domain *string
+	// Require both name and domain to match the same organization
+	MatchAll bool
+	// Opaque token for pagination
+	PageToken *string
+}
+
+// QueryOrgsListResult is the result type of the query-svc service
+// query-orgs-list method.
+type QueryOrgsListResult struct {
+	// Organizations found, ordered by relevance
+	Organizations []*Organization
+	// Opaque token if more results are available
+	PageToken *string
+}
+
 // QueryOrgsPayload is the payload type of the query-svc service query-orgs
 // method.
 type QueryOrgsPayload struct {
@@ -103,6 +288,29 @@ type QueryOrgsPayload struct {
 	Name *string
 	// Organization domain or website URL
 	Domain *string
+	// Alternative organization domains or website URLs, any of which may match;
+	// the organization of the first one that has one is returned
+	Domains []string
+	// Require both name and domain to match the same organization
+	MatchAll bool
+}
+
+// QueryResourcesCountBatchPayload is the payload type of the query-svc service
+// query-resources-count-batch method.
+type QueryResourcesCountBatchPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken string
+	// Version of the API
+	Version string
+	// Count queries
+	Queries []*CountQuery
+}
+
+// QueryResourcesCountBatchResult is the result type of the query-svc service
+// query-resources-count-batch method.
+type QueryResourcesCountBatchResult struct {
+	// Count outcomes, in the same order as the queries
+	Items []*CountItem
 }
 
 // QueryResourcesCountPayload is the payload type of the query-svc service
@@ -122,6 +330,9 @@ type QueryResourcesCountPayload struct {
 	Tags []string
 	// Tags to search with AND logic - matches resources that have all of these tags
 	TagsAll []string
+	// Break the count of private resources down by the relation granting access to
+	// them
+	IncludeRelations bool
 }
 
 // QueryResourcesCountResult is the result type of the query-svc service
@@ -129,11 +340,65 @@ type QueryResourcesCountPayload struct {
 type QueryResourcesCountResult struct {
 	// Count of resources found
 	Count uint64
+	// Count of resources found that the principal may access, the same as count
+	VisibleCount uint64
+	// Count of resources found before the access checks, including those the
+	// principal may not access (only reported to the allowed principals)
+	TotalCount *uint64
 	// True if count is not guaranteed to be exhaustive: client should request a
 	// narrower query
 	HasMore bool
 	// Cache control header
 	CacheControl *string
+	// True when the anonymous public-only path served the result (only reported
+	// when enabled)
+	PublicPath *bool
+	// True when the fallback search source served the result, as the primary one
+	// failed
+	Degraded *bool
+	// Count of private resources by the relation granting access to them, when
+	// include_relations is set
+	RelationCounts map[string]uint64
+}
+
+// QueryResourcesFacetsPayload is the payload type of the query-svc service
+// query-resources-facets method.
+type QueryResourcesFacetsPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken string
+	// Version of the API
+	Version string
+	// Fields to break the resources down by
+	Facet []string
+	// Resource name or alias; supports typeahead
+	Name *string
+	// Parent (for navigation; varies by object type)
+	Parent *string
+	// Resource type to search
+	Type *string
+	// Tags to search with OR logic - matches resources with any of these tags
+	Tags []string
+	// Tags to search with AND logic - matches resources that have all of these tags
+	TagsAll []string
+	// Also count the matching resources the principal can access
+	IncludeTotal bool
+}
+
+// QueryResourcesFacetsResult is the result type of the query-svc service
+// query-resources-facets method.
+type QueryResourcesFacetsResult struct {
+	// Values of each requested field, by descending count
+	Facets map[string][]*FacetValue
+	// Number of matching resources the principal can access, when include_total is
+	// set
+	Total *uint64
+	// Whether total is exact (eq) or a lower bound (gte), when include_total is set
+	TotalRelation *string
+	// Cache control header
+	CacheControl *string
+	// True when the anonymous public-only path served the result (only reported
+	// when enabled)
+	PublicPath *bool
 }
 
 // QueryResourcesPayload is the payload type of the query-svc service
@@ -145,16 +410,78 @@ type QueryResourcesPayload struct {
 	Version string
 	// Resource name or alias; supports typeahead
 	Name *string
+	// Whole resource name, matched exactly but regardless of case
+	NameExact *string
 	// Parent (for navigation; varies by object type)
 	Parent *string
+	// Parents to search with OR logic - matches resources under any of these
+	// parents, or under parent when also given
+	Parents []string
+	// Fields the name search matches: names only, or descriptions as well;
+	// defaults to the deployment setting
+	SearchScope *string
 	// Resource type to search
 	Type *string
 	// Tags to search with OR logic - matches resources with any of these tags
 	Tags []string
+	// Minimum number of tags a resource must match; defaults to 1 and may not
+	// exceed the number of tags
+	TagsMinMatch *int
 	// Tags to search with AND logic - matches resources that have all of these tags
 	TagsAll []string
-	// Sort order for results
-	Sort string
+	// Principal who created the resource; only narrows the results, access control
+	// still applies
+	CreatedBy *string
+	// Principal who last updated the resource; only narrows the results, access
+	// control still applies
+	UpdatedBy *string
+	// Transaction that last wrote the resource, e.g. to reconcile after an update
+	// event
+	TransactionID *string
+	// Resource IDs to leave out of the results; may be repeated
+	ExcludeID []string
+	// Include the relevance score of each resource in the response
+	IncludeScore bool
+	// Include the number of children of each resource, by child type
+	IncludeChildCounts bool
+	// Include whether the caller has each configured relation beyond viewing (e.g.
+	// edit) on each resource
+	IncludePermissions bool
+	// Field whose duplicates are collapsed, returning only the first resource of
+	// each group sharing its value
+	CollapseBy *string
+	// Include the references of the ancestors of each resource (e.g. its project
+	// and committee), for breadcrumbs
+	IncludeAncestry bool
+	// Wait for recent writes to become searchable before searching, at the cost of
+	// latency; reserved to privileged callers
+	WaitForRefresh bool
+	// When a name search finds nothing, return alternative names ("did you mean")
+	// in suggestions
+	SuggestOnEmpty bool
+	// Rank recently updated resources higher, their boost halving over the
+	// deployment's recency boost scale (30 days unless configured); affects
+	// relevance only, not the requested sort order
+	RecencyBoost bool
+	// Name of a server-side search template whose query narrows the search
+	Template *string
+	// Parameters of the search template, as name=value
+	TemplateParams []string
+	// Named search profile whose settings (sort, search scope, fuzziness, recency
+	// boost) apply unless overridden by explicit parameters
+	Profile *string
+	// Shape of the resource data: default returns it as indexed, public strips
+	// fields prefixed with _ and internal fields
+	View *string
+	// ETag of a previously returned anonymous result, to revalidate it
+	IfNoneMatch *string
+	// How accurately the total of an anonymous search is counted: true counts
+	// every hit, false disables counting, and a number counts hits exactly up to
+	// it; defaults to the deployment setting
+	TrackTotalHits *string
+	// Sort order for results; defaults to the search profile sort, if any, or
+	// name_asc
+	Sort *string
 	// Opaque token for pagination
 	PageToken *string
 }
@@ -164,10 +491,56 @@ type QueryResourcesPayload struct {
 type QueryResourcesResult struct {
 	// Resources found
 	Resources []*Resource
+	// Total number of resources found; only reported for anonymous searches, whose
+	// hits need no access check
+	Total *int
+	// Whether total is exact (eq) or a lower bound (gte)
+	TotalRelation *string
 	// Opaque token if more results are available
 	PageToken *string
+	// Alternative names for a name search that found nothing, when
+	// suggest_on_empty is set
+	Suggestions []string
 	// Cache control header
 	CacheControl *string
+	// True when the anonymous public-only path served the result (only reported
+	// when enabled)
+	PublicPath *bool
+	// True when the fallback search source served the result, as the primary one
+	// failed
+	Degraded *bool
+	// RFC 8288 link to the next page, if more results are available
+	Link *string
+	// Entity tag of the result; only set for anonymous results
+	Etag *string
+	// Set to "not-modified" when the If-None-Match ETag still matches
+	CacheStatus *string
+}
+
+// ResolveOrgsPayload is the payload type of the query-svc service resolve-orgs
+// method.
+type ResolveOrgsPayload struct {
+	// Token
+	BearerToken string
+	// Version of the API
+	Version string
+	// Domains or website URLs to resolve
+	Domains []string
+}
+
+// ResolveOrgsResult is the result type of the query-svc service resolve-orgs
+// method.
+type ResolveOrgsResult struct {
+	// Resolution of each domain, in the same order as the request
+	Organizations []*ResolvedOrganization
+}
+
+// The organization a domain resolved to, if any.
+type ResolvedOrganization struct {
+	// Requested domain, as given
+	Domain string
+	// Organization of the domain; absent when the domain has none
+	Organization *Organization
 }
 
 // A resource is a universal representation of an LFX API resource for indexing.
@@ -176,8 +549,53 @@ type Resource struct {
 	Type *string
 	// Resource ID (within its resource collection)
 	ID *string
+	// ID of the search index document of the resource, which may differ from the
+	// resource ID
+	DocumentID *string
+	// Search index that served the resource; only reported in debug mode
+	Index *string
+	// Principal who created the resource, when indexed
+	CreatedBy *string
+	// Principal who last updated the resource, when indexed
+	UpdatedBy *string
 	// Resource data snapshot
 	Data any
+	// Relevance score assigned by the search backend; only returned when requested
+	Score *float64
+	// Relevance score relative to the best match of the search, between 0 and 1;
+	// only returned when requested
+	NormalizedScore *float64
+	// Requested tags the resource matched when filtering by tags; only returned to
+	// authenticated users
+	MatchedTags []string
+	// Number of accessible children of the resource, by child type; only returned
+	// when requested
+	ChildCounts map[string]uint64
+	// Whether the caller has each configured relation beyond viewing on the
+	// resource; only returned when requested
+	Permissions map[string]bool
+	// References of the ancestors of the resource, as indexed; only returned when
+	// requested
+	Ancestry []string
+}
+
+// ResourceExplanation is the result type of the query-svc service
+// explain-resource method.
+type ResourceExplanation struct {
+	// Reference of the explained resource
+	ObjectRef string
+	// Stage of the search that decided the outcome
+	Stage string
+	// Human readable explanation of the outcome
+	Reason string
+	// True if the resource is indexed
+	Found bool
+	// True if the resource matches the search criteria
+	MatchedCriteria bool
+	// Access check the resource needs, if any
+	AccessCheckQuery *string
+	// True if the principal may see the resource
+	AccessGranted bool
 }
 
 type ServiceUnavailableError struct {
@@ -203,6 +621,61 @@ type SuggestOrgsResult struct {
 	Suggestions []*OrganizationSuggestion
 }
 
+// SuggestPayload is the payload type of the query-svc service suggest method.
+type SuggestPayload struct {
+	// Token
+	BearerToken string
+	// Version of the API
+	Version string
+	// Search query, matched against resource names and organizations
+	Query string
+	// Kinds of suggestions to return; may be repeated (default: all kinds)
+	Kind []string
+}
+
+// SuggestResult is the result type of the query-svc service suggest method.
+type SuggestResult struct {
+	// Suggestions of the requested kinds, by relevance
+	Suggestions []*Suggestion
+	// True when the organization suggestions failed and are left out
+	OrganizationsUnavailable bool
+}
+
+// A suggestion of any kind: the attribute named after its kind holds what is
+// suggested.
+type Suggestion struct {
+	// Kind of the suggestion
+	Kind string
+	// Name to display for the suggestion
+	Name string
+	// Resource suggested, for resource suggestions
+	Resource *Resource
+	// Organization suggested, for organization suggestions
+	Organization *OrganizationSuggestion
+}
+
+// UnifiedSearchPayload is the payload type of the query-svc service
+// unified-search method.
+type UnifiedSearchPayload struct {
+	// Token
+	BearerToken string
+	// Version of the API
+	Version string
+	// Search query, matched against resource names and organizations
+	Query string
+}
+
+// UnifiedSearchResult is the result type of the query-svc service
+// unified-search method.
+type UnifiedSearchResult struct {
+	// Resources found, limited to the section size
+	Resources []*Resource
+	// Organizations suggested, limited to the section size
+	Organizations []*OrganizationSuggestion
+	// True when the organization search failed and its section is empty
+	OrganizationsUnavailable bool
+}
+
 // Error returns an error description.
 func (e *BadRequestError) Error() string {
 	return ""
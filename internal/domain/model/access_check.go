@@ -5,3 +5,16 @@ package model
 
 // AccessCheckResult contains the results of access verification
 type AccessCheckResult map[string]string
+
+// Policies for resources lacking the metadata needed for an access check
+const (
+	// MissingAccessMetadataSkip logs and excludes private resources, while
+	// public ones are returned without a check
+	MissingAccessMetadataSkip = "skip"
+	// MissingAccessMetadataDeny excludes every resource of the type, public
+	// or not, logging each one as denied
+	MissingAccessMetadataDeny = "deny"
+	// MissingAccessMetadataIncludeIfPublic returns public resources and
+	// excludes private ones, without logging what is expected of the type
+	MissingAccessMetadataIncludeIfPublic = "include-if-public"
+)
@@ -3,6 +3,11 @@
 
 package model
 
+import (
+	"strings"
+	"unicode"
+)
+
 // Organization represents an organization entity
 type Organization struct {
 	// Organization name
@@ -15,8 +20,23 @@ type Organization struct {
 	Sector string `json:"sector"`
 	// Employee count or range
 	Employees string `json:"employees"`
+	// Metadata holds any further fields of the organization (e.g. "website"),
+	// returned as is
+	Metadata map[string]any `json:"metadata,omitempty"`
+}
+
+// OrganizationsResult contains a page of the organizations matching a list search
+type OrganizationsResult struct {
+	// Organizations found, ordered by relevance
+	Organizations []Organization `json:"organizations"`
+	// Total number of organizations matching the search
+	Total int `json:"total"`
 }
 
+// SuggestionKindOrganization is the kind of organization suggestions, telling
+// them apart from other kinds of suggestions
+const SuggestionKindOrganization = "organization"
+
 // OrganizationSuggestion represents a suggested organization for typeahead search
 type OrganizationSuggestion struct {
 	// Organization name
@@ -32,3 +52,32 @@ type OrganizationSuggestionsResult struct {
 	// Suggestions found
 	Suggestions []OrganizationSuggestion `json:"suggestions"`
 }
+
+// legalSuffixes are the legal form suffixes ignored when matching organization names
+var legalSuffixes = map[string]struct{}{
+	"inc":          {},
+	"incorporated": {},
+	"llc":          {},
+	"ltd":          {},
+	"limited":      {},
+	"corp":         {},
+	"corporation":  {},
+	"gmbh":         {},
+	"pty":          {},
+}
+
+// NormalizeOrganizationName returns the name of an organization as matched,
+// regardless of case, punctuation and legal form: "Acme, Inc.", "Acme LLC" and
+// "ACME Pty Ltd" are all "acme". A name made of a legal suffix alone is kept.
+func NormalizeOrganizationName(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for len(words) > 1 {
+		if _, ok := legalSuffixes[words[len(words)-1]]; !ok {
+			break
+		}
+		words = words[:len(words)-1]
+	}
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,36 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeOrganizationName(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "no suffix", input: "Acme", expected: "acme"},
+		{name: "inc", input: "Acme Inc", expected: "acme"},
+		{name: "inc with punctuation", input: "Acme, Inc.", expected: "acme"},
+		{name: "llc", input: "Acme LLC", expected: "acme"},
+		{name: "ltd", input: "Acme Ltd.", expected: "acme"},
+		{name: "corp", input: "ACME Corp", expected: "acme"},
+		{name: "gmbh", input: "Acme GmbH", expected: "acme"},
+		{name: "pty ltd", input: "Acme Pty Ltd", expected: "acme"},
+		{name: "suffix within the name is kept", input: "Inc Publishing Ltd", expected: "inc publishing"},
+		{name: "suffix alone is kept", input: "LLC", expected: "llc"},
+		{name: "punctuation between words", input: "Zyx-42 Quantum Widgets", expected: "zyx 42 quantum widgets"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, NormalizeOrganizationName(tc.input))
+		})
+	}
+}
@@ -3,14 +3,36 @@
 
 package model
 
+import "slices"
+
 // Resource represents a domain resource entity
 type Resource struct {
 	// Resource type
 	Type string
 	// Resource ID (within its resource collection)
 	ID string
+	// DocumentID is the ID of the search backend document of the resource,
+	// which may differ from the resource ID
+	DocumentID string
+	// Index is the search backend index that served the resource, for debugging
+	Index string
 	// Resource data snapshot
 	Data any
+	// Score is the relevance score assigned by the search backend, if any
+	Score *float64
+	// NormalizedScore is the score relative to the best match of the search,
+	// between 0 and 1, if the backend scored the results
+	NormalizedScore *float64
+	// MatchedTags lists the requested tags (OR filter) the resource matched
+	MatchedTags []string
+	// ChildCounts is the number of accessible children of the resource, by child type
+	ChildCounts map[string]uint64
+	// Permissions tells, by relation (e.g. "edit"), whether the principal has
+	// it on the resource; only set when requested
+	Permissions map[string]bool
+	// Ancestry lists the references of the ancestors of the resource (e.g.
+	// "project:123"), for breadcrumbs; only set when requested
+	Ancestry []string
 	// Metadata about the resource
 	TransactionBodyStub
 	// NeedCheck indicates if access control check is needed
@@ -31,4 +53,92 @@ type TransactionBodyStub struct {
 	HistoryCheckRelation string `json:"history_check_relation"`
 	AccessCheckQuery     string `json:"access_check_query"`
 	HistoryCheckQuery    string `json:"history_check_query"`
+	// TransactionID is the transaction that last wrote the resource; it
+	// assumes the indexer stores the ID of each transaction it applies in
+	// the top-level transaction_id (keyword) field of the document
+	TransactionID string `json:"transaction_id"`
+	// CreatedBy and UpdatedBy are the principals who created and last
+	// updated the resource, from the top-level created_by and updated_by
+	// (keyword) fields of the document
+	CreatedBy string `json:"created_by"`
+	UpdatedBy string `json:"updated_by"`
+	// ParentRefs are the references of the ancestors of the resource, from
+	// the top-level parent_refs (keyword) field of the document
+	ParentRefs []string `json:"parent_refs"`
+}
+
+// MatchTags returns the requested tags present in tags, in the requested
+// order and without duplicates; nil if none match.
+func MatchTags(requested, tags []string) []string {
+	var matched []string
+	for _, requestedTag := range requested {
+		if slices.Contains(tags, requestedTag) && !slices.Contains(matched, requestedTag) {
+			matched = append(matched, requestedTag)
+		}
+	}
+	return matched
+}
+
+// ExtractTags returns the "tags" of a resource data snapshot as a string
+// slice. Tags may be stored as []string, as []interface{} (the shape produced
+// by decoding JSON arrays), or as a single string; non-string elements are
+// ignored.
+func ExtractTags(data any) []string {
+	dataMap, ok := data.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	switch tags := dataMap["tags"].(type) {
+	case []string:
+		return tags
+	case []any:
+		result := make([]string, 0, len(tags))
+		for _, tag := range tags {
+			if tagStr, ok := tag.(string); ok {
+				result = append(result, tagStr)
+			}
+		}
+		return result
+	case string:
+		if tags == "" {
+			return nil
+		}
+		return []string{tags}
+	default:
+		return nil
+	}
+}
+
+// ExplanationStage is the stage of a search that decided whether a resource was returned
+type ExplanationStage string
+
+const (
+	// ExplanationStageNotFound means no current revision of the resource is indexed
+	ExplanationStageNotFound ExplanationStage = "not_found"
+	// ExplanationStageFilteredOut means the resource does not match the search criteria
+	ExplanationStageFilteredOut ExplanationStage = "filtered_out"
+	// ExplanationStageAccessDenied means the access check did not grant the resource
+	ExplanationStageAccessDenied ExplanationStage = "access_denied"
+	// ExplanationStageReturned means the search returns the resource
+	ExplanationStageReturned ExplanationStage = "returned"
+)
+
+// ResourceExplanation explains why a search does or doesn't return a resource
+type ResourceExplanation struct {
+	// ObjectRef of the explained resource
+	ObjectRef string
+	// Stage that decided the outcome
+	Stage ExplanationStage
+	// Reason is a human readable explanation of the outcome
+	Reason string
+	// Found indicates the resource is indexed
+	Found bool
+	// MatchedCriteria indicates the resource matches the search criteria
+	MatchedCriteria bool
+	// AccessCheckQuery is the access check the resource needs, if any
+	// (e.g. "committee:123#viewer@user:jdoe")
+	AccessCheckQuery string
+	// AccessGranted indicates the principal may see the resource
+	AccessGranted bool
 }
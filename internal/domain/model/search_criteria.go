@@ -7,16 +7,46 @@ package model
 type SearchCriteria struct {
 	// Tags to filter resources with OR logic (any tag matches)
 	Tags []string
+	// TagsMinMatch is the minimum number of Tags a resource must match; defaults to 1
+	TagsMinMatch *int
 	// TagsAll to filter resources with AND logic (all tags must match)
 	TagsAll []string
 	// Resource name or alias; supports typeahead
 	Name *string
+	// NameExact matches the whole resource name, regardless of case, unlike
+	// the substring match of Name
+	NameExact *string
+	// SearchScope is the scope of the name search, SearchScopeName or
+	// SearchScopeAll; empty matches the configured name fields as they are
+	SearchScope string
+	// Template is the name of a server-side search template whose query
+	// clause is added to the filters, rendered with TemplateParams
+	Template       string
+	TemplateParams map[string]string
 	// Parent (for navigation; varies by object type)
 	Parent *string
+	// Parents restricts the search to resources under any of these parents,
+	// together with Parent when both are set
+	Parents []string
 	// ParentRef is a reference to the parent resource
 	ParentRef *string
 	// ResourceType to search
 	ResourceType *string
+	// CreatedBy restricts the search to resources created by this principal;
+	// it is only a filter, access control still applies to the results
+	CreatedBy *string
+	// UpdatedBy restricts the search to resources last updated by this
+	// principal, likewise only a filter
+	UpdatedBy *string
+	// TransactionID restricts the search to resources last written by this
+	// transaction, e.g. to reconcile after an update event
+	TransactionID *string
+	// ExcludeIDs are resource IDs to leave out of the results
+	ExcludeIDs []string
+	// ObjectRef restricts the search to a single resource (e.g. "committee:123")
+	ObjectRef *string
+	// ResourceTypes restricts the search to these types (e.g. a deployment allowlist)
+	ResourceTypes []string
 	// SearchAfter is used for pagination
 	SearchAfter *string
 	// Sortby order for results
@@ -25,7 +55,7 @@ type SearchCriteria struct {
 	SortOrder string
 	// Opaque token for pagination
 	PageToken *string
-	// Pagesize for pagination
+	// Pagesize for pagination; PageSizeCount when only counting
 	PageSize int
 	// PublicOnly indicates if only public resources should be returned
 	PublicOnly bool
@@ -35,6 +65,76 @@ type SearchCriteria struct {
 	GroupBy string
 	// GroupBySize indicates the size of the group by
 	GroupBySize int
+	// IncludeScore indicates if the relevance score should be returned for each resource
+	IncludeScore bool
+	// IncludeTotal indicates if a facet search should also count the
+	// accessible resources matching
+	IncludeTotal bool
+	// IncludeChildCounts indicates if the number of children by type should be returned for each resource
+	IncludeChildCounts bool
+	// IncludePermissions indicates if the relations of the principal beyond
+	// viewing (e.g. "edit") should be returned for each resource
+	IncludePermissions bool
+	// CollapseBy returns one resource, the first in the sort order, of each
+	// group sharing the value of the field (one of CollapseFields)
+	CollapseBy *string
+	// IncludeAncestry indicates if the references of the ancestors should be
+	// returned for each resource
+	IncludeAncestry bool
+	// RecencyBoostScale boosts the relevance of recently updated resources:
+	// one updated that long ago (e.g. "30d") scores half. Empty disables it.
+	RecencyBoostScale string
+	// RecencyBoost asks for the recency boost even if the deployment does not
+	// apply it, over DefaultRecencyBoostScale unless a scale is configured
+	RecencyBoost bool
+	// WaitForRefresh delays the search until recent writes are searchable
+	WaitForRefresh bool
+	// SuggestOnEmpty asks for alternative names when a name search finds nothing
+	SuggestOnEmpty bool
+	// NameFuzzy lets the name match with typos, to find alternative names
+	NameFuzzy bool
+	// ExactNameBoost ranks resources whose whole name is the name searched
+	// above those only partly matching it
+	ExactNameBoost bool
+	// RangeFilters restricts numeric fields (e.g. "data.member_count") to a range
+	RangeFilters map[string]RangeFilter
+	// TrackTotalHits controls how accurately the total number of hits is counted;
+	// nil keeps the backend default
+	TrackTotalHits *TrackTotalHits
+}
+
+// PageSizeCount is the page size of searches that only count resources,
+// without returning any; other page sizes may not be negative
+const PageSizeCount = -1
+
+// CountOnly tells whether the search only counts resources
+func (c SearchCriteria) CountOnly() bool {
+	return c.PageSize == PageSizeCount
+}
+
+// Scopes of name searches
+const (
+	// SearchScopeName matches names (and aliases, slugs or titles) only
+	SearchScopeName = "name"
+	// SearchScopeAll matches descriptions as well as names
+	SearchScopeAll = "all"
+)
+
+// TrackTotalHits configures total hit counting: Exact counts every hit,
+// otherwise hits are counted exactly up to UpTo (disabled when not positive)
+type TrackTotalHits struct {
+	// Exact counts all hits, however many there are
+	Exact bool
+	// UpTo is the number of hits counted exactly when Exact is not set
+	UpTo int
+}
+
+// RangeFilter defines inclusive bounds for a numeric field; a nil bound is open
+type RangeFilter struct {
+	// Gte is the inclusive lower bound
+	Gte *float64
+	// Lte is the inclusive upper bound
+	Lte *float64
 }
 
 // SearchResult contains the results of a resource search
@@ -47,18 +147,133 @@ type SearchResult struct {
 	CacheControl *string
 	// Total number of resources found
 	Total int
+	// TotalRelation tells whether Total is exact ("eq") or a lower bound ("gte")
+	TotalRelation string
+	// PublicPath reports that the anonymous public-only path served the result
+	PublicPath bool
+	// Suggestions are alternative names ("did you mean") for a name search
+	// that found nothing, when requested
+	Suggestions []string
+	// Degraded reports that a fallback search source served the result
+	Degraded bool
 }
 
 // CountResult contains the results of a resource count search
 type CountResult struct {
 	// Count number of resources found
 	Count int
+	// VisibleCount is the number of resources the principal may access, the
+	// same as Count
+	VisibleCount int
+	// TotalCount is the number of resources matching, before the access
+	// checks; nil when it is not reported to the principal
+	TotalCount *int
 	// Aggregations
 	Aggregation TermsAggregation
 	// HasMore indicates if there are more results
 	HasMore bool
 	// Cache control header
 	CacheControl *string
+	// PublicPath reports that the anonymous public-only path served the result
+	PublicPath bool
+	// RelationCounts is the number of private resources counted, by the
+	// relation that granted access to them (e.g. "viewer")
+	RelationCounts map[string]uint64
+	// Degraded reports that a fallback search source served the result
+	Degraded bool
+}
+
+// ChildCountBucket is the number of children of a parent sharing a child type
+// and access check query. AccessCheckQuery is empty for public children, which
+// need no access check.
+type ChildCountBucket struct {
+	// ParentRef is the object reference of the parent
+	ParentRef string
+	// Type of the children
+	Type string
+	// AccessCheckQuery of the children (e.g. "committee:123#viewer"), empty when public
+	AccessCheckQuery string
+	// DocCount is the number of children in the bucket
+	DocCount uint64
+}
+
+// Facet fields resources can be broken down by
+const (
+	// FacetFieldType breaks resources down by resource type
+	FacetFieldType = "type"
+	// FacetFieldTags breaks resources down by tag; a resource counts for each of its tags
+	FacetFieldTags = "tags"
+	// FacetFieldStatus breaks resources down by the status in their data
+	FacetFieldStatus = "status"
+)
+
+// FacetFields lists the supported facet fields
+var FacetFields = []string{FacetFieldType, FacetFieldTags, FacetFieldStatus}
+
+// Fields duplicate resources can be collapsed by
+const (
+	// CollapseFieldObjectRef collapses resources sharing an object reference
+	CollapseFieldObjectRef = "object_ref"
+	// CollapseFieldSlug collapses resources sharing the slug in their data
+	CollapseFieldSlug = "slug"
+)
+
+// CollapseFields lists the supported collapse fields
+var CollapseFields = []string{CollapseFieldObjectRef, CollapseFieldSlug}
+
+// FacetBucket is the number of resources sharing a value of a facet field and
+// an access check query. AccessCheckQuery is empty for public resources, which
+// need no access check.
+type FacetBucket struct {
+	// Field is the facet field (e.g. "type")
+	Field string
+	// Value of the facet field (e.g. "committee")
+	Value string
+	// AccessCheckQuery of the resources (e.g. "committee:123#viewer"), empty when public
+	AccessCheckQuery string
+	// DocCount is the number of resources in the bucket
+	DocCount uint64
+}
+
+// FacetValue is the number of accessible resources with a value of a facet field
+type FacetValue struct {
+	// Value of the facet field
+	Value string
+	// Count of accessible resources with this value
+	Count uint64
+}
+
+// FacetResult contains the breakdown of the resources by facet field
+type FacetResult struct {
+	// Facets holds the values of each requested field, by descending count
+	Facets map[string][]FacetValue
+	// Total is the number of accessible resources matching, when requested
+	Total *uint64
+	// TotalRelation tells whether Total is exact ("eq") or a lower bound
+	// ("gte"), as private resources beyond the access check buckets are not
+	// counted
+	TotalRelation string
+	// CacheControl is the cache control header to be sent with the response
+	CacheControl *string
+	// PublicPath is set when the result was served by the anonymous public-only path
+	PublicPath bool
+}
+
+// CountBatchCriteria holds the criteria pair of a single count of a batch
+type CountBatchCriteria struct {
+	// Count criteria for public resources
+	Count SearchCriteria
+	// Aggregation criteria for private resources
+	Aggregation SearchCriteria
+}
+
+// CountItem is the outcome of a single count of a batch: either the result
+// or the error that prevented it
+type CountItem struct {
+	// Result of the count, nil when it failed
+	Result *CountResult
+	// Error of the count, nil when it succeeded
+	Error error
 }
 
 // OrganizationSearchCriteria encapsulates search parameters for organizations
@@ -67,6 +282,25 @@ type OrganizationSearchCriteria struct {
 	Name *string
 	// Organization domain or website URL
 	Domain *string
+	// Domains are alternative organization domains, any of which may match;
+	// the organization of the first one that has one is returned
+	Domains []string
+	// MatchAll requires both name and domain to match the same organization
+	MatchAll bool
+	// PageSize limits the number of organizations returned by list searches
+	PageSize int
+	// Offset is the position of the first organization returned by list searches
+	Offset int
+}
+
+// SearchDomains returns the domains to search, in order of preference: the
+// domain, if any, followed by the alternative domains
+func (c OrganizationSearchCriteria) SearchDomains() []string {
+	var domains []string
+	if c.Domain != nil {
+		domains = append(domains, *c.Domain)
+	}
+	return append(domains, c.Domains...)
 }
 
 // OrganizationSuggestionCriteria encapsulates search parameters for organization suggestions
@@ -0,0 +1,44 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package model
+
+// UnifiedSearchResult contains the results of a search across resources and organizations
+type UnifiedSearchResult struct {
+	// Resources found, filtered by access control
+	Resources []Resource
+	// Organizations suggested for the query
+	Organizations []OrganizationSuggestion
+	// OrganizationsUnavailable is set when the organization search failed, so
+	// the (empty) organizations are not a real answer
+	OrganizationsUnavailable bool
+}
+
+// SuggestionKindResource is the kind of resource suggestions
+const SuggestionKindResource = "resource"
+
+// SuggestionKinds lists the kinds of suggestions, in interleaving order
+var SuggestionKinds = []string{SuggestionKindResource, SuggestionKindOrganization}
+
+// Suggestion is a suggestion of any kind; the field matching the kind is set
+type Suggestion struct {
+	// Kind of the suggestion, e.g. SuggestionKindOrganization
+	Kind string
+	// Name to display for the suggestion
+	Name string
+	// Relevance of the suggestion within its kind, between 0 and 1
+	Relevance float64
+	// Organization suggested, for organization suggestions
+	Organization *OrganizationSuggestion
+	// Resource suggested, for resource suggestions
+	Resource *Resource
+}
+
+// SuggestionsResult contains the suggestions of all kinds, by relevance
+type SuggestionsResult struct {
+	// Suggestions of all the requested kinds
+	Suggestions []Suggestion
+	// OrganizationsUnavailable is set when the organization suggestions failed,
+	// so the suggestions lack organizations
+	OrganizationsUnavailable bool
+}
@@ -19,6 +19,14 @@ type ResourceSearcher interface {
 	// QueryResourcesCount searches for resources based on the provided criteria
 	QueryResourcesCount(ctx context.Context, countCriteria model.SearchCriteria, aggregationCriteria model.SearchCriteria, publicOnly bool) (*model.CountResult, error)
 
+	// QueryChildCounts counts the children of all the given parents at once, by
+	// parent, child type and access check query
+	QueryChildCounts(ctx context.Context, parentRefs []string, publicOnly bool) ([]model.ChildCountBucket, error)
+
+	// QueryFacets breaks the resources matching the criteria down by the values
+	// of each facet field, and by access check query
+	QueryFacets(ctx context.Context, criteria model.SearchCriteria, facetFields []string) ([]model.FacetBucket, error)
+
 	// IsReady checks if the search service is ready
 	IsReady(ctx context.Context) error
 }
@@ -30,9 +38,20 @@ type OrganizationSearcher interface {
 	// QueryOrganizations searches for organizations based on the provided criteria
 	QueryOrganizations(ctx context.Context, criteria model.OrganizationSearchCriteria) (*model.Organization, error)
 
+	// QueryOrganizationsList returns a page of all the organizations matching the criteria, ordered by relevance
+	QueryOrganizationsList(ctx context.Context, criteria model.OrganizationSearchCriteria) (*model.OrganizationsResult, error)
+
+	// CountOrganizations returns the number of organizations matching the
+	// criteria, all of them when it has no filter
+	CountOrganizations(ctx context.Context, criteria model.OrganizationSearchCriteria) (int, error)
+
 	// SuggestOrganizations returns organization suggestions for typeahead search
 	SuggestOrganizations(ctx context.Context, criteria model.OrganizationSuggestionCriteria) (*model.OrganizationSuggestionsResult, error)
 
+	// ResolveOrganizations looks up the organizations of normalized domains in one call;
+	// domains without an organization are absent from the map
+	ResolveOrganizations(ctx context.Context, domains []string) (map[string]*model.Organization, error)
+
 	// IsReady checks if the search service is ready
 	IsReady(ctx context.Context) error
 }
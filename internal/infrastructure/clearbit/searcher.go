@@ -5,9 +5,12 @@ package clearbit
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/model"
 	"github.com/linuxfoundation/lfx-v2-query-service/pkg/errors"
@@ -30,12 +33,16 @@ func (s *OrganizationSearcher) QueryOrganizations(ctx context.Context, criteria
 		err             error
 	)
 
-	// Search by domain first if provided (more accurate)
-	if criteria.Domain != nil {
-		slog.DebugContext(ctx, "searching by domain", "domain", *criteria.Domain)
-		clearbitCompany, err = s.client.FindCompanyByDomain(ctx, *criteria.Domain)
+	// Search by domain first if provided (more accurate). Clearbit has no
+	// bulk lookup, so alternative domains are looked up one by one, in order,
+	// until one is found.
+	domains := criteria.SearchDomains()
+	for _, domain := range domains {
+		slog.DebugContext(ctx, "searching by domain", "domain", domain)
+		clearbitCompany, err = s.client.FindCompanyByDomain(ctx, domain)
 		if err == nil {
 			slog.DebugContext(ctx, "found organization by domain", "name", clearbitCompany.Name)
+			break
 		}
 	}
 
@@ -66,6 +73,23 @@ func (s *OrganizationSearcher) QueryOrganizations(ctx context.Context, criteria
 		return nil, errors.NewNotFound("organization not found")
 	}
 
+	// In strict mode the organization found must satisfy both the name and the
+	// domain, names being compared regardless of case, punctuation and legal form
+	if criteria.MatchAll && criteria.Name != nil && len(domains) > 0 {
+		matchesDomain := slices.ContainsFunc(domains, func(domain string) bool {
+			return strings.EqualFold(clearbitCompany.Domain, domain)
+		})
+		searchName := model.NormalizeOrganizationName(*criteria.Name)
+		if !matchesDomain ||
+			(model.NormalizeOrganizationName(clearbitCompany.Name) != searchName && model.NormalizeOrganizationName(clearbitCompany.LegalName) != searchName) {
+			slog.DebugContext(ctx, "organization does not match both name and domain",
+				"name", clearbitCompany.Name,
+				"domain", clearbitCompany.Domain,
+			)
+			return nil, errors.NewNotFound("organization not found with matching name and domain")
+		}
+	}
+
 	// Convert Clearbit company to domain model
 	org := s.convertToDomainModel(clearbitCompany)
 
@@ -78,6 +102,126 @@ func (s *OrganizationSearcher) QueryOrganizations(ctx context.Context, criteria
 	return org, nil
 }
 
+// QueryOrganizationsList returns the organizations matching the criteria using Clearbit API.
+// A domain match comes first, followed by the Autocomplete API matches for the name,
+// which are already ordered by relevance.
+func (s *OrganizationSearcher) QueryOrganizationsList(ctx context.Context, criteria model.OrganizationSearchCriteria) (*model.OrganizationsResult, error) {
+	slog.DebugContext(ctx, "searching organization list via Clearbit API",
+		"name", criteria.Name,
+		"domain", criteria.Domain,
+	)
+
+	var organizations []model.Organization
+
+	if criteria.MatchAll && criteria.Name != nil && criteria.Domain != nil {
+		// In strict mode at most one organization satisfies both the name and the domain
+		org, err := s.QueryOrganizations(ctx, criteria)
+		var notFound errors.NotFound
+		if err != nil && !stderrors.As(err, &notFound) {
+			return nil, err
+		}
+		if org != nil {
+			organizations = append(organizations, *org)
+		}
+	} else {
+		if criteria.Domain != nil {
+			clearbitCompany, err := s.client.FindCompanyByDomain(ctx, *criteria.Domain)
+			var notFound errors.NotFound
+			if err != nil && !stderrors.As(err, &notFound) {
+				slog.ErrorContext(ctx, "error searching organization by domain", "error", err)
+				return nil, err
+			}
+			if clearbitCompany != nil {
+				organizations = append(organizations, *s.convertToDomainModel(clearbitCompany))
+			}
+		}
+
+		if criteria.Name != nil {
+			clearbitSuggestions, err := s.client.SuggestCompanies(ctx, *criteria.Name)
+			if err != nil {
+				slog.ErrorContext(ctx, "error searching organizations by name", "error", err)
+				return nil, err
+			}
+			for _, suggestion := range clearbitSuggestions {
+				duplicate := false
+				for _, org := range organizations {
+					if strings.EqualFold(org.Domain, suggestion.Domain) {
+						duplicate = true
+						break
+					}
+				}
+				if !duplicate {
+					organizations = append(organizations, model.Organization{
+						Name:   suggestion.Name,
+						Domain: suggestion.Domain,
+					})
+				}
+			}
+		}
+	}
+
+	result := &model.OrganizationsResult{
+		Organizations: []model.Organization{},
+		Total:         len(organizations),
+	}
+	for idx := criteria.Offset; idx < len(organizations); idx++ {
+		if criteria.PageSize > 0 && len(result.Organizations) >= criteria.PageSize {
+			break
+		}
+		result.Organizations = append(result.Organizations, organizations[idx])
+	}
+
+	slog.DebugContext(ctx, "successfully found organization list",
+		"total", result.Total,
+		"count", len(result.Organizations),
+	)
+
+	return result, nil
+}
+
+// CountOrganizations counts the organizations a list search matches using
+// Clearbit API. Clearbit can't enumerate its organizations, so a name or a
+// domain is required.
+func (s *OrganizationSearcher) CountOrganizations(ctx context.Context, criteria model.OrganizationSearchCriteria) (int, error) {
+	if criteria.Name == nil && criteria.Domain == nil {
+		return 0, errors.NewValidation("at least one search parameter must be provided: name or domain")
+	}
+
+	criteria.Offset, criteria.PageSize = 0, 1
+	result, err := s.QueryOrganizationsList(ctx, criteria)
+	if err != nil {
+		return 0, err
+	}
+	return result.Total, nil
+}
+
+// ResolveOrganizations resolves each domain using Clearbit API. Clearbit has no bulk
+// lookup, so the domains are looked up one by one; unknown domains are skipped.
+func (s *OrganizationSearcher) ResolveOrganizations(ctx context.Context, domains []string) (map[string]*model.Organization, error) {
+	slog.DebugContext(ctx, "resolving organizations via Clearbit API",
+		"domains", len(domains),
+	)
+
+	result := make(map[string]*model.Organization, len(domains))
+	for _, domain := range domains {
+		clearbitCompany, err := s.client.FindCompanyByDomain(ctx, domain)
+		var notFound errors.NotFound
+		if err != nil && !stderrors.As(err, &notFound) {
+			slog.ErrorContext(ctx, "error resolving organization by domain", "domain", domain, "error", err)
+			return nil, err
+		}
+		if clearbitCompany != nil {
+			result[domain] = s.convertToDomainModel(clearbitCompany)
+		}
+	}
+
+	slog.DebugContext(ctx, "successfully resolved organizations",
+		"resolved", len(result),
+	)
+
+	return result, nil
+}
+
 // SuggestOrganizations returns organization suggestions using Clearbit Autocomplete API
 func (s *OrganizationSearcher) SuggestOrganizations(ctx context.Context, criteria model.OrganizationSuggestionCriteria) (*model.OrganizationSuggestionsResult, error) {
 	slog.DebugContext(ctx, "searching organization suggestions via Clearbit Autocomplete API",
@@ -144,6 +288,21 @@ func (s *OrganizationSearcher) convertToDomainModel(company *ClearbitCompany) *m
 		}
 	}
 
+	// Pass the other company fields through as metadata
+	metadata := map[string]any{}
+	if company.LegalName != "" {
+		metadata["legal_name"] = company.LegalName
+	}
+	if company.Site != nil && company.Site.URL != "" {
+		metadata["website"] = company.Site.URL
+	}
+	if company.Description != "" {
+		metadata["description"] = company.Description
+	}
+	if len(metadata) > 0 {
+		org.Metadata = metadata
+	}
+
 	return org
 }
 
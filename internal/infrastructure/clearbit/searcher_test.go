@@ -7,6 +7,7 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"testing"
 	"time"
 
@@ -63,6 +64,57 @@ func TestNewOrganizationSearcher(t *testing.T) {
 	}
 }
 
+func TestQueryOrganizationsMatchAll(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name":"Acme, Inc.","legalName":"Acme Holdings LLC","domain":"acme.example"}`))
+	}))
+	defer ts.Close()
+
+	searcher := &OrganizationSearcher{
+		client: NewClient(Config{
+			APIKey:     "test-api-key",
+			BaseURL:    ts.URL,
+			Timeout:    5 * time.Second,
+			MaxRetries: 1,
+			RetryDelay: 100 * time.Millisecond,
+		}),
+	}
+
+	tests := []struct {
+		name        string
+		searchName  string
+		expectFound bool
+	}{
+		{name: "name without its legal suffix", searchName: "Acme", expectFound: true},
+		{name: "legal name with another suffix", searchName: "ACME Holdings Ltd", expectFound: true},
+		{name: "other name", searchName: "Acme Widgets", expectFound: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			domain := "acme.example"
+			org, err := searcher.QueryOrganizations(context.Background(), model.OrganizationSearchCriteria{
+				Name:     &tt.searchName,
+				Domain:   &domain,
+				MatchAll: true,
+			})
+			if tt.expectFound {
+				if err != nil {
+					t.Fatalf("Expected the organization but got: %v", err)
+				}
+				if org.Name != "Acme, Inc." {
+					t.Errorf("Expected Acme, Inc. but got %q", org.Name)
+				}
+				return
+			}
+			if err == nil {
+				t.Errorf("Expected no organization but got %q", org.Name)
+			}
+		})
+	}
+}
+
 func TestConvertToDomainModel(t *testing.T) {
 	searcher := &OrganizationSearcher{}
 
@@ -118,6 +170,25 @@ func TestConvertToDomainModel(t *testing.T) {
 				Employees: "250",
 			},
 		},
+		{
+			name: "company with metadata",
+			company: &ClearbitCompany{
+				Name:        "Metadata Company",
+				LegalName:   "Metadata Company Inc.",
+				Domain:      "metadata.com",
+				Site:        &ClearbitSite{URL: "https://metadata.com"},
+				Description: "Makes metadata",
+			},
+			expected: &model.Organization{
+				Name:   "Metadata Company",
+				Domain: "metadata.com",
+				Metadata: map[string]any{
+					"legal_name":  "Metadata Company Inc.",
+					"website":     "https://metadata.com",
+					"description": "Makes metadata",
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -139,6 +210,9 @@ func TestConvertToDomainModel(t *testing.T) {
 			if result.Employees != tt.expected.Employees {
 				t.Errorf("Expected employees '%s', got '%s'", tt.expected.Employees, result.Employees)
 			}
+			if !reflect.DeepEqual(result.Metadata, tt.expected.Metadata) {
+				t.Errorf("Expected metadata %v, got %v", tt.expected.Metadata, result.Metadata)
+			}
 		})
 	}
 }
@@ -0,0 +1,107 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+// Package fallback provides a resource searcher that falls back to a secondary
+// search source, such as a read-only replica, when the primary one fails.
+package fallback
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/model"
+	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/port"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/errors"
+)
+
+// Searcher queries the primary search source, and the secondary one when the
+// primary fails. Results served by the secondary are marked as degraded.
+type Searcher struct {
+	primary   port.ResourceSearcher
+	secondary port.ResourceSearcher
+}
+
+// shouldFallBack tells whether a primary error warrants the secondary: requests
+// the primary rejects are for the client to fix, not for another source
+func shouldFallBack(ctx context.Context, operation string, err error) bool {
+	if _, ok := err.(errors.Validation); ok {
+		return false
+	}
+	slog.WarnContext(ctx, "primary search source failed, falling back",
+		"operation", operation,
+		"error", err,
+	)
+	return true
+}
+
+// QueryResources implements the ResourceSearcher interface
+func (s *Searcher) QueryResources(ctx context.Context, criteria model.SearchCriteria) (*model.SearchResult, error) {
+	result, err := s.primary.QueryResources(ctx, criteria)
+	if err == nil || !shouldFallBack(ctx, "query_resources", err) {
+		return result, err
+	}
+
+	result, err = s.secondary.QueryResources(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+	result.Degraded = true
+	return result, nil
+}
+
+// QueryResourcesCount implements the ResourceSearcher interface
+func (s *Searcher) QueryResourcesCount(ctx context.Context, countCriteria model.SearchCriteria, aggregationCriteria model.SearchCriteria, publicOnly bool) (*model.CountResult, error) {
+	result, err := s.primary.QueryResourcesCount(ctx, countCriteria, aggregationCriteria, publicOnly)
+	if err == nil || !shouldFallBack(ctx, "query_resources_count", err) {
+		return result, err
+	}
+
+	result, err = s.secondary.QueryResourcesCount(ctx, countCriteria, aggregationCriteria, publicOnly)
+	if err != nil {
+		return nil, err
+	}
+	result.Degraded = true
+	return result, nil
+}
+
+// QueryChildCounts implements the ResourceSearcher interface
+func (s *Searcher) QueryChildCounts(ctx context.Context, parentRefs []string, publicOnly bool) ([]model.ChildCountBucket, error) {
+	buckets, err := s.primary.QueryChildCounts(ctx, parentRefs, publicOnly)
+	if err == nil || !shouldFallBack(ctx, "query_child_counts", err) {
+		return buckets, err
+	}
+	return s.secondary.QueryChildCounts(ctx, parentRefs, publicOnly)
+}
+
+// QueryFacets implements the ResourceSearcher interface
+func (s *Searcher) QueryFacets(ctx context.Context, criteria model.SearchCriteria, facetFields []string) ([]model.FacetBucket, error) {
+	buckets, err := s.primary.QueryFacets(ctx, criteria, facetFields)
+	if err == nil || !shouldFallBack(ctx, "query_facets", err) {
+		return buckets, err
+	}
+	return s.secondary.QueryFacets(ctx, criteria, facetFields)
+}
+
+// IsReady reports the searcher as ready while either search source is
+func (s *Searcher) IsReady(ctx context.Context) error {
+	errPrimary := s.primary.IsReady(ctx)
+	if errPrimary == nil {
+		return nil
+	}
+	if errSecondary := s.secondary.IsReady(ctx); errSecondary != nil {
+		return errPrimary
+	}
+	slog.WarnContext(ctx, "primary search source is not ready, serving from the fallback",
+		"error", errPrimary,
+	)
+	return nil
+}
+
+// NewSearcher returns a searcher falling back from the primary to the
+// secondary search source
+func NewSearcher(primary, secondary port.ResourceSearcher) port.ResourceSearcher {
+	return &Searcher{
+		primary:   primary,
+		secondary: secondary,
+	}
+}
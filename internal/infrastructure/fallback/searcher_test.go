@@ -0,0 +1,137 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package fallback
+
+import (
+	"context"
+	"testing"
+
+	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/model"
+	"github.com/linuxfoundation/lfx-v2-query-service/internal/infrastructure/mock"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func TestSearcherQueryResources(t *testing.T) {
+	tests := []struct {
+		name             string
+		primaryErr       error
+		secondaryErr     error
+		expectedDegraded bool
+		expectedError    bool
+	}{
+		{
+			name: "primary serves the results",
+		},
+		{
+			name:             "fallback serves when the primary fails",
+			primaryErr:       errors.NewServiceUnavailable("opensearch unavailable"),
+			expectedDegraded: true,
+		},
+		{
+			name:          "primary rejection is not retried",
+			primaryErr:    errors.NewValidation("page token is no longer valid"),
+			expectedError: true,
+		},
+		{
+			name:          "both sources fail",
+			primaryErr:    errors.NewServiceUnavailable("opensearch unavailable"),
+			secondaryErr:  errors.NewServiceUnavailable("replica unavailable"),
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			primary := mock.NewMockResourceSearcher()
+			if tc.primaryErr != nil {
+				primary.SetQueryResourcesError(tc.primaryErr)
+			}
+			secondary := mock.NewMockResourceSearcher()
+			if tc.secondaryErr != nil {
+				secondary.SetQueryResourcesError(tc.secondaryErr)
+			}
+
+			searcher := NewSearcher(primary, secondary)
+			result, err := searcher.QueryResources(context.Background(), model.SearchCriteria{
+				ResourceType: stringPtr("project"),
+				PageSize:     10,
+			})
+
+			if tc.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+				return
+			}
+			assert.NoError(t, err)
+			if assert.NotNil(t, result) {
+				assert.NotEmpty(t, result.Resources)
+				assert.Equal(t, tc.expectedDegraded, result.Degraded)
+			}
+		})
+	}
+}
+
+func TestSearcherQueryResourcesCount(t *testing.T) {
+	primary := mock.NewMockResourceSearcher()
+	primary.SetQueryResourcesCountError(errors.NewServiceUnavailable("opensearch unavailable"))
+	secondary := mock.NewMockResourceSearcher()
+	secondary.SetQueryResourcesCountResponse(&model.CountResult{Count: 7})
+
+	searcher := NewSearcher(primary, secondary)
+	result, err := searcher.QueryResourcesCount(context.Background(),
+		model.SearchCriteria{ResourceType: stringPtr("project")},
+		model.SearchCriteria{ResourceType: stringPtr("project")},
+		false,
+	)
+
+	assert.NoError(t, err)
+	if assert.NotNil(t, result) {
+		assert.Equal(t, 7, result.Count)
+		assert.True(t, result.Degraded)
+	}
+}
+
+func TestSearcherIsReady(t *testing.T) {
+	tests := []struct {
+		name          string
+		primaryErr    error
+		secondaryErr  error
+		expectedError bool
+	}{
+		{
+			name: "both ready",
+		},
+		{
+			name:       "fallback ready",
+			primaryErr: errors.NewServiceUnavailable("opensearch is not ready"),
+		},
+		{
+			name:          "neither ready",
+			primaryErr:    errors.NewServiceUnavailable("opensearch is not ready"),
+			secondaryErr:  errors.NewServiceUnavailable("replica is not ready"),
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			primary := mock.NewMockResourceSearcher()
+			primary.SetIsReadyError(tc.primaryErr)
+			secondary := mock.NewMockResourceSearcher()
+			secondary.SetIsReadyError(tc.secondaryErr)
+
+			err := NewSearcher(primary, secondary).IsReady(context.Background())
+			if tc.expectedError {
+				assert.EqualError(t, err, tc.primaryErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
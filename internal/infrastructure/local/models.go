@@ -0,0 +1,28 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package local
+
+import "github.com/linuxfoundation/lfx-v2-query-service/internal/domain/model"
+
+// Config represents the local search configuration
+type Config struct {
+	// Path is the JSON file containing the documents to index
+	Path string `json:"path"`
+}
+
+// document is a single indexed resource. The file layout mirrors the
+// OpenSearch source documents, so an index export can be loaded as is.
+type document struct {
+	model.TransactionBodyStub
+	Latest         *bool    `json:"latest"`
+	NameAndAliases []string `json:"name_and_aliases"`
+	SortName       string   `json:"sort_name"`
+	UpdatedAt      string   `json:"updated_at"`
+	Data           any      `json:"data"`
+
+	// source is the raw document, used for tags, grouping and range filters
+	source map[string]any
+	// nameTokens are the lower-cased words of the name and aliases
+	nameTokens []string
+}
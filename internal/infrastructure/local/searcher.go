@@ -0,0 +1,555 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package local
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/model"
+	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/port"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/errors"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/global"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/paging"
+)
+
+// LocalSearcher implements the ResourceSearcher interface over an in-memory
+// index loaded from a JSON file, for deployments without OpenSearch
+type LocalSearcher struct {
+	documents []document
+}
+
+// QueryResources implements the ResourceSearcher interface
+func (s *LocalSearcher) QueryResources(ctx context.Context, criteria model.SearchCriteria) (*model.SearchResult, error) {
+	slog.DebugContext(ctx, "executing local query for criteria",
+		"criteria", criteria,
+	)
+
+	// Search templates are OpenSearch query clauses
+	if criteria.Template != "" {
+		return nil, errors.NewValidation("search templates are not supported by the local search source")
+	}
+
+	matched := s.filter(criteria)
+	sortDocuments(matched, criteria.SortBy, criteria.SortOrder)
+	// Collapsing before paging keeps the offset of the page token consistent
+	if criteria.CollapseBy != nil {
+		matched = collapseDocuments(matched, *criteria.CollapseBy)
+	}
+
+	// The page token carries the offset of the next page
+	offset := 0
+	if criteria.SearchAfter != nil {
+		var err error
+		offset, err = strconv.Atoi(*criteria.SearchAfter)
+		if err != nil || offset < 0 {
+			return nil, errors.NewValidation("invalid page token")
+		}
+	}
+	if offset > len(matched) {
+		offset = len(matched)
+	}
+
+	end := len(matched)
+	if criteria.PageSize > 0 && offset+criteria.PageSize < end {
+		end = offset + criteria.PageSize
+	}
+
+	result := &model.SearchResult{
+		Resources:     make([]model.Resource, 0, end-offset),
+		Total:         len(matched),
+		TotalRelation: "eq",
+	}
+	for _, doc := range matched[offset:end] {
+		resource := doc.toResource()
+		if len(criteria.Tags) > 0 {
+			resource.MatchedTags = model.MatchTags(criteria.Tags, model.ExtractTags(doc.source))
+		}
+		result.Resources = append(result.Resources, resource)
+	}
+
+	if end < len(matched) {
+		pageToken, err := paging.EncodePageToken(end, global.PageTokenSecret(ctx))
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to encode page token", "error", err)
+			return nil, err
+		}
+		result.PageToken = &pageToken
+	}
+
+	slog.DebugContext(ctx, "local search completed",
+		"results_count", len(result.Resources),
+		"total", result.Total,
+	)
+	return result, nil
+}
+
+// QueryResourcesCount implements the ResourceSearcher interface
+func (s *LocalSearcher) QueryResourcesCount(
+	ctx context.Context,
+	publicCountCriteria model.SearchCriteria,
+	aggregationCriteria model.SearchCriteria,
+	publicOnly bool,
+) (*model.CountResult, error) {
+	slog.DebugContext(ctx, "executing local count for criteria",
+		"public_count_criteria", publicCountCriteria,
+		"aggregation_criteria", aggregationCriteria,
+	)
+
+	result := &model.CountResult{
+		Count: len(s.filter(publicCountCriteria)),
+	}
+	if publicOnly || aggregationCriteria.GroupBy == "" {
+		return result, nil
+	}
+
+	// Group the matching documents by the aggregation field, keeping first-seen order
+	field := strings.TrimSuffix(aggregationCriteria.GroupBy, ".keyword")
+	counts := make(map[string]uint64)
+	var keys []string
+	for _, doc := range s.filter(aggregationCriteria) {
+		key, ok := doc.source[field].(string)
+		if !ok || key == "" {
+			continue
+		}
+		if _, seen := counts[key]; !seen {
+			keys = append(keys, key)
+		}
+		counts[key]++
+	}
+
+	// Buckets are ordered by document count, as in a terms aggregation
+	sort.SliceStable(keys, func(i, j int) bool {
+		return counts[keys[i]] > counts[keys[j]]
+	})
+	for idx, key := range keys {
+		if aggregationCriteria.GroupBySize > 0 && idx >= aggregationCriteria.GroupBySize {
+			result.Aggregation.SumOtherDocCount += counts[key]
+			continue
+		}
+		result.Aggregation.Buckets = append(result.Aggregation.Buckets, model.AggregationBucket{
+			Key:      key,
+			DocCount: counts[key],
+		})
+	}
+
+	return result, nil
+}
+
+// QueryChildCounts implements the ResourceSearcher interface
+func (s *LocalSearcher) QueryChildCounts(ctx context.Context, parentRefs []string, publicOnly bool) ([]model.ChildCountBucket, error) {
+	slog.DebugContext(ctx, "executing local child count",
+		"parent_refs", parentRefs,
+	)
+
+	// Count the children by bucket, keeping first-seen order
+	counts := make(map[model.ChildCountBucket]uint64)
+	var keys []model.ChildCountBucket
+	for _, doc := range s.documents {
+		if doc.Latest != nil && !*doc.Latest {
+			continue
+		}
+		if publicOnly && !doc.Public {
+			continue
+		}
+
+		key := model.ChildCountBucket{Type: doc.ObjectType}
+		if !doc.Public {
+			if doc.AccessCheckQuery == "" {
+				continue
+			}
+			key.AccessCheckQuery = doc.AccessCheckQuery
+		}
+		for _, parentRef := range doc.ParentRefs {
+			if !slices.Contains(parentRefs, parentRef) {
+				continue
+			}
+			key.ParentRef = parentRef
+			if _, seen := counts[key]; !seen {
+				keys = append(keys, key)
+			}
+			counts[key]++
+		}
+	}
+
+	buckets := make([]model.ChildCountBucket, 0, len(keys))
+	for _, key := range keys {
+		key.DocCount = counts[key]
+		buckets = append(buckets, key)
+	}
+	return buckets, nil
+}
+
+// QueryFacets implements the ResourceSearcher interface
+func (s *LocalSearcher) QueryFacets(ctx context.Context, criteria model.SearchCriteria, facetFields []string) ([]model.FacetBucket, error) {
+	slog.DebugContext(ctx, "executing local facet query",
+		"criteria", criteria,
+		"facet_fields", facetFields,
+	)
+
+	// Count the matching documents by bucket, keeping first-seen order
+	documents := s.filter(criteria)
+	counts := make(map[model.FacetBucket]uint64)
+	var keys []model.FacetBucket
+	for _, field := range facetFields {
+		for _, doc := range documents {
+			key := model.FacetBucket{Field: field}
+			if !doc.Public {
+				if doc.AccessCheckQuery == "" {
+					continue
+				}
+				key.AccessCheckQuery = doc.AccessCheckQuery
+			}
+			for _, value := range doc.facetValues(field) {
+				key.Value = value
+				if _, seen := counts[key]; !seen {
+					keys = append(keys, key)
+				}
+				counts[key]++
+			}
+		}
+	}
+
+	buckets := make([]model.FacetBucket, 0, len(keys))
+	for _, key := range keys {
+		key.DocCount = counts[key]
+		buckets = append(buckets, key)
+	}
+	return buckets, nil
+}
+
+// IsReady implements the ResourceSearcher interface (always ready once loaded)
+func (s *LocalSearcher) IsReady(ctx context.Context) error {
+	return nil
+}
+
+// filter returns the documents matching the criteria, in index order
+func (s *LocalSearcher) filter(criteria model.SearchCriteria) []document {
+	var nameTerms []string
+	if criteria.Name != nil {
+		nameTerms = tokenize(*criteria.Name)
+	}
+
+	var matched []document
+	for _, doc := range s.documents {
+		if doc.Latest != nil && !*doc.Latest {
+			continue
+		}
+		if criteria.PublicOnly && !doc.Public {
+			continue
+		}
+		if criteria.PrivateOnly && doc.Public {
+			continue
+		}
+		if criteria.ResourceType != nil && doc.ObjectType != *criteria.ResourceType {
+			continue
+		}
+		if len(criteria.ResourceTypes) > 0 && !slices.Contains(criteria.ResourceTypes, doc.ObjectType) {
+			continue
+		}
+		if criteria.CreatedBy != nil && doc.CreatedBy != *criteria.CreatedBy {
+			continue
+		}
+		if criteria.UpdatedBy != nil && doc.UpdatedBy != *criteria.UpdatedBy {
+			continue
+		}
+		if criteria.TransactionID != nil && doc.TransactionID != *criteria.TransactionID {
+			continue
+		}
+		if criteria.NameExact != nil && !doc.matchesNameExact(*criteria.NameExact) {
+			continue
+		}
+		if slices.Contains(criteria.ExcludeIDs, doc.ObjectID) {
+			continue
+		}
+		if criteria.ObjectRef != nil && doc.ObjectRef != *criteria.ObjectRef {
+			continue
+		}
+		if !doc.matchesParents(criteria.Parent, criteria.Parents) {
+			continue
+		}
+		if criteria.ParentRef != nil && !slices.Contains(doc.ParentRefs, *criteria.ParentRef) {
+			continue
+		}
+		if criteria.Name != nil && !doc.matchesName(nameTerms) {
+			continue
+		}
+		if !doc.matchesTags(criteria.Tags, criteria.TagsAll, criteria.TagsMinMatch) {
+			continue
+		}
+		if !doc.matchesRanges(criteria.RangeFilters) {
+			continue
+		}
+		matched = append(matched, doc)
+	}
+	return matched
+}
+
+// matchesName reports whether every query term is a prefix of a name or alias word
+func (d document) matchesName(terms []string) bool {
+	for _, term := range terms {
+		found := false
+		for _, token := range d.nameTokens {
+			if strings.HasPrefix(token, term) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesNameExact reports whether the data name is the given name, regardless
+// of case
+func (d document) matchesNameExact(name string) bool {
+	dataMap, _ := d.Data.(map[string]any)
+	dataName, ok := dataMap["name"].(string)
+	return ok && strings.EqualFold(dataName, name)
+}
+
+// matchesParents tells whether the document is under parent or any of
+// parents; it matches every document when neither is set
+func (d document) matchesParents(parent *string, parents []string) bool {
+	if parent == nil && len(parents) == 0 {
+		return true
+	}
+	if parent != nil && slices.Contains(d.ParentRefs, *parent) {
+		return true
+	}
+	for _, ref := range parents {
+		if slices.Contains(d.ParentRefs, ref) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesTags applies the any-of (tags, at least minMatch of them) and
+// all-of (tagsAll) tag filters
+func (d document) matchesTags(tags, tagsAll []string, minMatch *int) bool {
+	if len(tags) == 0 && len(tagsAll) == 0 {
+		return true
+	}
+
+	docTags := model.ExtractTags(d.source)
+	for _, tag := range tagsAll {
+		if !slices.Contains(docTags, tag) {
+			return false
+		}
+	}
+	if len(tags) == 0 {
+		return true
+	}
+	threshold := 1
+	if minMatch != nil {
+		threshold = *minMatch
+	}
+	matched := 0
+	for _, tag := range tags {
+		if slices.Contains(docTags, tag) {
+			matched++
+		}
+	}
+	return matched >= max(threshold, 1)
+}
+
+// collapseDocuments keeps the first document of each group sharing the value
+// of a collapse field; documents without a value form a single group
+func collapseDocuments(documents []document, field string) []document {
+	collapsed := make([]document, 0, len(documents))
+	seen := make(map[string]bool)
+	for _, doc := range documents {
+		var key string
+		switch field {
+		case model.CollapseFieldObjectRef:
+			key = doc.ObjectRef
+		case model.CollapseFieldSlug:
+			data, _ := doc.Data.(map[string]any)
+			key, _ = data["slug"].(string)
+		}
+		if !seen[key] {
+			seen[key] = true
+			collapsed = append(collapsed, doc)
+		}
+	}
+	return collapsed
+}
+
+// facetValues returns the values of a facet field for the document
+func (d document) facetValues(field string) []string {
+	switch field {
+	case model.FacetFieldType:
+		return []string{d.ObjectType}
+	case model.FacetFieldTags:
+		return model.ExtractTags(d.source)
+	case model.FacetFieldStatus:
+		data, _ := d.Data.(map[string]any)
+		if status, ok := data["status"].(string); ok && status != "" {
+			return []string{status}
+		}
+	}
+	return nil
+}
+
+// matchesRanges applies the numeric range filters; a missing field never matches
+func (d document) matchesRanges(filters map[string]model.RangeFilter) bool {
+	for field, filter := range filters {
+		if filter.Gte == nil && filter.Lte == nil {
+			continue
+		}
+		value, ok := numericField(d.source, field)
+		if !ok {
+			return false
+		}
+		if filter.Gte != nil && value < *filter.Gte {
+			return false
+		}
+		if filter.Lte != nil && value > *filter.Lte {
+			return false
+		}
+	}
+	return true
+}
+
+// toResource converts an indexed document to a domain resource
+func (d document) toResource() model.Resource {
+	data := d.Data
+	if data == nil {
+		// If no separate data field, use the entire source as data
+		data = d.source
+	}
+
+	return model.Resource{
+		Type:                d.ObjectType,
+		ID:                  d.ObjectID,
+		Data:                data,
+		TransactionBodyStub: d.TransactionBodyStub,
+	}
+}
+
+// numericField resolves a dotted field path (e.g. "data.member_count") to a number
+func numericField(source map[string]any, field string) (float64, bool) {
+	var current any = source
+	for _, part := range strings.Split(field, ".") {
+		currentMap, ok := current.(map[string]any)
+		if !ok {
+			return 0, false
+		}
+		current = currentMap[part]
+	}
+	value, ok := current.(float64)
+	return value, ok
+}
+
+// sortDocuments orders documents by a top-level string field, using the
+// object ID as a tie-breaker so paging is stable
+func sortDocuments(documents []document, sortBy, sortOrder string) {
+	if sortBy == "" {
+		return
+	}
+	desc := sortOrder == "desc"
+	sort.SliceStable(documents, func(i, j int) bool {
+		left, _ := documents[i].source[sortBy].(string)
+		right, _ := documents[j].source[sortBy].(string)
+		if left == right {
+			return documents[i].ObjectID < documents[j].ObjectID
+		}
+		if desc {
+			return left > right
+		}
+		return left < right
+	})
+}
+
+// tokenize splits text into lower-cased words
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+// loadDocuments reads and indexes the documents from a JSON array file
+func loadDocuments(path string) ([]document, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read documents file: %w", err)
+	}
+
+	var rawDocuments []json.RawMessage
+	if err := json.Unmarshal(content, &rawDocuments); err != nil {
+		return nil, fmt.Errorf("failed to decode documents file: %w", err)
+	}
+
+	documents := make([]document, 0, len(rawDocuments))
+	for idx, raw := range rawDocuments {
+		var doc document
+		if err := json.Unmarshal(raw, &doc); err != nil {
+			return nil, fmt.Errorf("failed to decode document %d: %w", idx, err)
+		}
+		if err := json.Unmarshal(raw, &doc.source); err != nil {
+			return nil, fmt.Errorf("failed to decode document %d: %w", idx, err)
+		}
+		// Normalize tags to a string slice regardless of their stored representation
+		if dataMap, ok := doc.Data.(map[string]any); ok {
+			if _, ok := dataMap["tags"]; ok {
+				dataMap["tags"] = model.ExtractTags(dataMap)
+			}
+		}
+		if doc.ObjectRef == "" && doc.ObjectType != "" && doc.ObjectID != "" {
+			doc.ObjectRef = doc.ObjectType + ":" + doc.ObjectID
+		}
+
+		names := doc.NameAndAliases
+		if len(names) == 0 {
+			// Fall back to the data name when the document has no name_and_aliases
+			if dataMap, ok := doc.Data.(map[string]any); ok {
+				if name, ok := dataMap["name"].(string); ok {
+					names = []string{name}
+				}
+			}
+		}
+		for _, name := range names {
+			doc.nameTokens = append(doc.nameTokens, tokenize(name)...)
+		}
+
+		documents = append(documents, doc)
+	}
+
+	return documents, nil
+}
+
+// NewSearcher creates a new local searcher loading the documents from the configured file
+func NewSearcher(ctx context.Context, config Config) (port.ResourceSearcher, error) {
+
+	if config.Path == "" {
+		slog.ErrorContext(ctx, "local search documents path is required")
+		return nil, fmt.Errorf("local search documents path is required")
+	}
+
+	documents, err := loadDocuments(config.Path)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to load local search documents", "path", config.Path, "error", err)
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "local searcher initialized successfully",
+		"path", config.Path,
+		"documents", len(documents),
+	)
+
+	return &LocalSearcher{
+		documents: documents,
+	}, nil
+}
@@ -0,0 +1,328 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package local
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/model"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/global"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/paging"
+	"github.com/stretchr/testify/assert"
+)
+
+const fixturePath = "testdata/resources.json"
+
+func TestLocalSearcherQueryResources(t *testing.T) {
+	tests := []struct {
+		name        string
+		criteria    model.SearchCriteria
+		expectedIDs []string
+	}{
+		{
+			name:        "filter by type",
+			criteria:    model.SearchCriteria{ResourceType: stringPtr("project")},
+			expectedIDs: []string{"tlf", "kernel"},
+		},
+		{
+			name:        "filter by name prefix",
+			criteria:    model.SearchCriteria{Name: stringPtr("lin")},
+			expectedIDs: []string{"tlf", "kernel"},
+		},
+		{
+			name:        "filter by multi-word name",
+			criteria:    model.SearchCriteria{Name: stringPtr("technical comm")},
+			expectedIDs: []string{"tac"},
+		},
+		{
+			name:        "filter by alias",
+			criteria:    model.SearchCriteria{Name: stringPtr("TLF")},
+			expectedIDs: []string{"tlf"},
+		},
+		{
+			name:        "filter by tags with OR logic",
+			criteria:    model.SearchCriteria{Tags: []string{"foundation", "governance"}},
+			expectedIDs: []string{"tlf", "tac", "board"},
+		},
+		{
+			name:        "filter by tags with AND logic",
+			criteria:    model.SearchCriteria{TagsAll: []string{"governance", "active"}},
+			expectedIDs: []string{"tac"},
+		},
+		{
+			name:        "filter by single string tag",
+			criteria:    model.SearchCriteria{ResourceType: stringPtr("project"), TagsAll: []string{"active"}},
+			expectedIDs: []string{"tlf", "kernel"},
+		},
+		{
+			name:        "filter by parent",
+			criteria:    model.SearchCriteria{Parent: stringPtr("project:tlf")},
+			expectedIDs: []string{"kernel", "board"},
+		},
+		{
+			name:        "filter by creator",
+			criteria:    model.SearchCriteria{CreatedBy: stringPtr("user:alice")},
+			expectedIDs: []string{"tac"},
+		},
+		{
+			name:        "filter by unknown creator",
+			criteria:    model.SearchCriteria{CreatedBy: stringPtr("user:carol")},
+			expectedIDs: nil,
+		},
+		{
+			name:        "exclude IDs",
+			criteria:    model.SearchCriteria{Tags: []string{"foundation", "governance"}, ExcludeIDs: []string{"tac", "unknown"}},
+			expectedIDs: []string{"tlf", "board"},
+		},
+		{
+			name:        "public only",
+			criteria:    model.SearchCriteria{Name: stringPtr("g"), PublicOnly: true},
+			expectedIDs: nil,
+		},
+		{
+			name: "filter by numeric range",
+			criteria: model.SearchCriteria{
+				RangeFilters: map[string]model.RangeFilter{
+					"data.member_count": {Gte: float64Ptr(20), Lte: float64Ptr(200)},
+				},
+			},
+			expectedIDs: []string{"kernel", "board"},
+		},
+		{
+			name:        "sort by name descending",
+			criteria:    model.SearchCriteria{ResourceType: stringPtr("committee"), SortBy: "sort_name", SortOrder: "desc"},
+			expectedIDs: []string{"tac", "board"},
+		},
+		{
+			name:        "skips non-latest documents",
+			criteria:    model.SearchCriteria{Name: stringPtr("governing")},
+			expectedIDs: []string{"board"},
+		},
+	}
+
+	searcher, err := NewSearcher(context.Background(), Config{Path: fixturePath})
+	if err != nil {
+		t.Fatalf("failed to create local searcher: %v", err)
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			result, err := searcher.QueryResources(context.Background(), tc.criteria)
+
+			assertion.NoError(err)
+			var ids []string
+			for _, resource := range result.Resources {
+				ids = append(ids, resource.ID)
+			}
+			assertion.Equal(tc.expectedIDs, ids)
+			assertion.Equal(len(tc.expectedIDs), result.Total)
+			assertion.Nil(result.PageToken)
+		})
+	}
+}
+
+func TestLocalSearcherQueryResourcesPaging(t *testing.T) {
+	assertion := assert.New(t)
+	t.Setenv("PAGE_TOKEN_SECRET", "12345678901234567890123456789012")
+	ctx := context.Background()
+
+	searcher, err := NewSearcher(ctx, Config{Path: fixturePath})
+	if err != nil {
+		t.Fatalf("failed to create local searcher: %v", err)
+	}
+
+	criteria := model.SearchCriteria{
+		Tags:      []string{"active", "governance"},
+		SortBy:    "sort_name",
+		SortOrder: "asc",
+		PageSize:  2,
+	}
+
+	// First page
+	first, err := searcher.QueryResources(ctx, criteria)
+	assertion.NoError(err)
+	assertion.Len(first.Resources, 2)
+	assertion.Equal("board", first.Resources[0].ID)
+	assertion.Equal("kernel", first.Resources[1].ID)
+	if !assertion.NotNil(first.PageToken) {
+		return
+	}
+
+	// Second page, decoding the token the same way the service does
+	searchAfter, err := paging.DecodePageToken(ctx, *first.PageToken, global.PageTokenSecret(ctx))
+	assertion.NoError(err)
+	criteria.SearchAfter = &searchAfter
+
+	second, err := searcher.QueryResources(ctx, criteria)
+	assertion.NoError(err)
+	assertion.Len(second.Resources, 2)
+	assertion.Equal("tac", second.Resources[0].ID)
+	assertion.Equal("tlf", second.Resources[1].ID)
+	assertion.Nil(second.PageToken)
+
+	// Invalid offset
+	criteria.SearchAfter = stringPtr("not-a-number")
+	_, err = searcher.QueryResources(ctx, criteria)
+	assertion.Error(err)
+}
+
+func TestLocalSearcherQueryResourcesCount(t *testing.T) {
+	assertion := assert.New(t)
+	ctx := context.Background()
+
+	searcher, err := NewSearcher(ctx, Config{Path: fixturePath})
+	if err != nil {
+		t.Fatalf("failed to create local searcher: %v", err)
+	}
+
+	countCriteria := model.SearchCriteria{TagsAll: []string{"active"}, PublicOnly: true}
+	aggregationCriteria := model.SearchCriteria{
+		TagsAll:     []string{"active"},
+		PrivateOnly: true,
+		GroupBy:     "access_check_query.keyword",
+		GroupBySize: 10,
+	}
+
+	// Public only
+	result, err := searcher.QueryResourcesCount(ctx, countCriteria, aggregationCriteria, true)
+	assertion.NoError(err)
+	assertion.Equal(2, result.Count)
+	assertion.Empty(result.Aggregation.Buckets)
+
+	// With private aggregation
+	result, err = searcher.QueryResourcesCount(ctx, countCriteria, aggregationCriteria, false)
+	assertion.NoError(err)
+	assertion.Equal(2, result.Count)
+	assertion.Equal([]model.AggregationBucket{{Key: "committee:tac#viewer", DocCount: 1}}, result.Aggregation.Buckets)
+
+	// Bucket overflow
+	aggregationCriteria.TagsAll = nil
+	aggregationCriteria.GroupBySize = 1
+	result, err = searcher.QueryResourcesCount(ctx, countCriteria, aggregationCriteria, false)
+	assertion.NoError(err)
+	assertion.Len(result.Aggregation.Buckets, 1)
+	assertion.Equal(uint64(1), result.Aggregation.SumOtherDocCount)
+}
+
+func TestLocalSearcherQueryChildCounts(t *testing.T) {
+	assertion := assert.New(t)
+	ctx := context.Background()
+
+	searcher, err := NewSearcher(ctx, Config{Path: fixturePath})
+	if err != nil {
+		t.Fatalf("failed to create local searcher: %v", err)
+	}
+
+	// Public and private children
+	buckets, err := searcher.QueryChildCounts(ctx, []string{"project:tlf", "project:kernel"}, false)
+	assertion.NoError(err)
+	assertion.Equal([]model.ChildCountBucket{
+		{ParentRef: "project:tlf", Type: "project", DocCount: 1},
+		{ParentRef: "project:kernel", Type: "committee", AccessCheckQuery: "committee:tac#viewer", DocCount: 1},
+		{ParentRef: "project:tlf", Type: "committee", AccessCheckQuery: "committee:board#viewer", DocCount: 1},
+	}, buckets)
+
+	// Public only
+	buckets, err = searcher.QueryChildCounts(ctx, []string{"project:tlf", "project:kernel"}, true)
+	assertion.NoError(err)
+	assertion.Equal([]model.ChildCountBucket{
+		{ParentRef: "project:tlf", Type: "project", DocCount: 1},
+	}, buckets)
+}
+
+func TestLocalSearcherConvertDocument(t *testing.T) {
+	assertion := assert.New(t)
+
+	searcher, err := NewSearcher(context.Background(), Config{Path: fixturePath})
+	if err != nil {
+		t.Fatalf("failed to create local searcher: %v", err)
+	}
+
+	result, err := searcher.QueryResources(context.Background(), model.SearchCriteria{Name: stringPtr("technical")})
+	assertion.NoError(err)
+	if !assertion.Len(result.Resources, 1) {
+		return
+	}
+
+	resource := result.Resources[0]
+	assertion.Equal("committee", resource.Type)
+	assertion.Equal("tac", resource.ID)
+	assertion.Equal("committee:tac", resource.ObjectRef)
+	assertion.Equal("committee:tac", resource.AccessCheckObject)
+	assertion.Equal("viewer", resource.AccessCheckRelation)
+	assertion.False(resource.Public)
+	assertion.Equal(map[string]any{"name": "Technical Advisory Committee", "member_count": float64(12)}, resource.Data)
+}
+
+func TestNewSearcher(t *testing.T) {
+	tests := []struct {
+		name          string
+		content       *string
+		path          string
+		expectedError bool
+	}{
+		{
+			name:          "valid fixture file",
+			path:          fixturePath,
+			expectedError: false,
+		},
+		{
+			name:          "missing path",
+			path:          "",
+			expectedError: true,
+		},
+		{
+			name:          "file not found",
+			path:          "testdata/missing.json",
+			expectedError: true,
+		},
+		{
+			name:          "invalid JSON",
+			content:       stringPtr("{not json"),
+			expectedError: true,
+		},
+		{
+			name:          "not an array",
+			content:       stringPtr(`{"object_id": "tlf"}`),
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			path := tc.path
+			if tc.content != nil {
+				path = filepath.Join(t.TempDir(), "resources.json")
+				if err := os.WriteFile(path, []byte(*tc.content), 0o600); err != nil {
+					t.Fatalf("failed to write fixture: %v", err)
+				}
+			}
+
+			searcher, err := NewSearcher(context.Background(), Config{Path: path})
+
+			if tc.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, searcher)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NoError(t, searcher.IsReady(context.Background()))
+		})
+	}
+}
+
+// Helper function to create string pointers
+func stringPtr(s string) *string {
+	return &s
+}
+
+// Helper function to create float64 pointers
+func float64Ptr(f float64) *float64 {
+	return &f
+}
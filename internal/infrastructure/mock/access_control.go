@@ -29,6 +29,9 @@ type MockAccessControlChecker struct {
 	checkAccessResponse map[string]string
 	checkAccessError    error
 	isReadyError        error
+	checkAccessCalls    int
+	// faults simulates the latency and failures of the access check service
+	faults faults
 }
 
 // CheckAccess implements the AccessControlChecker interface with mock behavior
@@ -39,6 +42,11 @@ func (m *MockAccessControlChecker) CheckAccess(ctx context.Context, subj string,
 		"message", string(data),
 		"public_only", m.PublicResourcesOnly,
 	)
+	m.checkAccessCalls++
+
+	if err := m.faults.inject(ctx); err != nil {
+		return nil, err
+	}
 
 	// If test has set a mock error, return it
 	if m.checkAccessError != nil {
@@ -196,11 +204,28 @@ func (m *MockAccessControlChecker) SetCheckAccessResponse(response map[string]st
 	m.checkAccessResponse = response
 }
 
+// CheckAccessCalls returns the number of access check requests made
+func (m *MockAccessControlChecker) CheckAccessCalls() int {
+	return m.checkAccessCalls
+}
+
 // SetCheckAccessError sets the mock error for CheckAccess calls
 func (m *MockAccessControlChecker) SetCheckAccessError(err error) {
 	m.checkAccessError = err
 }
 
+// SetLatency sets the delay of CheckAccess calls, to simulate the timing of a
+// production backend
+func (m *MockAccessControlChecker) SetLatency(latency time.Duration) {
+	m.faults.setLatency(latency)
+}
+
+// SetErrorRate sets the probability, between 0 and 1, of CheckAccess calls
+// failing
+func (m *MockAccessControlChecker) SetErrorRate(rate float64) {
+	m.faults.setErrorRate(rate)
+}
+
 // SetIsReadyError sets the mock error for IsReady calls
 func (m *MockAccessControlChecker) SetIsReadyError(err error) {
 	m.isReadyError = err
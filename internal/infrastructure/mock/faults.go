@@ -0,0 +1,57 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package mock
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/errors"
+)
+
+// faults simulates the timing and failures of a production backend, so that
+// the service can be load-tested with the mock implementations
+type faults struct {
+	mu        sync.RWMutex
+	latency   time.Duration
+	errorRate float64
+}
+
+// setLatency sets the delay added to each call
+func (f *faults) setLatency(latency time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.latency = latency
+}
+
+// setErrorRate sets the probability, between 0 and 1, of a call failing
+func (f *faults) setErrorRate(rate float64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.errorRate = rate
+}
+
+// inject waits for the latency, unless the context is done first, then fails
+// at the error rate
+func (f *faults) inject(ctx context.Context) error {
+	f.mu.RLock()
+	latency, errorRate := f.latency, f.errorRate
+	f.mu.RUnlock()
+
+	if latency > 0 {
+		timer := time.NewTimer(latency)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if errorRate > 0 && rand.Float64() < errorRate {
+		return errors.NewServiceUnavailable("simulated mock failure")
+	}
+	return nil
+}
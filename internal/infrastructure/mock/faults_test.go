@@ -0,0 +1,113 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package mock
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/model"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMockFaultsLatency(t *testing.T) {
+	const latency = 20 * time.Millisecond
+
+	tests := []struct {
+		name string
+		call func(latency time.Duration) error
+	}{
+		{
+			name: "resource searcher",
+			call: func(latency time.Duration) error {
+				searcher := NewMockResourceSearcher()
+				searcher.SetLatency(latency)
+				_, err := searcher.QueryResources(context.Background(), model.SearchCriteria{PageSize: 10})
+				return err
+			},
+		},
+		{
+			name: "access control checker",
+			call: func(latency time.Duration) error {
+				checker := NewMockAccessControlChecker()
+				checker.SetLatency(latency)
+				_, err := checker.CheckAccess(context.Background(), "lfx.access_check.request", []byte("project:123#viewer@user:admin"), time.Second)
+				return err
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			start := time.Now()
+			err := tc.call(latency)
+
+			assertion.NoError(err)
+			assertion.GreaterOrEqual(time.Since(start), latency)
+		})
+	}
+}
+
+func TestMockFaultsLatencyCanceled(t *testing.T) {
+	assertion := assert.New(t)
+	searcher := NewMockResourceSearcher()
+	searcher.SetLatency(time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := searcher.QueryResources(ctx, model.SearchCriteria{PageSize: 10})
+
+	assertion.ErrorIs(err, context.DeadlineExceeded)
+	assertion.Less(time.Since(start), time.Minute)
+}
+
+func TestMockFaultsErrorRate(t *testing.T) {
+	const calls = 2000
+
+	tests := []struct {
+		name string
+		rate float64
+		min  int
+		max  int
+	}{
+		{name: "never fails", rate: 0, min: 0, max: 0},
+		{name: "fails at the rate", rate: 0.3, min: 450, max: 750},
+		{name: "always fails", rate: 1, min: calls, max: calls},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+			searcher := NewMockResourceSearcher()
+			searcher.SetErrorRate(tc.rate)
+			checker := NewMockAccessControlChecker()
+			checker.SetErrorRate(tc.rate)
+
+			searchFailures, checkFailures := 0, 0
+			for range calls {
+				if _, err := searcher.QueryResources(context.Background(), model.SearchCriteria{PageSize: 10}); err != nil {
+					var unavailable errors.ServiceUnavailable
+					assertion.ErrorAs(err, &unavailable)
+					searchFailures++
+				}
+				if _, err := checker.CheckAccess(context.Background(), "lfx.access_check.request", []byte("project:123#viewer@user:admin"), time.Second); err != nil {
+					var unavailable errors.ServiceUnavailable
+					assertion.ErrorAs(err, &unavailable)
+					checkFailures++
+				}
+			}
+
+			assertion.GreaterOrEqual(searchFailures, tc.min)
+			assertion.LessOrEqual(searchFailures, tc.max)
+			assertion.GreaterOrEqual(checkFailures, tc.min)
+			assertion.LessOrEqual(checkFailures, tc.max)
+		})
+	}
+}
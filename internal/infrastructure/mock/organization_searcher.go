@@ -7,7 +7,11 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/model"
 	"github.com/linuxfoundation/lfx-v2-query-service/pkg/errors"
@@ -16,7 +20,12 @@ import (
 // MockOrganizationSearcher is a mock implementation of OrganizationSearcher for testing
 // This demonstrates how the clean architecture allows easy swapping of implementations
 type MockOrganizationSearcher struct {
+	// mu guards the mock data and settings below, so that tests may add
+	// organizations while searches run concurrently
+	mu            sync.RWMutex
 	organizations []model.Organization
+	suggestError  error
+	delay         time.Duration
 }
 
 // NewMockOrganizationSearcher creates a new mock organization searcher with sample data
@@ -90,7 +99,32 @@ func (m *MockOrganizationSearcher) QueryOrganizations(ctx context.Context, crite
 		"domain", criteria.Domain,
 	)
 
-	// Search by exact name match (case-insensitive)
+	if err := m.wait(ctx); err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	domains := criteria.SearchDomains()
+
+	// Strict mode: both name and domain must match the same organization
+	if criteria.MatchAll && criteria.Name != nil && len(domains) > 0 {
+		searchName := model.NormalizeOrganizationName(*criteria.Name)
+		for _, domain := range domains {
+			searchDomain := strings.ToLower(domain)
+			for _, org := range m.organizations {
+				if model.NormalizeOrganizationName(org.Name) == searchName && strings.ToLower(org.Domain) == searchDomain {
+					slog.DebugContext(ctx, "found organization by name and domain", "organization", org.Name)
+					return &org, nil
+				}
+			}
+		}
+		return nil, errors.NewNotFound(fmt.Sprintf("organization not found with name '%s' and domain '%s'", *criteria.Name, strings.Join(domains, "' or '")))
+	}
+
+	// Search by exact name match (case-insensitive), and then by normalized
+	// name, so that "Acme" finds "Acme, Inc." while an exact match still wins
 	if criteria.Name != nil {
 		searchName := strings.ToLower(*criteria.Name)
 		for _, org := range m.organizations {
@@ -99,11 +133,18 @@ func (m *MockOrganizationSearcher) QueryOrganizations(ctx context.Context, crite
 				return &org, nil
 			}
 		}
+		normalizedName := model.NormalizeOrganizationName(*criteria.Name)
+		for _, org := range m.organizations {
+			if model.NormalizeOrganizationName(org.Name) == normalizedName {
+				slog.DebugContext(ctx, "found organization by normalized name", "organization", org.Name)
+				return &org, nil
+			}
+		}
 	}
 
-	// Search by exact domain match (case-insensitive)
-	if criteria.Domain != nil {
-		searchDomain := strings.ToLower(*criteria.Domain)
+	// Search by exact domain match (case-insensitive), in order of preference
+	for _, domain := range domains {
+		searchDomain := strings.ToLower(domain)
 		for _, org := range m.organizations {
 			if strings.ToLower(org.Domain) == searchDomain {
 				slog.DebugContext(ctx, "found organization by domain", "organization", org.Name)
@@ -113,23 +154,162 @@ func (m *MockOrganizationSearcher) QueryOrganizations(ctx context.Context, crite
 	}
 
 	// Not found - return appropriate error
-	if criteria.Name != nil && criteria.Domain != nil {
-		return nil, errors.NewNotFound(fmt.Sprintf("organization not found with name '%s' or domain '%s'", *criteria.Name, *criteria.Domain))
+	if criteria.Name != nil && len(domains) > 0 {
+		return nil, errors.NewNotFound(fmt.Sprintf("organization not found with name '%s' or domain '%s'", *criteria.Name, strings.Join(domains, "' or '")))
 	} else if criteria.Name != nil {
 		return nil, errors.NewNotFound(fmt.Sprintf("organization not found with name '%s'", *criteria.Name))
-	} else if criteria.Domain != nil {
-		return nil, errors.NewNotFound(fmt.Sprintf("organization not found with domain '%s'", *criteria.Domain))
+	} else if len(domains) > 0 {
+		return nil, errors.NewNotFound(fmt.Sprintf("organization not found with domain '%s'", strings.Join(domains, "' or '")))
 	}
 
 	return nil, errors.NewValidation("no search criteria provided")
 }
 
+// QueryOrganizationsList implements the OrganizationSearcher interface with mock data.
+// Organizations whose name or domain contains the search terms are ranked by how
+// closely they match: exact, prefix, word prefix and then any other fragment.
+func (m *MockOrganizationSearcher) QueryOrganizationsList(ctx context.Context, criteria model.OrganizationSearchCriteria) (*model.OrganizationsResult, error) {
+	slog.DebugContext(ctx, "executing mock organization list search",
+		"name", criteria.Name,
+		"domain", criteria.Domain,
+	)
+
+	if err := m.wait(ctx); err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if criteria.Name == nil && criteria.Domain == nil {
+		return nil, errors.NewValidation("no search criteria provided")
+	}
+
+	type rankedOrganization struct {
+		org  model.Organization
+		rank int
+	}
+
+	var ranked []rankedOrganization
+	for _, org := range m.organizations {
+		nameRank, domainRank := -1, -1
+		if criteria.Name != nil {
+			nameRank = fragmentRank(org.Name, *criteria.Name)
+			if nameRank != 0 && model.NormalizeOrganizationName(org.Name) == model.NormalizeOrganizationName(*criteria.Name) {
+				nameRank = 0
+			}
+		}
+		if criteria.Domain != nil {
+			domainRank = fragmentRank(org.Domain, *criteria.Domain)
+		}
+
+		var rank int
+		switch {
+		case criteria.MatchAll && criteria.Name != nil && criteria.Domain != nil:
+			// Strict mode: both name and domain must match
+			if nameRank < 0 || domainRank < 0 {
+				continue
+			}
+			rank = nameRank + domainRank
+		case nameRank >= 0 && (domainRank < 0 || nameRank <= domainRank):
+			rank = nameRank
+		case domainRank >= 0:
+			rank = domainRank
+		default:
+			continue
+		}
+		ranked = append(ranked, rankedOrganization{org: org, rank: rank})
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].rank != ranked[j].rank {
+			return ranked[i].rank < ranked[j].rank
+		}
+		return strings.ToLower(ranked[i].org.Name) < strings.ToLower(ranked[j].org.Name)
+	})
+
+	result := &model.OrganizationsResult{
+		Organizations: []model.Organization{},
+		Total:         len(ranked),
+	}
+	for idx := criteria.Offset; idx < len(ranked); idx++ {
+		if criteria.PageSize > 0 && len(result.Organizations) >= criteria.PageSize {
+			break
+		}
+		result.Organizations = append(result.Organizations, ranked[idx].org)
+	}
+
+	slog.DebugContext(ctx, "mock organization list search completed",
+		"total", result.Total,
+		"organization_count", len(result.Organizations),
+	)
+
+	return result, nil
+}
+
+// CountOrganizations implements the OrganizationSearcher interface by counting
+// the organizations a list search matches, or all of them without criteria
+func (m *MockOrganizationSearcher) CountOrganizations(ctx context.Context, criteria model.OrganizationSearchCriteria) (int, error) {
+	slog.DebugContext(ctx, "executing mock organization count",
+		"name", criteria.Name,
+		"domain", criteria.Domain,
+	)
+
+	if criteria.Name == nil && criteria.Domain == nil {
+		if err := m.wait(ctx); err != nil {
+			return 0, err
+		}
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+		return len(m.organizations), nil
+	}
+
+	criteria.Offset, criteria.PageSize = 0, 1
+	result, err := m.QueryOrganizationsList(ctx, criteria)
+	if err != nil {
+		return 0, err
+	}
+	return result.Total, nil
+}
+
+// fragmentRank ranks how closely value matches the search fragment
+// (case-insensitive): 0 exact, 1 prefix, 2 word prefix, 3 substring, -1 no match
+func fragmentRank(value, fragment string) int {
+	value = strings.ToLower(value)
+	fragment = strings.ToLower(strings.TrimSpace(fragment))
+	switch {
+	case fragment == "":
+		return -1
+	case value == fragment:
+		return 0
+	case strings.HasPrefix(value, fragment):
+		return 1
+	case strings.Contains(value, " "+fragment):
+		return 2
+	case strings.Contains(value, fragment):
+		return 3
+	default:
+		return -1
+	}
+}
+
 // SuggestOrganizations implements the OrganizationSearcher interface with mock suggestions
 func (m *MockOrganizationSearcher) SuggestOrganizations(ctx context.Context, criteria model.OrganizationSuggestionCriteria) (*model.OrganizationSuggestionsResult, error) {
 	slog.DebugContext(ctx, "executing mock organization suggestions search",
 		"query", criteria.Query,
 	)
 
+	if err := m.wait(ctx); err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if m.suggestError != nil {
+		return nil, m.suggestError
+	}
+
 	suggestions := make([]model.OrganizationSuggestion, 0)
 	query := strings.ToLower(strings.TrimSpace(criteria.Query))
 
@@ -161,28 +341,94 @@ func (m *MockOrganizationSearcher) SuggestOrganizations(ctx context.Context, cri
 	return result, nil
 }
 
+// ResolveOrganizations implements the OrganizationSearcher interface by domain membership
+func (m *MockOrganizationSearcher) ResolveOrganizations(ctx context.Context, domains []string) (map[string]*model.Organization, error) {
+	slog.DebugContext(ctx, "executing mock organization resolution", "domains", domains)
+
+	if err := m.wait(ctx); err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	byDomain := make(map[string]model.Organization, len(m.organizations))
+	for _, org := range m.organizations {
+		byDomain[strings.ToLower(org.Domain)] = org
+	}
+
+	result := make(map[string]*model.Organization)
+	for _, domain := range domains {
+		if org, ok := byDomain[domain]; ok {
+			result[domain] = &org
+		}
+	}
+	return result, nil
+}
+
 // IsReady implements the OrganizationSearcher interface (always ready for mock)
 func (m *MockOrganizationSearcher) IsReady(ctx context.Context) error {
 	return nil
 }
 
+// SetDelay makes every search take the given time, unless its context is
+// done first, to simulate a slow backend
+func (m *MockOrganizationSearcher) SetDelay(delay time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.delay = delay
+}
+
+// wait waits for the configured delay, returning early with the context error
+// when it is done first
+func (m *MockOrganizationSearcher) wait(ctx context.Context) error {
+	m.mu.RLock()
+	delay := m.delay
+	m.mu.RUnlock()
+
+	if delay <= 0 {
+		return nil
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(delay):
+		return nil
+	}
+}
+
+// SetSuggestOrganizationsError sets the mock error for SuggestOrganizations calls
+func (m *MockOrganizationSearcher) SetSuggestOrganizationsError(err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.suggestError = err
+}
+
 // AddOrganization adds an organization to the mock data (useful for testing)
 func (m *MockOrganizationSearcher) AddOrganization(org model.Organization) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	m.organizations = append(m.organizations, org)
 }
 
 // ClearOrganizations clears all organizations (useful for testing)
 func (m *MockOrganizationSearcher) ClearOrganizations() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	m.organizations = []model.Organization{}
 }
 
 // GetOrganizationCount returns the total number of organizations
 func (m *MockOrganizationSearcher) GetOrganizationCount() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	return len(m.organizations)
 }
 
 // GetOrganizationByName returns an organization by name (for testing purposes)
 func (m *MockOrganizationSearcher) GetOrganizationByName(name string) *model.Organization {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	searchName := strings.ToLower(name)
 	for _, org := range m.organizations {
 		if strings.ToLower(org.Name) == searchName {
@@ -194,6 +440,8 @@ func (m *MockOrganizationSearcher) GetOrganizationByName(name string) *model.Org
 
 // GetOrganizationByDomain returns an organization by domain (for testing purposes)
 func (m *MockOrganizationSearcher) GetOrganizationByDomain(domain string) *model.Organization {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	searchDomain := strings.ToLower(domain)
 	for _, org := range m.organizations {
 		if strings.ToLower(org.Domain) == searchDomain {
@@ -205,5 +453,7 @@ func (m *MockOrganizationSearcher) GetOrganizationByDomain(domain string) *model
 
 // GetAllOrganizations returns all organizations (for testing purposes)
 func (m *MockOrganizationSearcher) GetAllOrganizations() []model.Organization {
-	return m.organizations
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return slices.Clone(m.organizations)
 }
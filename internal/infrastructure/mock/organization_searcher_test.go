@@ -0,0 +1,90 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package mock
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMockOrganizationSearcherQueryOrganizationsNormalizedName(t *testing.T) {
+	searcher := &MockOrganizationSearcher{
+		organizations: []model.Organization{
+			{Name: "Acme, Inc.", Domain: "acme.example"},
+			{Name: "Globex Corporation", Domain: "globex.example"},
+		},
+	}
+
+	for _, name := range []string{"Acme", "Acme Inc", "Acme, Inc.", "acme llc", "Acme Ltd", "Acme Corp", "Acme GmbH", "Acme Pty"} {
+		t.Run(name, func(t *testing.T) {
+			org, err := searcher.QueryOrganizations(context.Background(), model.OrganizationSearchCriteria{Name: &name})
+			assert.NoError(t, err)
+			if assert.NotNil(t, org) {
+				assert.Equal(t, "Acme, Inc.", org.Name)
+			}
+
+			list, err := searcher.QueryOrganizationsList(context.Background(), model.OrganizationSearchCriteria{Name: &name})
+			assert.NoError(t, err)
+			if assert.NotNil(t, list) && assert.Len(t, list.Organizations, 1) {
+				assert.Equal(t, "Acme, Inc.", list.Organizations[0].Name)
+			}
+		})
+	}
+
+	t.Run("strict mode", func(t *testing.T) {
+		name, domain := "Globex", "globex.example"
+		org, err := searcher.QueryOrganizations(context.Background(), model.OrganizationSearchCriteria{Name: &name, Domain: &domain, MatchAll: true})
+		assert.NoError(t, err)
+		if assert.NotNil(t, org) {
+			assert.Equal(t, "Globex Corporation", org.Name)
+		}
+	})
+
+	t.Run("other names do not match", func(t *testing.T) {
+		name := "Acme Widgets"
+		_, err := searcher.QueryOrganizations(context.Background(), model.OrganizationSearchCriteria{Name: &name})
+		assert.Error(t, err)
+	})
+}
+
+func TestMockOrganizationSearcherConcurrentAccess(t *testing.T) {
+	searcher := NewMockOrganizationSearcher()
+	initialCount := searcher.GetOrganizationCount()
+	ctx := context.Background()
+
+	const workers = 8
+	var wg sync.WaitGroup
+	for worker := 0; worker < workers; worker++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			searcher.AddOrganization(model.Organization{
+				Name:   fmt.Sprintf("Concurrent %d Inc", worker),
+				Domain: fmt.Sprintf("concurrent-%d.example", worker),
+			})
+		}()
+		go func() {
+			defer wg.Done()
+			name := "Concurrent"
+			_, err := searcher.QueryOrganizationsList(ctx, model.OrganizationSearchCriteria{Name: &name})
+			assert.NoError(t, err)
+			_, err = searcher.CountOrganizations(ctx, model.OrganizationSearchCriteria{})
+			assert.NoError(t, err)
+			_, err = searcher.SuggestOrganizations(ctx, model.OrganizationSuggestionCriteria{Query: "concurrent"})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, initialCount+workers, searcher.GetOrganizationCount())
+	name := "Concurrent"
+	count, err := searcher.CountOrganizations(ctx, model.OrganizationSearchCriteria{Name: &name})
+	assert.NoError(t, err)
+	assert.Equal(t, workers, count)
+}
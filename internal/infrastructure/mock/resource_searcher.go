@@ -5,24 +5,55 @@ package mock
 
 import (
 	"context"
+	"encoding/json"
 	"log/slog"
+	"slices"
+	"sort"
 	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
 
 	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/model"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/errors"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/slug"
 )
 
 // MockResourceSearcher is a mock implementation of ResourceSearcher for testing
 // This demonstrates how the clean architecture allows easy swapping of implementations
 type MockResourceSearcher struct {
-	resources                    []model.Resource
-	queryResourcesCountResponse  *model.CountResult
-	queryResourcesCountError     error
-	isReadyError                 error
+	// NameFields lists, in priority order, the data fields the name filter
+	// matches against. Missing or non-string fields fall through to the next
+	// one, and "id" falls back to the resource ID when absent from the data.
+	NameFields []string
+	// CaseInsensitiveTypes matches the resource type filters regardless of
+	// case, like the service does when it normalizes the requested type
+	CaseInsensitiveTypes bool
+
+	// mu guards the mock data and responses below, so that tests may add
+	// resources while queries run concurrently
+	mu                          sync.RWMutex
+	resources                   []model.Resource
+	parentRefs                  map[string][]string
+	queryResourcesPageToken     *string
+	queryResourcesError         error
+	queryResourcesCountResponse *model.CountResult
+	queryResourcesCountError    error
+	isReadyError                error
+	// faults simulates the latency and failures of OpenSearch
+	faults faults
 }
 
+// IndexName is the synthetic index the mock reports serving its resources from
+const IndexName = "mock-resources"
+
+// DefaultNameFields is the default priority list used by the mock name filter
+var DefaultNameFields = []string{"name", "title", "slug", "id"}
+
 // NewMockResourceSearcher creates a new mock searcher with some sample data
 func NewMockResourceSearcher() *MockResourceSearcher {
 	return &MockResourceSearcher{
+		NameFields: append([]string(nil), DefaultNameFields...),
 		resources: []model.Resource{
 			{
 				Type: "committee",
@@ -42,6 +73,8 @@ func NewMockResourceSearcher() *MockResourceSearcher {
 					AccessCheckRelation:  "member",
 					HistoryCheckObject:   "committee:123",
 					HistoryCheckRelation: "viewer",
+					CreatedBy:            "user:alice",
+					UpdatedBy:            "user:bob",
 				},
 				NeedCheck: true,
 			},
@@ -64,6 +97,8 @@ func NewMockResourceSearcher() *MockResourceSearcher {
 					AccessCheckRelation:  "viewer",
 					HistoryCheckObject:   "project:456",
 					HistoryCheckRelation: "viewer",
+					CreatedBy:            "user:alice",
+					UpdatedBy:            "user:alice",
 				},
 				NeedCheck: false,
 			},
@@ -85,6 +120,8 @@ func NewMockResourceSearcher() *MockResourceSearcher {
 					AccessCheckRelation:  "member",
 					HistoryCheckObject:   "committee:567",
 					HistoryCheckRelation: "viewer",
+					CreatedBy:            "user:bob",
+					UpdatedBy:            "user:carol",
 				},
 				NeedCheck: true,
 			},
@@ -106,6 +143,8 @@ func NewMockResourceSearcher() *MockResourceSearcher {
 					AccessCheckRelation:  "",
 					HistoryCheckObject:   "meeting:101",
 					HistoryCheckRelation: "viewer",
+					CreatedBy:            "user:carol",
+					UpdatedBy:            "user:carol",
 				},
 				NeedCheck: true,
 			},
@@ -128,23 +167,50 @@ func NewMockResourceSearcher() *MockResourceSearcher {
 					AccessCheckRelation:  "contributor",
 					HistoryCheckObject:   "project:789",
 					HistoryCheckRelation: "viewer",
+					CreatedBy:            "user:bob",
+					UpdatedBy:            "user:bob",
 				},
 				NeedCheck: true,
 			},
 		},
+		// Both committees belong to the public project, and the meeting to a committee
+		parentRefs: map[string][]string{
+			"committee:123": {"project:456"},
+			"committee:567": {"project:456"},
+			"meeting:101":   {"committee:123"},
+		},
 	}
 }
 
 // QueryResources implements the ResourceSearcher interface with mock data
 func (m *MockResourceSearcher) QueryResources(ctx context.Context, criteria model.SearchCriteria) (*model.SearchResult, error) {
+	if err := m.faults.inject(ctx); err != nil {
+		return nil, err
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.queryResources(ctx, criteria)
+}
+
+// queryResources runs a mock search; the caller must hold m.mu
+func (m *MockResourceSearcher) queryResources(ctx context.Context, criteria model.SearchCriteria) (*model.SearchResult, error) {
 	slog.DebugContext(ctx, "executing mock search", "criteria", criteria)
 
+	if m.queryResourcesError != nil {
+		return nil, m.queryResourcesError
+	}
+
+	// Search templates are OpenSearch query clauses
+	if criteria.Template != "" {
+		return nil, errors.NewValidation("search templates are not supported by the mock search source")
+	}
+
 	var filteredResources []model.Resource
 
 	// Filter by type
 	if criteria.ResourceType != nil {
 		for _, resource := range m.resources {
-			if resource.Type == *criteria.ResourceType {
+			if m.matchesType(resource.Type, *criteria.ResourceType) {
 				filteredResources = append(filteredResources, resource)
 			}
 		}
@@ -152,35 +218,61 @@ func (m *MockResourceSearcher) QueryResources(ctx context.Context, criteria mode
 		filteredResources = m.resources
 	}
 
-	// Filter by name (case-insensitive substring search)
+	// Filter by allowed types
+	if len(criteria.ResourceTypes) > 0 {
+		var typesFilteredResources []model.Resource
+		for _, resource := range filteredResources {
+			if slices.ContainsFunc(criteria.ResourceTypes, func(resourceType string) bool {
+				return m.matchesType(resource.Type, resourceType)
+			}) {
+				typesFilteredResources = append(typesFilteredResources, resource)
+			}
+		}
+		filteredResources = typesFilteredResources
+	}
+
+	// Order the resources by ID so that ties are deterministic whatever the
+	// insertion order; the stable sorts below keep this order among equally
+	// ranked resources. Cloned so sorting never reorders m.resources.
+	filteredResources = slices.Clone(filteredResources)
+	slices.SortStableFunc(filteredResources, func(a, b model.Resource) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+	// Approximate the recency boost by ranking recently updated resources first
+	if criteria.RecencyBoostScale != "" {
+		slices.SortStableFunc(filteredResources, func(a, b model.Resource) int {
+			return strings.Compare(updatedAt(b), updatedAt(a))
+		})
+	}
+
+	// Filter by name (case-insensitive substring search, or within a few typos
+	// when fuzzy); matches on a higher priority name field rank first, like a
+	// boosted field would
 	if criteria.Name != nil {
 		var nameFilteredResources []model.Resource
 		searchName := strings.ToLower(*criteria.Name)
 
 		for _, resource := range filteredResources {
-			if data, ok := resource.Data.(map[string]interface{}); ok {
-				// Check name field
-				nameMatch := false
-				if name, ok := data["name"].(string); ok {
-					if strings.Contains(strings.ToLower(name), searchName) {
-						nameMatch = true
-					}
-				}
-
-				// For projects, also check slug field
-				if !nameMatch && resource.Type == "project" {
-					if slug, ok := data["slug"].(string); ok {
-						if strings.Contains(strings.ToLower(slug), searchName) {
-							nameMatch = true
-						}
-					}
-				}
-
-				if nameMatch {
-					nameFilteredResources = append(nameFilteredResources, resource)
-				}
+			if m.nameMatchRank(resource, searchName, criteria.SearchScope, criteria.NameFuzzy) >= 0 {
+				nameFilteredResources = append(nameFilteredResources, resource)
 			}
 		}
+		slices.SortStableFunc(nameFilteredResources, func(a, b model.Resource) int {
+			return m.nameMatchRank(a, searchName, criteria.SearchScope, criteria.NameFuzzy) - m.nameMatchRank(b, searchName, criteria.SearchScope, criteria.NameFuzzy)
+		})
+		// Exact name matches rank above partial ones, like the boosted clause
+		if criteria.ExactNameBoost {
+			slices.SortStableFunc(nameFilteredResources, func(a, b model.Resource) int {
+				aExact, bExact := hasExactName(a, searchName), hasExactName(b, searchName)
+				switch {
+				case aExact && !bExact:
+					return -1
+				case bExact && !aExact:
+					return 1
+				}
+				return 0
+			})
+		}
 		filteredResources = nameFilteredResources
 	}
 
@@ -188,21 +280,16 @@ func (m *MockResourceSearcher) QueryResources(ctx context.Context, criteria mode
 	if len(criteria.Tags) > 0 {
 		var tagFilteredResources []model.Resource
 
+		minMatch := 1
+		if criteria.TagsMinMatch != nil {
+			minMatch = *criteria.TagsMinMatch
+		}
 		for _, resource := range filteredResources {
-			if data, ok := resource.Data.(map[string]interface{}); ok {
-				if resourceTags, ok := data["tags"].([]string); ok {
-					// OR logic: resource must have any of the requested tags
-					for _, requestedTag := range criteria.Tags {
-						for _, resourceTag := range resourceTags {
-							if requestedTag == resourceTag {
-								tagFilteredResources = append(tagFilteredResources, resource)
-								goto nextResourceOR
-							}
-						}
-					}
-				}
+			// OR logic: resource must have at least minMatch of the requested tags
+			if matchedTags := model.MatchTags(criteria.Tags, model.ExtractTags(resource.Data)); len(matchedTags) >= max(minMatch, 1) {
+				resource.MatchedTags = matchedTags
+				tagFilteredResources = append(tagFilteredResources, resource)
 			}
-		nextResourceOR:
 		}
 		filteredResources = tagFilteredResources
 	}
@@ -213,7 +300,7 @@ func (m *MockResourceSearcher) QueryResources(ctx context.Context, criteria mode
 
 		for _, resource := range filteredResources {
 			if data, ok := resource.Data.(map[string]interface{}); ok {
-				if resourceTags, ok := data["tags"].([]string); ok {
+				if resourceTags := model.ExtractTags(data); len(resourceTags) > 0 {
 					// AND logic: resource must have all requested tags
 					matchCount := 0
 					for _, requestedTag := range criteria.TagsAll {
@@ -233,11 +320,114 @@ func (m *MockResourceSearcher) QueryResources(ctx context.Context, criteria mode
 		filteredResources = tagAllFilteredResources
 	}
 
+	// Filter by numeric ranges
+	if len(criteria.RangeFilters) > 0 {
+		filteredResources = filterByRanges(filteredResources, criteria.RangeFilters)
+	}
+
+	// Filter by creator
+	if criteria.CreatedBy != nil {
+		filteredResources = filterByAttribution(filteredResources, "created_by", *criteria.CreatedBy)
+	}
+	if criteria.UpdatedBy != nil {
+		filteredResources = filterByAttribution(filteredResources, "updated_by", *criteria.UpdatedBy)
+	}
+
+	// Filter by parents (OR logic - any parent matches)
+	if criteria.Parent != nil || len(criteria.Parents) > 0 {
+		filteredResources = m.filterByParents(filteredResources, criteria.Parent, criteria.Parents)
+	}
+
+	// Filter by exact name (case-insensitive, whole name only)
+	if criteria.NameExact != nil {
+		var exactFilteredResources []model.Resource
+		for _, resource := range filteredResources {
+			if hasExactName(resource, *criteria.NameExact) {
+				exactFilteredResources = append(exactFilteredResources, resource)
+			}
+		}
+		filteredResources = exactFilteredResources
+	}
+
+	// Filter by transaction
+	if criteria.TransactionID != nil {
+		var transactionFilteredResources []model.Resource
+		for _, resource := range filteredResources {
+			if resource.TransactionID == *criteria.TransactionID {
+				transactionFilteredResources = append(transactionFilteredResources, resource)
+			}
+		}
+		filteredResources = transactionFilteredResources
+	}
+
+	// Filter by object reference
+	if criteria.ObjectRef != nil {
+		var refFilteredResources []model.Resource
+		for _, resource := range filteredResources {
+			if resource.ObjectRef == *criteria.ObjectRef {
+				refFilteredResources = append(refFilteredResources, resource)
+			}
+		}
+		filteredResources = refFilteredResources
+	}
+
+	// Filter by public only if requested
+	if criteria.PublicOnly {
+		var publicResources []model.Resource
+		for _, resource := range filteredResources {
+			if resource.Public {
+				publicResources = append(publicResources, resource)
+			}
+		}
+		filteredResources = publicResources
+	}
+
+	// Leave out excluded IDs
+	if len(criteria.ExcludeIDs) > 0 {
+		var includedResources []model.Resource
+		for _, resource := range filteredResources {
+			if !slices.Contains(criteria.ExcludeIDs, resource.ID) {
+				includedResources = append(includedResources, resource)
+			}
+		}
+		filteredResources = includedResources
+	}
+
 	// Sort results (simplified implementation)
 	m.sortResources(filteredResources, criteria.SortBy)
 
+	// Keep the first resource of each group sharing the collapse field value;
+	// like OpenSearch, resources without a value form a single group
+	if criteria.CollapseBy != nil {
+		var collapsedResources []model.Resource
+		seen := make(map[string]bool)
+		for _, resource := range filteredResources {
+			key := collapseKey(resource, *criteria.CollapseBy)
+			if !seen[key] {
+				seen[key] = true
+				collapsedResources = append(collapsedResources, resource)
+			}
+		}
+		filteredResources = collapsedResources
+	}
+
+	// Assign a synthetic, decreasing relevance score based on the result position
+	scoredResources := make([]model.Resource, len(filteredResources))
+	for i, resource := range filteredResources {
+		score := float64(len(filteredResources) - i)
+		normalizedScore := score / float64(len(filteredResources))
+		resource.Score = &score
+		resource.NormalizedScore = &normalizedScore
+		resource.Index = IndexName
+		resource.ParentRefs = slices.Clone(m.parentRefs[resource.ObjectRef])
+		scoredResources[i] = resource
+	}
+
 	result := &model.SearchResult{
-		Resources: filteredResources,
+		Resources:     scoredResources,
+		PageToken:     m.queryResourcesPageToken,
+		Total:         len(scoredResources),
+		TotalRelation: "eq",
 	}
 
 	slog.DebugContext(ctx, "mock search completed", "results_count", len(result.Resources))
@@ -248,6 +438,9 @@ func (m *MockResourceSearcher) QueryResources(ctx context.Context, criteria mode
 func (m *MockResourceSearcher) QueryResourcesCount(ctx context.Context, countCriteria model.SearchCriteria, aggregationCriteria model.SearchCriteria, publicOnly bool) (*model.CountResult, error) {
 	slog.DebugContext(ctx, "executing mock count search", "countCriteria", countCriteria, "aggregationCriteria", aggregationCriteria, "publicOnly", publicOnly)
 
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
 	// If test has set a mock error, return it
 	if m.queryResourcesCountError != nil {
 		return nil, m.queryResourcesCountError
@@ -274,35 +467,33 @@ func (m *MockResourceSearcher) QueryResourcesCount(ctx context.Context, countCri
 	if countCriteria.ResourceType != nil {
 		var typeFiltered []model.Resource
 		for _, resource := range filteredResources {
-			if resource.Type == *countCriteria.ResourceType {
+			if m.matchesType(resource.Type, *countCriteria.ResourceType) {
 				typeFiltered = append(typeFiltered, resource)
 			}
 		}
 		filteredResources = typeFiltered
 	}
 
+	// Filter by allowed types
+	if len(countCriteria.ResourceTypes) > 0 {
+		var typesFiltered []model.Resource
+		for _, resource := range filteredResources {
+			if slices.ContainsFunc(countCriteria.ResourceTypes, func(resourceType string) bool {
+				return m.matchesType(resource.Type, resourceType)
+			}) {
+				typesFiltered = append(typesFiltered, resource)
+			}
+		}
+		filteredResources = typesFiltered
+	}
+
 	// Filter by name
 	if countCriteria.Name != nil {
 		var nameFiltered []model.Resource
 		searchName := strings.ToLower(*countCriteria.Name)
 		for _, resource := range filteredResources {
-			if data, ok := resource.Data.(map[string]interface{}); ok {
-				nameMatch := false
-				if name, ok := data["name"].(string); ok {
-					if strings.Contains(strings.ToLower(name), searchName) {
-						nameMatch = true
-					}
-				}
-				if !nameMatch && resource.Type == "project" {
-					if slug, ok := data["slug"].(string); ok {
-						if strings.Contains(strings.ToLower(slug), searchName) {
-							nameMatch = true
-						}
-					}
-				}
-				if nameMatch {
-					nameFiltered = append(nameFiltered, resource)
-				}
+			if m.matchesName(resource, searchName, countCriteria.SearchScope) {
+				nameFiltered = append(nameFiltered, resource)
 			}
 		}
 		filteredResources = nameFiltered
@@ -313,7 +504,7 @@ func (m *MockResourceSearcher) QueryResourcesCount(ctx context.Context, countCri
 		var tagFiltered []model.Resource
 		for _, resource := range filteredResources {
 			if data, ok := resource.Data.(map[string]interface{}); ok {
-				if resourceTags, ok := data["tags"].([]string); ok {
+				if resourceTags := model.ExtractTags(data); len(resourceTags) > 0 {
 					// OR logic: resource must have any of the requested tags
 					for _, requestedTag := range countCriteria.Tags {
 						for _, resourceTag := range resourceTags {
@@ -335,7 +526,7 @@ func (m *MockResourceSearcher) QueryResourcesCount(ctx context.Context, countCri
 		var tagAllFiltered []model.Resource
 		for _, resource := range filteredResources {
 			if data, ok := resource.Data.(map[string]interface{}); ok {
-				if resourceTags, ok := data["tags"].([]string); ok {
+				if resourceTags := model.ExtractTags(data); len(resourceTags) > 0 {
 					// AND logic: resource must have all requested tags
 					matchCount := 0
 					for _, requestedTag := range countCriteria.TagsAll {
@@ -355,6 +546,19 @@ func (m *MockResourceSearcher) QueryResourcesCount(ctx context.Context, countCri
 		filteredResources = tagAllFiltered
 	}
 
+	// Filter by numeric ranges
+	if len(countCriteria.RangeFilters) > 0 {
+		filteredResources = filterByRanges(filteredResources, countCriteria.RangeFilters)
+	}
+
+	// Filter by creator
+	if countCriteria.CreatedBy != nil {
+		filteredResources = filterByAttribution(filteredResources, "created_by", *countCriteria.CreatedBy)
+	}
+	if countCriteria.UpdatedBy != nil {
+		filteredResources = filterByAttribution(filteredResources, "updated_by", *countCriteria.UpdatedBy)
+	}
+
 	// Build aggregation based on aggregationCriteria
 	aggregationBuckets := make(map[string]uint64)
 
@@ -371,7 +575,8 @@ func (m *MockResourceSearcher) QueryResourcesCount(ctx context.Context, countCri
 		}
 	}
 
-	// Convert map to buckets slice
+	// Convert map to buckets slice, ordered by document count as in a terms
+	// aggregation, and overflow the buckets beyond the size limit
 	var buckets []model.AggregationBucket
 	for key, count := range aggregationBuckets {
 		buckets = append(buckets, model.AggregationBucket{
@@ -379,12 +584,25 @@ func (m *MockResourceSearcher) QueryResourcesCount(ctx context.Context, countCri
 			DocCount: count,
 		})
 	}
+	sort.Slice(buckets, func(i, j int) bool {
+		if buckets[i].DocCount != buckets[j].DocCount {
+			return buckets[i].DocCount > buckets[j].DocCount
+		}
+		return buckets[i].Key < buckets[j].Key
+	})
+	var sumOtherDocCount uint64
+	if aggregationCriteria.GroupBySize > 0 && len(buckets) > aggregationCriteria.GroupBySize {
+		for _, bucket := range buckets[aggregationCriteria.GroupBySize:] {
+			sumOtherDocCount += bucket.DocCount
+		}
+		buckets = buckets[:aggregationCriteria.GroupBySize]
+	}
 
 	result := &model.CountResult{
 		Count: len(filteredResources),
 		Aggregation: model.TermsAggregation{
 			DocCountErrorUpperBound: 0,
-			SumOtherDocCount:        0,
+			SumOtherDocCount:        sumOtherDocCount,
 			Buckets:                 buckets,
 		},
 		HasMore: false,
@@ -394,8 +612,135 @@ func (m *MockResourceSearcher) QueryResourcesCount(ctx context.Context, countCri
 	return result, nil
 }
 
+// QueryChildCounts implements the ResourceSearcher interface using the mock parent relationships
+func (m *MockResourceSearcher) QueryChildCounts(ctx context.Context, parentRefs []string, publicOnly bool) ([]model.ChildCountBucket, error) {
+	slog.DebugContext(ctx, "executing mock child count", "parent_refs", parentRefs, "publicOnly", publicOnly)
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	counts := make(map[model.ChildCountBucket]uint64)
+	var keys []model.ChildCountBucket
+	for _, resource := range m.resources {
+		if publicOnly && !resource.Public {
+			continue
+		}
+
+		key := model.ChildCountBucket{Type: resource.Type}
+		if !resource.Public {
+			key.AccessCheckQuery = resource.AccessCheckQuery
+			if key.AccessCheckQuery == "" && resource.AccessCheckObject != "" && resource.AccessCheckRelation != "" {
+				key.AccessCheckQuery = resource.AccessCheckObject + "#" + resource.AccessCheckRelation
+			}
+			if key.AccessCheckQuery == "" {
+				// Unable to perform access check without these fields.
+				continue
+			}
+		}
+
+		for _, parentRef := range m.parentRefs[resource.ObjectRef] {
+			if !slices.Contains(parentRefs, parentRef) {
+				continue
+			}
+			key.ParentRef = parentRef
+			if _, seen := counts[key]; !seen {
+				keys = append(keys, key)
+			}
+			counts[key]++
+		}
+	}
+
+	buckets := make([]model.ChildCountBucket, 0, len(keys))
+	for _, key := range keys {
+		key.DocCount = counts[key]
+		buckets = append(buckets, key)
+	}
+
+	slog.DebugContext(ctx, "mock child count completed", "buckets", len(buckets))
+	return buckets, nil
+}
+
+// QueryFacets implements the ResourceSearcher interface with mock data
+func (m *MockResourceSearcher) QueryFacets(ctx context.Context, criteria model.SearchCriteria, facetFields []string) ([]model.FacetBucket, error) {
+	slog.DebugContext(ctx, "executing mock facet query", "criteria", criteria, "facet_fields", facetFields)
+
+	m.mu.RLock()
+	result, err := m.queryResources(ctx, criteria)
+	m.mu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[model.FacetBucket]uint64)
+	var keys []model.FacetBucket
+	for _, field := range facetFields {
+		for _, resource := range result.Resources {
+			key := model.FacetBucket{Field: field}
+			if !resource.Public {
+				key.AccessCheckQuery = resource.AccessCheckQuery
+				if key.AccessCheckQuery == "" && resource.AccessCheckObject != "" && resource.AccessCheckRelation != "" {
+					key.AccessCheckQuery = resource.AccessCheckObject + "#" + resource.AccessCheckRelation
+				}
+				if key.AccessCheckQuery == "" {
+					// Unable to perform access check without these fields.
+					continue
+				}
+			}
+
+			for _, value := range facetValues(resource, field) {
+				key.Value = value
+				if _, seen := counts[key]; !seen {
+					keys = append(keys, key)
+				}
+				counts[key]++
+			}
+		}
+	}
+
+	buckets := make([]model.FacetBucket, 0, len(keys))
+	for _, key := range keys {
+		key.DocCount = counts[key]
+		buckets = append(buckets, key)
+	}
+
+	slog.DebugContext(ctx, "mock facet query completed", "buckets", len(buckets))
+	return buckets, nil
+}
+
+// collapseKey returns the value of a collapse field for a resource
+func collapseKey(resource model.Resource, field string) string {
+	switch field {
+	case model.CollapseFieldObjectRef:
+		return resource.ObjectRef
+	case model.CollapseFieldSlug:
+		data, _ := resource.Data.(map[string]any)
+		slug, _ := data["slug"].(string)
+		return slug
+	}
+	return ""
+}
+
+// facetValues returns the values of a facet field for a resource
+func facetValues(resource model.Resource, field string) []string {
+	data, _ := resource.Data.(map[string]any)
+	switch field {
+	case model.FacetFieldType:
+		return []string{resource.Type}
+	case model.FacetFieldTags:
+		return model.ExtractTags(data)
+	case model.FacetFieldStatus:
+		if status, ok := data["status"].(string); ok && status != "" {
+			return []string{status}
+		}
+	}
+	return nil
+}
+
 // IsReady implements the ResourceSearcher interface (always ready for mock)
 func (m *MockResourceSearcher) IsReady(ctx context.Context) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
 	if m.isReadyError != nil {
 		return m.isReadyError
 	}
@@ -416,6 +761,221 @@ func (m *MockResourceSearcher) sortResources(resources []model.Resource, sort st
 	}
 }
 
+// hasExactName reports whether the whole name of the resource is the given
+// one, regardless of case
+func hasExactName(resource model.Resource, name string) bool {
+	data, _ := resource.Data.(map[string]any)
+	value, ok := data["name"].(string)
+	return ok && strings.EqualFold(value, name)
+}
+
+// matchesName reports whether any of the name fields of the search scope of
+// the resource contains the (lower-cased) search term
+func (m *MockResourceSearcher) matchesName(resource model.Resource, searchName, scope string) bool {
+	return m.nameMatchRank(resource, searchName, scope, false) >= 0
+}
+
+// nameMatchRank returns the position, in the name fields of the search scope,
+// of the field of the resource containing the (lower-cased) search term, or
+// within a few typos of it when fuzzy, or -1 when none does. Only the first
+// name field the resource has is matched, the next ones being fallbacks for
+// resources without it; the description, and the slug of a project, are
+// matched whatever the name.
+func (m *MockResourceSearcher) nameMatchRank(resource model.Resource, searchName, scope string, fuzzy bool) int {
+	data, _ := resource.Data.(map[string]any)
+	named := false
+	for idx, field := range m.scopedNameFields(scope) {
+		alwaysMatched := field == "description" || (field == "slug" && resource.Type == "project")
+		if named && !alwaysMatched {
+			continue
+		}
+		value, _ := data[field].(string)
+		if value == "" && field == "id" {
+			value = resource.ID
+		}
+		if value == "" {
+			continue
+		}
+		if field != "description" {
+			named = true
+		}
+		value = strings.ToLower(value)
+		if strings.Contains(value, searchName) || (fuzzy && fuzzyMatches(value, searchName)) {
+			return idx
+		}
+	}
+	return -1
+}
+
+// fuzzyMatches reports whether the value, or one of its words, is within the
+// typos allowed for the search term: none up to 2 characters, one up to 5,
+// and two beyond, like OpenSearch's "AUTO" fuzziness
+func fuzzyMatches(value, searchName string) bool {
+	allowed := 2
+	switch length := utf8.RuneCountInString(searchName); {
+	case length <= 2:
+		allowed = 0
+	case length <= 5:
+		allowed = 1
+	}
+	for _, candidate := range append(strings.Fields(value), value) {
+		if editDistance(candidate, searchName) <= allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// editDistance returns the Levenshtein distance between two strings
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(rb)]
+}
+
+// scopedNameFields returns the configured name fields without "description"
+// for model.SearchScopeName, and with it (last) for model.SearchScopeAll
+func (m *MockResourceSearcher) scopedNameFields(scope string) []string {
+	switch scope {
+	case model.SearchScopeName:
+		return slices.DeleteFunc(slices.Clone(m.NameFields), func(field string) bool {
+			return field == "description"
+		})
+	case model.SearchScopeAll:
+		if !slices.Contains(m.NameFields, "description") {
+			return append(slices.Clone(m.NameFields), "description")
+		}
+	}
+	return m.NameFields
+}
+
+// filterByRanges keeps the resources whose numeric fields fall within all the given ranges.
+// Resources with a missing or non-numeric value for a filtered field are skipped.
+func filterByRanges(resources []model.Resource, rangeFilters map[string]model.RangeFilter) []model.Resource {
+	var rangeFiltered []model.Resource
+	for _, resource := range resources {
+		matches := true
+		for field, filter := range rangeFilters {
+			value, ok := numericField(resource, field)
+			if !ok ||
+				(filter.Gte != nil && value < *filter.Gte) ||
+				(filter.Lte != nil && value > *filter.Lte) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			rangeFiltered = append(rangeFiltered, resource)
+		}
+	}
+	return rangeFiltered
+}
+
+// updatedAt returns the data["updated_at"] timestamp of the resource, which
+// sorts chronologically as it is RFC 3339 formatted
+func updatedAt(resource model.Resource) string {
+	data, _ := resource.Data.(map[string]any)
+	updated, _ := data["updated_at"].(string)
+	return updated
+}
+
+// filterByAttribution keeps the resources the given principal created or last
+// updated, by field ("created_by" or "updated_by"). Resources without
+// attribution fall back to the same field of their data.
+func filterByAttribution(resources []model.Resource, field, principal string) []model.Resource {
+	var attributionFiltered []model.Resource
+	for _, resource := range resources {
+		attribution := resource.CreatedBy
+		if field == "updated_by" {
+			attribution = resource.UpdatedBy
+		}
+		if attribution == "" {
+			data, _ := resource.Data.(map[string]any)
+			attribution, _ = data[field].(string)
+		}
+		if attribution == principal {
+			attributionFiltered = append(attributionFiltered, resource)
+		}
+	}
+	return attributionFiltered
+}
+
+// matchesType reports whether the type of a resource is the one filtered on
+func (m *MockResourceSearcher) matchesType(resourceType, filterType string) bool {
+	if m.CaseInsensitiveTypes {
+		return strings.EqualFold(resourceType, filterType)
+	}
+	return resourceType == filterType
+}
+
+// filterByParents keeps the resources under parent or any of parents
+func (m *MockResourceSearcher) filterByParents(resources []model.Resource, parent *string, parents []string) []model.Resource {
+	wanted := parents
+	if parent != nil {
+		wanted = append([]string{*parent}, parents...)
+	}
+	var parentFiltered []model.Resource
+	for _, resource := range resources {
+		for _, ref := range m.parentRefs[resource.ObjectRef] {
+			if slices.Contains(wanted, ref) {
+				parentFiltered = append(parentFiltered, resource)
+				break
+			}
+		}
+	}
+	return parentFiltered
+}
+
+// numericField looks up a dotted field path (e.g. "data.member_count") in the
+// resource data and returns its value as a float64.
+func numericField(resource model.Resource, field string) (float64, bool) {
+	var value any = resource.Data
+	for _, key := range strings.Split(strings.TrimPrefix(field, "data."), ".") {
+		data, ok := value.(map[string]any)
+		if !ok {
+			return 0, false
+		}
+		if value, ok = data[key]; !ok {
+			return 0, false
+		}
+	}
+
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
 // AddResource adds a resource to the mock data (useful for testing)
 func (m *MockResourceSearcher) AddResource(resource model.Resource) {
 	// Ensure the resource has proper access control fields if not already set
@@ -437,6 +997,8 @@ func (m *MockResourceSearcher) AddResource(resource model.Resource) {
 		resource.NeedCheck = true
 	}
 
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	m.resources = append(m.resources, resource)
 }
 
@@ -446,9 +1008,7 @@ func NewResourceWithDefaults(resourceType, id string, data map[string]any, isPub
 	if resourceType == "project" {
 		if _, hasSlug := data["slug"]; !hasSlug {
 			if name, hasName := data["name"].(string); hasName {
-				// Generate slug from name: lowercase, replace spaces with hyphens
-				slug := strings.ToLower(strings.ReplaceAll(name, " ", "-"))
-				data["slug"] = slug
+				data["slug"] = slug.Make(name)
 			}
 		}
 	}
@@ -487,29 +1047,77 @@ func NewResourceWithDefaults(resourceType, id string, data map[string]any, isPub
 	return resource
 }
 
-// ClearResources clears all resources (useful for testing)
+// ClearResources clears all resources and their parent relationships (useful for testing)
 func (m *MockResourceSearcher) ClearResources() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	m.resources = []model.Resource{}
+	m.parentRefs = nil
+}
+
+// SetParentRefs sets the parents of a resource, by object reference (useful for testing)
+func (m *MockResourceSearcher) SetParentRefs(objectRef string, parentRefs ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.parentRefs == nil {
+		m.parentRefs = make(map[string][]string)
+	}
+	m.parentRefs[objectRef] = parentRefs
 }
 
 // GetResourceCount returns the total number of resources
 func (m *MockResourceSearcher) GetResourceCount() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	return len(m.resources)
 }
 
 // Test helper methods for setting up mock responses
 
+// SetQueryResourcesPageToken sets the page token returned by QueryResources
+// calls, to simulate more pages being available
+func (m *MockResourceSearcher) SetQueryResourcesPageToken(pageToken string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.queryResourcesPageToken = &pageToken
+}
+
+// SetQueryResourcesError sets the mock error for QueryResources calls
+func (m *MockResourceSearcher) SetQueryResourcesError(err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.queryResourcesError = err
+}
+
 // SetQueryResourcesCountResponse sets the mock response for QueryResourcesCount calls
 func (m *MockResourceSearcher) SetQueryResourcesCountResponse(response *model.CountResult) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	m.queryResourcesCountResponse = response
 }
 
 // SetQueryResourcesCountError sets the mock error for QueryResourcesCount calls
 func (m *MockResourceSearcher) SetQueryResourcesCountError(err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	m.queryResourcesCountError = err
 }
 
+// SetLatency sets the delay of QueryResources calls, to simulate the timing of
+// a production backend
+func (m *MockResourceSearcher) SetLatency(latency time.Duration) {
+	m.faults.setLatency(latency)
+}
+
+// SetErrorRate sets the probability, between 0 and 1, of QueryResources calls
+// failing
+func (m *MockResourceSearcher) SetErrorRate(rate float64) {
+	m.faults.setErrorRate(rate)
+}
+
 // SetIsReadyError sets the mock error for IsReady calls
 func (m *MockResourceSearcher) SetIsReadyError(err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	m.isReadyError = err
 }
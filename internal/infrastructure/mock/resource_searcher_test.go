@@ -5,6 +5,9 @@ package mock
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/model"
@@ -183,6 +186,24 @@ func TestMockResourceSearcherQueryResourcesWithTags(t *testing.T) {
 			expectedCount: 4, // Resources with "governance" OR "security"
 			expectedError: false,
 		},
+		{
+			name: "search with tags and a minimum match of 1",
+			criteria: model.SearchCriteria{
+				Tags:         []string{"active", "security"},
+				TagsMinMatch: intPtr(1),
+			},
+			expectedCount: 5, // Resources with "active" OR "security"
+			expectedError: false,
+		},
+		{
+			name: "search with tags and a minimum match of 2",
+			criteria: model.SearchCriteria{
+				Tags:         []string{"active", "security"},
+				TagsMinMatch: intPtr(2),
+			},
+			expectedCount: 2, // Resources with both "active" and "security"
+			expectedError: false,
+		},
 		{
 			name: "search with tags_all (AND logic)",
 			criteria: model.SearchCriteria{
@@ -242,6 +263,624 @@ func TestMockResourceSearcherQueryResourcesWithTags(t *testing.T) {
 	}
 }
 
+func TestMockResourceSearcherMatchedTags(t *testing.T) {
+	assertion := assert.New(t)
+
+	searcher := NewMockResourceSearcher()
+	searcher.ClearResources()
+	searcher.AddResource(model.Resource{
+		Type: "committee",
+		ID:   "tagged-committee",
+		Data: map[string]any{
+			"name": "Tagged Committee",
+			"tags": []any{"governance", "active", "security"},
+		},
+	})
+
+	// Two of the three requested tags match
+	result, err := searcher.QueryResources(context.Background(), model.SearchCriteria{
+		Tags: []string{"active", "archived", "governance"},
+	})
+	assertion.NoError(err)
+	if assertion.Len(result.Resources, 1) {
+		assertion.Equal([]string{"active", "governance"}, result.Resources[0].MatchedTags)
+	}
+
+	// No tag filter, no matched tags
+	result, err = searcher.QueryResources(context.Background(), model.SearchCriteria{
+		Name: stringPtr("Tagged"),
+	})
+	assertion.NoError(err)
+	if assertion.Len(result.Resources, 1) {
+		assertion.Nil(result.Resources[0].MatchedTags)
+	}
+}
+
+func TestMockResourceSearcherQueryResourcesWithCreator(t *testing.T) {
+	tests := []struct {
+		name          string
+		createdBy     string
+		expectedIDs   []string
+		expectedCount int
+	}{
+		{
+			name:          "matching creator",
+			createdBy:     "user:alice",
+			expectedIDs:   []string{"alice-committee"},
+			expectedCount: 1,
+		},
+		{
+			name:          "non-matching creator",
+			createdBy:     "user:carol",
+			expectedIDs:   nil,
+			expectedCount: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			searcher := NewMockResourceSearcher()
+			searcher.ClearResources()
+			for _, creator := range []string{"alice", "bob"} {
+				searcher.AddResource(model.Resource{
+					Type: "committee",
+					ID:   creator + "-committee",
+					Data: map[string]any{"name": creator + " committee", "created_by": "user:" + creator},
+					TransactionBodyStub: model.TransactionBodyStub{
+						Public: true,
+					},
+				})
+			}
+
+			criteria := model.SearchCriteria{CreatedBy: stringPtr(tc.createdBy)}
+			result, err := searcher.QueryResources(context.Background(), criteria)
+			assertion.NoError(err)
+			var ids []string
+			for _, resource := range result.Resources {
+				ids = append(ids, resource.ID)
+			}
+			assertion.Equal(tc.expectedIDs, ids)
+
+			count, err := searcher.QueryResourcesCount(context.Background(), criteria, model.SearchCriteria{}, true)
+			assertion.NoError(err)
+			assertion.Equal(tc.expectedCount, count.Count)
+		})
+	}
+}
+
+func TestMockResourceSearcherQueryResourcesByAttribution(t *testing.T) {
+	tests := []struct {
+		name        string
+		criteria    model.SearchCriteria
+		expectedIDs []string
+	}{
+		{
+			name:        "created by",
+			criteria:    model.SearchCriteria{CreatedBy: stringPtr("user:alice")},
+			expectedIDs: []string{"123", "456"},
+		},
+		{
+			name:        "updated by",
+			criteria:    model.SearchCriteria{UpdatedBy: stringPtr("user:carol")},
+			expectedIDs: []string{"101", "567"},
+		},
+		{
+			name:        "created and updated by",
+			criteria:    model.SearchCriteria{CreatedBy: stringPtr("user:bob"), UpdatedBy: stringPtr("user:bob")},
+			expectedIDs: []string{"789"},
+		},
+		{
+			name:        "unknown principal",
+			criteria:    model.SearchCriteria{CreatedBy: stringPtr("user:unknown")},
+			expectedIDs: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			// The sample resources are seeded with attribution
+			searcher := NewMockResourceSearcher()
+			result, err := searcher.QueryResources(context.Background(), tc.criteria)
+			assertion.NoError(err)
+
+			var ids []string
+			for _, resource := range result.Resources {
+				ids = append(ids, resource.ID)
+				assertion.NotEmpty(resource.CreatedBy)
+				assertion.NotEmpty(resource.UpdatedBy)
+			}
+			assertion.ElementsMatch(tc.expectedIDs, ids)
+		})
+	}
+}
+
+func TestMockResourceSearcherQueryResourcesWithExcludedIDs(t *testing.T) {
+	tests := []struct {
+		name        string
+		criteria    model.SearchCriteria
+		expectedIDs []string
+	}{
+		{
+			name:        "excluded IDs never appear",
+			criteria:    model.SearchCriteria{ExcludeIDs: []string{"committee-1", "project-1"}},
+			expectedIDs: []string{"committee-2"},
+		},
+		{
+			name:        "exclusion composes with other filters",
+			criteria:    model.SearchCriteria{ResourceType: stringPtr("committee"), Tags: []string{"active"}, ExcludeIDs: []string{"committee-2"}},
+			expectedIDs: []string{"committee-1"},
+		},
+		{
+			name:        "unknown excluded IDs are ignored",
+			criteria:    model.SearchCriteria{ResourceType: stringPtr("committee"), ExcludeIDs: []string{"unknown"}},
+			expectedIDs: []string{"committee-1", "committee-2"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			searcher := NewMockResourceSearcher()
+			searcher.ClearResources()
+			for id, resourceType := range map[string]string{"committee-1": "committee", "committee-2": "committee", "project-1": "project"} {
+				searcher.AddResource(model.Resource{
+					Type: resourceType,
+					ID:   id,
+					Data: map[string]any{"name": id, "tags": []string{"active"}},
+					TransactionBodyStub: model.TransactionBodyStub{
+						Public: true,
+					},
+				})
+			}
+
+			result, err := searcher.QueryResources(context.Background(), tc.criteria)
+			assertion.NoError(err)
+			var ids []string
+			for _, resource := range result.Resources {
+				ids = append(ids, resource.ID)
+			}
+			assertion.ElementsMatch(tc.expectedIDs, ids)
+			for _, excludedID := range tc.criteria.ExcludeIDs {
+				assertion.NotContains(ids, excludedID)
+			}
+		})
+	}
+}
+
+func TestMockResourceSearcherQueryResourcesNameFieldRanking(t *testing.T) {
+	assertion := assert.New(t)
+
+	searcher := NewMockResourceSearcher()
+	searcher.ClearResources()
+	searcher.NameFields = []string{"name", "description"}
+	for _, data := range []map[string]any{
+		{"name": "Budget Committee", "description": "Reviews the security budget"},
+		{"name": "Security Committee", "description": "Handles vulnerabilities"},
+		{"name": "Marketing Committee", "description": "Promotes the project"},
+		{"name": "Audit Committee", "description": "Audits security practices"},
+	} {
+		searcher.AddResource(model.Resource{
+			Type: "committee",
+			ID:   data["name"].(string),
+			Data: data,
+		})
+	}
+
+	result, err := searcher.QueryResources(context.Background(), model.SearchCriteria{Name: stringPtr("security")})
+	assertion.NoError(err)
+
+	var ids []string
+	for _, resource := range result.Resources {
+		ids = append(ids, resource.ID)
+	}
+	// Name matches first, then description-only matches by ID
+	assertion.Equal([]string{"Security Committee", "Audit Committee", "Budget Committee"}, ids)
+}
+
+func TestMockResourceSearcherQueryResourcesByExactName(t *testing.T) {
+	tests := []struct {
+		name        string
+		nameExact   string
+		expectedIDs []string
+	}{
+		{
+			name:        "same name",
+			nameExact:   "Governing Board",
+			expectedIDs: []string{"board"},
+		},
+		{
+			name:        "different case",
+			nameExact:   "governing BOARD",
+			expectedIDs: []string{"board"},
+		},
+		{
+			name:        "substring does not match",
+			nameExact:   "Board",
+			expectedIDs: nil,
+		},
+		{
+			name:        "longer name does not match",
+			nameExact:   "Governing Board Minutes Archive",
+			expectedIDs: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			searcher := NewMockResourceSearcher()
+			searcher.ClearResources()
+			searcher.AddResource(NewResourceWithDefaults("committee", "board", map[string]any{"name": "Governing Board"}, true))
+			searcher.AddResource(NewResourceWithDefaults("committee", "minutes", map[string]any{"name": "Governing Board Minutes"}, true))
+
+			result, err := searcher.QueryResources(context.Background(), model.SearchCriteria{NameExact: stringPtr(tc.nameExact)})
+			assertion.NoError(err)
+
+			var ids []string
+			for _, resource := range result.Resources {
+				ids = append(ids, resource.ID)
+			}
+			assertion.Equal(tc.expectedIDs, ids)
+		})
+	}
+}
+
+func TestMockResourceSearcherQueryResourcesByTransaction(t *testing.T) {
+	tests := []struct {
+		name          string
+		transactionID string
+		expectedIDs   []string
+	}{
+		{
+			name:          "matching transaction",
+			transactionID: "txn-1",
+			expectedIDs:   []string{"committee-1", "committee-3"},
+		},
+		{
+			name:          "non-matching transaction",
+			transactionID: "txn-unknown",
+			expectedIDs:   nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			searcher := NewMockResourceSearcher()
+			searcher.ClearResources()
+			for id, transactionID := range map[string]string{
+				"committee-1": "txn-1",
+				"committee-2": "txn-2",
+				"committee-3": "txn-1",
+			} {
+				resource := NewResourceWithDefaults("committee", id, map[string]any{"name": id}, true)
+				resource.TransactionID = transactionID
+				searcher.AddResource(resource)
+			}
+
+			result, err := searcher.QueryResources(context.Background(), model.SearchCriteria{TransactionID: stringPtr(tc.transactionID)})
+			assertion.NoError(err)
+
+			var ids []string
+			for _, resource := range result.Resources {
+				ids = append(ids, resource.ID)
+			}
+			assertion.Equal(tc.expectedIDs, ids)
+		})
+	}
+}
+
+func TestMockResourceSearcherQueryResourcesRecencyBoost(t *testing.T) {
+	tests := []struct {
+		name        string
+		scale       string
+		expectedIDs []string
+	}{
+		{
+			name:        "without recency boost",
+			expectedIDs: []string{"committee-a", "committee-b", "committee-c"},
+		},
+		{
+			name:        "with recency boost",
+			scale:       "30d",
+			expectedIDs: []string{"committee-b", "committee-c", "committee-a"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			searcher := NewMockResourceSearcher()
+			searcher.ClearResources()
+			for id, updated := range map[string]string{
+				"committee-a": "2024-01-01T00:00:00Z",
+				"committee-b": "2025-06-01T00:00:00Z",
+				"committee-c": "2025-01-01T00:00:00Z",
+			} {
+				searcher.AddResource(NewResourceWithDefaults("committee", id, map[string]any{"name": "Committee " + id, "updated_at": updated}, true))
+			}
+
+			result, err := searcher.QueryResources(context.Background(), model.SearchCriteria{
+				Name:              stringPtr("committee"),
+				RecencyBoostScale: tc.scale,
+			})
+			assertion.NoError(err)
+
+			var ids []string
+			for _, resource := range result.Resources {
+				ids = append(ids, resource.ID)
+			}
+			assertion.Equal(tc.expectedIDs, ids)
+		})
+	}
+}
+
+func TestMockResourceSearcherQueryResourcesCollapse(t *testing.T) {
+	tests := []struct {
+		name        string
+		collapseBy  *string
+		expectedIDs []string
+	}{
+		{
+			name:        "without collapse",
+			expectedIDs: []string{"project-a", "project-b", "project-c"},
+		},
+		{
+			name:        "collapsed by slug",
+			collapseBy:  stringPtr(model.CollapseFieldSlug),
+			expectedIDs: []string{"project-a", "project-c"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			searcher := NewMockResourceSearcher()
+			searcher.ClearResources()
+			searcher.AddResource(NewResourceWithDefaults("project", "project-a", map[string]any{"name": "Kubernetes", "slug": "kubernetes"}, true))
+			searcher.AddResource(NewResourceWithDefaults("project", "project-b", map[string]any{"name": "Kubernetes (v2)", "slug": "kubernetes"}, true))
+			searcher.AddResource(NewResourceWithDefaults("project", "project-c", map[string]any{"name": "Istio", "slug": "istio"}, true))
+
+			result, err := searcher.QueryResources(context.Background(), model.SearchCriteria{
+				ResourceType: stringPtr("project"),
+				CollapseBy:   tc.collapseBy,
+			})
+			assertion.NoError(err)
+
+			var ids []string
+			for _, resource := range result.Resources {
+				ids = append(ids, resource.ID)
+			}
+			assertion.Equal(tc.expectedIDs, ids)
+		})
+	}
+}
+
+func TestMockResourceSearcherCaseInsensitiveTypes(t *testing.T) {
+	tests := []struct {
+		name            string
+		caseInsensitive bool
+		resourceType    string
+		expectedIDs     []string
+	}{
+		{
+			name:         "case-sensitive types",
+			resourceType: "project",
+			expectedIDs:  []string{"project-a"},
+		},
+		{
+			name:            "case-insensitive types",
+			caseInsensitive: true,
+			resourceType:    "project",
+			expectedIDs:     []string{"project-a", "project-b"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			searcher := NewMockResourceSearcher()
+			searcher.CaseInsensitiveTypes = tc.caseInsensitive
+			searcher.ClearResources()
+			searcher.AddResource(NewResourceWithDefaults("project", "project-a", map[string]any{"name": "Kubernetes"}, true))
+			searcher.AddResource(NewResourceWithDefaults("Project", "project-b", map[string]any{"name": "Istio"}, true))
+
+			result, err := searcher.QueryResources(context.Background(), model.SearchCriteria{
+				ResourceType: stringPtr(tc.resourceType),
+			})
+			assertion.NoError(err)
+
+			var ids []string
+			for _, resource := range result.Resources {
+				ids = append(ids, resource.ID)
+			}
+			assertion.Equal(tc.expectedIDs, ids)
+		})
+	}
+}
+
+func TestMockResourceSearcherQueryResourcesDeterministicOrder(t *testing.T) {
+	ids := []string{"committee-c", "committee-a", "committee-d", "committee-b"}
+
+	tests := []struct {
+		name        string
+		criteria    model.SearchCriteria
+		expectedIDs []string
+	}{
+		{
+			name:        "ties ordered by ID",
+			criteria:    model.SearchCriteria{Name: stringPtr("committee")},
+			expectedIDs: []string{"committee-a", "committee-b", "committee-c", "committee-d"},
+		},
+		{
+			name:        "explicit sort is kept",
+			criteria:    model.SearchCriteria{Name: stringPtr("committee"), SortBy: "name_desc"},
+			expectedIDs: []string{"committee-d", "committee-c", "committee-b", "committee-a"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			// The same resources inserted in different orders, searched repeatedly
+			for run := range len(ids) {
+				searcher := NewMockResourceSearcher()
+				searcher.ClearResources()
+				for i := range ids {
+					id := ids[(i+run)%len(ids)]
+					searcher.AddResource(model.Resource{
+						Type: "committee",
+						ID:   id,
+						Data: map[string]any{"name": "Committee " + id},
+					})
+				}
+
+				result, err := searcher.QueryResources(context.Background(), tc.criteria)
+				assertion.NoError(err)
+
+				var resultIDs []string
+				for _, resource := range result.Resources {
+					resultIDs = append(resultIDs, resource.ID)
+				}
+				assertion.Equal(tc.expectedIDs, resultIDs, "run %d", run)
+			}
+		})
+	}
+}
+
+func TestMockResourceSearcherQueryResourcesByParents(t *testing.T) {
+	tests := []struct {
+		name         string
+		parent       *string
+		parents      []string
+		expectedRefs []string
+	}{
+		{
+			name:         "single parent",
+			parent:       stringPtr("project:456"),
+			expectedRefs: []string{"committee:123", "committee:567"},
+		},
+		{
+			name:         "multiple parents",
+			parents:      []string{"committee:123", "project:000"},
+			expectedRefs: []string{"meeting:101"},
+		},
+		{
+			name:         "singular and plural parents are combined",
+			parent:       stringPtr("project:456"),
+			parents:      []string{"committee:123"},
+			expectedRefs: []string{"committee:123", "committee:567", "meeting:101"},
+		},
+		{
+			name:    "unknown parents",
+			parents: []string{"project:000"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			searcher := NewMockResourceSearcher()
+			result, err := searcher.QueryResources(context.Background(), model.SearchCriteria{
+				Parent:  tc.parent,
+				Parents: tc.parents,
+			})
+
+			assertion.NoError(err)
+			var refs []string
+			for _, resource := range result.Resources {
+				refs = append(refs, resource.ObjectRef)
+			}
+			assertion.ElementsMatch(tc.expectedRefs, refs)
+		})
+	}
+}
+
+func TestMockResourceSearcherQueryChildCounts(t *testing.T) {
+	tests := []struct {
+		name            string
+		parentRefs      []string
+		publicOnly      bool
+		expectedBuckets []model.ChildCountBucket
+	}{
+		{
+			name:       "children of the seeded project",
+			parentRefs: []string{"project:456"},
+			expectedBuckets: []model.ChildCountBucket{
+				{ParentRef: "project:456", Type: "committee", AccessCheckQuery: "committee:123#member", DocCount: 1},
+				{ParentRef: "project:456", Type: "committee", AccessCheckQuery: "committee:567#member", DocCount: 1},
+			},
+		},
+		{
+			name:       "children without access control information are skipped",
+			parentRefs: []string{"committee:123"},
+		},
+		{
+			name:       "public only",
+			parentRefs: []string{"project:456"},
+			publicOnly: true,
+		},
+		{
+			name:       "unknown parent",
+			parentRefs: []string{"project:000"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			searcher := NewMockResourceSearcher()
+			buckets, err := searcher.QueryChildCounts(context.Background(), tc.parentRefs, tc.publicOnly)
+
+			assertion.NoError(err)
+			assertion.ElementsMatch(tc.expectedBuckets, buckets)
+		})
+	}
+}
+
+func TestMockResourceSearcherQueryFacets(t *testing.T) {
+	assertion := assert.New(t)
+
+	searcher := NewMockResourceSearcher()
+	searcher.ClearResources()
+	searcher.AddResource(NewResourceWithDefaults("committee", "1", map[string]any{"name": "Board", "tags": []string{"active"}, "status": "active"}, true))
+	searcher.AddResource(NewResourceWithDefaults("committee", "2", map[string]any{"name": "TAC", "tags": []any{"active", "tech"}, "status": "active"}, false))
+	searcher.AddResource(NewResourceWithDefaults("meeting", "3", map[string]any{"name": "Sync"}, true))
+
+	buckets, err := searcher.QueryFacets(context.Background(), model.SearchCriteria{}, []string{model.FacetFieldType, model.FacetFieldTags, model.FacetFieldStatus})
+
+	assertion.NoError(err)
+	assertion.ElementsMatch([]model.FacetBucket{
+		{Field: "type", Value: "committee", DocCount: 1},
+		{Field: "type", Value: "committee", AccessCheckQuery: "committee:2#member", DocCount: 1},
+		{Field: "type", Value: "meeting", DocCount: 1},
+		{Field: "tags", Value: "active", DocCount: 1},
+		{Field: "tags", Value: "active", AccessCheckQuery: "committee:2#member", DocCount: 1},
+		{Field: "tags", Value: "tech", AccessCheckQuery: "committee:2#member", DocCount: 1},
+		{Field: "status", Value: "active", DocCount: 1},
+		{Field: "status", Value: "active", AccessCheckQuery: "committee:2#member", DocCount: 1},
+	}, buckets)
+
+	// The criteria narrow the faceted resources
+	buckets, err = searcher.QueryFacets(context.Background(), model.SearchCriteria{PublicOnly: true, ResourceTypes: []string{"meeting"}}, []string{model.FacetFieldType})
+
+	assertion.NoError(err)
+	assertion.Equal([]model.FacetBucket{{Field: "type", Value: "meeting", DocCount: 1}}, buckets)
+}
+
 func TestMockResourceSearcherAddResource(t *testing.T) {
 	assertion := assert.New(t)
 
@@ -292,7 +931,372 @@ func TestMockResourceSearcherClearResources(t *testing.T) {
 	assertion.Equal(0, len(result.Resources))
 }
 
+func TestMockResourceSearcherConcurrentAccess(t *testing.T) {
+	searcher := NewMockResourceSearcher()
+	initialCount := searcher.GetResourceCount()
+	ctx := context.Background()
+
+	const workers = 8
+	var wg sync.WaitGroup
+	for worker := 0; worker < workers; worker++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			id := fmt.Sprintf("concurrent-%d", worker)
+			searcher.AddResource(NewResourceWithDefaults("test-type", id, map[string]any{"name": id}, true))
+			searcher.SetParentRefs("test-type:"+id, "project:456")
+		}()
+		go func() {
+			defer wg.Done()
+			_, err := searcher.QueryResources(ctx, model.SearchCriteria{Name: stringPtr("concurrent")})
+			assert.NoError(t, err)
+			_, err = searcher.QueryFacets(ctx, model.SearchCriteria{}, []string{model.FacetFieldType})
+			assert.NoError(t, err)
+			_, err = searcher.QueryChildCounts(ctx, []string{"project:456"}, false)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, initialCount+workers, searcher.GetResourceCount())
+	result, err := searcher.QueryResources(ctx, model.SearchCriteria{ResourceType: stringPtr("test-type")})
+	assert.NoError(t, err)
+	assert.Len(t, result.Resources, workers)
+}
+
+func TestMockResourceSearcherQueryResourcesWithRangeFilters(t *testing.T) {
+	tests := []struct {
+		name         string
+		rangeFilters map[string]model.RangeFilter
+		expectedIDs  []string
+	}{
+		{
+			name: "gte only",
+			rangeFilters: map[string]model.RangeFilter{
+				"data.member_count": {Gte: float64Ptr(100)},
+			},
+			expectedIDs: []string{"p-150", "p-300"},
+		},
+		{
+			name: "lte only",
+			rangeFilters: map[string]model.RangeFilter{
+				"data.member_count": {Lte: float64Ptr(150)},
+			},
+			expectedIDs: []string{"p-20", "p-150"},
+		},
+		{
+			name: "both bounds",
+			rangeFilters: map[string]model.RangeFilter{
+				"data.member_count": {Gte: float64Ptr(50), Lte: float64Ptr(200)},
+			},
+			expectedIDs: []string{"p-150"},
+		},
+		{
+			name: "field with a non-numeric value is skipped",
+			rangeFilters: map[string]model.RangeFilter{
+				"data.member_count": {Gte: float64Ptr(0)},
+			},
+			expectedIDs: []string{"p-20", "p-150", "p-300"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			searcher := NewMockResourceSearcher()
+			searcher.ClearResources()
+			searcher.AddResource(NewResourceWithDefaults("project", "p-20", map[string]any{"name": "Small", "member_count": 20}, true))
+			searcher.AddResource(NewResourceWithDefaults("project", "p-150", map[string]any{"name": "Medium", "member_count": float64(150)}, true))
+			searcher.AddResource(NewResourceWithDefaults("project", "p-300", map[string]any{"name": "Large", "member_count": json.Number("300")}, true))
+			searcher.AddResource(NewResourceWithDefaults("project", "p-nan", map[string]any{"name": "Unknown", "member_count": "many"}, true))
+			searcher.AddResource(NewResourceWithDefaults("project", "p-none", map[string]any{"name": "Missing"}, true))
+
+			ctx := context.Background()
+			result, err := searcher.QueryResources(ctx, model.SearchCriteria{
+				ResourceType: stringPtr("project"),
+				RangeFilters: tc.rangeFilters,
+			})
+			assertion.NoError(err)
+
+			ids := make([]string, len(result.Resources))
+			for i, resource := range result.Resources {
+				ids[i] = resource.ID
+			}
+			assertion.ElementsMatch(tc.expectedIDs, ids)
+
+			countResult, err := searcher.QueryResourcesCount(ctx, model.SearchCriteria{
+				ResourceType: stringPtr("project"),
+				RangeFilters: tc.rangeFilters,
+			}, model.SearchCriteria{}, false)
+			assertion.NoError(err)
+			assertion.Equal(len(tc.expectedIDs), countResult.Count)
+		})
+	}
+}
+
+func TestMockResourceSearcherQueryResourcesWithTagRepresentations(t *testing.T) {
+	tests := []struct {
+		name        string
+		criteria    model.SearchCriteria
+		expectedIDs []string
+	}{
+		{
+			name:        "tags (OR logic) across representations",
+			criteria:    model.SearchCriteria{Tags: []string{"active"}},
+			expectedIDs: []string{"string-slice", "interface-slice", "single-string"},
+		},
+		{
+			name:        "tags_all (AND logic) across representations",
+			criteria:    model.SearchCriteria{TagsAll: []string{"active", "security"}},
+			expectedIDs: []string{"string-slice", "interface-slice"},
+		},
+		{
+			name:        "single string tag matches tags_all with one tag",
+			criteria:    model.SearchCriteria{TagsAll: []string{"active"}},
+			expectedIDs: []string{"string-slice", "interface-slice", "single-string"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			searcher := NewMockResourceSearcher()
+			searcher.ClearResources()
+			searcher.AddResource(NewResourceWithDefaults("committee", "string-slice", map[string]any{"name": "A", "tags": []string{"active", "security"}}, true))
+			searcher.AddResource(NewResourceWithDefaults("committee", "interface-slice", map[string]any{"name": "B", "tags": []any{"active", "security", 42}}, true))
+			searcher.AddResource(NewResourceWithDefaults("committee", "single-string", map[string]any{"name": "C", "tags": "active"}, true))
+			searcher.AddResource(NewResourceWithDefaults("committee", "no-tags", map[string]any{"name": "D"}, true))
+
+			ctx := context.Background()
+			result, err := searcher.QueryResources(ctx, tc.criteria)
+			assertion.NoError(err)
+
+			ids := make([]string, len(result.Resources))
+			for i, resource := range result.Resources {
+				ids[i] = resource.ID
+			}
+			assertion.ElementsMatch(tc.expectedIDs, ids)
+
+			countResult, err := searcher.QueryResourcesCount(ctx, tc.criteria, model.SearchCriteria{}, false)
+			assertion.NoError(err)
+			assertion.Equal(len(tc.expectedIDs), countResult.Count)
+		})
+	}
+}
+
+func TestMockResourceSearcherNameFallback(t *testing.T) {
+	tests := []struct {
+		name        string
+		nameFields  []string
+		searchName  string
+		expectedIDs []string
+	}{
+		{
+			name:        "resource exposing only title",
+			searchName:  "steering",
+			expectedIDs: []string{"title-only"},
+		},
+		{
+			name:        "resource exposing only slug",
+			searchName:  "outreach-group",
+			expectedIDs: []string{"slug-only"},
+		},
+		{
+			name:        "resource without name fields falls back to id",
+			searchName:  "bare-resource",
+			expectedIDs: []string{"bare-resource"},
+		},
+		{
+			name:        "named resource does not match through its id",
+			searchName:  "budget-123",
+			expectedIDs: nil,
+		},
+		{
+			name:        "named resource does not match through its slug",
+			searchName:  "finance",
+			expectedIDs: nil,
+		},
+		{
+			name:        "named resource matches through its name",
+			searchName:  "budget",
+			expectedIDs: []string{"budget-123"},
+		},
+		{
+			name:        "custom field priority list excludes title",
+			nameFields:  []string{"name", "slug"},
+			searchName:  "steering",
+			expectedIDs: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			searcher := NewMockResourceSearcher()
+			searcher.ClearResources()
+			if tc.nameFields != nil {
+				searcher.NameFields = tc.nameFields
+			}
+			searcher.AddResource(NewResourceWithDefaults("committee", "title-only", map[string]any{"title": "Steering Committee"}, true))
+			searcher.AddResource(NewResourceWithDefaults("committee", "slug-only", map[string]any{"slug": "outreach-group"}, true))
+			searcher.AddResource(NewResourceWithDefaults("committee", "bare-resource", map[string]any{"status": "active"}, true))
+			searcher.AddResource(NewResourceWithDefaults("committee", "budget-123", map[string]any{"name": "Budget Committee", "slug": "finance"}, true))
+
+			ctx := context.Background()
+			criteria := model.SearchCriteria{Name: stringPtr(tc.searchName)}
+			result, err := searcher.QueryResources(ctx, criteria)
+			assertion.NoError(err)
+
+			var ids []string
+			for _, resource := range result.Resources {
+				ids = append(ids, resource.ID)
+			}
+			assertion.ElementsMatch(tc.expectedIDs, ids)
+
+			countResult, err := searcher.QueryResourcesCount(ctx, criteria, model.SearchCriteria{}, false)
+			assertion.NoError(err)
+			assertion.Equal(len(tc.expectedIDs), countResult.Count)
+		})
+	}
+}
+
+func TestMockResourceSearcherProjectSlug(t *testing.T) {
+	assertion := assert.New(t)
+
+	searcher := NewMockResourceSearcher()
+
+	// A named project still matches through its slug
+	result, err := searcher.QueryResources(context.Background(), model.SearchCriteria{Name: stringPtr("lfx-platform")})
+	assertion.NoError(err)
+
+	var ids []string
+	for _, resource := range result.Resources {
+		ids = append(ids, resource.ID)
+	}
+	assertion.Equal([]string{"456"}, ids)
+}
+
+func TestMockResourceSearcherSearchScope(t *testing.T) {
+	tests := []struct {
+		name        string
+		nameFields  []string
+		scope       string
+		expectedIDs []string
+	}{
+		{
+			name:        "default fields ignore descriptions",
+			expectedIDs: []string{"named"},
+		},
+		{
+			name:        "name scope ignores descriptions",
+			scope:       model.SearchScopeName,
+			expectedIDs: []string{"named"},
+		},
+		{
+			name:        "all scope matches descriptions",
+			scope:       model.SearchScopeAll,
+			expectedIDs: []string{"named", "described"},
+		},
+		{
+			name:        "name scope drops a configured description field",
+			nameFields:  []string{"name", "description"},
+			scope:       model.SearchScopeName,
+			expectedIDs: []string{"named"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			searcher := NewMockResourceSearcher()
+			searcher.ClearResources()
+			if tc.nameFields != nil {
+				searcher.NameFields = tc.nameFields
+			}
+			searcher.AddResource(NewResourceWithDefaults("committee", "named", map[string]any{"name": "Budget Committee"}, true))
+			searcher.AddResource(NewResourceWithDefaults("committee", "described", map[string]any{"name": "Finance", "description": "Oversees the budget"}, true))
+
+			ctx := context.Background()
+			criteria := model.SearchCriteria{Name: stringPtr("budget"), SearchScope: tc.scope}
+			result, err := searcher.QueryResources(ctx, criteria)
+			assertion.NoError(err)
+
+			var ids []string
+			for _, resource := range result.Resources {
+				ids = append(ids, resource.ID)
+			}
+			assertion.ElementsMatch(tc.expectedIDs, ids)
+
+			countResult, err := searcher.QueryResourcesCount(ctx, criteria, model.SearchCriteria{}, false)
+			assertion.NoError(err)
+			assertion.Equal(len(tc.expectedIDs), countResult.Count)
+		})
+	}
+}
+
+func TestMockResourceSearcherFuzzyName(t *testing.T) {
+	tests := []struct {
+		name        string
+		searchName  string
+		fuzzy       bool
+		expectedIDs []string
+	}{
+		{name: "typo without fuzzy matching", searchName: "budgte"},
+		{name: "typo with fuzzy matching", searchName: "budgte", fuzzy: true, expectedIDs: []string{"budget"}},
+		{name: "short terms allow no typo", searchName: "bx", fuzzy: true},
+		{name: "too many typos", searchName: "bugdte", fuzzy: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			searcher := NewMockResourceSearcher()
+			searcher.ClearResources()
+			searcher.AddResource(NewResourceWithDefaults("committee", "budget", map[string]any{"name": "Budget Committee"}, true))
+
+			result, err := searcher.QueryResources(context.Background(), model.SearchCriteria{
+				Name:      stringPtr(tc.searchName),
+				NameFuzzy: tc.fuzzy,
+			})
+			assertion.NoError(err)
+
+			var ids []string
+			for _, resource := range result.Resources {
+				ids = append(ids, resource.ID)
+			}
+			assertion.Equal(tc.expectedIDs, ids)
+		})
+	}
+}
+
+func TestMockResourceSearcherIndex(t *testing.T) {
+	assertion := assert.New(t)
+
+	searcher := NewMockResourceSearcher()
+	result, err := searcher.QueryResources(context.Background(), model.SearchCriteria{ResourceType: stringPtr("committee")})
+
+	assertion.NoError(err)
+	assertion.NotEmpty(result.Resources)
+	for _, resource := range result.Resources {
+		assertion.Equal(IndexName, resource.Index)
+	}
+}
+
 // Helper function to create string pointers
 func stringPtr(s string) *string {
 	return &s
-}
\ No newline at end of file
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+// Helper function to create float64 pointers
+func float64Ptr(f float64) *float64 {
+	return &f
+}
@@ -5,17 +5,23 @@ package nats
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
 
 	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/model"
 	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/port"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
 )
 
 // NATSAccessControlChecker implements the AccessControlChecker interface for NATS
 type NATSAccessControlChecker struct {
-	client NATSClientInterface
+	client              NATSClientInterface
+	noRespondersRetries int
+	noRespondersBackoff time.Duration
 }
 
 // CheckAccess implements the AccessControlChecker interface
@@ -27,11 +33,30 @@ func (n *NATSAccessControlChecker) CheckAccess(ctx context.Context, subj string,
 	)
 
 	// Send request via NATS
-	response, err := n.client.CheckAccess(ctx, &AccessCheckNATSRequest{
+	request := &AccessCheckNATSRequest{
 		Subject: subj,
 		Message: data,
 		Timeout: timeout,
-	})
+		MsgID:   uuid.NewString(),
+	}
+	response, err := n.client.CheckAccess(ctx, request)
+	// Responders restarting are a transient condition worth a few retries;
+	// any other error is returned as is
+	backoff := n.noRespondersBackoff
+	for attempt := 1; attempt <= n.noRespondersRetries && errors.Is(err, nats.ErrNoResponders); attempt++ {
+		slog.WarnContext(ctx, "no NATS access control responders, retrying",
+			"subject", subj,
+			"attempt", attempt,
+			"backoff", backoff,
+		)
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("NATS access control check failed: %w", ctx.Err())
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		response, err = n.client.CheckAccess(ctx, request)
+	}
 	if err != nil {
 		slog.ErrorContext(ctx, "NATS access control check failed",
 			"error", err,
@@ -74,12 +99,20 @@ func NewAccessControlChecker(ctx context.Context, config Config) (port.AccessCon
 		"url", config.URL,
 	)
 
-	client, err := NewClient(ctx, config)
+	var client NATSClientInterface
+	var err error
+	if config.JetStream {
+		client, err = NewJetStreamClient(ctx, config)
+	} else {
+		client, err = NewClient(ctx, config)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to create NATS client: %w", err)
 	}
 
 	return &NATSAccessControlChecker{
-		client: client,
+		client:              client,
+		noRespondersRetries: config.NoRespondersRetries,
+		noRespondersBackoff: config.NoRespondersBackoff,
 	}, nil
 }
@@ -6,17 +6,22 @@ package nats
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/model"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/nats-io/nats.go"
 )
 
 // MockNATSClient is a mock implementation of NATSClientInterface
 type MockNATSClient struct {
 	checkAccessResponse AccessCheckNATSResponse
 	checkAccessError    error
+	checkAccessErrors   []error
+	checkAccessCalls    int
 	closeError          error
 	isReadyError        error
 }
@@ -26,6 +31,12 @@ func NewMockNATSClient() *MockNATSClient {
 }
 
 func (m *MockNATSClient) CheckAccess(ctx context.Context, request *AccessCheckNATSRequest) (AccessCheckNATSResponse, error) {
+	m.checkAccessCalls++
+	if len(m.checkAccessErrors) > 0 {
+		err := m.checkAccessErrors[0]
+		m.checkAccessErrors = m.checkAccessErrors[1:]
+		return nil, err
+	}
 	if m.checkAccessError != nil {
 		return nil, m.checkAccessError
 	}
@@ -48,6 +59,12 @@ func (m *MockNATSClient) SetCheckAccessError(err error) {
 	m.checkAccessError = err
 }
 
+// SetCheckAccessErrors sets errors returned, in order, by the next calls
+// before falling back to the configured response or error
+func (m *MockNATSClient) SetCheckAccessErrors(errs ...error) {
+	m.checkAccessErrors = errs
+}
+
 func (m *MockNATSClient) SetCloseError(err error) {
 	m.closeError = err
 }
@@ -207,6 +224,71 @@ func TestNATSAccessControlChecker_CheckAccess(t *testing.T) {
 	}
 }
 
+func TestNATSAccessControlChecker_CheckAccessNoRespondersRetry(t *testing.T) {
+	noResponders := fmt.Errorf("NATS request failed: %w", nats.ErrNoResponders)
+
+	tests := []struct {
+		name          string
+		retries       int
+		errs          []error
+		expectedError bool
+		expectedCalls int
+	}{
+		{
+			name:          "retry succeeds after no responders",
+			retries:       2,
+			errs:          []error{noResponders},
+			expectedCalls: 2,
+		},
+		{
+			name:          "no responders beyond the retries",
+			retries:       2,
+			errs:          []error{noResponders, noResponders, noResponders},
+			expectedError: true,
+			expectedCalls: 3,
+		},
+		{
+			name:          "other errors are not retried",
+			retries:       2,
+			errs:          []error{errors.New("NATS connection timeout")},
+			expectedError: true,
+			expectedCalls: 1,
+		},
+		{
+			name:          "retries disabled",
+			retries:       0,
+			errs:          []error{noResponders},
+			expectedError: true,
+			expectedCalls: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockClient := NewMockNATSClient()
+			mockClient.SetCheckAccessResponse(AccessCheckNATSResponse{"project:abc#viewer@user:user123": "true"})
+			mockClient.SetCheckAccessErrors(tc.errs...)
+
+			checker := &NATSAccessControlChecker{
+				client:              mockClient,
+				noRespondersRetries: tc.retries,
+				noRespondersBackoff: time.Millisecond,
+			}
+
+			result, err := checker.CheckAccess(context.Background(), "access.check", []byte("project:abc#viewer@user:user123"), time.Second)
+
+			if tc.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, model.AccessCheckResult{"project:abc#viewer@user:user123": "true"}, result)
+			}
+			assert.Equal(t, tc.expectedCalls, mockClient.checkAccessCalls)
+		})
+	}
+}
+
 func TestNATSAccessControlChecker_Close(t *testing.T) {
 	tests := []struct {
 		name           string
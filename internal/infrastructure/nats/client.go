@@ -34,12 +34,8 @@ type NATSClientInterface interface {
 // CheckAccess sends an access control request via NATS and waits for the response
 func (c *NATSClient) CheckAccess(ctx context.Context, request *AccessCheckNATSRequest) (AccessCheckNATSResponse, error) {
 
-	if request == nil {
-		return nil, fmt.Errorf("invalid NATS access check request: request cannot be nil")
-	}
-
-	if request.Subject == "" || request.Message == nil || len(request.Message) == 0 {
-		return nil, fmt.Errorf("invalid NATS access check request: subject and message must be set")
+	if err := validateAccessCheckRequest(request); err != nil {
+		return nil, err
 	}
 
 	// Send the request and wait for response
@@ -54,10 +50,28 @@ func (c *NATSClient) CheckAccess(ctx context.Context, request *AccessCheckNATSRe
 		"timeout", request.Timeout,
 	)
 
+	return parseAccessCheckResponse(ctx, natsResponse.Data)
+}
+
+// validateAccessCheckRequest rejects requests without a subject or message
+func validateAccessCheckRequest(request *AccessCheckNATSRequest) error {
+	if request == nil {
+		return fmt.Errorf("invalid NATS access check request: request cannot be nil")
+	}
+
+	if request.Subject == "" || request.Message == nil || len(request.Message) == 0 {
+		return fmt.Errorf("invalid NATS access check request: subject and message must be set")
+	}
+	return nil
+}
+
+// parseAccessCheckResponse parses the reply of the access check responders,
+// one "<relation>\t<allowed>" line per checked tuple
+func parseAccessCheckResponse(ctx context.Context, data []byte) (AccessCheckNATSResponse, error) {
 	response := make(map[string]string)
 	// Deserialize the response
 	// Parse the response.
-	lines := bytes.Split(natsResponse.Data, []byte("\n"))
+	lines := bytes.Split(data, []byte("\n"))
 	for _, line := range lines {
 		// Split the relation from the "allowed" result.
 		var relationPart, allowedPart []byte
@@ -0,0 +1,93 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/errors"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+)
+
+// msgRequester sends a request message and waits for its reply; it is
+// implemented by *nats.Conn and stubbed in tests
+type msgRequester interface {
+	RequestMsgWithContext(ctx context.Context, msg *nats.Msg) (*nats.Msg, error)
+}
+
+// JetStreamClient issues the access checks with the JetStream
+// de-duplication header (Nats-Msg-Id), so that a stream capturing the access
+// check subject stores a check retried across a NATS restart only once. The
+// stream must not acknowledge the requests (no_ack), for the reply to come
+// from the access check responders.
+type JetStreamClient struct {
+	*NATSClient
+	requester msgRequester
+}
+
+// CheckAccess sends an access control request with a de-duplication ID and
+// waits for the response
+func (c *JetStreamClient) CheckAccess(ctx context.Context, request *AccessCheckNATSRequest) (AccessCheckNATSResponse, error) {
+
+	if err := validateAccessCheckRequest(request); err != nil {
+		return nil, err
+	}
+	// Kept on the request, for its retries to be de-duplicated
+	if request.MsgID == "" {
+		request.MsgID = uuid.NewString()
+	}
+
+	msg := nats.NewMsg(request.Subject)
+	msg.Data = request.Message
+	msg.Header.Set(nats.MsgIdHdr, request.MsgID)
+
+	if request.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, request.Timeout)
+		defer cancel()
+	}
+
+	natsResponse, errRequest := c.requester.RequestMsgWithContext(ctx, msg)
+	if errRequest != nil {
+		return nil, fmt.Errorf("NATS JetStream request failed: %w", errRequest)
+	}
+
+	slog.DebugContext(ctx, "received NATS JetStream response",
+		"subject", request.Subject,
+		"msg_id", request.MsgID,
+		"message", string(natsResponse.Data),
+	)
+
+	return parseAccessCheckResponse(ctx, natsResponse.Data)
+}
+
+// NewJetStreamClient creates a NATS client issuing the access checks with
+// JetStream de-duplication; JetStream must be enabled on the server
+func NewJetStreamClient(ctx context.Context, config Config) (*JetStreamClient, error) {
+	client, err := NewClient(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	js, err := client.conn.JetStream()
+	if err != nil {
+		_ = client.Close()
+		return nil, errors.NewServiceUnavailable("failed to create NATS JetStream context", err)
+	}
+	if _, err := js.AccountInfo(); err != nil {
+		_ = client.Close()
+		return nil, errors.NewServiceUnavailable("NATS JetStream is not available", err)
+	}
+
+	slog.InfoContext(ctx, "NATS JetStream access checks enabled")
+
+	return &JetStreamClient{
+		NATSClient: client,
+		requester:  client.conn,
+	}, nil
+}
@@ -0,0 +1,125 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nats-io/nats.go"
+)
+
+// stubRequester records the request messages and replies with a fixed message
+type stubRequester struct {
+	requests []*nats.Msg
+	reply    []byte
+	err      error
+}
+
+func (s *stubRequester) RequestMsgWithContext(ctx context.Context, msg *nats.Msg) (*nats.Msg, error) {
+	s.requests = append(s.requests, msg)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &nats.Msg{Data: s.reply}, nil
+}
+
+func TestJetStreamClient_CheckAccess(t *testing.T) {
+	tests := []struct {
+		name             string
+		request          *AccessCheckNATSRequest
+		reply            []byte
+		requestErr       error
+		expectedResponse AccessCheckNATSResponse
+		expectedMsgID    string
+		expectedError    bool
+	}{
+		{
+			name: "dedup header from the request",
+			request: &AccessCheckNATSRequest{
+				Subject: "lfx.access_check.request",
+				Message: []byte("project:123#viewer@user:456"),
+				Timeout: time.Second,
+				MsgID:   "check-1",
+			},
+			reply:            []byte("project:123#viewer@user:456\ttrue"),
+			expectedResponse: AccessCheckNATSResponse{"project:123#viewer@user:456": "true"},
+			expectedMsgID:    "check-1",
+		},
+		{
+			name: "dedup header generated",
+			request: &AccessCheckNATSRequest{
+				Subject: "lfx.access_check.request",
+				Message: []byte("project:123#viewer@user:456"),
+			},
+			reply:            []byte("project:123#viewer@user:456\tfalse"),
+			expectedResponse: AccessCheckNATSResponse{"project:123#viewer@user:456": "false"},
+		},
+		{
+			name: "request failure",
+			request: &AccessCheckNATSRequest{
+				Subject: "lfx.access_check.request",
+				Message: []byte("project:123#viewer@user:456"),
+				MsgID:   "check-2",
+			},
+			requestErr:    nats.ErrNoResponders,
+			expectedMsgID: "check-2",
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+			requester := &stubRequester{reply: tc.reply, err: tc.requestErr}
+			client := &JetStreamClient{requester: requester}
+
+			response, err := client.CheckAccess(context.Background(), tc.request)
+
+			if tc.expectedError {
+				assertion.Error(err)
+				assertion.True(errors.Is(err, tc.requestErr))
+			} else {
+				assertion.NoError(err)
+				assertion.Equal(tc.expectedResponse, response)
+			}
+			if assertion.Len(requester.requests, 1) {
+				msg := requester.requests[0]
+				assertion.Equal(tc.request.Subject, msg.Subject)
+				assertion.Equal(tc.request.Message, msg.Data)
+				msgID := msg.Header.Get(nats.MsgIdHdr)
+				assertion.NotEmpty(msgID)
+				assertion.Equal(tc.request.MsgID, msgID)
+				if tc.expectedMsgID != "" {
+					assertion.Equal(tc.expectedMsgID, msgID)
+				}
+			}
+		})
+	}
+}
+
+func TestJetStreamClient_CheckAccessRetryKeepsMsgID(t *testing.T) {
+	assertion := assert.New(t)
+	requester := &stubRequester{err: nats.ErrNoResponders}
+	checker := &NATSAccessControlChecker{
+		client:              &JetStreamClient{requester: requester},
+		noRespondersRetries: 2,
+		noRespondersBackoff: time.Millisecond,
+	}
+
+	_, err := checker.CheckAccess(context.Background(), "lfx.access_check.request", []byte("project:123#viewer@user:456"), time.Second)
+
+	assertion.Error(err)
+	if assertion.Len(requester.requests, 3) {
+		msgID := requester.requests[0].Header.Get(nats.MsgIdHdr)
+		assertion.NotEmpty(msgID)
+		for _, msg := range requester.requests[1:] {
+			assertion.Equal(msgID, msg.Header.Get(nats.MsgIdHdr))
+		}
+	}
+}
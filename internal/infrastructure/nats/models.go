@@ -17,6 +17,15 @@ type Config struct {
 	MaxReconnect int `json:"max_reconnect"`
 	// ReconnectWait is the time to wait between reconnection attempts
 	ReconnectWait time.Duration `json:"reconnect_wait"`
+	// NoRespondersRetries is the number of times an access check is retried
+	// when no responder is available, e.g. while the responders restart
+	NoRespondersRetries int `json:"no_responders_retries"`
+	// NoRespondersBackoff is the wait before the first retry, doubled for
+	// each following one
+	NoRespondersBackoff time.Duration `json:"no_responders_backoff"`
+	// JetStream issues the access checks with a JetStream de-duplication
+	// header instead of as plain core requests
+	JetStream bool `json:"jetstream"`
 }
 
 // AccessCheckNATSRequest represents a NATS request for access checking
@@ -27,6 +36,9 @@ type AccessCheckNATSRequest struct {
 	Message []byte `json:"message"`
 	// Timeout is the request timeout duration
 	Timeout time.Duration `json:"timeout"`
+	// MsgID identifies the request for JetStream de-duplication; retries of
+	// a request reuse it
+	MsgID string `json:"msg_id"`
 }
 
 // AccessCheckNATSResponse represents a NATS response for access checking
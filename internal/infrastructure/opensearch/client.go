@@ -7,28 +7,49 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"log/slog"
 	"net/http"
+	"sort"
 
-	"github.com/linuxfoundation/lfx-v2-query-service/pkg/constants"
 	"github.com/linuxfoundation/lfx-v2-query-service/pkg/errors"
 	"github.com/linuxfoundation/lfx-v2-query-service/pkg/global"
 	"github.com/linuxfoundation/lfx-v2-query-service/pkg/paging"
+	"github.com/opensearch-project/opensearch-go/v4"
 	"github.com/opensearch-project/opensearch-go/v4/opensearchapi"
 )
 
+// indexNotFoundException is the type of the OpenSearch error for a missing index
+const indexNotFoundException = "index_not_found_exception"
+
+// indexNotFoundError maps the OpenSearch error for a missing index to a
+// ServiceUnavailable error, as the index is misconfigured or not created
+// yet, which is for the operators to fix rather than the client
+func indexNotFoundError(err error, index string) (errors.ServiceUnavailable, bool) {
+	var structErr *opensearch.StructError
+	if !stderrors.As(err, &structErr) || structErr.Err.Type != indexNotFoundException {
+		return errors.ServiceUnavailable{}, false
+	}
+	if structErr.Err.Index != "" {
+		index = structErr.Err.Index
+	}
+	return errors.NewServiceUnavailable(fmt.Sprintf("index %s not found", index)), true
+}
+
 type httpClient struct {
 	baseURL    string
 	httpClient *http.Client
 	client     *opensearchapi.Client
+	// queryLogMaxBytes is the size above which queries are truncated in logs
+	queryLogMaxBytes int
 }
 
 func (c *httpClient) Search(ctx context.Context, index string, query []byte) (*SearchResponse, error) {
 
 	slog.DebugContext(ctx, "executing opensearch search",
 		"index", index,
-		"query", string(query),
+		"query", loggedQuery(query, c.queryLogMaxBytes),
 	)
 
 	searchRequest := opensearchapi.SearchReq{
@@ -43,6 +64,10 @@ func (c *httpClient) Search(ctx context.Context, index string, query []byte) (*S
 				"public",
 				"access_check_object",
 				"access_check_relation",
+				"transaction_id",
+				"created_by",
+				"updated_by",
+				"parent_refs",
 				"data",
 			},
 		},
@@ -50,6 +75,9 @@ func (c *httpClient) Search(ctx context.Context, index string, query []byte) (*S
 
 	searchResponse, errSearchResponse := c.client.Search(ctx, &searchRequest)
 	if errSearchResponse != nil {
+		if errIndex, ok := indexNotFoundError(errSearchResponse, index); ok {
+			return nil, errIndex
+		}
 		return nil, fmt.Errorf("failed to execute search: %w", errSearchResponse)
 	}
 
@@ -61,20 +89,24 @@ func (c *httpClient) Search(ctx context.Context, index string, query []byte) (*S
 	result := &SearchResponse{
 		Hits: Hits{
 			Total: Total{
-				Value: searchResponse.Hits.Total.Value,
+				Value:    searchResponse.Hits.Total.Value,
+				Relation: searchResponse.Hits.Total.Relation,
 			},
-			Hits: make([]Hit, len(searchResponse.Hits.Hits)),
+			MaxScore: float64(searchResponse.Hits.MaxScore),
+			Hits:     make([]Hit, len(searchResponse.Hits.Hits)),
 		},
 	}
 	for i, hit := range searchResponse.Hits.Hits {
 		result.Hits.Hits[i] = Hit{
 			ID:     hit.ID,
+			Score:  float64(hit.Score),
 			Source: hit.Source,
 		}
 	}
 
-	// if the number of hits returned equals the page size, there may be more results.
-	if len(searchResponse.Hits.Hits) == constants.DefaultPageSize {
+	// The sort values of the last hit resume the search on the next page; the
+	// searcher, which knows the page size, drops them when the page is not full
+	if len(searchResponse.Hits.Hits) > 0 && searchResponse.Hits.Hits[len(searchResponse.Hits.Hits)-1].Sort != nil {
 		searchAfter := searchResponse.Hits.Hits[len(searchResponse.Hits.Hits)-1].Sort
 		pageToken, errEncodePageToken := paging.EncodePageToken(searchAfter, global.PageTokenSecret(ctx))
 		if errEncodePageToken != nil {
@@ -100,6 +132,9 @@ func (c *httpClient) AggregationSearch(ctx context.Context, index string, query
 	// Perform the search.
 	searchResponse, err := c.client.Search(ctx, &searchRequest)
 	if err != nil {
+		if errIndex, ok := indexNotFoundError(err, index); ok {
+			return nil, errIndex
+		}
 		return nil, fmt.Errorf("opensearch search failed: %w", err)
 	}
 
@@ -124,6 +159,9 @@ func (c *httpClient) Count(ctx context.Context, index string, query []byte) (*Co
 	}
 	countResponse, err := c.client.Indices.Count(ctx, &countRequest)
 	if err != nil {
+		if errIndex, ok := indexNotFoundError(err, index); ok {
+			return nil, errIndex
+		}
 		return nil, fmt.Errorf("opensearch count failed: %w", err)
 	}
 	return &CountResponse{
@@ -131,6 +169,30 @@ func (c *httpClient) Count(ctx context.Context, index string, query []byte) (*Co
 	}, nil
 }
 
+func (c *httpClient) ResolveAlias(ctx context.Context, name string) ([]string, error) {
+	aliasResponse, err := c.client.Indices.Alias.Get(ctx, opensearchapi.AliasGetReq{
+		Indices: []string{"_all"},
+		Alias:   []string{name},
+	})
+	if err != nil {
+		// A missing alias is reported as not found: the name is a concrete index
+		if aliasResponse != nil {
+			if inspect := aliasResponse.Inspect(); inspect.Response != nil && inspect.Response.StatusCode == http.StatusNotFound {
+				return nil, nil
+			}
+		}
+		return nil, fmt.Errorf("opensearch alias lookup failed: %w", err)
+	}
+
+	indices := make([]string, 0, len(aliasResponse.Indices))
+	for index := range aliasResponse.Indices {
+		indices = append(indices, index)
+	}
+	sort.Strings(indices)
+
+	return indices, nil
+}
+
 func (c *httpClient) IsReady(ctx context.Context) error {
 	pingReq := &opensearchapi.PingReq{
 		Params: opensearchapi.PingParams{
@@ -0,0 +1,71 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package opensearch
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	pkgerrors "github.com/linuxfoundation/lfx-v2-query-service/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// indexNotFoundResponse is the response of OpenSearch for a missing index
+const indexNotFoundResponse = `{
+  "error": {
+    "root_cause": [{"type": "index_not_found_exception", "reason": "no such index [lfx-resources]", "index": "lfx-resources", "resource.type": "index_or_alias", "resource.id": "lfx-resources", "index_uuid": "_na_"}],
+    "type": "index_not_found_exception",
+    "reason": "no such index [lfx-resources]",
+    "index": "lfx-resources",
+    "resource.type": "index_or_alias",
+    "resource.id": "lfx-resources",
+    "index_uuid": "_na_"
+  },
+  "status": 404
+}`
+
+func TestHTTPClientIndexNotFound(t *testing.T) {
+	operations := map[string]func(client *httpClient) error{
+		"search": func(client *httpClient) error {
+			_, err := client.Search(context.Background(), "lfx-resources", []byte(`{"query":{"match_all":{}}}`))
+			return err
+		},
+		"aggregation search": func(client *httpClient) error {
+			_, err := client.AggregationSearch(context.Background(), "lfx-resources", []byte(`{"size":0}`))
+			return err
+		},
+		"count": func(client *httpClient) error {
+			_, err := client.Count(context.Background(), "lfx-resources", []byte(`{"query":{"match_all":{}}}`))
+			return err
+		},
+	}
+
+	for name, operation := range operations {
+		t.Run(name, func(t *testing.T) {
+			var served atomic.Int32
+			server := failoverServer(t, http.StatusNotFound, indexNotFoundResponse, &served)
+			client, _ := newFailoverClient(t, server.URL)
+
+			err := operation(client)
+
+			unavailable, ok := err.(pkgerrors.ServiceUnavailable)
+			if assert.True(t, ok, "expected a ServiceUnavailable error, got %T: %v", err, err) {
+				assert.Equal(t, "index lfx-resources not found", unavailable.Error())
+			}
+		})
+	}
+
+	t.Run("other errors are not mapped", func(t *testing.T) {
+		var served atomic.Int32
+		server := failoverServer(t, http.StatusBadRequest, `{"error":{"type":"parsing_exception","reason":"bad query"},"status":400}`, &served)
+		client, _ := newFailoverClient(t, server.URL)
+
+		_, err := client.Search(context.Background(), "lfx-resources", []byte(`{"query":{}}`))
+		assert.Error(t, err)
+		_, ok := err.(pkgerrors.ServiceUnavailable)
+		assert.False(t, ok)
+	})
+}
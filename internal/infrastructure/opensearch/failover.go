@@ -0,0 +1,156 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package opensearch
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// endpointCooldown is how long an endpoint that failed to connect is tried
+// after the healthy ones
+const endpointCooldown = 30 * time.Second
+
+// ParseURLs parses a comma-separated list of OpenSearch endpoints, in the
+// order they are tried. Requests fail over by scheme and host only, so when
+// several endpoints are listed none of them may carry a path or credentials.
+func ParseURLs(value string) ([]*url.URL, error) {
+	var endpoints []*url.URL
+	for _, raw := range strings.Split(value, ",") {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+		endpoint, err := url.Parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid opensearch URL %s: %w", raw, err)
+		}
+		if endpoint.Scheme == "" || endpoint.Host == "" {
+			return nil, fmt.Errorf("invalid opensearch URL %s: scheme and host are required", raw)
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("opensearch URL is required")
+	}
+	if len(endpoints) > 1 {
+		for _, endpoint := range endpoints {
+			if endpoint.User != nil || strings.Trim(endpoint.Path, "/") != "" {
+				return nil, fmt.Errorf("invalid opensearch URL %s: a path or credentials are not supported with several URLs", endpoint.Redacted())
+			}
+		}
+	}
+	return endpoints, nil
+}
+
+// failoverTransport sends each request to the first endpoint it can connect
+// to, in the configured order, except that endpoints which recently failed to
+// connect are tried last. Responses are never retried elsewhere, whatever their
+// status: only connection failures fail over.
+type failoverTransport struct {
+	endpoints []*url.URL
+	base      http.RoundTripper
+
+	mu sync.Mutex
+	// failedUntil is, per endpoint, the end of its cooldown after a failure
+	failedUntil []time.Time
+	now         func() time.Time
+}
+
+func newFailoverTransport(endpoints []*url.URL, base http.RoundTripper) *failoverTransport {
+	return &failoverTransport{
+		endpoints:   endpoints,
+		base:        base,
+		failedUntil: make([]time.Time, len(endpoints)),
+		now:         time.Now,
+	}
+}
+
+// order returns the endpoint indexes to try: healthy endpoints first, then
+// those cooling down, each in the configured order
+func (t *failoverTransport) order() []int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	healthy := make([]int, 0, len(t.endpoints))
+	var coolingDown []int
+	for idx, failedUntil := range t.failedUntil {
+		if now.Before(failedUntil) {
+			coolingDown = append(coolingDown, idx)
+			continue
+		}
+		healthy = append(healthy, idx)
+	}
+	return append(healthy, coolingDown...)
+}
+
+func (t *failoverTransport) markFailed(idx int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.failedUntil[idx] = t.now().Add(endpointCooldown)
+}
+
+func (t *failoverTransport) markHealthy(idx int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.failedUntil[idx] = time.Time{}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *failoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// The body is sent again to each endpoint tried
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var lastErr error
+	for _, idx := range t.order() {
+		endpoint := t.endpoints[idx]
+
+		attempt := req.Clone(req.Context())
+		attempt.URL.Scheme = endpoint.Scheme
+		attempt.URL.Host = endpoint.Host
+		attempt.Host = endpoint.Host
+		if body != nil {
+			attempt.Body = io.NopCloser(bytes.NewReader(body))
+			attempt.GetBody = func() (io.ReadCloser, error) {
+				return io.NopCloser(bytes.NewReader(body)), nil
+			}
+		}
+
+		response, err := t.base.RoundTrip(attempt)
+		if err == nil {
+			t.markHealthy(idx)
+			return response, nil
+		}
+		// A cancelled request says nothing about the endpoint
+		if req.Context().Err() != nil {
+			return nil, err
+		}
+
+		lastErr = err
+		t.markFailed(idx)
+		if len(t.endpoints) > 1 {
+			slog.WarnContext(req.Context(), "opensearch endpoint unreachable, failing over",
+				"endpoint", endpoint.Host,
+				"error", err,
+			)
+		}
+	}
+	return nil, lastErr
+}
@@ -0,0 +1,67 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package opensearch
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/global"
+)
+
+// UserAgent identifies the query service and its build version in the
+// requests sent to OpenSearch
+func UserAgent() string {
+	return constants.ServiceName + "/" + global.Version
+}
+
+// headerTransport sets fixed headers on every request. The headers replace
+// those set by the OpenSearch client, whose global headers are only added to
+// its own User-Agent.
+type headerTransport struct {
+	header http.Header
+	base   http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the caller's request
+	req = req.Clone(req.Context())
+	for name, values := range t.header {
+		req.Header[name] = values
+	}
+	return t.base.RoundTrip(req)
+}
+
+// ParseHeaders parses comma-separated "Name=value" request headers, e.g.
+// "X-Opaque-Id=query-service,X-Team=lfx"
+func ParseHeaders(value string) (map[string]string, error) {
+	headers := make(map[string]string)
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, headerValue, ok := strings.Cut(entry, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" || strings.ContainsAny(name, " \t:") {
+			return nil, fmt.Errorf("invalid header %q: must be <name>=<value>", entry)
+		}
+		headers[name] = strings.TrimSpace(headerValue)
+	}
+	return headers, nil
+}
+
+// requestHeader returns the headers of the requests sent to OpenSearch: the
+// User-Agent and the configured ones, which may override it
+func requestHeader(headers map[string]string) http.Header {
+	header := http.Header{}
+	header.Set("User-Agent", UserAgent())
+	for name, value := range headers {
+		header.Set(name, value)
+	}
+	return header
+}
@@ -0,0 +1,122 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package opensearch
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// recordingTransport records the outgoing requests and answers each with a
+// search response
+type recordingTransport struct {
+	requests []*http.Request
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests = append(t.requests, req)
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(failoverSearchResponse)),
+		Request:    req,
+	}, nil
+}
+
+func TestOpenSearchClientHeaders(t *testing.T) {
+	tests := []struct {
+		name              string
+		headers           map[string]string
+		expectedUserAgent string
+		expectedHeaders   map[string]string
+	}{
+		{
+			name:              "service user agent",
+			expectedUserAgent: UserAgent(),
+		},
+		{
+			name:              "custom headers",
+			headers:           map[string]string{"X-Opaque-Id": "query-service", "X-Team": "lfx"},
+			expectedUserAgent: UserAgent(),
+			expectedHeaders:   map[string]string{"X-Opaque-Id": "query-service", "X-Team": "lfx"},
+		},
+		{
+			name:              "user agent overridden",
+			headers:           map[string]string{"User-Agent": "custom-agent/1.0"},
+			expectedUserAgent: "custom-agent/1.0",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+			endpoint, err := url.Parse("http://opensearch.example:9200")
+			assertion.NoError(err)
+
+			transport := &recordingTransport{}
+			opensearchClient, err := newOpenSearchClient([]*url.URL{endpoint}, transport, requestHeader(tc.headers))
+			assertion.NoError(err)
+			client := &httpClient{client: opensearchClient}
+
+			_, err = client.Search(context.Background(), "resources", []byte(`{"query":{"match_all":{}}}`))
+			assertion.NoError(err)
+
+			if assertion.Len(transport.requests, 1) {
+				header := transport.requests[0].Header
+				assertion.Equal([]string{tc.expectedUserAgent}, header.Values("User-Agent"))
+				for name, value := range tc.expectedHeaders {
+					assertion.Equal(value, header.Get(name))
+				}
+			}
+		})
+	}
+}
+
+func TestParseHeaders(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         string
+		expected      map[string]string
+		expectedError bool
+	}{
+		{
+			name:     "several headers",
+			value:    "X-Opaque-Id=query-service, X-Team=lfx",
+			expected: map[string]string{"X-Opaque-Id": "query-service", "X-Team": "lfx"},
+		},
+		{
+			name:     "value with an equal sign",
+			value:    "X-Tags=env=prod",
+			expected: map[string]string{"X-Tags": "env=prod"},
+		},
+		{
+			name:          "missing value",
+			value:         "X-Opaque-Id",
+			expectedError: true,
+		},
+		{
+			name:          "invalid name",
+			value:         "X Opaque=query-service",
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			headers, err := ParseHeaders(tc.value)
+			if tc.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, headers)
+		})
+	}
+}
@@ -3,14 +3,71 @@
 
 package opensearch
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/model"
+)
 
 // Config represents OpenSearch configuration
 type Config struct {
+	// URL is the OpenSearch URL, or comma-separated URLs tried in order when
+	// the previous ones cannot be connected to
 	URL   string `json:"url"`
 	Index string `json:"index"`
+	// NameFields are the fields the name search matches, each optionally
+	// boosted with the "field^boost" syntax; empty means DefaultNameFields
+	NameFields []string `json:"name_fields"`
+	// IDField and TypeField are the source fields holding the identifier and
+	// type of a resource; empty means DefaultIDField and DefaultTypeField
+	IDField   string `json:"id_field"`
+	TypeField string `json:"type_field"`
+	// DefaultPublicTypes are the resource types considered public when the
+	// public field is absent from their source; other types are private
+	DefaultPublicTypes []string `json:"default_public_types"`
+	// LegacyResourceID keeps the document _id as the resource ID (and for
+	// excluded IDs) instead of the identifier source field
+	LegacyResourceID bool `json:"legacy_resource_id"`
+	// QueryLogMaxBytes is the size above which rendered queries are truncated
+	// in debug logs; 0 means DefaultQueryLogMaxBytes, negative logs them in full
+	QueryLogMaxBytes int `json:"query_log_max_bytes"`
+	// TemplatesDir is the directory of the search templates clients may
+	// invoke by name; empty registers none
+	TemplatesDir string `json:"templates_dir"`
+	// Headers are sent with every request, in addition to the User-Agent
+	// identifying the service, which they may override
+	Headers map[string]string `json:"headers"`
+}
+
+// queryParams are the parameters of the resource query template
+type queryParams struct {
+	model.SearchCriteria
+	NameFields []string
+	// ExcludeIDsField is the field matching the excluded IDs; empty matches
+	// the document _id
+	ExcludeIDsField string
+	// Facets are the facet aggregations of the query, never combined with GroupBy
+	Facets    []facetParams
+	FacetSize int
+	// BucketSize is the number of access check buckets per facet value
+	BucketSize int
+	// TemplateQuery is the rendered clause of the requested search template
+	TemplateQuery string
+	// CollapseField is the index field of the requested collapse field
+	CollapseField string
+}
+
+// facetParams are the aggregation name and index field of a facet
+type facetParams struct {
+	Name  string
+	Field string
 }
 
+// facetAggregationPrefix prefixes the names of the facet aggregations, to
+// tell them apart from the other aggregations of a response
+const facetAggregationPrefix = "facet_"
+
 // SearchResponse represents the OpenSearch search response
 type SearchResponse struct {
 	Hits      `json:"hits"`
@@ -36,22 +93,100 @@ type TermsAggregation struct {
 
 // AggregationResponse represents the aggregations in a search response.
 type AggregationResponse struct {
-	GroupBy TermsAggregation `json:"group_by"`
+	GroupBy TermsAggregation   `json:"group_by"`
+	Parents ParentsAggregation `json:"parents"`
+	// Facets are the facet aggregations, by facet field
+	Facets map[string]FacetAggregation `json:"-"`
+}
+
+// UnmarshalJSON decodes the aggregations, collecting the facet aggregations
+// by facet field
+func (r *AggregationResponse) UnmarshalJSON(data []byte) error {
+	type aggregationResponse AggregationResponse
+	var response aggregationResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return err
+	}
+
+	var aggregations map[string]json.RawMessage
+	if err := json.Unmarshal(data, &aggregations); err != nil {
+		return err
+	}
+	for name, raw := range aggregations {
+		field, ok := strings.CutPrefix(name, facetAggregationPrefix)
+		if !ok {
+			continue
+		}
+		var facet FacetAggregation
+		if err := json.Unmarshal(raw, &facet); err != nil {
+			return err
+		}
+		if response.Facets == nil {
+			response.Facets = make(map[string]FacetAggregation)
+		}
+		response.Facets[field] = facet
+	}
+
+	*r = AggregationResponse(response)
+	return nil
+}
+
+// FacetAggregation represents the public and private resources of each value
+// of a facet field.
+type FacetAggregation struct {
+	SumOtherDocCount uint64            `json:"sum_other_doc_count"`
+	Buckets          []ChildTypeBucket `json:"buckets"`
+}
+
+// ParentsAggregation represents the child count aggregation, by parent.
+type ParentsAggregation struct {
+	Buckets []ParentBucket `json:"buckets"`
+}
+
+// ParentBucket represents the children of a single parent, by child type.
+type ParentBucket struct {
+	Key   string `json:"key"`
+	Types struct {
+		Buckets []ChildTypeBucket `json:"buckets"`
+	} `json:"types"`
+}
+
+// ChildTypeBucket represents the public and private children of a single type,
+// or the public and private resources with a single facet value.
+type ChildTypeBucket struct {
+	Key    string `json:"key"`
+	Public struct {
+		DocCount uint64 `json:"doc_count"`
+	} `json:"public"`
+	Private struct {
+		AccessCheck TermsAggregation `json:"access_check"`
+	} `json:"private"`
+}
+
+// childCountParams are the parameters of the child count query template.
+type childCountParams struct {
+	ParentRefs []string
+	PublicOnly bool
+	TypesSize  int
+	BucketSize int
 }
 
 // Hits represents the hits in the search response
 type Hits struct {
-	Total `json:"total"`
-	Hits  []Hit `json:"hits"`
+	Total    `json:"total"`
+	MaxScore float64 `json:"max_score"`
+	Hits     []Hit   `json:"hits"`
 }
 
 // Total represents the total number of hits
 type Total struct {
-	Value int `json:"value"`
+	Value    int    `json:"value"`
+	Relation string `json:"relation"`
 }
 
 // Hit represents a single search result hit
 type Hit struct {
+	Index  string          `json:"_index"`
 	ID     string          `json:"_id"`
 	Score  float64         `json:"_score"`
 	Source json.RawMessage `json:"_source"`
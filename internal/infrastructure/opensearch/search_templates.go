@@ -0,0 +1,145 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package opensearch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/errors"
+)
+
+// SearchTemplateExtension is the file extension of the search templates of a
+// template directory; the file name without it is the template name
+const SearchTemplateExtension = ".tmpl"
+
+// SearchTemplates are query clauses registered server-side, which clients
+// invoke by name with parameters instead of constructing queries. A template
+// renders a single query clause, added to the filters of the search, and reads
+// its parameters with {{ param "name" }}, which yields the JSON-quoted value.
+type SearchTemplates struct {
+	templates map[string]*template.Template
+}
+
+// LoadSearchTemplates parses each search template file of the directory
+func LoadSearchTemplates(dir string) (*SearchTemplates, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*"+SearchTemplateExtension))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list search templates: %w", err)
+	}
+
+	templates := &SearchTemplates{templates: make(map[string]*template.Template, len(paths))}
+	for _, path := range paths {
+		source, errRead := os.ReadFile(path)
+		if errRead != nil {
+			return nil, fmt.Errorf("failed to read search template %s: %w", path, errRead)
+		}
+		name := strings.TrimSuffix(filepath.Base(path), SearchTemplateExtension)
+		if err := templates.Register(name, string(source)); err != nil {
+			return nil, err
+		}
+	}
+	return templates, nil
+}
+
+// Register parses the source of a search template under the given name
+func (t *SearchTemplates) Register(name, source string) error {
+	parsed, err := template.New(name).
+		Funcs(template.FuncMap{
+			"quote": strconv.Quote,
+			// Bound to the parameters of each invocation when rendering
+			"param": func(string) (string, error) { return "", nil },
+		}).
+		Parse(source)
+	if err != nil {
+		return fmt.Errorf("failed to parse search template %s: %w", name, err)
+	}
+	if t.templates == nil {
+		t.templates = make(map[string]*template.Template)
+	}
+	t.templates[name] = parsed
+	return nil
+}
+
+// Names returns the names of the registered templates, sorted
+func (t *SearchTemplates) Names() []string {
+	if t == nil {
+		return nil
+	}
+	names := make([]string, 0, len(t.templates))
+	for name := range t.templates {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Render renders the named template with the given parameters. Unknown
+// templates, missing parameters and parameters the template does not use are
+// validation errors.
+func (t *SearchTemplates) Render(name string, params map[string]string) (string, error) {
+	var registered *template.Template
+	if t != nil {
+		registered = t.templates[name]
+	}
+	if registered == nil {
+		return "", errors.NewValidation(fmt.Sprintf("unknown search template %q", name))
+	}
+
+	var missing []string
+	used := make(map[string]struct{}, len(params))
+	invocation, err := registered.Clone()
+	if err != nil {
+		return "", fmt.Errorf("failed to clone search template %s: %w", name, err)
+	}
+	invocation.Funcs(template.FuncMap{
+		"param": func(param string) (string, error) {
+			value, ok := params[param]
+			if !ok {
+				if !slices.Contains(missing, param) {
+					missing = append(missing, param)
+				}
+				return "null", nil
+			}
+			used[param] = struct{}{}
+			quoted, errQuote := json.Marshal(value)
+			if errQuote != nil {
+				return "", errQuote
+			}
+			return string(quoted), nil
+		},
+	})
+
+	var buf bytes.Buffer
+	if err := invocation.Execute(&buf, nil); err != nil {
+		return "", fmt.Errorf("failed to render search template %s: %w", name, err)
+	}
+
+	if len(missing) > 0 {
+		return "", errors.NewValidation(fmt.Sprintf("search template %q requires parameters: %s", name, strings.Join(missing, ", ")))
+	}
+	var unknown []string
+	for param := range params {
+		if _, ok := used[param]; !ok {
+			unknown = append(unknown, param)
+		}
+	}
+	if len(unknown) > 0 {
+		slices.Sort(unknown)
+		return "", errors.NewValidation(fmt.Sprintf("search template %q does not take parameters: %s", name, strings.Join(unknown, ", ")))
+	}
+
+	clause := bytes.TrimSpace(buf.Bytes())
+	if !json.Valid(clause) {
+		return "", fmt.Errorf("search template %s rendered an invalid query clause", name)
+	}
+	return string(clause), nil
+}
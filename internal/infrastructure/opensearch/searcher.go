@@ -11,16 +11,22 @@ import (
 	"log/slog"
 	"net"
 	"net/http"
+	"net/url"
+	"slices"
 	"strconv"
+	"strings"
 	"text/template"
 	"time"
+	"unicode/utf8"
 
 	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/model"
 	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/port"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/constants"
 	"github.com/linuxfoundation/lfx-v2-query-service/pkg/errors"
 
 	"github.com/opensearch-project/opensearch-go/v4"
 	"github.com/opensearch-project/opensearch-go/v4/opensearchapi"
+	"golang.org/x/sync/errgroup"
 )
 
 var queryResourceTemplate = template.Must(
@@ -30,10 +36,117 @@ var queryResourceTemplate = template.Must(
 		}).
 		Parse(queryResourceSource))
 
+var childCountTemplate = template.Must(
+	template.New("childCount").
+		Funcs(template.FuncMap{
+			"quote": strconv.Quote,
+		}).
+		Parse(childCountSource))
+
 // OpenSearchSearcher implements the ResourceSearcher interface for OpenSearch
 type OpenSearchSearcher struct {
-	client OpenSearchClientRetriever
-	index  string
+	client     OpenSearchClientRetriever
+	index      string
+	nameFields []string
+	idField    string
+	typeField  string
+	// defaultPublicTypes are public when the public field is absent
+	defaultPublicTypes []string
+	// legacyResourceID keeps the document _id as the resource ID
+	legacyResourceID bool
+	// templates are the search templates clients invoke by name
+	templates *SearchTemplates
+	// queryLogMaxBytes is the size above which queries are truncated in logs
+	queryLogMaxBytes int
+}
+
+// Source fields holding the identifier and type of a resource, when no other
+// fields are configured
+const (
+	DefaultIDField   = "object_id"
+	DefaultTypeField = "object_type"
+)
+
+// DefaultNameFields are the fields the name search matches, all with the same
+// weight, when no name fields are configured
+var DefaultNameFields = []string{
+	"name_and_aliases",
+	"name_and_aliases._2gram",
+	"name_and_aliases._3gram",
+}
+
+// DefaultQueryLogMaxBytes is the size above which rendered queries are
+// truncated in debug logs, when no other size is configured
+const DefaultQueryLogMaxBytes = 4096
+
+// loggedQuery returns the query for debug logs: in full up to maxBytes, and
+// otherwise its first maxBytes followed by its total length. A maxBytes of 0
+// means DefaultQueryLogMaxBytes, a negative one keeps the query in full.
+func loggedQuery(query []byte, maxBytes int) string {
+	if maxBytes == 0 {
+		maxBytes = DefaultQueryLogMaxBytes
+	}
+	if maxBytes < 0 || len(query) <= maxBytes {
+		return string(query)
+	}
+	// Don't split a multi-byte character
+	cut := maxBytes
+	for cut > 0 && !utf8.RuneStart(query[cut]) {
+		cut--
+	}
+	return fmt.Sprintf("%s... (truncated, %d bytes)", query[:cut], len(query))
+}
+
+// DescriptionField is the field name searches match in addition to the name
+// fields when their scope is model.SearchScopeAll
+const DescriptionField = "description"
+
+// scopedNameFields returns the name fields a search of the given scope
+// matches: description fields are dropped for model.SearchScopeName and
+// added for model.SearchScopeAll, other scopes keep the fields as they are
+func scopedNameFields(fields []string, scope string) []string {
+	var scoped []string
+	hasDescription := false
+	for _, entry := range fields {
+		field, _, _ := strings.Cut(entry, "^")
+		isDescription := field == DescriptionField || strings.HasPrefix(field, DescriptionField+".")
+		if isDescription && scope == model.SearchScopeName {
+			continue
+		}
+		hasDescription = hasDescription || isDescription
+		scoped = append(scoped, entry)
+	}
+	if scope == model.SearchScopeAll && !hasDescription {
+		scoped = append(scoped, DescriptionField)
+	}
+	return scoped
+}
+
+// ParseNameFields parses a comma-separated list of name search fields, each
+// optionally boosted with the "field^boost" syntax (e.g. "name^3,description")
+func ParseNameFields(value string) ([]string, error) {
+	var fields []string
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		field, boost, boosted := strings.Cut(entry, "^")
+		if field == "" {
+			return nil, fmt.Errorf("invalid name field %q: missing field name", entry)
+		}
+		if boosted {
+			boostValue, err := strconv.ParseFloat(boost, 64)
+			if err != nil || boostValue <= 0 {
+				return nil, fmt.Errorf("invalid name field %q: boost must be a positive number", entry)
+			}
+		}
+		fields = append(fields, entry)
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("at least one name field is required")
+	}
+	return fields, nil
 }
 
 // OpenSearchClientRetriever defines the interface for OpenSearch operations
@@ -42,6 +155,9 @@ type OpenSearchClientRetriever interface {
 	Search(ctx context.Context, index string, query []byte) (*SearchResponse, error)
 	Count(ctx context.Context, index string, query []byte) (*CountResponse, error)
 	AggregationSearch(ctx context.Context, index string, query []byte) (*AggregationResponse, error)
+	// ResolveAlias returns the indices the alias points to, sorted, or none
+	// when the name is not an alias
+	ResolveAlias(ctx context.Context, name string) ([]string, error)
 	IsReady(ctx context.Context) error
 }
 
@@ -54,13 +170,26 @@ func (os *OpenSearchSearcher) QueryResources(ctx context.Context, criteria model
 	// Render the appropriate query template
 	query, err := os.Render(ctx, criteria)
 	if err != nil {
+		// e.g. an unknown search template, for the client to fix
+		if _, ok := err.(errors.Validation); ok {
+			return nil, err
+		}
 		return nil, fmt.Errorf("failed to render query: %w", err)
 	}
 
 	// Execute the search
 	response, err := os.client.Search(ctx, os.index, query)
 	if err != nil {
-		return nil, fmt.Errorf("opensearch search failed: %w", err)
+		if criteria.SearchAfter != nil && isSearchAfterError(err) {
+			slog.WarnContext(ctx, "page token rejected by opensearch", "error", err)
+			return nil, errors.NewValidation("page token is no longer valid, restart pagination without the page token")
+		}
+		return nil, searchError(err)
+	}
+	// Fewer hits than the page size means there are no more results, and
+	// collapsed results cannot be paged
+	if len(response.Hits.Hits) < criteria.PageSize || criteria.CollapseBy != nil {
+		response.PageToken = nil
 	}
 
 	// Convert response to domain objects
@@ -69,6 +198,13 @@ func (os *OpenSearchSearcher) QueryResources(ctx context.Context, criteria model
 		return nil, fmt.Errorf("failed to convert search response: %w", err)
 	}
 
+	// Report which of the requested tags (OR filter) each resource matched
+	if len(criteria.Tags) > 0 {
+		for idx := range result.Resources {
+			result.Resources[idx].MatchedTags = model.MatchTags(criteria.Tags, model.ExtractTags(result.Resources[idx].Data))
+		}
+	}
+
 	slog.DebugContext(ctx, "opensearch search completed",
 		"results_count", len(result.Resources),
 	)
@@ -86,21 +222,19 @@ func (os *OpenSearchSearcher) QueryResourcesCount(
 		"aggregation_criteria", aggregationCriteria,
 	)
 
-	parsedCount, err := os.Render(ctx, publicCountCriteria)
+	parsedCount, err := os.renderCount(ctx, publicCountCriteria)
 	if err != nil {
 		// Not expected to happen: this is an error with our interpolation logic.
 		slog.ErrorContext(ctx, "unrecoverable request parsing error", "error", err)
 		return nil, fmt.Errorf("failed to render query: %w", err)
 	}
-	slog.DebugContext(ctx, "public resource count query", "query", string(parsedCount))
-
-	// Execute the search
-	countResponse, err := os.client.Count(ctx, os.index, parsedCount)
-	if err != nil {
-		return nil, fmt.Errorf("opensearch search failed: %w", err)
-	}
+	slog.DebugContext(ctx, "public resource count query", "query", loggedQuery(parsedCount, os.queryLogMaxBytes))
 
 	if publicOnly {
+		countResponse, err := os.client.Count(ctx, os.index, parsedCount)
+		if err != nil {
+			return nil, searchError(err)
+		}
 		return &model.CountResult{
 			Count: countResponse.Count,
 		}, nil
@@ -112,11 +246,33 @@ func (os *OpenSearchSearcher) QueryResourcesCount(
 		slog.ErrorContext(ctx, "unrecoverable request parsing error", "error", err)
 		return nil, fmt.Errorf("failed to render query: %w", err)
 	}
-	slog.DebugContext(ctx, "resource aggregation query", "query", string(parsedSearch))
+	slog.DebugContext(ctx, "resource aggregation query", "query", loggedQuery(parsedSearch, os.queryLogMaxBytes))
 
-	aggregationResponse, err := os.client.AggregationSearch(ctx, os.index, parsedSearch)
-	if err != nil {
-		return nil, fmt.Errorf("opensearch search failed: %w", err)
+	// The public count and the aggregation are independent, so they run
+	// concurrently; the first failure cancels the other call.
+	var (
+		countResponse       *CountResponse
+		aggregationResponse *AggregationResponse
+	)
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.Go(func() error {
+		response, errCount := os.client.Count(groupCtx, os.index, parsedCount)
+		if errCount != nil {
+			return errCount
+		}
+		countResponse = response
+		return nil
+	})
+	group.Go(func() error {
+		response, errAggregation := os.client.AggregationSearch(groupCtx, os.index, parsedSearch)
+		if errAggregation != nil {
+			return errAggregation
+		}
+		aggregationResponse = response
+		return nil
+	})
+	if err := group.Wait(); err != nil {
+		return nil, searchError(err)
 	}
 
 	slog.DebugContext(ctx, "aggregation response", "response", aggregationResponse)
@@ -131,10 +287,171 @@ func (os *OpenSearchSearcher) QueryResourcesCount(
 	return result, nil
 }
 
+// searchError wraps an error of the OpenSearch client, except for an
+// unavailable index, which is reported as it is for the operators to see
+func searchError(err error) error {
+	if _, ok := err.(errors.ServiceUnavailable); ok {
+		return err
+	}
+	return fmt.Errorf("opensearch search failed: %w", err)
+}
+
+// isSearchAfterError reports whether OpenSearch rejected the search_after
+// values of a page token, e.g. once the index alias points to an index with a
+// different sort mapping after a reindex or rollover
+func isSearchAfterError(err error) bool {
+	return strings.Contains(err.Error(), "search_after")
+}
+
+// QueryChildCounts implements the ResourceSearcher interface with a single
+// aggregation search over all the parents
+func (os *OpenSearchSearcher) QueryChildCounts(ctx context.Context, parentRefs []string, publicOnly bool) ([]model.ChildCountBucket, error) {
+	slog.DebugContext(ctx, "executing opensearch child count",
+		"parent_refs", parentRefs,
+		"public_only", publicOnly,
+	)
+
+	if len(parentRefs) == 0 {
+		return nil, nil
+	}
+
+	var buf bytes.Buffer
+	if err := childCountTemplate.Execute(&buf, childCountParams{
+		ParentRefs: parentRefs,
+		PublicOnly: publicOnly,
+		TypesSize:  constants.DefaultChildTypesSize,
+		BucketSize: constants.DefaultBucketSize,
+	}); err != nil {
+		// Not expected to happen: this is an error with our interpolation logic.
+		slog.ErrorContext(ctx, "unrecoverable request parsing error", "error", err)
+		return nil, fmt.Errorf("failed to render query: %w", err)
+	}
+	query, err := json.Marshal(json.RawMessage(buf.Bytes()))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to marshal rendered query", "error", err)
+		return nil, fmt.Errorf("failed to render query: %w", err)
+	}
+	slog.DebugContext(ctx, "child count query", "query", loggedQuery(query, os.queryLogMaxBytes))
+
+	aggregationResponse, err := os.client.AggregationSearch(ctx, os.index, query)
+	if err != nil {
+		return nil, searchError(err)
+	}
+
+	return os.convertChildCountResponse(ctx, aggregationResponse), nil
+}
+
+// facetIndexFields maps the facet fields to the index fields they aggregate
+var facetIndexFields = map[string]string{
+	model.FacetFieldType:   "object_type",
+	model.FacetFieldTags:   "tags",
+	model.FacetFieldStatus: "data.status.keyword",
+}
+
+// collapseIndexFields maps the collapse fields to the index fields they collapse
+var collapseIndexFields = map[string]string{
+	model.CollapseFieldObjectRef: "object_ref",
+	model.CollapseFieldSlug:      "data.slug.keyword",
+}
+
+// QueryFacets implements the ResourceSearcher interface with a single search
+// aggregating every facet field
+func (os *OpenSearchSearcher) QueryFacets(ctx context.Context, criteria model.SearchCriteria, facetFields []string) ([]model.FacetBucket, error) {
+	slog.DebugContext(ctx, "executing opensearch facet query",
+		"criteria", criteria,
+		"facet_fields", facetFields,
+	)
+
+	facets := make([]facetParams, 0, len(facetFields))
+	for _, field := range facetFields {
+		indexField, ok := facetIndexFields[field]
+		if !ok {
+			return nil, errors.NewValidation(fmt.Sprintf("unsupported facet field: %s", field))
+		}
+		facets = append(facets, facetParams{Name: facetAggregationPrefix + field, Field: indexField})
+	}
+	if len(facets) == 0 {
+		return nil, nil
+	}
+
+	criteria.PageSize = 0
+	query, err := os.render(ctx, queryParams{
+		SearchCriteria: criteria,
+		Facets:         facets,
+		FacetSize:      constants.DefaultFacetSize,
+		BucketSize:     constants.DefaultBucketSize,
+	})
+	if err != nil {
+		// Not expected to happen: this is an error with our interpolation logic.
+		slog.ErrorContext(ctx, "unrecoverable request parsing error", "error", err)
+		return nil, fmt.Errorf("failed to render query: %w", err)
+	}
+	slog.DebugContext(ctx, "facet query", "query", loggedQuery(query, os.queryLogMaxBytes))
+
+	aggregationResponse, err := os.client.AggregationSearch(ctx, os.index, query)
+	if err != nil {
+		return nil, searchError(err)
+	}
+
+	return os.convertFacetResponse(ctx, facetFields, aggregationResponse), nil
+}
+
 // Render generates the OpenSearch query based on the provided search criteria
 func (os *OpenSearchSearcher) Render(ctx context.Context, criteria model.SearchCriteria) ([]byte, error) {
+	return os.render(ctx, queryParams{SearchCriteria: criteria})
+}
+
+// renderCount generates the body of a _count request for the search criteria.
+// The _count API only accepts the query, so the search clauses of the template
+// (size, sort, track_total_hits...) are left out: they are rejected in strict mode.
+func (os *OpenSearchSearcher) renderCount(ctx context.Context, criteria model.SearchCriteria) ([]byte, error) {
+	parsed, err := os.Render(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	var body struct {
+		Query json.RawMessage `json:"query"`
+	}
+	if err := json.Unmarshal(parsed, &body); err != nil {
+		slog.ErrorContext(ctx, "failed to decode rendered query", "error", err)
+		return nil, err
+	}
+	return json.Marshal(body)
+}
+
+// render generates the OpenSearch query based on the template parameters
+func (os *OpenSearchSearcher) render(ctx context.Context, params queryParams) ([]byte, error) {
+	params.NameFields = os.nameFields
+	if len(params.NameFields) == 0 {
+		params.NameFields = DefaultNameFields
+	}
+	params.NameFields = scopedNameFields(params.NameFields, params.SearchScope)
+	if params.Template != "" {
+		clause, err := os.templates.Render(params.Template, params.TemplateParams)
+		if err != nil {
+			slog.WarnContext(ctx, "failed to render search template", "template", params.Template, "error", err)
+			return nil, err
+		}
+		params.TemplateQuery = clause
+	}
+	// Excluded IDs are resource IDs, matched against the field they come from
+	if !os.legacyResourceID {
+		params.ExcludeIDsField = os.idFieldName()
+	}
+	if params.CollapseBy != nil {
+		indexField, ok := collapseIndexFields[*params.CollapseBy]
+		if !ok {
+			return nil, errors.NewValidation(fmt.Sprintf("unsupported collapse field: %s", *params.CollapseBy))
+		}
+		params.CollapseField = indexField
+		if params.SearchAfter != nil {
+			return nil, errors.NewValidation("page_token cannot be used with collapse_by")
+		}
+	}
+
 	var buf bytes.Buffer
-	if err := queryResourceTemplate.Execute(&buf, criteria); err != nil {
+	if err := queryResourceTemplate.Execute(&buf, params); err != nil {
 		slog.ErrorContext(ctx, "failed to render query template", "error", err)
 		return nil, err
 	}
@@ -152,28 +469,44 @@ func (os *OpenSearchSearcher) Render(ctx context.Context, criteria model.SearchC
 func (os *OpenSearchSearcher) convertSearchResponse(ctx context.Context, response *SearchResponse) (*model.SearchResult, error) {
 
 	result := &model.SearchResult{
-		Resources: make([]model.Resource, 0, len(response.Hits.Hits)),
-		PageToken: response.PageToken,
-		Total:     response.Value,
+		Resources:     make([]model.Resource, 0, len(response.Hits.Hits)),
+		PageToken:     response.PageToken,
+		Total:         response.Value,
+		TotalRelation: response.Relation,
 	}
 
 	for _, hit := range response.Hits.Hits {
-		resource, err := os.convertHit(hit)
+		resource, err := os.convertHit(ctx, hit)
 		if err != nil {
 			// Log error but continue processing other hits
 			slog.ErrorContext(ctx, "failed to convert hit", "hitid", hit.ID, "error", err)
 			continue
 		}
+		resource.NormalizedScore = normalizeScore(hit.Score, response.MaxScore)
 		result.Resources = append(result.Resources, resource)
 	}
 
 	return result, nil
 }
 
+// normalizeScore returns the score relative to the best score of the search,
+// between 0 and 1, or nil when the search was not scored
+func normalizeScore(score, maxScore float64) *float64 {
+	if maxScore <= 0 {
+		return nil
+	}
+	normalized := min(max(score/maxScore, 0), 1)
+	return &normalized
+}
+
 // convertHit converts a single OpenSearch hit to a domain resource
-func (os *OpenSearchSearcher) convertHit(hit Hit) (model.Resource, error) {
+func (os *OpenSearchSearcher) convertHit(ctx context.Context, hit Hit) (model.Resource, error) {
+	score := hit.Score
 	resource := model.Resource{
-		ID: hit.ID,
+		ID:         hit.ID,
+		DocumentID: hit.ID,
+		Index:      hit.Index,
+		Score:      &score,
 	}
 
 	// Parse the source data
@@ -183,8 +516,13 @@ func (os *OpenSearchSearcher) convertHit(hit Hit) (model.Resource, error) {
 			return resource, fmt.Errorf("failed to unmarshal source data: %w", err)
 		}
 
+		idField, typeField := os.idFieldName(), os.typeField
+		if typeField == "" {
+			typeField = DefaultTypeField
+		}
+
 		// Extract type
-		if typeVal, ok := sourceData["object_type"].(string); ok {
+		if typeVal, ok := sourceData[typeField].(string); ok {
 			resource.Type = typeVal
 		}
 
@@ -194,17 +532,53 @@ func (os *OpenSearchSearcher) convertHit(hit Hit) (model.Resource, error) {
 			// If no separate data field, use the entire source as data
 			data = sourceData
 		}
+		// Normalize tags to a string slice regardless of their stored representation
+		if dataMap, ok := data.(map[string]any); ok {
+			if _, hasTags := dataMap["tags"]; hasTags {
+				dataMap["tags"] = model.ExtractTags(dataMap)
+			}
+		}
 		resource.Data = data
 
 		if err := json.Unmarshal(hit.Source, &resource.TransactionBodyStub); err != nil {
 			return resource, fmt.Errorf("failed to unmarshal source data into TransactionBodyStub: %w", err)
 		}
+		// Indices with other identifier and type fields leave them unset
+		if idVal, ok := sourceData[idField].(string); ok && resource.ObjectID == "" {
+			resource.ObjectID = idVal
+		}
+		// The resource ID is the business identifier, the document _id is
+		// kept apart as it may differ
+		if !os.legacyResourceID && resource.ObjectID != "" {
+			resource.ID = resource.ObjectID
+		}
+		if resource.ObjectType == "" {
+			resource.ObjectType = resource.Type
+		}
+		// A mis-indexed resource without the public field falls back to the
+		// default of its type rather than silently requiring an access check
+		if _, hasPublic := sourceData["public"]; !hasPublic {
+			resource.Public = slices.Contains(os.defaultPublicTypes, resource.Type)
+			slog.WarnContext(ctx, "resource indexed without the public field, applying the default of its type",
+				"id", hit.ID,
+				"type", resource.Type,
+				"public", resource.Public,
+			)
+		}
 
 	}
 
 	return resource, nil
 }
 
+// idFieldName returns the source field holding the resource identifier
+func (os *OpenSearchSearcher) idFieldName() string {
+	if os.idField == "" {
+		return DefaultIDField
+	}
+	return os.idField
+}
+
 func (os *OpenSearchSearcher) convertCountResponse(response *CountResponse, aggregationResponse *AggregationResponse) (*model.CountResult, error) {
 	aggregation := model.TermsAggregation{
 		DocCountErrorUpperBound: aggregationResponse.GroupBy.DocCountErrorUpperBound,
@@ -224,6 +598,81 @@ func (os *OpenSearchSearcher) convertCountResponse(response *CountResponse, aggr
 	}, nil
 }
 
+// convertChildCountResponse flattens the parent and type buckets of the child
+// count aggregation
+func (os *OpenSearchSearcher) convertChildCountResponse(ctx context.Context, response *AggregationResponse) []model.ChildCountBucket {
+	var buckets []model.ChildCountBucket
+	for _, parent := range response.Parents.Buckets {
+		for _, childType := range parent.Types.Buckets {
+			if childType.Public.DocCount > 0 {
+				buckets = append(buckets, model.ChildCountBucket{
+					ParentRef: parent.Key,
+					Type:      childType.Key,
+					DocCount:  childType.Public.DocCount,
+				})
+			}
+			accessCheck := childType.Private.AccessCheck
+			if accessCheck.SumOtherDocCount > 0 {
+				slog.WarnContext(ctx, "child count access check buckets overflow, private children are undercounted",
+					"parent_ref", parent.Key,
+					"type", childType.Key,
+					"sum_other_doc_count", accessCheck.SumOtherDocCount,
+				)
+			}
+			for _, bucket := range accessCheck.Buckets {
+				buckets = append(buckets, model.ChildCountBucket{
+					ParentRef:        parent.Key,
+					Type:             childType.Key,
+					AccessCheckQuery: bucket.Key,
+					DocCount:         bucket.DocCount,
+				})
+			}
+		}
+	}
+	return buckets
+}
+
+// convertFacetResponse flattens the value buckets of the facet aggregations,
+// in the order of the facet fields
+func (os *OpenSearchSearcher) convertFacetResponse(ctx context.Context, facetFields []string, response *AggregationResponse) []model.FacetBucket {
+	var buckets []model.FacetBucket
+	for _, field := range facetFields {
+		facet := response.Facets[field]
+		if facet.SumOtherDocCount > 0 {
+			slog.DebugContext(ctx, "facet values truncated",
+				"field", field,
+				"sum_other_doc_count", facet.SumOtherDocCount,
+			)
+		}
+		for _, value := range facet.Buckets {
+			if value.Public.DocCount > 0 {
+				buckets = append(buckets, model.FacetBucket{
+					Field:    field,
+					Value:    value.Key,
+					DocCount: value.Public.DocCount,
+				})
+			}
+			accessCheck := value.Private.AccessCheck
+			if accessCheck.SumOtherDocCount > 0 {
+				slog.WarnContext(ctx, "facet access check buckets overflow, private resources are undercounted",
+					"field", field,
+					"value", value.Key,
+					"sum_other_doc_count", accessCheck.SumOtherDocCount,
+				)
+			}
+			for _, bucket := range accessCheck.Buckets {
+				buckets = append(buckets, model.FacetBucket{
+					Field:            field,
+					Value:            value.Key,
+					AccessCheckQuery: bucket.Key,
+					DocCount:         bucket.DocCount,
+				})
+			}
+		}
+	}
+	return buckets
+}
+
 func (o *OpenSearchSearcher) IsReady(ctx context.Context) error {
 	if err := o.client.IsReady(ctx); err != nil {
 		slog.ErrorContext(ctx, "opensearch client is not ready", "error", err)
@@ -233,6 +682,48 @@ func (o *OpenSearchSearcher) IsReady(ctx context.Context) error {
 
 }
 
+// logIndexAlias logs whether the configured index is an alias, and which
+// indices it points to, to help diagnose searches hitting the wrong index.
+// It is informational only: a failed lookup does not prevent searching.
+func (o *OpenSearchSearcher) logIndexAlias(ctx context.Context) {
+	indices, err := o.client.ResolveAlias(ctx, o.index)
+	if err != nil {
+		slog.WarnContext(ctx, "unable to resolve opensearch index alias",
+			"index", o.index,
+			"error", err,
+		)
+		return
+	}
+
+	if len(indices) == 0 {
+		slog.InfoContext(ctx, "opensearch index is not an alias",
+			"index", o.index,
+		)
+		return
+	}
+
+	slog.InfoContext(ctx, "opensearch index is an alias",
+		"alias", o.index,
+		"indices", indices,
+	)
+}
+
+// newOpenSearchClient returns a client of the given endpoints, failing over
+// from one to the next when it cannot connect, and sending the given headers
+// with every request
+func newOpenSearchClient(endpoints []*url.URL, base http.RoundTripper, header http.Header) (*opensearchapi.Client, error) {
+	return opensearchapi.NewClient(opensearchapi.Config{
+		Client: opensearch.Config{
+			// Requests are sent to the endpoint the transport picks
+			Addresses: []string{endpoints[0].String()},
+			Transport: &headerTransport{
+				header: header,
+				base:   newFailoverTransport(endpoints, base),
+			},
+		},
+	})
+}
+
 // NewSearcher returns a new OpenSearchSearcher implementation
 func NewSearcher(ctx context.Context, config Config) (port.ResourceSearcher, error) {
 
@@ -245,16 +736,17 @@ func NewSearcher(ctx context.Context, config Config) (port.ResourceSearcher, err
 		return nil, fmt.Errorf("opensearch index is required")
 	}
 
-	opensearchClient, errpensearchClient := opensearchapi.NewClient(opensearchapi.Config{
-		Client: opensearch.Config{
-			Addresses: []string{config.URL},
-			Transport: &http.Transport{
-				MaxIdleConnsPerHost:   10,
-				ResponseHeaderTimeout: time.Second,
-				DialContext:           (&net.Dialer{Timeout: 3 * time.Second}).DialContext,
-			},
-		},
-	})
+	endpoints, errEndpoints := ParseURLs(config.URL)
+	if errEndpoints != nil {
+		slog.ErrorContext(ctx, "invalid opensearch URL", "error", errEndpoints)
+		return nil, errEndpoints
+	}
+
+	opensearchClient, errpensearchClient := newOpenSearchClient(endpoints, &http.Transport{
+		MaxIdleConnsPerHost:   10,
+		ResponseHeaderTimeout: time.Second,
+		DialContext:           (&net.Dialer{Timeout: 3 * time.Second}).DialContext,
+	}, requestHeader(config.Headers))
 	if errpensearchClient != nil {
 		return nil, errors.NewServiceUnavailable("failed to create OpenSearch client", errpensearchClient)
 	}
@@ -263,14 +755,39 @@ func NewSearcher(ctx context.Context, config Config) (port.ResourceSearcher, err
 		"index", config.Index,
 	)
 
-	return &OpenSearchSearcher{
+	searcher := &OpenSearchSearcher{
 		client: &httpClient{
 			baseURL: config.URL,
 			httpClient: &http.Client{
 				Timeout: 30 * time.Second,
 			},
-			client: opensearchClient,
+			client:           opensearchClient,
+			queryLogMaxBytes: config.QueryLogMaxBytes,
 		},
-		index: config.Index,
-	}, nil
+		queryLogMaxBytes:   config.QueryLogMaxBytes,
+		index:              config.Index,
+		nameFields:         config.NameFields,
+		idField:            config.IDField,
+		typeField:          config.TypeField,
+		defaultPublicTypes: config.DefaultPublicTypes,
+		legacyResourceID:   config.LegacyResourceID,
+	}
+
+	if config.TemplatesDir != "" {
+		templates, errTemplates := LoadSearchTemplates(config.TemplatesDir)
+		if errTemplates != nil {
+			slog.ErrorContext(ctx, "failed to load search templates", "error", errTemplates)
+			return nil, errTemplates
+		}
+		searcher.templates = templates
+		slog.InfoContext(ctx, "loaded search templates",
+			"dir", config.TemplatesDir,
+			"templates", templates.Names(),
+		)
+	}
+
+	// The index may be an alias, e.g. for zero-downtime reindexing
+	searcher.logIndexAlias(ctx)
+
+	return searcher, nil
 }
@@ -4,13 +4,19 @@
 package opensearch
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/model"
+	pkgerrors "github.com/linuxfoundation/lfx-v2-query-service/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -20,8 +26,13 @@ type MockOpenSearchClient struct {
 	searchError         error
 	countResponse       *CountResponse
 	countError          error
+	countDelay          time.Duration
 	aggregationResponse *AggregationResponse
 	aggregationError    error
+	aggregationDelay    time.Duration
+	aggregationQuery    []byte
+	aliasIndices        []string
+	aliasError          error
 }
 
 func NewMockOpenSearchClient() *MockOpenSearchClient {
@@ -36,6 +47,9 @@ func (m *MockOpenSearchClient) Search(ctx context.Context, index string, query [
 }
 
 func (m *MockOpenSearchClient) Count(ctx context.Context, index string, query []byte) (*CountResponse, error) {
+	if err := sleepContext(ctx, m.countDelay); err != nil {
+		return nil, err
+	}
 	if m.countError != nil {
 		return nil, m.countError
 	}
@@ -43,6 +57,10 @@ func (m *MockOpenSearchClient) Count(ctx context.Context, index string, query []
 }
 
 func (m *MockOpenSearchClient) AggregationSearch(ctx context.Context, index string, query []byte) (*AggregationResponse, error) {
+	m.aggregationQuery = query
+	if err := sleepContext(ctx, m.aggregationDelay); err != nil {
+		return nil, err
+	}
 	if m.aggregationError != nil {
 		return nil, m.aggregationError
 	}
@@ -73,6 +91,46 @@ func (m *MockOpenSearchClient) SetAggregationError(err error) {
 	m.aggregationError = err
 }
 
+// SetCountDelay delays Count calls, to simulate a slow cluster
+func (m *MockOpenSearchClient) SetCountDelay(delay time.Duration) {
+	m.countDelay = delay
+}
+
+// SetAggregationDelay delays AggregationSearch calls, to simulate a slow cluster
+func (m *MockOpenSearchClient) SetAggregationDelay(delay time.Duration) {
+	m.aggregationDelay = delay
+}
+
+// sleepContext waits for the delay, or until the context is done
+func sleepContext(ctx context.Context, delay time.Duration) error {
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (m *MockOpenSearchClient) ResolveAlias(ctx context.Context, name string) ([]string, error) {
+	if m.aliasError != nil {
+		return nil, m.aliasError
+	}
+	return m.aliasIndices, nil
+}
+
+func (m *MockOpenSearchClient) SetAliasIndices(indices ...string) {
+	m.aliasIndices = indices
+}
+
+func (m *MockOpenSearchClient) SetAliasError(err error) {
+	m.aliasError = err
+}
+
 func (m *MockOpenSearchClient) IsReady(ctx context.Context) error {
 	return nil
 }
@@ -242,12 +300,196 @@ func TestOpenSearchSearcherQueryResources(t *testing.T) {
 	}
 }
 
+func TestOpenSearchSearcherQueryResourcesMatchedTags(t *testing.T) {
+	assertion := assert.New(t)
+
+	mockClient := NewMockOpenSearchClient()
+	mockClient.SetSearchResponse(&SearchResponse{
+		Hits: Hits{
+			Total: Total{Value: 1},
+			Hits: []Hit{
+				{
+					ID:    "committee-1",
+					Score: 1.0,
+					Source: mustMarshal(map[string]any{
+						"object_type": "committee",
+						"object_id":   "committee-1",
+						"data": map[string]any{
+							"name": "Tagged Committee",
+							"tags": []any{"governance", "active", "security"},
+						},
+					}),
+				},
+			},
+		},
+	})
+	searcher := &OpenSearchSearcher{
+		client: mockClient,
+		index:  "test-index",
+	}
+
+	result, err := searcher.QueryResources(context.Background(), model.SearchCriteria{
+		Tags: []string{"active", "archived", "governance"},
+	})
+
+	assertion.NoError(err)
+	if assertion.Len(result.Resources, 1) {
+		assertion.Equal([]string{"active", "governance"}, result.Resources[0].MatchedTags)
+	}
+}
+
+func TestOpenSearchSearcherQueryResourcesIndex(t *testing.T) {
+	assertion := assert.New(t)
+
+	// The index of each hit is decoded from the search response
+	var response SearchResponse
+	assertion.NoError(json.Unmarshal([]byte(`{"hits":{"total":{"value":1},"hits":[{
+		"_index": "resources-v2",
+		"_id": "committee-1",
+		"_score": 1.0,
+		"_source": {"object_type": "committee", "object_id": "committee-1", "data": {"name": "Committee"}}
+	}]}}`), &response))
+
+	mockClient := NewMockOpenSearchClient()
+	mockClient.SetSearchResponse(&response)
+	searcher := &OpenSearchSearcher{
+		client: mockClient,
+		index:  "resources",
+	}
+
+	result, err := searcher.QueryResources(context.Background(), model.SearchCriteria{Name: stringPtr("committee")})
+
+	assertion.NoError(err)
+	if assertion.Len(result.Resources, 1) {
+		assertion.Equal("resources-v2", result.Resources[0].Index)
+	}
+}
+
+func TestOpenSearchSearcherQueryResourcesPageToken(t *testing.T) {
+	hits := func(n int) []Hit {
+		hits := make([]Hit, n)
+		for i := range hits {
+			hits[i] = Hit{ID: fmt.Sprintf("project:%d", i), Source: mustMarshal(map[string]any{"object_type": "project", "public": true})}
+		}
+		return hits
+	}
+
+	tests := []struct {
+		name          string
+		pageSize      int
+		hits          int
+		expectedToken bool
+	}{
+		{name: "full page of a custom size", pageSize: 200, hits: 200, expectedToken: true},
+		{name: "full default page", pageSize: 50, hits: 50, expectedToken: true},
+		{name: "last page", pageSize: 200, hits: 50},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+			client := NewMockOpenSearchClient()
+			client.searchResponse = &SearchResponse{
+				Hits:      Hits{Hits: hits(tc.hits)},
+				PageToken: stringPtr("next-page"),
+			}
+			searcher := &OpenSearchSearcher{client: client, index: "test-index"}
+
+			result, err := searcher.QueryResources(context.Background(), model.SearchCriteria{ResourceType: stringPtr("project"), PageSize: tc.pageSize})
+
+			assertion.NoError(err)
+			assertion.Len(result.Resources, tc.hits)
+			if tc.expectedToken {
+				assertion.Equal(stringPtr("next-page"), result.PageToken)
+			} else {
+				assertion.Nil(result.PageToken)
+			}
+		})
+	}
+}
+
+func TestOpenSearchSearcherQueryResourcesStalePageToken(t *testing.T) {
+	// Returned by OpenSearch when a page token of the index the alias used to
+	// point to doesn't fit the sort of the current index
+	aliasSwitchErr := errors.New(`failed to execute search: [400 Bad Request] search_phase_execution_exception: search_after has 2 value(s) but sort has 3.`)
+
+	tests := []struct {
+		name               string
+		criteria           model.SearchCriteria
+		searchErr          error
+		expectedValidation bool
+	}{
+		{
+			name:               "search_after rejected after alias switch",
+			criteria:           model.SearchCriteria{Name: stringPtr("test"), SearchAfter: stringPtr(`["test",1]`)},
+			searchErr:          aliasSwitchErr,
+			expectedValidation: true,
+		},
+		{
+			name:      "search_after error without page token",
+			criteria:  model.SearchCriteria{Name: stringPtr("test")},
+			searchErr: aliasSwitchErr,
+		},
+		{
+			name:      "other error with page token",
+			criteria:  model.SearchCriteria{Name: stringPtr("test"), SearchAfter: stringPtr(`["test",1]`)},
+			searchErr: errors.New("connection refused"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			mockClient := NewMockOpenSearchClient()
+			mockClient.SetSearchError(tc.searchErr)
+			searcher := &OpenSearchSearcher{
+				client: mockClient,
+				index:  "test-index",
+			}
+
+			result, err := searcher.QueryResources(context.Background(), tc.criteria)
+
+			assertion.Nil(result)
+			assertion.Error(err)
+			validation, isValidation := err.(pkgerrors.Validation)
+			assertion.Equal(tc.expectedValidation, isValidation)
+			if tc.expectedValidation {
+				assertion.Contains(validation.Error(), "restart pagination")
+				assertion.NotContains(validation.Error(), "search_phase_execution_exception")
+			}
+		})
+	}
+}
+
+func TestOpenSearchSearcherQueryResourcesIndexNotFound(t *testing.T) {
+	assertion := assert.New(t)
+
+	mockClient := NewMockOpenSearchClient()
+	mockClient.SetSearchError(pkgerrors.NewServiceUnavailable("index test-index not found"))
+	searcher := &OpenSearchSearcher{
+		client: mockClient,
+		index:  "test-index",
+	}
+
+	result, err := searcher.QueryResources(context.Background(), model.SearchCriteria{Name: stringPtr("test")})
+
+	assertion.Nil(result)
+	// Reported as is, for the service to answer 503 rather than 500
+	unavailable, ok := err.(pkgerrors.ServiceUnavailable)
+	if assertion.True(ok) {
+		assertion.Equal("index test-index not found", unavailable.Error())
+	}
+}
+
 func TestOpenSearchSearcherRender(t *testing.T) {
 	tests := []struct {
-		name           string
-		criteria       model.SearchCriteria
-		expectedError  bool
-		expectedFields []string
+		name             string
+		nameFields       []string
+		criteria         model.SearchCriteria
+		expectedError    bool
+		expectedFields   []string
+		unexpectedFields []string
 	}{
 		{
 			name: "render query with name only",
@@ -257,6 +499,54 @@ func TestOpenSearchSearcherRender(t *testing.T) {
 			expectedError:  false,
 			expectedFields: []string{"multi_match", "test project"},
 		},
+		{
+			name: "render query with default name fields",
+			criteria: model.SearchCriteria{
+				Name: stringPtr("test project"),
+			},
+			expectedError:    false,
+			expectedFields:   []string{`"fields":["name_and_aliases","name_and_aliases._2gram","name_and_aliases._3gram"]`},
+			unexpectedFields: []string{"^"},
+		},
+		{
+			name:       "render query with boosted name fields",
+			nameFields: []string{"name_and_aliases^3", "name_and_aliases._2gram^3", "description"},
+			criteria: model.SearchCriteria{
+				Name: stringPtr("test project"),
+			},
+			expectedError:  false,
+			expectedFields: []string{`"fields":["name_and_aliases^3","name_and_aliases._2gram^3","description"]`},
+		},
+		{
+			name:       "render query with name search scope",
+			nameFields: []string{"name_and_aliases^3", "description", "description._2gram"},
+			criteria: model.SearchCriteria{
+				Name:        stringPtr("test project"),
+				SearchScope: model.SearchScopeName,
+			},
+			expectedError:    false,
+			expectedFields:   []string{`"fields":["name_and_aliases^3"]`},
+			unexpectedFields: []string{"description"},
+		},
+		{
+			name: "render query with all search scope",
+			criteria: model.SearchCriteria{
+				Name:        stringPtr("test project"),
+				SearchScope: model.SearchScopeAll,
+			},
+			expectedError:  false,
+			expectedFields: []string{`"fields":["name_and_aliases","name_and_aliases._2gram","name_and_aliases._3gram","description"]`},
+		},
+		{
+			name:       "render query with all search scope keeps configured description",
+			nameFields: []string{"name_and_aliases^3", "description^0.5"},
+			criteria: model.SearchCriteria{
+				Name:        stringPtr("test project"),
+				SearchScope: model.SearchScopeAll,
+			},
+			expectedError:  false,
+			expectedFields: []string{`"fields":["name_and_aliases^3","description^0.5"]`},
+		},
 		{
 			name: "render query with resource type",
 			criteria: model.SearchCriteria{
@@ -271,7 +561,172 @@ func TestOpenSearchSearcherRender(t *testing.T) {
 				Tags: []string{"active", "governance"},
 			},
 			expectedError:  false,
-			expectedFields: []string{"should", "active", "governance"},
+			expectedFields: []string{"should", "active", "governance", `"minimum_should_match":1`},
+		},
+		{
+			name: "render query with tags and a minimum match",
+			criteria: model.SearchCriteria{
+				Tags:         []string{"active", "governance"},
+				TagsMinMatch: intPtr(2),
+			},
+			expectedError:  false,
+			expectedFields: []string{"should", "active", "governance", `"minimum_should_match":2`},
+		},
+		{
+			name: "render query without recency boost",
+			criteria: model.SearchCriteria{
+				Name: stringPtr("test project"),
+			},
+			expectedError:    false,
+			expectedFields:   []string{`"query":{"bool":`},
+			unexpectedFields: []string{"function_score", "gauss"},
+		},
+		{
+			name: "render query with recency boost",
+			criteria: model.SearchCriteria{
+				Name:              stringPtr("test project"),
+				RecencyBoostScale: "30d",
+			},
+			expectedError: false,
+			expectedFields: []string{
+				`"query":{"function_score":{"query":{"bool":`,
+				`"functions":[{"gauss":{"updated_at":{"origin":"now","scale":"30d","decay":0.5}}}]`,
+				`"boost_mode":"multiply"`,
+				"test project",
+			},
+		},
+		{
+			name: "render query with transaction",
+			criteria: model.SearchCriteria{
+				TransactionID: stringPtr("txn-1"),
+			},
+			expectedError:  false,
+			expectedFields: []string{`"term":{"transaction_id":"txn-1"}`},
+		},
+		{
+			name: "render query with exact name",
+			criteria: model.SearchCriteria{
+				NameExact: stringPtr("Governing Board"),
+			},
+			expectedError:    false,
+			expectedFields:   []string{`"term":{"data.name.lowercase":"Governing Board"}`},
+			unexpectedFields: []string{`"multi_match"`},
+		},
+		{
+			name: "render query collapsed by slug",
+			criteria: model.SearchCriteria{
+				ResourceType: stringPtr("project"),
+				PageSize:     10,
+				SortBy:       "sort_name",
+				SortOrder:    "asc",
+				CollapseBy:   stringPtr(model.CollapseFieldSlug),
+			},
+			expectedError: false,
+			expectedFields: []string{
+				`"sort":[{"sort_name":{"order":"asc"}},{"data.slug.keyword":"asc"}]`,
+				`"collapse":{"field":"data.slug.keyword"}`,
+			},
+			unexpectedFields: []string{`{"_id":"asc"}`},
+		},
+		{
+			name: "render collapsed query with a page token",
+			criteria: model.SearchCriteria{
+				ResourceType: stringPtr("project"),
+				PageSize:     10,
+				SortBy:       "sort_name",
+				SortOrder:    "asc",
+				CollapseBy:   stringPtr(model.CollapseFieldSlug),
+				SearchAfter:  stringPtr(`["alpha","alpha"]`),
+			},
+			expectedError: true,
+		},
+		{
+			name: "render query with unsupported collapse field",
+			criteria: model.SearchCriteria{
+				ResourceType: stringPtr("project"),
+				PageSize:     10,
+				CollapseBy:   stringPtr("name"),
+			},
+			expectedError: true,
+		},
+		{
+			name: "render count query",
+			criteria: model.SearchCriteria{
+				ResourceType: stringPtr("project"),
+				PageSize:     model.PageSizeCount,
+			},
+			expectedError:    false,
+			expectedFields:   []string{`{"size":0,"query":`},
+			unexpectedFields: []string{`"size":-1`, `"sort"`},
+		},
+		{
+			name:           "render query with empty criteria matches all current resources",
+			criteria:       model.SearchCriteria{PageSize: 10},
+			expectedError:  false,
+			expectedFields: []string{`"query":{"bool":{"must":[{"term":{"latest":true}}]}}`},
+		},
+		{
+			name: "render query with fuzzy name",
+			criteria: model.SearchCriteria{
+				Name:      stringPtr("budgte"),
+				NameFuzzy: true,
+			},
+			expectedError:  false,
+			expectedFields: []string{`"query":"budgte","type":"bool_prefix","fuzziness":"AUTO"`},
+		},
+		{
+			name: "render query without fuzzy name",
+			criteria: model.SearchCriteria{
+				Name: stringPtr("budget"),
+			},
+			expectedError:    false,
+			unexpectedFields: []string{"fuzziness"},
+		},
+		{
+			name: "render query with exact name boost",
+			criteria: model.SearchCriteria{
+				Name:           stringPtr("Kubernetes"),
+				ExactNameBoost: true,
+			},
+			expectedError: false,
+			expectedFields: []string{
+				`{"bool":{"should":[{"multi_match":{"query":"Kubernetes","type":"bool_prefix"`,
+				`{"term":{"data.name.lowercase":{"value":"Kubernetes","boost":10}}}],"minimum_should_match":1}}`,
+			},
+		},
+		{
+			name: "render query without exact name boost",
+			criteria: model.SearchCriteria{
+				Name: stringPtr("Kubernetes"),
+			},
+			expectedError:    false,
+			expectedFields:   []string{`{"multi_match":{"query":"Kubernetes","type":"bool_prefix"`},
+			unexpectedFields: []string{`"should"`, `"data.name.lowercase"`},
+		},
+		{
+			name: "render query with parent",
+			criteria: model.SearchCriteria{
+				Parent: stringPtr("project:123"),
+			},
+			expectedError:  false,
+			expectedFields: []string{`"term":{"parent_refs":"project:123"}`},
+		},
+		{
+			name: "render query with parents",
+			criteria: model.SearchCriteria{
+				Parents: []string{"project:123", "project:456"},
+			},
+			expectedError:  false,
+			expectedFields: []string{`"terms":{"parent_refs":["project:123","project:456"]}`},
+		},
+		{
+			name: "render query with parent and parents",
+			criteria: model.SearchCriteria{
+				Parent:  stringPtr("project:123"),
+				Parents: []string{"project:456"},
+			},
+			expectedError:  false,
+			expectedFields: []string{`"terms":{"parent_refs":["project:123","project:456"]}`},
 		},
 		{
 			name: "render query with tags_all (AND logic)",
@@ -303,6 +758,173 @@ func TestOpenSearchSearcherRender(t *testing.T) {
 			expectedError:  false,
 			expectedFields: []string{"multi_match", "object_type", "should", "sort"},
 		},
+		{
+			name: "render query with range filter lower bound only",
+			criteria: model.SearchCriteria{
+				RangeFilters: map[string]model.RangeFilter{
+					"data.member_count": {Gte: float64Ptr(50)},
+				},
+			},
+			expectedError:  false,
+			expectedFields: []string{`"range":{"data.member_count":{"gte":50}}`},
+		},
+		{
+			name: "render query with range filter upper bound only",
+			criteria: model.SearchCriteria{
+				RangeFilters: map[string]model.RangeFilter{
+					"data.member_count": {Lte: float64Ptr(200)},
+				},
+			},
+			expectedError:  false,
+			expectedFields: []string{`"range":{"data.member_count":{"lte":200}}`},
+		},
+		{
+			name: "render query with range filter both bounds",
+			criteria: model.SearchCriteria{
+				RangeFilters: map[string]model.RangeFilter{
+					"data.member_count": {Gte: float64Ptr(50), Lte: float64Ptr(200.5)},
+				},
+			},
+			expectedError:  false,
+			expectedFields: []string{`"range":{"data.member_count":{"gte":50,"lte":200.5}}`},
+		},
+		{
+			name: "render query with empty range filter",
+			criteria: model.SearchCriteria{
+				RangeFilters: map[string]model.RangeFilter{
+					"data.member_count": {},
+				},
+			},
+			expectedError:    false,
+			unexpectedFields: []string{"range"},
+		},
+		{
+			name: "render query with allowed resource types",
+			criteria: model.SearchCriteria{
+				Name:          stringPtr("test"),
+				ResourceTypes: []string{"project", "committee"},
+			},
+			expectedError:  false,
+			expectedFields: []string{`"terms":{"object_type":["project","committee"]}`},
+		},
+		{
+			name: "render query with exact total hits",
+			criteria: model.SearchCriteria{
+				Name:           stringPtr("test"),
+				TrackTotalHits: &model.TrackTotalHits{Exact: true},
+			},
+			expectedError:  false,
+			expectedFields: []string{`"track_total_hits":true`},
+		},
+		{
+			name: "render query with total hits threshold",
+			criteria: model.SearchCriteria{
+				Name:           stringPtr("test"),
+				TrackTotalHits: &model.TrackTotalHits{UpTo: 50000},
+			},
+			expectedError:  false,
+			expectedFields: []string{`"track_total_hits":50000`},
+		},
+		{
+			name: "render query with total hits disabled",
+			criteria: model.SearchCriteria{
+				Name:           stringPtr("test"),
+				TrackTotalHits: &model.TrackTotalHits{},
+			},
+			expectedError:  false,
+			expectedFields: []string{`"track_total_hits":false`},
+		},
+		{
+			name: "render query without track total hits",
+			criteria: model.SearchCriteria{
+				Name: stringPtr("test"),
+			},
+			expectedError:    false,
+			unexpectedFields: []string{"track_total_hits"},
+		},
+		{
+			name: "render query with creator",
+			criteria: model.SearchCriteria{
+				CreatedBy: stringPtr("user:jdoe"),
+			},
+			expectedError:  false,
+			expectedFields: []string{`"term":{"created_by":"user:jdoe"}`},
+		},
+		{
+			name: "render query with updater",
+			criteria: model.SearchCriteria{
+				UpdatedBy: stringPtr("user:jdoe"),
+			},
+			expectedError:    false,
+			expectedFields:   []string{`"term":{"updated_by":"user:jdoe"}`},
+			unexpectedFields: []string{"created_by"},
+		},
+		{
+			name: "render query without creator",
+			criteria: model.SearchCriteria{
+				Name: stringPtr("test"),
+			},
+			expectedError:    false,
+			unexpectedFields: []string{"created_by"},
+		},
+		{
+			name: "render query with object reference",
+			criteria: model.SearchCriteria{
+				ResourceType: stringPtr("committee"),
+				ObjectRef:    stringPtr("committee:123"),
+			},
+			expectedError:  false,
+			expectedFields: []string{`"term":{"object_ref":"committee:123"}`},
+		},
+		{
+			name: "render query with excluded IDs",
+			criteria: model.SearchCriteria{
+				Name:       stringPtr("test"),
+				Tags:       []string{"active"},
+				ExcludeIDs: []string{"123", "456"},
+			},
+			expectedError: false,
+			expectedFields: []string{
+				`"must_not":[{"terms":{"object_id":["123","456"]}}]`,
+				`"should":[{"term":{"tags":"active"}}]`,
+			},
+		},
+		{
+			name: "render query without excluded IDs",
+			criteria: model.SearchCriteria{
+				Name: stringPtr("test"),
+			},
+			expectedError:    false,
+			unexpectedFields: []string{`"ids"`},
+		},
+		{
+			name: "render query with include score",
+			criteria: model.SearchCriteria{
+				Name:         stringPtr("test"),
+				IncludeScore: true,
+			},
+			expectedError:  false,
+			expectedFields: []string{`"track_scores":true`},
+		},
+		{
+			name: "render query without include score",
+			criteria: model.SearchCriteria{
+				Name: stringPtr("test"),
+			},
+			expectedError:    false,
+			unexpectedFields: []string{"track_scores"},
+		},
+		{
+			name: "render query with aggregation bucket limit",
+			criteria: model.SearchCriteria{
+				PageSize:    0,
+				PrivateOnly: true,
+				GroupBy:     "access_check_query.keyword",
+				GroupBySize: 25,
+			},
+			expectedError:  false,
+			expectedFields: []string{`"terms":{"field":"access_check_query.keyword","size":25}`},
+		},
 		{
 			name: "render query with empty criteria",
 			criteria: model.SearchCriteria{
@@ -319,8 +941,9 @@ func TestOpenSearchSearcherRender(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Create searcher
 			searcher := &OpenSearchSearcher{
-				client: NewMockOpenSearchClient(),
-				index:  "test-index",
+				client:     NewMockOpenSearchClient(),
+				index:      "test-index",
+				nameFields: tc.nameFields,
 			}
 
 			// Execute
@@ -340,17 +963,144 @@ func TestOpenSearchSearcherRender(t *testing.T) {
 			for _, field := range tc.expectedFields {
 				assertion.Contains(queryStr, field)
 			}
+			for _, field := range tc.unexpectedFields {
+				assertion.NotContains(queryStr, field)
+			}
+		})
+	}
+}
+
+func TestOpenSearchSearcherRenderCount(t *testing.T) {
+	assertion := assert.New(t)
+
+	searcher := &OpenSearchSearcher{
+		client: NewMockOpenSearchClient(),
+		index:  "test-index",
+	}
+
+	query, err := searcher.renderCount(context.Background(), model.SearchCriteria{
+		ResourceType:   stringPtr("project"),
+		Tags:           []string{"active"},
+		PublicOnly:     true,
+		PageSize:       20,
+		SortBy:         "sort_name",
+		SortOrder:      "asc",
+		SearchAfter:    stringPtr(`["abc","123"]`),
+		IncludeScore:   true,
+		TrackTotalHits: &model.TrackTotalHits{Exact: true},
+	})
+	assertion.NoError(err)
+
+	var body map[string]json.RawMessage
+	assertion.NoError(json.Unmarshal(query, &body))
+	// Only the query, with all its filters, is left for the _count API
+	assertion.Len(body, 1)
+	assertion.Contains(body, "query")
+	for _, clause := range []string{"sort", "size", "from", "_source", "track_total_hits", "search_after", "track_scores"} {
+		assertion.NotContains(body, clause)
+	}
+	queryStr := string(body["query"])
+	for _, filter := range []string{`"object_type":"project"`, "active", `"public":true`} {
+		assertion.Contains(queryStr, filter)
+	}
+}
+
+func TestOpenSearchSearcherRenderTemplate(t *testing.T) {
+	dir := t.TempDir()
+	source := `{"bool": {"must": [{"term": {"data.status": {{ param "status" }}}}, {"term": {"data.category": {{ param "category" }}}}]}}`
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "active_committees"+SearchTemplateExtension), []byte(source), 0o600))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a template"), 0o600))
+
+	templates, err := LoadSearchTemplates(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"active_committees"}, templates.Names())
+
+	searcher := &OpenSearchSearcher{
+		client:    NewMockOpenSearchClient(),
+		index:     "test-index",
+		templates: templates,
+	}
+
+	tests := []struct {
+		name             string
+		template         string
+		params           map[string]string
+		expectedFields   []string
+		expectedErrorMsg string
+	}{
+		{
+			name:     "template clause added to the filters",
+			template: "active_committees",
+			params:   map[string]string{"status": "active", "category": "board"},
+			expectedFields: []string{
+				`"term":{"latest":true}`,
+				`"object_type":"committee"`,
+				`{"term":{"data.status":"active"}}`,
+				`{"term":{"data.category":"board"}}`,
+			},
+		},
+		{
+			name:     "parameters are quoted",
+			template: "active_committees",
+			params:   map[string]string{"status": `active"}},{"match_all":{}}]}}`, "category": "board"},
+			expectedFields: []string{
+				`{"term":{"data.status":"active\"}},{\"match_all\":{}}]}}"}}`,
+			},
+		},
+		{
+			name:             "missing parameter",
+			template:         "active_committees",
+			params:           map[string]string{"status": "active"},
+			expectedErrorMsg: `search template "active_committees" requires parameters: category`,
+		},
+		{
+			name:             "unused parameter",
+			template:         "active_committees",
+			params:           map[string]string{"status": "active", "category": "board", "owner": "jdoe"},
+			expectedErrorMsg: `search template "active_committees" does not take parameters: owner`,
+		},
+		{
+			name:             "unknown template",
+			template:         "archived_committees",
+			expectedErrorMsg: `unknown search template "archived_committees"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			query, err := searcher.Render(context.Background(), model.SearchCriteria{
+				ResourceType:   stringPtr("committee"),
+				Template:       tc.template,
+				TemplateParams: tc.params,
+				PageSize:       10,
+			})
+			if tc.expectedErrorMsg != "" {
+				assert.EqualError(t, err, tc.expectedErrorMsg)
+				_, isValidation := err.(pkgerrors.Validation)
+				assert.True(t, isValidation)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, json.Valid(query))
+
+			var compact bytes.Buffer
+			assert.NoError(t, json.Compact(&compact, query))
+			for _, field := range tc.expectedFields {
+				assert.Contains(t, compact.String(), field)
+			}
 		})
 	}
 }
 
 func TestOpenSearchSearcherConvertResponse(t *testing.T) {
 	tests := []struct {
-		name           string
-		response       *SearchResponse
-		expectedCount  int
-		expectedError  bool
-		expectedFields map[string]any
+		name             string
+		response         *SearchResponse
+		expectedCount    int
+		expectedError    bool
+		expectedFields   map[string]any
+		expectedTotal    int
+		expectedRelation string
 	}{
 		{
 			name: "convert response with valid hits",
@@ -420,6 +1170,19 @@ func TestOpenSearchSearcherConvertResponse(t *testing.T) {
 			expectedCount: 0, // Invalid hits should be skipped
 			expectedError: false,
 		},
+		{
+			name: "convert response with lower bound total",
+			response: &SearchResponse{
+				Hits: Hits{
+					Total: Total{Value: 10000, Relation: "gte"},
+					Hits:  []Hit{},
+				},
+			},
+			expectedCount:    0,
+			expectedError:    false,
+			expectedTotal:    10000,
+			expectedRelation: "gte",
+		},
 	}
 
 	assertion := assert.New(t)
@@ -446,6 +1209,11 @@ func TestOpenSearchSearcherConvertResponse(t *testing.T) {
 			assertion.NotNil(result)
 			assertion.Equal(tc.expectedCount, len(result.Resources))
 
+			if tc.expectedRelation != "" {
+				assertion.Equal(tc.expectedTotal, result.Total)
+				assertion.Equal(tc.expectedRelation, result.TotalRelation)
+			}
+
 			// Check specific fields if expected
 			if tc.expectedFields != nil && len(result.Resources) > 0 {
 				firstResource := result.Resources[0]
@@ -460,6 +1228,84 @@ func TestOpenSearchSearcherConvertResponse(t *testing.T) {
 	}
 }
 
+func TestOpenSearchSearcherConvertResponseNormalizedScore(t *testing.T) {
+	hit := func(id string, score float64) Hit {
+		return Hit{
+			ID:    id,
+			Score: score,
+			Source: mustMarshal(map[string]any{
+				"object_type": "project",
+				"object_id":   id,
+				"data":        map[string]any{"name": id},
+			}),
+		}
+	}
+
+	tests := []struct {
+		name             string
+		response         *SearchResponse
+		expectedScores   []float64
+		expectNormalized bool
+	}{
+		{
+			name: "scores are normalized by the max score",
+			response: &SearchResponse{
+				Hits: Hits{
+					MaxScore: 8.0,
+					Hits:     []Hit{hit("project-1", 8.0), hit("project-2", 4.0), hit("project-3", 2.0)},
+				},
+			},
+			expectedScores:   []float64{1.0, 0.5, 0.25},
+			expectNormalized: true,
+		},
+		{
+			name: "later page keeps the scale of the max score",
+			response: &SearchResponse{
+				Hits: Hits{
+					MaxScore: 10.0,
+					Hits:     []Hit{hit("project-4", 5.0)},
+				},
+			},
+			expectedScores:   []float64{0.5},
+			expectNormalized: true,
+		},
+		{
+			name: "unscored search has no normalized score",
+			response: &SearchResponse{
+				Hits: Hits{
+					Hits: []Hit{hit("project-1", 0)},
+				},
+			},
+			expectNormalized: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			searcher := &OpenSearchSearcher{
+				client: NewMockOpenSearchClient(),
+				index:  "test-index",
+			}
+
+			result, err := searcher.convertSearchResponse(context.Background(), tc.response)
+			assertion.NoError(err)
+			assertion.Len(result.Resources, len(tc.response.Hits.Hits))
+
+			for idx, resource := range result.Resources {
+				if !tc.expectNormalized {
+					assertion.Nil(resource.NormalizedScore)
+					continue
+				}
+				if assertion.NotNil(resource.NormalizedScore) {
+					assertion.InDelta(tc.expectedScores[idx], *resource.NormalizedScore, 1e-9)
+				}
+			}
+		})
+	}
+}
+
 func TestOpenSearchSearcherConvertHit(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -508,7 +1354,49 @@ func TestOpenSearchSearcherConvertHit(t *testing.T) {
 			},
 			expectedError: false,
 			expectedType:  "project",
-			expectedID:    "project-2",
+			expectedID:    "project-2",
+		},
+		{
+			name: "convert hit normalizes array tags",
+			hit: Hit{
+				ID:    "project-3",
+				Score: 1.0,
+				Source: mustMarshal(map[string]any{
+					"object_type": "project",
+					"data": map[string]any{
+						"name": "Tagged Project",
+						"tags": []any{"active", "governance"},
+					},
+				}),
+			},
+			expectedError: false,
+			expectedType:  "project",
+			expectedID:    "project-3",
+			expectedData: map[string]any{
+				"name": "Tagged Project",
+				"tags": []string{"active", "governance"},
+			},
+		},
+		{
+			name: "convert hit normalizes single string tag",
+			hit: Hit{
+				ID:    "project-4",
+				Score: 1.0,
+				Source: mustMarshal(map[string]any{
+					"object_type": "project",
+					"data": map[string]any{
+						"name": "Single Tag Project",
+						"tags": "active",
+					},
+				}),
+			},
+			expectedError: false,
+			expectedType:  "project",
+			expectedID:    "project-4",
+			expectedData: map[string]any{
+				"name": "Single Tag Project",
+				"tags": []string{"active"},
+			},
 		},
 		{
 			name: "convert hit with invalid JSON",
@@ -543,7 +1431,7 @@ func TestOpenSearchSearcherConvertHit(t *testing.T) {
 			}
 
 			// Execute
-			resource, err := searcher.convertHit(tc.hit)
+			resource, err := searcher.convertHit(context.Background(), tc.hit)
 
 			// Verify
 			if tc.expectedError {
@@ -553,6 +1441,9 @@ func TestOpenSearchSearcherConvertHit(t *testing.T) {
 
 			assertion.NoError(err)
 			assertion.Equal(tc.expectedID, resource.ID)
+			if assertion.NotNil(resource.Score) {
+				assertion.Equal(tc.hit.Score, *resource.Score)
+			}
 
 			if tc.expectedType != "" {
 				assertion.Equal(tc.expectedType, resource.Type)
@@ -565,6 +1456,232 @@ func TestOpenSearchSearcherConvertHit(t *testing.T) {
 	}
 }
 
+func TestOpenSearchSearcherConvertHitAttribution(t *testing.T) {
+	assertion := assert.New(t)
+	searcher := &OpenSearchSearcher{
+		client: NewMockOpenSearchClient(),
+		index:  "test-index",
+	}
+
+	resource, err := searcher.convertHit(context.Background(), Hit{
+		ID: "committee:123",
+		Source: mustMarshal(map[string]any{
+			"object_type": "committee",
+			"object_id":   "123",
+			"public":      true,
+			"created_by":  "user:alice",
+			"updated_by":  "user:bob",
+			"data":        map[string]any{"name": "Board"},
+		}),
+	})
+
+	assertion.NoError(err)
+	assertion.Equal("user:alice", resource.CreatedBy)
+	assertion.Equal("user:bob", resource.UpdatedBy)
+	assertion.Equal(map[string]any{"name": "Board"}, resource.Data)
+
+	// Unattributed documents leave both empty
+	resource, err = searcher.convertHit(context.Background(), Hit{
+		ID:     "committee:456",
+		Source: mustMarshal(map[string]any{"object_type": "committee", "object_id": "456", "public": true}),
+	})
+	assertion.NoError(err)
+	assertion.Empty(resource.CreatedBy)
+	assertion.Empty(resource.UpdatedBy)
+}
+
+func TestOpenSearchSearcherConvertHitParentRefs(t *testing.T) {
+	assertion := assert.New(t)
+	searcher := &OpenSearchSearcher{
+		client: NewMockOpenSearchClient(),
+		index:  "test-index",
+	}
+
+	resource, err := searcher.convertHit(context.Background(), Hit{
+		ID: "meeting:789",
+		Source: mustMarshal(map[string]any{
+			"object_type": "meeting",
+			"object_id":   "789",
+			"public":      true,
+			"parent_refs": []string{"project:123", "committee:456"},
+			"data":        map[string]any{"title": "Board Sync"},
+		}),
+	})
+
+	assertion.NoError(err)
+	assertion.Equal([]string{"project:123", "committee:456"}, resource.ParentRefs)
+	assertion.Equal(map[string]any{"title": "Board Sync"}, resource.Data)
+}
+
+func TestOpenSearchSearcherConvertHitFieldMapping(t *testing.T) {
+	tests := []struct {
+		name               string
+		idField            string
+		typeField          string
+		source             map[string]any
+		expectedID         string
+		expectedType       string
+		expectedObjectID   string
+		expectedObjectType string
+	}{
+		{
+			name:      "alternate field names",
+			idField:   "id",
+			typeField: "type",
+			source: map[string]any{
+				"id":   "abc",
+				"type": "committee",
+				"data": map[string]any{"name": "Board"},
+			},
+			expectedID:         "abc",
+			expectedType:       "committee",
+			expectedObjectID:   "abc",
+			expectedObjectType: "committee",
+		},
+		{
+			name:      "alternate field names ignore the default fields",
+			idField:   "id",
+			typeField: "type",
+			source: map[string]any{
+				"object_type": "project",
+				"data":        map[string]any{"name": "Board"},
+			},
+			expectedID:         "hit-1",
+			expectedObjectType: "project",
+		},
+		{
+			name: "default field names",
+			source: map[string]any{
+				"object_id":   "abc",
+				"object_type": "committee",
+				"type":        "other",
+			},
+			expectedID:         "abc",
+			expectedType:       "committee",
+			expectedObjectID:   "abc",
+			expectedObjectType: "committee",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			searcher := &OpenSearchSearcher{
+				client:    NewMockOpenSearchClient(),
+				index:     "test-index",
+				idField:   tc.idField,
+				typeField: tc.typeField,
+			}
+
+			resource, err := searcher.convertHit(context.Background(), Hit{ID: "hit-1", Source: mustMarshal(tc.source)})
+
+			assertion.NoError(err)
+			assertion.Equal(tc.expectedID, resource.ID)
+			assertion.Equal("hit-1", resource.DocumentID)
+			assertion.Equal(tc.expectedType, resource.Type)
+			assertion.Equal(tc.expectedObjectID, resource.ObjectID)
+			assertion.Equal(tc.expectedObjectType, resource.ObjectType)
+		})
+	}
+}
+
+func TestOpenSearchSearcherConvertHitDefaultPublic(t *testing.T) {
+	tests := []struct {
+		name           string
+		source         map[string]any
+		expectedPublic bool
+	}{
+		{
+			name:           "absent public field of a default public type",
+			source:         map[string]any{"object_type": "project", "object_id": "1"},
+			expectedPublic: true,
+		},
+		{
+			name:           "absent public field of another type",
+			source:         map[string]any{"object_type": "committee", "object_id": "2"},
+			expectedPublic: false,
+		},
+		{
+			name:           "indexed public field wins over the default",
+			source:         map[string]any{"object_type": "project", "object_id": "3", "public": false},
+			expectedPublic: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			searcher := &OpenSearchSearcher{
+				client:             NewMockOpenSearchClient(),
+				index:              "test-index",
+				defaultPublicTypes: []string{"project", "meeting"},
+			}
+
+			resource, err := searcher.convertHit(context.Background(), Hit{ID: "hit-1", Source: mustMarshal(tc.source)})
+
+			assertion.NoError(err)
+			assertion.Equal(tc.expectedPublic, resource.Public)
+		})
+	}
+}
+
+func TestOpenSearchSearcherResourceID(t *testing.T) {
+	tests := []struct {
+		name               string
+		legacyResourceID   bool
+		expectedID         string
+		expectedExcludeIDs string
+	}{
+		{
+			name:               "resource ID from the object ID",
+			expectedID:         "123",
+			expectedExcludeIDs: `"must_not":[{"terms":{"object_id":["123"]}}]`,
+		},
+		{
+			name:               "legacy resource ID from the document _id",
+			legacyResourceID:   true,
+			expectedID:         "committee:123",
+			expectedExcludeIDs: `"must_not":[{"ids":{"values":["123"]}}]`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			searcher := &OpenSearchSearcher{
+				client:           NewMockOpenSearchClient(),
+				index:            "test-index",
+				legacyResourceID: tc.legacyResourceID,
+			}
+
+			resource, err := searcher.convertHit(context.Background(), Hit{
+				ID: "committee:123",
+				Source: mustMarshal(map[string]any{
+					"object_type": "committee",
+					"object_id":   "123",
+					"public":      true,
+				}),
+			})
+
+			assertion.NoError(err)
+			assertion.Equal(tc.expectedID, resource.ID)
+			assertion.Equal("committee:123", resource.DocumentID)
+			assertion.Equal("123", resource.ObjectID)
+
+			// Excluded IDs are matched like the returned resource IDs
+			query, err := searcher.Render(context.Background(), model.SearchCriteria{
+				Name:       stringPtr("test"),
+				ExcludeIDs: []string{"123"},
+			})
+			assertion.NoError(err)
+			assertion.Contains(string(query), tc.expectedExcludeIDs)
+		})
+	}
+}
+
 func TestNewSearcher(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -697,9 +1814,232 @@ func TestOpenSearchSearcherIntegration(t *testing.T) {
 	})
 }
 
+func TestOpenSearchSearcherQueryResourcesCountConcurrency(t *testing.T) {
+	const delay = 200 * time.Millisecond
+
+	countCriteria := model.SearchCriteria{
+		ResourceType: stringPtr("committee"),
+		PageSize:     -1,
+		PublicOnly:   true,
+	}
+	aggregationCriteria := model.SearchCriteria{
+		GroupBy:     "access_check_query.keyword",
+		PrivateOnly: true,
+	}
+
+	t.Run("count and aggregation run concurrently", func(t *testing.T) {
+		assertion := assert.New(t)
+
+		mockClient := NewMockOpenSearchClient()
+		mockClient.SetCountDelay(delay)
+		mockClient.SetAggregationDelay(delay)
+		mockClient.SetCountResponse(&CountResponse{Count: 3})
+		mockClient.SetAggregationResponse(&AggregationResponse{
+			GroupBy: TermsAggregation{
+				SumOtherDocCount: 4,
+				Buckets: []AggregationBucket{
+					{Key: "committee:123#viewer@user:test-user", DocCount: 2},
+				},
+			},
+		})
+		searcher := &OpenSearchSearcher{client: mockClient, index: "test-index"}
+
+		start := time.Now()
+		result, err := searcher.QueryResourcesCount(context.Background(), countCriteria, aggregationCriteria, false)
+		elapsed := time.Since(start)
+
+		assertion.NoError(err)
+		if assertion.NotNil(result) {
+			assertion.Equal(3, result.Count)
+			assertion.Equal(uint64(4), result.Aggregation.SumOtherDocCount)
+			if assertion.Len(result.Aggregation.Buckets, 1) {
+				assertion.Equal("committee:123#viewer@user:test-user", result.Aggregation.Buckets[0].Key)
+				assertion.Equal(uint64(2), result.Aggregation.Buckets[0].DocCount)
+			}
+		}
+		// Sequential calls would take at least twice the delay
+		assertion.Less(elapsed, 2*delay)
+	})
+
+	t.Run("failure cancels the other call", func(t *testing.T) {
+		assertion := assert.New(t)
+
+		mockClient := NewMockOpenSearchClient()
+		mockClient.SetCountError(errors.New("opensearch count failed"))
+		mockClient.SetAggregationDelay(10 * time.Second)
+		searcher := &OpenSearchSearcher{client: mockClient, index: "test-index"}
+
+		start := time.Now()
+		result, err := searcher.QueryResourcesCount(context.Background(), countCriteria, aggregationCriteria, false)
+
+		assertion.Error(err)
+		assertion.Contains(err.Error(), "opensearch count failed")
+		assertion.Nil(result)
+		assertion.Less(time.Since(start), 5*time.Second)
+	})
+}
+
+func TestOpenSearchSearcherQueryChildCounts(t *testing.T) {
+	assertion := assert.New(t)
+
+	var response AggregationResponse
+	err := json.Unmarshal([]byte(`{
+		"parents": {
+			"buckets": [
+				{
+					"key": "project:123",
+					"doc_count": 4,
+					"types": {
+						"buckets": [
+							{
+								"key": "committee",
+								"doc_count": 4,
+								"public": {"doc_count": 1},
+								"private": {
+									"doc_count": 3,
+									"access_check": {
+										"sum_other_doc_count": 0,
+										"buckets": [
+											{"key": "committee:1#viewer", "doc_count": 2},
+											{"key": "committee:2#viewer", "doc_count": 1}
+										]
+									}
+								}
+							}
+						]
+					}
+				}
+			]
+		}
+	}`), &response)
+	if err != nil {
+		t.Fatalf("failed to decode aggregation response: %v", err)
+	}
+
+	client := NewMockOpenSearchClient()
+	client.SetAggregationResponse(&response)
+	searcher := &OpenSearchSearcher{
+		client: client,
+		index:  "test-index",
+	}
+
+	buckets, err := searcher.QueryChildCounts(context.Background(), []string{"project:123", "project:456"}, false)
+
+	assertion.NoError(err)
+	assertion.Equal([]model.ChildCountBucket{
+		{ParentRef: "project:123", Type: "committee", DocCount: 1},
+		{ParentRef: "project:123", Type: "committee", AccessCheckQuery: "committee:1#viewer", DocCount: 2},
+		{ParentRef: "project:123", Type: "committee", AccessCheckQuery: "committee:2#viewer", DocCount: 1},
+	}, buckets)
+
+	// A single search covers all the parents
+	query := string(client.aggregationQuery)
+	assertion.Contains(query, `"terms":{"parent_refs":["project:123","project:456"]}`)
+	assertion.Contains(query, `"include":["project:123","project:456"],"size":2`)
+	assertion.NotContains(query, `{"term":{"public":true}}]`)
+
+	// Public only
+	_, err = searcher.QueryChildCounts(context.Background(), []string{"project:123"}, true)
+	assertion.NoError(err)
+	assertion.Contains(string(client.aggregationQuery), `{"term":{"public":true}}]`)
+
+	// Search error
+	client.SetAggregationError(errors.New("opensearch search failed"))
+	_, err = searcher.QueryChildCounts(context.Background(), []string{"project:123"}, false)
+	assertion.Error(err)
+}
+
+func TestOpenSearchSearcherQueryFacets(t *testing.T) {
+	assertion := assert.New(t)
+
+	var response AggregationResponse
+	err := json.Unmarshal([]byte(`{
+		"facet_type": {
+			"sum_other_doc_count": 0,
+			"buckets": [
+				{
+					"key": "committee",
+					"doc_count": 3,
+					"public": {"doc_count": 1},
+					"private": {
+						"doc_count": 2,
+						"access_check": {
+							"sum_other_doc_count": 0,
+							"buckets": [
+								{"key": "committee:1#viewer", "doc_count": 2}
+							]
+						}
+					}
+				}
+			]
+		},
+		"facet_tags": {
+			"sum_other_doc_count": 4,
+			"buckets": [
+				{
+					"key": "active",
+					"doc_count": 1,
+					"public": {"doc_count": 0},
+					"private": {
+						"doc_count": 1,
+						"access_check": {
+							"sum_other_doc_count": 0,
+							"buckets": [
+								{"key": "committee:1#viewer", "doc_count": 1}
+							]
+						}
+					}
+				}
+			]
+		}
+	}`), &response)
+	if err != nil {
+		t.Fatalf("failed to decode aggregation response: %v", err)
+	}
+
+	client := NewMockOpenSearchClient()
+	client.SetAggregationResponse(&response)
+	searcher := &OpenSearchSearcher{
+		client: client,
+		index:  "test-index",
+	}
+
+	buckets, err := searcher.QueryFacets(context.Background(), model.SearchCriteria{
+		Parent:   stringPtr("project:123"),
+		PageSize: 50,
+	}, []string{model.FacetFieldType, model.FacetFieldTags, model.FacetFieldStatus})
+
+	assertion.NoError(err)
+	assertion.Equal([]model.FacetBucket{
+		{Field: "type", Value: "committee", DocCount: 1},
+		{Field: "type", Value: "committee", AccessCheckQuery: "committee:1#viewer", DocCount: 2},
+		{Field: "tags", Value: "active", AccessCheckQuery: "committee:1#viewer", DocCount: 1},
+	}, buckets)
+
+	// A single search aggregates every facet field, without returning hits
+	query := string(client.aggregationQuery)
+	assertion.Contains(query, `"size":0`)
+	assertion.Contains(query, `{"term":{"parent_refs":"project:123"}}`)
+	assertion.Contains(query, `"facet_type":{"terms":{"field":"object_type","size":50}`)
+	assertion.Contains(query, `"facet_tags":{"terms":{"field":"tags","size":50}`)
+	assertion.Contains(query, `"facet_status":{"terms":{"field":"data.status.keyword","size":50}`)
+	assertion.Contains(query, `"access_check":{"terms":{"field":"access_check_query.keyword","size":100}}`)
+	assertion.NotContains(query, `"sort"`)
+
+	// Unsupported field
+	_, err = searcher.QueryFacets(context.Background(), model.SearchCriteria{}, []string{"name"})
+	var validationErr pkgerrors.Validation
+	assertion.ErrorAs(err, &validationErr)
+
+	// Search error
+	client.SetAggregationError(errors.New("opensearch search failed"))
+	_, err = searcher.QueryFacets(context.Background(), model.SearchCriteria{}, []string{model.FacetFieldType})
+	assertion.Error(err)
+}
+
 func TestOpenSearchSearcherQueryResourcesCount(t *testing.T) {
 	tests := []struct {
-		name                    string
+		name                   string
 		countCriteria          model.SearchCriteria
 		aggregationCriteria    model.SearchCriteria
 		publicOnly             bool
@@ -829,12 +2169,20 @@ func TestOpenSearchSearcherQueryResourcesCount(t *testing.T) {
 	}
 }
 
-
 // Helper function to create string pointers
 func stringPtr(s string) *string {
 	return &s
 }
 
+func intPtr(i int) *int {
+	return &i
+}
+
+// Helper function to create float64 pointers
+func float64Ptr(f float64) *float64 {
+	return &f
+}
+
 // Helper function to marshal JSON without error handling for test setup
 func mustMarshal(v any) []byte {
 	b, err := json.Marshal(v)
@@ -843,3 +2191,209 @@ func mustMarshal(v any) []byte {
 	}
 	return b
 }
+
+func TestOpenSearchSearcherLogIndexAlias(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupMock     func(*MockOpenSearchClient)
+		expectedLevel string
+		expectedMsg   string
+		expectedAttrs map[string]any
+	}{
+		{
+			name: "alias pointing at a single index",
+			setupMock: func(mock *MockOpenSearchClient) {
+				mock.SetAliasIndices("resources-v2")
+			},
+			expectedLevel: "INFO",
+			expectedMsg:   "opensearch index is an alias",
+			expectedAttrs: map[string]any{
+				"alias":   "resources",
+				"indices": []any{"resources-v2"},
+			},
+		},
+		{
+			name: "alias pointing at several indices during a reindex",
+			setupMock: func(mock *MockOpenSearchClient) {
+				mock.SetAliasIndices("resources-v1", "resources-v2")
+			},
+			expectedLevel: "INFO",
+			expectedMsg:   "opensearch index is an alias",
+			expectedAttrs: map[string]any{
+				"alias":   "resources",
+				"indices": []any{"resources-v1", "resources-v2"},
+			},
+		},
+		{
+			name:          "concrete index",
+			setupMock:     func(mock *MockOpenSearchClient) {},
+			expectedLevel: "INFO",
+			expectedMsg:   "opensearch index is not an alias",
+			expectedAttrs: map[string]any{
+				"index": "resources",
+			},
+		},
+		{
+			name: "lookup failure",
+			setupMock: func(mock *MockOpenSearchClient) {
+				mock.SetAliasError(errors.New("connection refused"))
+			},
+			expectedLevel: "WARN",
+			expectedMsg:   "unable to resolve opensearch index alias",
+			expectedAttrs: map[string]any{
+				"index": "resources",
+				"error": "connection refused",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			var logs bytes.Buffer
+			defaultLogger := slog.Default()
+			slog.SetDefault(slog.New(slog.NewJSONHandler(&logs, nil)))
+			defer slog.SetDefault(defaultLogger)
+
+			mockClient := NewMockOpenSearchClient()
+			tc.setupMock(mockClient)
+			searcher := &OpenSearchSearcher{
+				client: mockClient,
+				index:  "resources",
+			}
+
+			searcher.logIndexAlias(context.Background())
+
+			var entry map[string]any
+			assertion.NoError(json.Unmarshal(logs.Bytes(), &entry))
+			assertion.Equal(tc.expectedLevel, entry["level"])
+			assertion.Equal(tc.expectedMsg, entry["msg"])
+			for key, value := range tc.expectedAttrs {
+				assertion.Equal(value, entry[key], key)
+			}
+		})
+	}
+}
+
+func TestParseNameFields(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         string
+		expected      []string
+		expectedError bool
+	}{
+		{
+			name:     "fields with and without boosts",
+			value:    "name_and_aliases^3, description^1.5,slug",
+			expected: []string{"name_and_aliases^3", "description^1.5", "slug"},
+		},
+		{
+			name:     "empty entries are skipped",
+			value:    "name_and_aliases,,",
+			expected: []string{"name_and_aliases"},
+		},
+		{
+			name:          "missing field name",
+			value:         "^3",
+			expectedError: true,
+		},
+		{
+			name:          "invalid boost",
+			value:         "name_and_aliases^high",
+			expectedError: true,
+		},
+		{
+			name:          "non-positive boost",
+			value:         "name_and_aliases^0",
+			expectedError: true,
+		},
+		{
+			name:          "no fields",
+			value:         " , ",
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fields, err := ParseNameFields(tc.value)
+			if tc.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, fields)
+		})
+	}
+}
+
+func TestOpenSearchSearcherQueryLogTruncation(t *testing.T) {
+	parentRefs := make([]string, 200)
+	for i := range parentRefs {
+		parentRefs[i] = fmt.Sprintf("project:%05d", i)
+	}
+
+	tests := []struct {
+		name              string
+		queryLogMaxBytes  int
+		expectedTruncated bool
+	}{
+		{
+			name:              "oversized query is truncated",
+			queryLogMaxBytes:  256,
+			expectedTruncated: true,
+		},
+		{
+			name:             "query within the limit is logged in full",
+			queryLogMaxBytes: 1 << 20,
+		},
+		{
+			name:             "negative limit logs the query in full",
+			queryLogMaxBytes: -1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var logs bytes.Buffer
+			defaultLogger := slog.Default()
+			slog.SetDefault(slog.New(slog.NewJSONHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug})))
+			defer slog.SetDefault(defaultLogger)
+
+			client := NewMockOpenSearchClient()
+			client.aggregationResponse = &AggregationResponse{}
+			searcher := &OpenSearchSearcher{
+				client:           client,
+				index:            "test-index",
+				queryLogMaxBytes: tc.queryLogMaxBytes,
+			}
+
+			_, err := searcher.QueryChildCounts(context.Background(), parentRefs, false)
+			assert.NoError(t, err)
+
+			var logged string
+			for _, line := range bytes.Split(logs.Bytes(), []byte("\n")) {
+				var entry map[string]any
+				if json.Unmarshal(line, &entry) == nil && entry["msg"] == "child count query" {
+					logged, _ = entry["query"].(string)
+				}
+			}
+			if !assert.NotEmpty(t, logged) {
+				return
+			}
+
+			if tc.expectedTruncated {
+				assert.Equal(t, fmt.Sprintf("%s... (truncated, %d bytes)", client.aggregationQuery[:tc.queryLogMaxBytes], len(client.aggregationQuery)), logged)
+				return
+			}
+			assert.Equal(t, string(client.aggregationQuery), logged)
+		})
+	}
+}
+
+func TestLoggedQuery(t *testing.T) {
+	// A multi-byte character is never split
+	assert.Equal(t, `{"name":"... (truncated, 13 bytes)`, loggedQuery([]byte(`{"name":"é"}`), 10))
+	assert.Equal(t, `{"name":"é"}`, loggedQuery([]byte(`{"name":"é"}`), 13))
+}
@@ -4,10 +4,16 @@
 package opensearch
 
 const queryResourceSource = `{
-  {{- if ge .PageSize 0 }}
+  {{- if .CountOnly }}
+  "size": 0,
+  {{- else if ge .PageSize 0 }}
   "size": {{ .PageSize }},
   {{- end }}
   "query": {
+    {{- if .RecencyBoostScale }}
+    "function_score": {
+      "query": {
+    {{- end }}
     "bool": {
       "must": [
         {
@@ -34,7 +40,64 @@ const queryResourceSource = `{
           }
         }
         {{- end }}
-        {{- if .Parent }},
+        {{- if .ResourceTypes }},
+        {
+          "terms": {
+            "object_type": [
+              {{- range $idx, $type := .ResourceTypes }}
+              {{- if $idx }},{{ end }}
+              {{ $type | quote }}
+              {{- end }}
+            ]
+          }
+        }
+        {{- end }}
+        {{- if .CreatedBy }},
+        {
+          "term": {
+            "created_by": {{ .CreatedBy | quote }}
+          }
+        }
+        {{- end }}
+        {{- if .UpdatedBy }},
+        {
+          "term": {
+            "updated_by": {{ .UpdatedBy | quote }}
+          }
+        }
+        {{- end }}
+        {{- if .TransactionID }},
+        {
+          "term": {
+            "transaction_id": {{ .TransactionID | quote }}
+          }
+        }
+        {{- end }}
+        {{- if .TemplateQuery }},
+        {{ .TemplateQuery }}
+        {{- end }}
+        {{- if .ObjectRef }},
+        {
+          "term": {
+            "object_ref": {{ .ObjectRef | quote }}
+          }
+        }
+        {{- end }}
+        {{- if .Parents }},
+        {
+          "terms": {
+            "parent_refs": [
+              {{- with .Parent }}
+              {{ . | quote }},
+              {{- end }}
+              {{- range $idx, $parent := .Parents }}
+              {{- if $idx }},{{ end }}
+              {{ $parent | quote }}
+              {{- end }}
+            ]
+          }
+        }
+        {{- else if .Parent }},
         {
           "term": {
             "parent_refs": {{ .Parent | quote }}
@@ -43,17 +106,63 @@ const queryResourceSource = `{
         {{- end }}
         {{- if .Name }},
         {
+          {{- if .ExactNameBoost }}
+          "bool": {
+            "should": [
+              {
+          {{- end }}
           "multi_match": {
             "query": {{ .Name | quote }},
             "type": "bool_prefix",
+            {{- if .NameFuzzy }}
+            "fuzziness": "AUTO",
+            {{- end }}
             "fields": [
-              "name_and_aliases",
-              "name_and_aliases._2gram",
-              "name_and_aliases._3gram"
+              {{- range $idx, $field := .NameFields }}
+              {{- if $idx }},{{ end }}
+              {{ $field | quote }}
+              {{- end }}
             ]
           }
+          {{- if .ExactNameBoost }}
+              },
+              {
+                "term": {
+                  "data.name.lowercase": {
+                    "value": {{ .Name | quote }},
+                    "boost": 10
+                  }
+                }
+              }
+            ],
+            "minimum_should_match": 1
+          }
+          {{- end }}
         }
         {{- end }}
+        {{- if .NameExact }},
+        {
+          "term": {
+            "data.name.lowercase": {{ .NameExact | quote }}
+          }
+        }
+        {{- end }}
+        {{- range $field, $filter := .RangeFilters }}
+        {{- if or $filter.Gte $filter.Lte }},
+        {
+          "range": {
+            {{ $field | quote }}: {
+              {{- if $filter.Gte }}
+              "gte": {{ $filter.Gte }}{{ if $filter.Lte }},{{ end }}
+              {{- end }}
+              {{- if $filter.Lte }}
+              "lte": {{ $filter.Lte }}
+              {{- end }}
+            }
+          }
+        }
+        {{- end }}
+        {{- end }}
         {{- if .TagsAll }}
         {{- range .TagsAll }},
         {
@@ -64,8 +173,33 @@ const queryResourceSource = `{
         {{- end }}
         {{- end }}
       ]
+      {{- if .ExcludeIDs }},
+      "must_not": [
+        {
+          {{- if .ExcludeIDsField }}
+          "terms": {
+            {{ .ExcludeIDsField | quote }}: [
+              {{- range $idx, $id := .ExcludeIDs }}
+              {{- if $idx }},{{ end }}
+              {{ $id | quote }}
+              {{- end }}
+            ]
+          }
+          {{- else }}
+          "ids": {
+            "values": [
+              {{- range $idx, $id := .ExcludeIDs }}
+              {{- if $idx }},{{ end }}
+              {{ $id | quote }}
+              {{- end }}
+            ]
+          }
+          {{- end }}
+        }
+      ]
+      {{- end }}
       {{- if .Tags }},
-      "minimum_should_match": 1,
+      "minimum_should_match": {{ with .TagsMinMatch }}{{ . }}{{ else }}1{{ end }},
       "should": [
         {{- $first := true -}}
         {{- range .Tags -}}
@@ -82,10 +216,32 @@ const queryResourceSource = `{
       ]
       {{- end }}
     }
+    {{- if .RecencyBoostScale }}
+      },
+      "functions": [
+        {
+          "gauss": {
+            "updated_at": {
+              "origin": "now",
+              "scale": {{ .RecencyBoostScale | quote }},
+              "decay": 0.5
+            }
+          }
+        }
+      ],
+      "boost_mode": "multiply"
+    }
+    {{- end }}
   }
   {{- if .SearchAfter }},
   "search_after": {{ .SearchAfter }}
   {{- end }}
+  {{- if .IncludeScore }},
+  "track_scores": true
+  {{- end }}
+  {{- with .TrackTotalHits }},
+  "track_total_hits": {{ if .Exact }}true{{ else if gt .UpTo 0 }}{{ .UpTo }}{{ else }}false{{ end }}
+  {{- end }}
   {{- if gt .PageSize 0 }},
   "sort": [
     {
@@ -93,8 +249,19 @@ const queryResourceSource = `{
         "order": {{ .SortOrder | quote }}
       }
     },
+    {{- if .CollapseField }}
+    {
+      {{ .CollapseField | quote }}: "asc"
+    }
+    {{- else }}
     {"_id": "asc"}
+    {{- end }}
   ]
+  {{- with .CollapseField }},
+  "collapse": {
+    "field": {{ . | quote }}
+  }
+  {{- end }}
   {{- end }}
   {{- if .GroupBy }},
   "aggs": {
@@ -106,4 +273,115 @@ const queryResourceSource = `{
     }
   }
   {{- end }}
+  {{- if .Facets }},
+  "aggs": {
+    {{- range $idx, $facet := .Facets }}
+    {{- if $idx }},{{ end }}
+    {{ $facet.Name | quote }}: {
+      "terms": {
+        "field": {{ $facet.Field | quote }},
+        "size": {{ $.FacetSize }}
+      },
+      "aggs": {
+        "public": {
+          "filter": {
+            "term": {"public": true}
+          }
+        },
+        "private": {
+          "filter": {
+            "bool": {
+              "must_not": {
+                "term": {"public": true}
+              }
+            }
+          },
+          "aggs": {
+            "access_check": {
+              "terms": {
+                "field": "access_check_query.keyword",
+                "size": {{ $.BucketSize }}
+              }
+            }
+          }
+        }
+      }
+    }
+    {{- end }}
+  }
+  {{- end }}
+}`
+
+const childCountSource = `{
+  "size": 0,
+  "query": {
+    "bool": {
+      "must": [
+        {
+          "term": {"latest": true}
+        },
+        {
+          "terms": {
+            "parent_refs": [
+              {{- range $idx, $ref := .ParentRefs }}
+              {{- if $idx }},{{ end }}
+              {{ $ref | quote }}
+              {{- end }}
+            ]
+          }
+        }
+        {{- if .PublicOnly }},
+        {
+          "term": {"public": true}
+        }
+        {{- end }}
+      ]
+    }
+  },
+  "aggs": {
+    "parents": {
+      "terms": {
+        "field": "parent_refs",
+        "include": [
+          {{- range $idx, $ref := .ParentRefs }}
+          {{- if $idx }},{{ end }}
+          {{ $ref | quote }}
+          {{- end }}
+        ],
+        "size": {{ len .ParentRefs }}
+      },
+      "aggs": {
+        "types": {
+          "terms": {
+            "field": "object_type",
+            "size": {{ .TypesSize }}
+          },
+          "aggs": {
+            "public": {
+              "filter": {
+                "term": {"public": true}
+              }
+            },
+            "private": {
+              "filter": {
+                "bool": {
+                  "must_not": {
+                    "term": {"public": true}
+                  }
+                }
+              },
+              "aggs": {
+                "access_check": {
+                  "terms": {
+                    "field": "access_check_query.keyword",
+                    "size": {{ .BucketSize }}
+                  }
+                }
+              }
+            }
+          }
+        }
+      }
+    }
+  }
 }`
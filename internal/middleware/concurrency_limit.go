@@ -0,0 +1,49 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"slices"
+)
+
+// concurrencyLimitRetryAfter is the Retry-After, in seconds, of requests
+// rejected because too many requests are in flight
+const concurrencyLimitRetryAfter = "1"
+
+// ConcurrencyLimitMiddleware creates a middleware that serves at most limit
+// requests at once, so bursts can't exhaust the backend connections. Requests
+// beyond the limit are rejected with a 503 and a Retry-After header rather
+// than queued. Requests to the exempt paths (e.g. health checks) are always
+// served. A non-positive limit disables the middleware.
+func ConcurrencyLimitMiddleware(limit int, exemptPaths ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if limit <= 0 {
+			return next
+		}
+		inFlight := make(chan struct{}, limit)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if slices.Contains(exemptPaths, r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			select {
+			case inFlight <- struct{}{}:
+				defer func() { <-inFlight }()
+				next.ServeHTTP(w, r)
+			default:
+				slog.WarnContext(r.Context(), "too many concurrent requests, rejecting request",
+					"path", r.URL.Path,
+					"limit", limit,
+				)
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set("Retry-After", concurrencyLimitRetryAfter)
+				w.WriteHeader(http.StatusServiceUnavailable)
+				_, _ = w.Write([]byte(`{"message":"too many concurrent requests, retry later"}`))
+			}
+		})
+	}
+}
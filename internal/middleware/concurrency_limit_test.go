@@ -0,0 +1,79 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrencyLimitMiddleware(t *testing.T) {
+	assertion := assert.New(t)
+
+	const limit = 2
+	started := make(chan struct{}, limit)
+	release := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/query/resources" {
+			started <- struct{}{}
+			<-release
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	limited := ConcurrencyLimitMiddleware(limit, "/livez", "/readyz")(handler)
+
+	// Saturate the limiter with requests that block until released
+	var wg sync.WaitGroup
+	codes := make([]int, limit)
+	for i := range limit {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rec := httptest.NewRecorder()
+			limited.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/query/resources", nil))
+			codes[i] = rec.Code
+		}()
+	}
+	for range limit {
+		<-started
+	}
+
+	// Further requests are rejected
+	rec := httptest.NewRecorder()
+	limited.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/query/resources", nil))
+	assertion.Equal(http.StatusServiceUnavailable, rec.Code)
+	assertion.Equal("1", rec.Header().Get("Retry-After"))
+	assertion.Contains(rec.Body.String(), "too many concurrent requests")
+
+	// Health checks are still served
+	for _, path := range []string{"/livez", "/readyz"} {
+		rec := httptest.NewRecorder()
+		limited.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		assertion.Equal(http.StatusOK, rec.Code, path)
+	}
+
+	// Once the in-flight requests complete, requests are served again
+	close(release)
+	wg.Wait()
+	assertion.Equal([]int{http.StatusOK, http.StatusOK}, codes)
+
+	rec = httptest.NewRecorder()
+	limited.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/query/resources", nil))
+	assertion.Equal(http.StatusOK, rec.Code)
+}
+
+func TestConcurrencyLimitMiddlewareDisabled(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	rec := httptest.NewRecorder()
+	ConcurrencyLimitMiddleware(0)(handler).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/query/resources", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
@@ -0,0 +1,26 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/constants"
+)
+
+// RequestURLMiddleware creates a middleware that adds the URL of the request to
+// the context, so handlers can build links relative to it (e.g. pagination)
+func RequestURLMiddleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Copy the URL, so later changes to the request don't leak into the context
+			requestURL := *r.URL
+
+			ctx := context.WithValue(r.Context(), constants.RequestURLContextID, &requestURL)
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestURLMiddleware(t *testing.T) {
+	assertion := assert.New(t)
+
+	var capturedURL *url.URL
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		capturedURL, _ = r.Context().Value(constants.RequestURLContextID).(*url.URL)
+		// Changes to the request URL must not leak into the context
+		r.URL.RawQuery = ""
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest("GET", "/query/resources?v=1&type=project", nil)
+	rec := httptest.NewRecorder()
+	RequestURLMiddleware()(handler).ServeHTTP(rec, req)
+
+	assertion.Equal(http.StatusOK, rec.Code)
+	if assertion.NotNil(capturedURL) {
+		assertion.Equal("/query/resources", capturedURL.Path)
+		assertion.Equal("v=1&type=project", capturedURL.RawQuery)
+	}
+}
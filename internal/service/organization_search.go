@@ -5,10 +5,17 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/model"
 	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/port"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/errors"
 )
 
 // OrganizationSearcher defines the interface for organization search operations
@@ -18,9 +25,18 @@ type OrganizationSearcher interface {
 	// QueryOrganizations searches for organizations based on the provided criteria
 	QueryOrganizations(ctx context.Context, criteria model.OrganizationSearchCriteria) (*model.Organization, error)
 
+	// QueryOrganizationsList returns a page of all the organizations matching the criteria, ordered by relevance
+	QueryOrganizationsList(ctx context.Context, criteria model.OrganizationSearchCriteria) (*model.OrganizationsResult, error)
+
+	// CountOrganizations returns the number of organizations matching the criteria
+	CountOrganizations(ctx context.Context, criteria model.OrganizationSearchCriteria) (int, error)
+
 	// SuggestOrganizations returns organization suggestions for typeahead search
 	SuggestOrganizations(ctx context.Context, criteria model.OrganizationSuggestionCriteria) (*model.OrganizationSuggestionsResult, error)
 
+	// ResolveOrganizations resolves each domain to its organization, or nil when there is none
+	ResolveOrganizations(ctx context.Context, domains []string) (map[string]*model.Organization, error)
+
 	// IsReady checks if the search service is ready
 	IsReady(ctx context.Context) error
 }
@@ -28,7 +44,55 @@ type OrganizationSearcher interface {
 // OrganizationSearch handles organization-related business operations
 // It depends on abstractions (interfaces) rather than concrete implementations
 type OrganizationSearch struct {
-	organizationSearcher port.OrganizationSearcher
+	organizationSearcher   port.OrganizationSearcher
+	suggestMinQueryLength  int
+	suggestAllowEmptyQuery bool
+	searchTimeout          time.Duration
+}
+
+// OrganizationSearchOption configures optional OrganizationSearch behavior
+type OrganizationSearchOption func(*OrganizationSearch)
+
+// WithSuggestMinQueryLength sets the minimum query length (in characters) for
+// suggestions; shorter queries return an empty result without hitting the backend
+func WithSuggestMinQueryLength(length int) OrganizationSearchOption {
+	return func(s *OrganizationSearch) {
+		s.suggestMinQueryLength = length
+	}
+}
+
+// WithSuggestAllowEmptyQuery controls whether an empty suggestion query is
+// forwarded to the backend to return its top suggestions
+func WithSuggestAllowEmptyQuery(allow bool) OrganizationSearchOption {
+	return func(s *OrganizationSearch) {
+		s.suggestAllowEmptyQuery = allow
+	}
+}
+
+// WithSearchTimeout bounds every backend search, so that a slow backend fails
+// the request as unavailable instead of hanging it; zero means no bound
+func WithSearchTimeout(timeout time.Duration) OrganizationSearchOption {
+	return func(s *OrganizationSearch) {
+		s.searchTimeout = timeout
+	}
+}
+
+// withSearchTimeout returns the context of a backend search, bounded by the
+// search timeout if any
+func (s *OrganizationSearch) withSearchTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if s.searchTimeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, s.searchTimeout)
+}
+
+// searchError reports a backend search that ran out of time as unavailable,
+// and any other error as is
+func searchError(searchCtx context.Context, err error) error {
+	if searchCtx.Err() == context.DeadlineExceeded {
+		return errors.NewServiceUnavailable("organization search timed out", err)
+	}
+	return err
 }
 
 // QueryOrganizations performs organization search with business logic validation
@@ -37,15 +101,30 @@ func (s *OrganizationSearch) QueryOrganizations(ctx context.Context, criteria mo
 	slog.DebugContext(ctx, "starting organization search",
 		"name", criteria.Name,
 		"domain", criteria.Domain,
+		"domains", len(criteria.Domains),
 	)
 
+	// Alternative domains are searched normalized, each once
+	if len(criteria.Domains) > constants.MaxResolveDomains {
+		return nil, errors.NewValidation(fmt.Sprintf("at most %d domains can be searched at once", constants.MaxResolveDomains))
+	}
+	var domains []string
+	for _, domain := range criteria.Domains {
+		if domain = normalizeDomain(domain); domain != "" && !slices.Contains(domains, domain) {
+			domains = append(domains, domain)
+		}
+	}
+	criteria.Domains = domains
+
 	// Delegate to the search implementation
-	result, err := s.organizationSearcher.QueryOrganizations(ctx, criteria)
+	searchCtx, cancel := s.withSearchTimeout(ctx)
+	defer cancel()
+	result, err := s.organizationSearcher.QueryOrganizations(searchCtx, criteria)
 	if err != nil {
 		slog.ErrorContext(ctx, "organization search operation failed while executing query organizations",
 			"error", err,
 		)
-		return nil, err
+		return nil, searchError(searchCtx, err)
 	}
 
 	var orgName, orgDomain string
@@ -62,6 +141,145 @@ func (s *OrganizationSearch) QueryOrganizations(ctx context.Context, criteria mo
 	return result, nil
 }
 
+// QueryOrganizationsList performs an organization list search with business logic validation
+func (s *OrganizationSearch) QueryOrganizationsList(ctx context.Context, criteria model.OrganizationSearchCriteria) (*model.OrganizationsResult, error) {
+
+	slog.DebugContext(ctx, "starting organization list search",
+		"name", criteria.Name,
+		"domain", criteria.Domain,
+		"offset", criteria.Offset,
+	)
+
+	if criteria.Name == nil && criteria.Domain == nil {
+		return nil, errors.NewValidation("at least one search parameter must be provided: name or domain")
+	}
+	if criteria.PageSize <= 0 {
+		criteria.PageSize = constants.DefaultPageSize
+	}
+	if criteria.Offset < 0 {
+		criteria.Offset = 0
+	}
+
+	// Delegate to the search implementation
+	searchCtx, cancel := s.withSearchTimeout(ctx)
+	defer cancel()
+	result, err := s.organizationSearcher.QueryOrganizationsList(searchCtx, criteria)
+	if err != nil {
+		slog.ErrorContext(ctx, "organization search operation failed while executing query organizations list",
+			"error", err,
+		)
+		return nil, searchError(searchCtx, err)
+	}
+
+	slog.DebugContext(ctx, "organization list search completed",
+		"total", result.Total,
+		"organization_count", len(result.Organizations),
+	)
+
+	return result, nil
+}
+
+// CountOrganizations counts the organizations matching the criteria; without
+// name or domain, every organization is counted
+func (s *OrganizationSearch) CountOrganizations(ctx context.Context, criteria model.OrganizationSearchCriteria) (int, error) {
+
+	slog.DebugContext(ctx, "starting organization count",
+		"name", criteria.Name,
+		"domain", criteria.Domain,
+	)
+
+	// Delegate to the search implementation
+	searchCtx, cancel := s.withSearchTimeout(ctx)
+	defer cancel()
+	count, err := s.organizationSearcher.CountOrganizations(searchCtx, criteria)
+	if err != nil {
+		slog.ErrorContext(ctx, "organization search operation failed while counting organizations",
+			"error", err,
+		)
+		return 0, searchError(searchCtx, err)
+	}
+
+	slog.DebugContext(ctx, "organization count completed",
+		"count", count,
+	)
+
+	return count, nil
+}
+
+// ResolveOrganizations resolves a batch of domains with a single backend call.
+// The result has an entry for every requested domain, as given, which is nil
+// when the domain has no organization.
+func (s *OrganizationSearch) ResolveOrganizations(ctx context.Context, domains []string) (map[string]*model.Organization, error) {
+
+	slog.DebugContext(ctx, "starting organization resolution",
+		"domains", len(domains),
+	)
+
+	if len(domains) == 0 {
+		return nil, errors.NewValidation("at least one domain is required")
+	}
+	if len(domains) > constants.MaxResolveDomains {
+		return nil, errors.NewValidation(fmt.Sprintf("at most %d domains can be resolved at once", constants.MaxResolveDomains))
+	}
+
+	// Look up each normalized domain once, however many times it is requested
+	normalized := make(map[string]string, len(domains))
+	var lookup []string
+	for _, domain := range domains {
+		normalizedDomain := normalizeDomain(domain)
+		normalized[domain] = normalizedDomain
+		if normalizedDomain != "" && !slices.Contains(lookup, normalizedDomain) {
+			lookup = append(lookup, normalizedDomain)
+		}
+	}
+
+	found := map[string]*model.Organization{}
+	if len(lookup) > 0 {
+		searchCtx, cancel := s.withSearchTimeout(ctx)
+		defer cancel()
+		var err error
+		found, err = s.organizationSearcher.ResolveOrganizations(searchCtx, lookup)
+		if err != nil {
+			slog.ErrorContext(ctx, "organization search operation failed while resolving organizations",
+				"error", err,
+			)
+			return nil, searchError(searchCtx, err)
+		}
+	}
+
+	result := make(map[string]*model.Organization, len(domains))
+	for _, domain := range domains {
+		result[domain] = found[normalized[domain]]
+	}
+
+	slog.DebugContext(ctx, "organization resolution completed",
+		"domains", len(domains),
+		"resolved", len(found),
+	)
+
+	return result, nil
+}
+
+// normalizeDomain reduces a domain or website URL to its lower-cased host name,
+// without scheme, "www." prefix, port, path or trailing dot
+func normalizeDomain(domain string) string {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+	if idx := strings.Index(domain, "://"); idx >= 0 {
+		domain = domain[idx+3:]
+	}
+	if idx := strings.IndexAny(domain, "/?#"); idx >= 0 {
+		domain = domain[:idx]
+	}
+	if idx := strings.LastIndex(domain, "@"); idx >= 0 {
+		domain = domain[idx+1:]
+	}
+	if idx := strings.Index(domain, ":"); idx >= 0 {
+		domain = domain[:idx]
+	}
+	domain = strings.TrimSuffix(domain, ".")
+	return strings.TrimPrefix(domain, "www.")
+}
+
 // SuggestOrganizations performs organization suggestions with business logic validation
 func (s *OrganizationSearch) SuggestOrganizations(ctx context.Context, criteria model.OrganizationSuggestionCriteria) (*model.OrganizationSuggestionsResult, error) {
 
@@ -69,13 +287,29 @@ func (s *OrganizationSearch) SuggestOrganizations(ctx context.Context, criteria
 		"query", criteria.Query,
 	)
 
+	// Short queries are expensive and rarely useful, so they are answered
+	// without hitting the backend. The empty query is a deliberate request for
+	// the top suggestions and is governed separately.
+	queryLength := utf8.RuneCountInString(strings.TrimSpace(criteria.Query))
+	if (queryLength == 0 && !s.suggestAllowEmptyQuery) || (queryLength > 0 && queryLength < s.suggestMinQueryLength) {
+		slog.DebugContext(ctx, "suggestion query below minimum length, skipping search",
+			"query_length", queryLength,
+			"min_query_length", s.suggestMinQueryLength,
+		)
+		return &model.OrganizationSuggestionsResult{
+			Suggestions: []model.OrganizationSuggestion{},
+		}, nil
+	}
+
 	// Delegate to the search implementation
-	result, err := s.organizationSearcher.SuggestOrganizations(ctx, criteria)
+	searchCtx, cancel := s.withSearchTimeout(ctx)
+	defer cancel()
+	result, err := s.organizationSearcher.SuggestOrganizations(searchCtx, criteria)
 	if err != nil {
 		slog.ErrorContext(ctx, "organization suggestions search operation failed",
 			"error", err,
 		)
-		return nil, err
+		return nil, searchError(searchCtx, err)
 	}
 
 	var suggestionCount int
@@ -100,8 +334,14 @@ func (s *OrganizationSearch) IsReady(ctx context.Context) error {
 }
 
 // NewOrganizationSearch creates a new OrganizationSearch instance
-func NewOrganizationSearch(organizationSearcher port.OrganizationSearcher) OrganizationSearcher {
-	return &OrganizationSearch{
-		organizationSearcher: organizationSearcher,
+func NewOrganizationSearch(organizationSearcher port.OrganizationSearcher, opts ...OrganizationSearchOption) OrganizationSearcher {
+	s := &OrganizationSearch{
+		organizationSearcher:   organizationSearcher,
+		suggestMinQueryLength:  constants.DefaultSuggestMinQueryLength,
+		suggestAllowEmptyQuery: true,
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
 }
@@ -6,9 +6,11 @@ package service
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/model"
 	"github.com/linuxfoundation/lfx-v2-query-service/internal/infrastructure/mock"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/constants"
 	"github.com/linuxfoundation/lfx-v2-query-service/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
@@ -155,6 +157,107 @@ func TestOrganizationSearchQueryOrganizations(t *testing.T) {
 			expectedOrganization:    &model.Organization{Name: "The Linux Foundation", Domain: "linuxfoundation.org", Industry: "Non-Profit", Sector: "Technology", Employees: "100-499"},
 			expectedOrganizationNil: false,
 		},
+		{
+			name: "strict match returns organization when name and domain match",
+			criteria: model.OrganizationSearchCriteria{
+				Name:     stringPtr("the linux foundation"),
+				Domain:   stringPtr("LinuxFoundation.org"),
+				MatchAll: true,
+			},
+			setupMock: func(searcher *mock.MockOrganizationSearcher) {
+				// Default mock data includes "The Linux Foundation"
+			},
+			expectedError:           false,
+			expectedOrganization:    &model.Organization{Name: "The Linux Foundation", Domain: "linuxfoundation.org", Industry: "Non-Profit", Sector: "Technology", Employees: "100-499"},
+			expectedOrganizationNil: false,
+		},
+		{
+			name: "strict match not found when only name matches",
+			criteria: model.OrganizationSearchCriteria{
+				Name:     stringPtr("The Linux Foundation"),
+				Domain:   stringPtr("example.com"),
+				MatchAll: true,
+			},
+			setupMock: func(searcher *mock.MockOrganizationSearcher) {
+				// Default mock data includes "The Linux Foundation"
+			},
+			expectedError:           true,
+			expectedErrorType:       errors.NotFound{},
+			expectedOrganization:    nil,
+			expectedOrganizationNil: true,
+		},
+		{
+			name: "strict match not found when name and domain belong to different organizations",
+			criteria: model.OrganizationSearchCriteria{
+				Name:     stringPtr("The Linux Foundation"),
+				Domain:   stringPtr("whizbang-doodads.fake"),
+				MatchAll: true,
+			},
+			setupMock: func(searcher *mock.MockOrganizationSearcher) {
+				// Default mock data includes both organizations
+			},
+			expectedError:           true,
+			expectedErrorType:       errors.NotFound{},
+			expectedOrganization:    nil,
+			expectedOrganizationNil: true,
+		},
+		{
+			name: "search by several domains matches the one that exists",
+			criteria: model.OrganizationSearchCriteria{
+				Domains: []string{"unknown.example", "https://www.LinuxFoundation.org/about", "other.example"},
+			},
+			setupMock: func(searcher *mock.MockOrganizationSearcher) {
+				// Default mock data includes "linuxfoundation.org" only
+			},
+			expectedError:           false,
+			expectedOrganization:    &model.Organization{Name: "The Linux Foundation", Domain: "linuxfoundation.org", Industry: "Non-Profit", Sector: "Technology", Employees: "100-499"},
+			expectedOrganizationNil: false,
+		},
+		{
+			name: "search by several domains none of which exists",
+			criteria: model.OrganizationSearchCriteria{
+				Domains: []string{"unknown.example", "other.example"},
+			},
+			setupMock:               func(searcher *mock.MockOrganizationSearcher) {},
+			expectedError:           true,
+			expectedErrorType:       errors.NotFound{},
+			expectedOrganizationNil: true,
+		},
+		{
+			name: "strict match with several domains",
+			criteria: model.OrganizationSearchCriteria{
+				Name:     stringPtr("The Linux Foundation"),
+				Domains:  []string{"unknown.example", "linuxfoundation.org"},
+				MatchAll: true,
+			},
+			setupMock:               func(searcher *mock.MockOrganizationSearcher) {},
+			expectedError:           false,
+			expectedOrganization:    &model.Organization{Name: "The Linux Foundation", Domain: "linuxfoundation.org", Industry: "Non-Profit", Sector: "Technology", Employees: "100-499"},
+			expectedOrganizationNil: false,
+		},
+		{
+			name: "too many domains",
+			criteria: model.OrganizationSearchCriteria{
+				Domains: make([]string, 101),
+			},
+			setupMock:               func(searcher *mock.MockOrganizationSearcher) {},
+			expectedError:           true,
+			expectedErrorType:       errors.Validation{},
+			expectedOrganizationNil: true,
+		},
+		{
+			name: "strict match with only name behaves like a name search",
+			criteria: model.OrganizationSearchCriteria{
+				Name:     stringPtr("The Linux Foundation"),
+				MatchAll: true,
+			},
+			setupMock: func(searcher *mock.MockOrganizationSearcher) {
+				// Default mock data includes "The Linux Foundation"
+			},
+			expectedError:           false,
+			expectedOrganization:    &model.Organization{Name: "The Linux Foundation", Domain: "linuxfoundation.org", Industry: "Non-Profit", Sector: "Technology", Employees: "100-499"},
+			expectedOrganizationNil: false,
+		},
 	}
 
 	assertion := assert.New(t)
@@ -387,6 +490,66 @@ func TestOrganizationSearchSuggestOrganizations(t *testing.T) {
 	}
 }
 
+func TestOrganizationSearchSuggestOrganizationsMinQueryLength(t *testing.T) {
+	tests := []struct {
+		name                     string
+		query                    string
+		opts                     []OrganizationSearchOption
+		expectedSuggestionsCount int
+	}{
+		{
+			name:                     "default minimum allows single character query",
+			query:                    "l",
+			expectedSuggestionsCount: 5, // Mock limits to 5 suggestions
+		},
+		{
+			name:                     "query below minimum returns empty result",
+			query:                    "li",
+			opts:                     []OrganizationSearchOption{WithSuggestMinQueryLength(3)},
+			expectedSuggestionsCount: 0,
+		},
+		{
+			name:                     "whitespace does not count towards minimum",
+			query:                    "  li  ",
+			opts:                     []OrganizationSearchOption{WithSuggestMinQueryLength(3)},
+			expectedSuggestionsCount: 0,
+		},
+		{
+			name:                     "query at minimum hits the backend",
+			query:                    "lin",
+			opts:                     []OrganizationSearchOption{WithSuggestMinQueryLength(3)},
+			expectedSuggestionsCount: 1,
+		},
+		{
+			name:                     "empty query returns top suggestions by default",
+			query:                    "",
+			opts:                     []OrganizationSearchOption{WithSuggestMinQueryLength(3)},
+			expectedSuggestionsCount: 5, // Mock limits to 5 suggestions
+		},
+		{
+			name:                     "empty query returns empty result when disallowed",
+			query:                    "",
+			opts:                     []OrganizationSearchOption{WithSuggestAllowEmptyQuery(false)},
+			expectedSuggestionsCount: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockSearcher := mock.NewMockOrganizationSearcher()
+			service := NewOrganizationSearch(mockSearcher, tc.opts...)
+
+			ctx := context.Background()
+			result, err := service.SuggestOrganizations(ctx, model.OrganizationSuggestionCriteria{Query: tc.query})
+
+			assert.NoError(t, err)
+			assert.NotNil(t, result)
+			assert.NotNil(t, result.Suggestions)
+			assert.Len(t, result.Suggestions, tc.expectedSuggestionsCount)
+		})
+	}
+}
+
 func TestOrganizationSearchSuggestOrganizationsEdgeCases(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -751,6 +914,255 @@ func TestOrganizationSearchQueryOrganizationsEdgeCases(t *testing.T) {
 	})
 }
 
+func TestOrganizationSearchQueryOrganizationsList(t *testing.T) {
+	tests := []struct {
+		name          string
+		criteria      model.OrganizationSearchCriteria
+		expectedNames []string
+		expectedTotal int
+		expectedError bool
+	}{
+		{
+			name:     "shared name fragment returns all matches ordered by relevance",
+			criteria: model.OrganizationSearchCriteria{Name: stringPtr("linux")},
+			expectedNames: []string{
+				"Linux",                // exact
+				"Linux Foundation",     // prefix
+				"Foundation for Linux", // word prefix
+				"The Linux Foundation", // word prefix
+				"Openlinux Labs",       // fragment
+			},
+			expectedTotal: 5,
+		},
+		{
+			name:          "page of matches",
+			criteria:      model.OrganizationSearchCriteria{Name: stringPtr("linux"), PageSize: 2, Offset: 2},
+			expectedNames: []string{"Foundation for Linux", "The Linux Foundation"},
+			expectedTotal: 5,
+		},
+		{
+			name:          "domain fragment",
+			criteria:      model.OrganizationSearchCriteria{Domain: stringPtr("linuxfoundation.org")},
+			expectedNames: []string{"The Linux Foundation"},
+			expectedTotal: 1,
+		},
+		{
+			name:          "strict match on name and domain",
+			criteria:      model.OrganizationSearchCriteria{Name: stringPtr("linux"), Domain: stringPtr("linux.dev"), MatchAll: true},
+			expectedNames: []string{"Linux Foundation"},
+			expectedTotal: 1,
+		},
+		{
+			name:          "no matches",
+			criteria:      model.OrganizationSearchCriteria{Name: stringPtr("nonexistent")},
+			expectedNames: []string{},
+			expectedTotal: 0,
+		},
+		{
+			name:          "missing criteria",
+			criteria:      model.OrganizationSearchCriteria{},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			mockSearcher := mock.NewMockOrganizationSearcher()
+			mockSearcher.ClearOrganizations()
+			for _, org := range []model.Organization{
+				{Name: "The Linux Foundation", Domain: "linuxfoundation.org"},
+				{Name: "Openlinux Labs", Domain: "openlinux.example"},
+				{Name: "Linux Foundation", Domain: "linux.dev"},
+				{Name: "Other Corp", Domain: "other.example"},
+				{Name: "Foundation for Linux", Domain: "ffl.example"},
+				{Name: "Linux", Domain: "kernel.org"},
+			} {
+				mockSearcher.AddOrganization(org)
+			}
+			service := NewOrganizationSearch(mockSearcher)
+
+			result, err := service.QueryOrganizationsList(context.Background(), tc.criteria)
+
+			if tc.expectedError {
+				var validationErr errors.Validation
+				assertion.ErrorAs(err, &validationErr)
+				return
+			}
+
+			assertion.NoError(err)
+			names := []string{}
+			for _, org := range result.Organizations {
+				names = append(names, org.Name)
+			}
+			assertion.Equal(tc.expectedNames, names)
+			assertion.Equal(tc.expectedTotal, result.Total)
+		})
+	}
+}
+
+func TestOrganizationSearchCountOrganizations(t *testing.T) {
+	tests := []struct {
+		name          string
+		criteria      model.OrganizationSearchCriteria
+		expectedCount int
+	}{
+		{
+			name:          "unfiltered counts every organization",
+			criteria:      model.OrganizationSearchCriteria{},
+			expectedCount: 4,
+		},
+		{
+			name:          "name fragment",
+			criteria:      model.OrganizationSearchCriteria{Name: stringPtr("linux")},
+			expectedCount: 3,
+		},
+		{
+			name:          "domain",
+			criteria:      model.OrganizationSearchCriteria{Domain: stringPtr("linux.dev")},
+			expectedCount: 1,
+		},
+		{
+			name:          "strict match on name and domain",
+			criteria:      model.OrganizationSearchCriteria{Name: stringPtr("linux"), Domain: stringPtr("other.example"), MatchAll: true},
+			expectedCount: 0,
+		},
+		{
+			name:          "no matches",
+			criteria:      model.OrganizationSearchCriteria{Name: stringPtr("nonexistent")},
+			expectedCount: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockSearcher := mock.NewMockOrganizationSearcher()
+			mockSearcher.ClearOrganizations()
+			for _, org := range []model.Organization{
+				{Name: "The Linux Foundation", Domain: "linuxfoundation.org"},
+				{Name: "Linux Foundation", Domain: "linux.dev"},
+				{Name: "Other Corp", Domain: "other.example"},
+				{Name: "Linux", Domain: "kernel.org"},
+			} {
+				mockSearcher.AddOrganization(org)
+			}
+			service := NewOrganizationSearch(mockSearcher)
+
+			count, err := service.CountOrganizations(context.Background(), tc.criteria)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedCount, count)
+		})
+	}
+}
+
+func TestOrganizationSearchResolveOrganizations(t *testing.T) {
+	tests := []struct {
+		name            string
+		domains         []string
+		expectedDomains map[string]string
+		expectedError   bool
+	}{
+		{
+			name:    "mix of known and unknown domains",
+			domains: []string{"linuxfoundation.org", "unknown.example", "kernel.org"},
+			expectedDomains: map[string]string{
+				"linuxfoundation.org": "linuxfoundation.org",
+				"unknown.example":     "",
+				"kernel.org":          "kernel.org",
+			},
+		},
+		{
+			name:    "domains are normalized before resolution",
+			domains: []string{"https://WWW.LinuxFoundation.org/about", "kernel.org:443", "   "},
+			expectedDomains: map[string]string{
+				"https://WWW.LinuxFoundation.org/about": "linuxfoundation.org",
+				"kernel.org:443":                        "kernel.org",
+				"   ":                                   "",
+			},
+		},
+		{
+			name:    "email addresses resolve by their domain",
+			domains: []string{"jdoe@LinuxFoundation.org", "someone@unknown.example"},
+			expectedDomains: map[string]string{
+				"jdoe@LinuxFoundation.org": "linuxfoundation.org",
+				"someone@unknown.example":  "",
+			},
+		},
+		{
+			name:            "all unknown domains",
+			domains:         []string{"unknown.example"},
+			expectedDomains: map[string]string{"unknown.example": ""},
+		},
+		{
+			name:          "no domains",
+			domains:       nil,
+			expectedError: true,
+		},
+		{
+			name:          "too many domains",
+			domains:       make([]string, constants.MaxResolveDomains+1),
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			mockSearcher := mock.NewMockOrganizationSearcher()
+			mockSearcher.ClearOrganizations()
+			mockSearcher.AddOrganization(model.Organization{Name: "The Linux Foundation", Domain: "linuxfoundation.org"})
+			mockSearcher.AddOrganization(model.Organization{Name: "Linux Kernel", Domain: "kernel.org"})
+			service := NewOrganizationSearch(mockSearcher)
+
+			result, err := service.ResolveOrganizations(context.Background(), tc.domains)
+
+			if tc.expectedError {
+				var validationErr errors.Validation
+				assertion.ErrorAs(err, &validationErr)
+				return
+			}
+
+			assertion.NoError(err)
+			assertion.Len(result, len(tc.expectedDomains))
+			for domain, expectedDomain := range tc.expectedDomains {
+				org, ok := result[domain]
+				assertion.True(ok, "missing entry for %q", domain)
+				if expectedDomain == "" {
+					assertion.Nil(org, "unexpected organization for %q", domain)
+					continue
+				}
+				if assertion.NotNil(org, "missing organization for %q", domain) {
+					assertion.Equal(expectedDomain, org.Domain)
+				}
+			}
+		})
+	}
+}
+
+func TestNormalizeDomain(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: "linuxfoundation.org", expected: "linuxfoundation.org"},
+		{input: "  LinuxFoundation.ORG  ", expected: "linuxfoundation.org"},
+		{input: "www.linuxfoundation.org", expected: "linuxfoundation.org"},
+		{input: "https://www.linuxfoundation.org/about?x=1", expected: "linuxfoundation.org"},
+		{input: "http://user@linuxfoundation.org:8080", expected: "linuxfoundation.org"},
+		{input: "linuxfoundation.org.", expected: "linuxfoundation.org"},
+		{input: "", expected: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			assert.Equal(t, tc.expected, normalizeDomain(tc.input))
+		})
+	}
+}
+
 func TestOrganizationSearchInterface(t *testing.T) {
 	assertion := assert.New(t)
 
@@ -784,3 +1196,58 @@ func TestOrganizationSearchInterface(t *testing.T) {
 		assertion.NoError(err)
 	})
 }
+
+func TestOrganizationSearchTimeout(t *testing.T) {
+	tests := []struct {
+		name          string
+		opts          []OrganizationSearchOption
+		delay         time.Duration
+		expectedError bool
+	}{
+		{
+			name:          "slow backend times out",
+			opts:          []OrganizationSearchOption{WithSearchTimeout(10 * time.Millisecond)},
+			delay:         time.Second,
+			expectedError: true,
+		},
+		{
+			name:  "backend within the timeout",
+			opts:  []OrganizationSearchOption{WithSearchTimeout(time.Second)},
+			delay: time.Millisecond,
+		},
+		{
+			name:  "no timeout by default",
+			delay: 20 * time.Millisecond,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			mockSearcher := mock.NewMockOrganizationSearcher()
+			mockSearcher.SetDelay(tc.delay)
+			service := NewOrganizationSearch(mockSearcher, tc.opts...)
+
+			ctx := context.Background()
+			start := time.Now()
+			suggestions, errSuggest := service.SuggestOrganizations(ctx, model.OrganizationSuggestionCriteria{Query: "linux"})
+			org, errQuery := service.QueryOrganizations(ctx, model.OrganizationSearchCriteria{Name: stringPtr("The Linux Foundation")})
+			elapsed := time.Since(start)
+
+			if tc.expectedError {
+				assertion.IsType(errors.ServiceUnavailable{}, errSuggest)
+				assertion.Nil(suggestions)
+				assertion.IsType(errors.ServiceUnavailable{}, errQuery)
+				assertion.Nil(org)
+				// The timeout fires well before the backend answers
+				assertion.Less(elapsed, tc.delay)
+				return
+			}
+			assertion.NoError(errSuggest)
+			assertion.NotNil(suggestions)
+			assertion.NoError(errQuery)
+			assertion.NotNil(org)
+		})
+	}
+}
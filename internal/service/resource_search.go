@@ -4,10 +4,14 @@
 package service
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/model"
 	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/port"
@@ -25,6 +29,28 @@ type ResourceSearcher interface {
 	// QueryResourcesCount searches for resources based on the provided criteria
 	QueryResourcesCount(ctx context.Context, countCriteria model.SearchCriteria, aggregationCriteria model.SearchCriteria) (*model.CountResult, error)
 
+	// QueryResourcesCountBatch runs several counts, reporting the error of each
+	// failed count in its own item instead of failing the whole batch
+	QueryResourcesCountBatch(ctx context.Context, batch []model.CountBatchCriteria) ([]model.CountItem, error)
+
+	// QueryFacets breaks the accessible resources matching the criteria down
+	// by the values of each facet field
+	QueryFacets(ctx context.Context, criteria model.SearchCriteria, facetFields []string) (*model.FacetResult, error)
+
+	// SuggestResources returns the best resources matching the query that the
+	// principal can access, with a bounded latency
+	SuggestResources(ctx context.Context, query string) (*model.SearchResult, error)
+
+	// ExplainResource explains why a search does or doesn't return a resource
+	ExplainResource(ctx context.Context, criteria model.SearchCriteria, objectRef string) (*model.ResourceExplanation, error)
+
+	// CheckTuples returns the access check answer of each given tuple
+	CheckTuples(ctx context.Context, tuples []string) (map[string]string, error)
+
+	// FlushCache clears the cached search results and returns how many were
+	// cached
+	FlushCache(ctx context.Context) (int, error)
+
 	// IsReady checks if the search service is ready
 	IsReady(ctx context.Context) error
 }
@@ -32,8 +58,315 @@ type ResourceSearcher interface {
 // ResourceSearch handles resource-related business operations
 // It depends on abstractions (interfaces) rather than concrete implementations
 type ResourceSearch struct {
-	resourceSearcher port.ResourceSearcher
-	accessChecker    port.AccessControlChecker
+	resourceSearcher    port.ResourceSearcher
+	accessChecker       port.AccessControlChecker
+	accessAllowedValues map[string]struct{}
+	resultCache         *resultCache
+	allowedTypes        []string
+	trackTotalHits      *model.TrackTotalHits
+	aggregationLimit    int
+	reportPublicPath    bool
+	allowUnfiltered     bool
+	allowEmptyCriteria  bool
+	explainPrincipals   map[string]struct{}
+	tupleCheckers       map[string]struct{}
+	cacheAdmins         map[string]struct{}
+	nameMinQueryLength  int
+	refreshWait         time.Duration
+	refreshPrincipals   map[string]struct{}
+	recencyBoostScale   string
+	exactNameBoost      bool
+	searchScope         string
+	missingAccessPolicy map[string]string
+	// permissionRelations are reported for each resource on request, only to
+	// permissionPrincipals when any are set
+	permissionRelations  []string
+	permissionPrincipals map[string]struct{}
+	// totalCountPrincipals are the only principals the total count is
+	// reported to, when any are set
+	totalCountPrincipals map[string]struct{}
+	strictAccessCheck    bool
+	readinessTimeout     time.Duration
+}
+
+// ResourceSearchOption configures optional ResourceSearch behavior
+type ResourceSearchOption func(*ResourceSearch)
+
+// WithAccessAllowedValues sets the access check response values that grant access,
+// so the service can be mapped to the authorizer's vocabulary (e.g. "allowed", "ALLOW").
+// Values are matched case-insensitively; an empty list keeps the default, which
+// only an exact "true" matches.
+func WithAccessAllowedValues(values ...string) ResourceSearchOption {
+	return func(s *ResourceSearch) {
+		allowed := make(map[string]struct{}, len(values))
+		for _, value := range values {
+			if normalized := normalizeAccessValue(value); normalized != "" {
+				allowed[normalized] = struct{}{}
+			}
+		}
+		if len(allowed) > 0 {
+			s.accessAllowedValues = allowed
+		}
+	}
+}
+
+// WithPrincipalCache enables a short-TTL cache of authenticated search results,
+// keyed on the principal and the search criteria. It holds at most maxEntries
+// results (constants.DefaultPrincipalCacheMaxEntries when not positive); a
+// non-positive TTL leaves the cache disabled.
+func WithPrincipalCache(ttl time.Duration, maxEntries int) ResourceSearchOption {
+	return func(s *ResourceSearch) {
+		if ttl <= 0 {
+			s.resultCache = nil
+			return
+		}
+		s.resultCache = newResultCache(ttl, maxEntries)
+	}
+}
+
+// WithAllowedResourceTypes restricts the resource types the service may return;
+// explicit requests for other types are rejected. An empty list allows all types.
+func WithAllowedResourceTypes(types ...string) ResourceSearchOption {
+	return func(s *ResourceSearch) {
+		s.allowedTypes = nil
+		for _, resourceType := range types {
+			if resourceType = strings.TrimSpace(resourceType); resourceType != "" {
+				s.allowedTypes = append(s.allowedTypes, resourceType)
+			}
+		}
+	}
+}
+
+// WithMissingAccessMetadataPolicy sets, per resource type, how resources
+// lacking the metadata needed for an access check are handled; types not
+// listed use model.MissingAccessMetadataSkip
+func WithMissingAccessMetadataPolicy(policies map[string]string) ResourceSearchOption {
+	return func(s *ResourceSearch) {
+		s.missingAccessPolicy = make(map[string]string, len(policies))
+		for resourceType, policy := range policies {
+			s.missingAccessPolicy[resourceType] = policy
+		}
+	}
+}
+
+// WithTrackTotalHits sets how accurately the total number of hits is counted
+// for searches that do not set it themselves
+func WithTrackTotalHits(trackTotalHits model.TrackTotalHits) ResourceSearchOption {
+	return func(s *ResourceSearch) {
+		s.trackTotalHits = &trackTotalHits
+	}
+}
+
+// WithAggregationBucketLimit caps the number of buckets of the aggregation
+// driving private count access checks, which bounds the size of the access
+// check message. Counts overflowing the limit are reported as having more.
+func WithAggregationBucketLimit(limit int) ResourceSearchOption {
+	return func(s *ResourceSearch) {
+		s.aggregationLimit = limit
+	}
+}
+
+// WithPublicPathReporting flags results served by the anonymous public-only
+// path, as opposed to the access-checked path, for cache and debugging purposes
+func WithPublicPathReporting(enabled bool) ResourceSearchOption {
+	return func(s *ResourceSearch) {
+		s.reportPublicPath = enabled
+	}
+}
+
+// WithUnfilteredSearch allows authenticated (non-anonymous) principals to
+// search without any filter, listing every resource they can access.
+// Anonymous principals must always provide at least one filter.
+func WithUnfilteredSearch(enabled bool) ResourceSearchOption {
+	return func(s *ResourceSearch) {
+		s.allowUnfiltered = enabled
+	}
+}
+
+// WithExplainPrincipals allows these principals to explain search results.
+// Explanations reveal whether resources exist regardless of access, so they
+// are reserved to debugging principals; with none, explaining is disabled.
+func WithExplainPrincipals(principals ...string) ResourceSearchOption {
+	return func(s *ResourceSearch) {
+		s.explainPrincipals = make(map[string]struct{}, len(principals))
+		for _, principal := range principals {
+			if principal = strings.TrimSpace(principal); principal != "" {
+				s.explainPrincipals[principal] = struct{}{}
+			}
+		}
+	}
+}
+
+// WithTupleCheckPrincipals allows these principals to check access tuples in
+// bulk for debugging; with none, tuple checks are disabled.
+func WithTupleCheckPrincipals(principals ...string) ResourceSearchOption {
+	return func(s *ResourceSearch) {
+		s.tupleCheckers = make(map[string]struct{}, len(principals))
+		for _, principal := range principals {
+			if principal = strings.TrimSpace(principal); principal != "" {
+				s.tupleCheckers[principal] = struct{}{}
+			}
+		}
+	}
+}
+
+// WithCacheAdminPrincipals allows these principals to flush the cached search
+// results, e.g. after a reindex; with none, flushing is disabled.
+func WithCacheAdminPrincipals(principals ...string) ResourceSearchOption {
+	return func(s *ResourceSearch) {
+		s.cacheAdmins = make(map[string]struct{}, len(principals))
+		for _, principal := range principals {
+			if principal = strings.TrimSpace(principal); principal != "" {
+				s.cacheAdmins[principal] = struct{}{}
+			}
+		}
+	}
+}
+
+// WithRefreshWait lets these principals (e.g. tools searching right after a
+// write) ask for a search delayed by the given wait, long enough for the index
+// to refresh so that their writes are searchable. With none, it is disabled.
+func WithRefreshWait(wait time.Duration, principals ...string) ResourceSearchOption {
+	return func(s *ResourceSearch) {
+		s.refreshWait = wait
+		s.refreshPrincipals = make(map[string]struct{}, len(principals))
+		for _, principal := range principals {
+			if principal = strings.TrimSpace(principal); principal != "" {
+				s.refreshPrincipals[principal] = struct{}{}
+			}
+		}
+	}
+}
+
+// WithPermissions sets the relations (e.g. "edit", "admin") reported for each
+// resource when permissions are requested, checked along with the view access.
+// With principals, only they may request permissions; otherwise any
+// authenticated principal may.
+func WithPermissions(relations []string, principals ...string) ResourceSearchOption {
+	return func(s *ResourceSearch) {
+		s.permissionRelations = nil
+		for _, relation := range relations {
+			if relation = strings.TrimSpace(relation); relation != "" {
+				s.permissionRelations = append(s.permissionRelations, relation)
+			}
+		}
+		s.permissionPrincipals = nil
+		for _, principal := range principals {
+			if principal = strings.TrimSpace(principal); principal != "" {
+				if s.permissionPrincipals == nil {
+					s.permissionPrincipals = make(map[string]struct{})
+				}
+				s.permissionPrincipals[principal] = struct{}{}
+			}
+		}
+	}
+}
+
+// WithTotalCountPrincipals restricts the total count, which reveals how many
+// private resources match, to the given principals; without principals, it is
+// reported to any principal
+func WithTotalCountPrincipals(principals ...string) ResourceSearchOption {
+	return func(s *ResourceSearch) {
+		s.totalCountPrincipals = nil
+		for _, principal := range principals {
+			if principal = strings.TrimSpace(principal); principal != "" {
+				if s.totalCountPrincipals == nil {
+					s.totalCountPrincipals = make(map[string]struct{})
+				}
+				s.totalCountPrincipals[principal] = struct{}{}
+			}
+		}
+	}
+}
+
+// reportsTotalCount reports whether the total count is reported to the
+// principal
+func (s *ResourceSearch) reportsTotalCount(principal string) bool {
+	if s.totalCountPrincipals == nil {
+		return true
+	}
+	_, allowed := s.totalCountPrincipals[principal]
+	return allowed
+}
+
+// WithReadinessTimeout bounds the readiness check of each dependency, so that a
+// hung dependency is reported as not ready instead of hanging the probe; a
+// non-positive timeout keeps the default
+func WithReadinessTimeout(timeout time.Duration) ResourceSearchOption {
+	return func(s *ResourceSearch) {
+		if timeout > 0 {
+			s.readinessTimeout = timeout
+		}
+	}
+}
+
+// WithStrictAccessCheck fails searches whose access check response lacks any
+// of the tuples asked about, instead of treating them as denied, to surface
+// inconsistent authorizer responses while debugging
+func WithStrictAccessCheck(enabled bool) ResourceSearchOption {
+	return func(s *ResourceSearch) {
+		s.strictAccessCheck = enabled
+	}
+}
+
+// WithRecencyBoost makes recently updated resources slightly more relevant,
+// decaying their boost over the given scale (e.g. "30d"); only scores, and so
+// the ranking of suggestions, are affected, not the requested sort order
+func WithRecencyBoost(scale string) ResourceSearchOption {
+	return func(s *ResourceSearch) {
+		s.recencyBoostScale = strings.TrimSpace(scale)
+	}
+}
+
+// WithExactNameBoost ranks resources whose whole name is the name searched
+// above those only partly matching it (e.g. "Kubernetes" above "Kubernetes
+// Dashboard"); like the recency boost, only scores are affected, not the
+// requested sort order
+func WithExactNameBoost(enabled bool) ResourceSearchOption {
+	return func(s *ResourceSearch) {
+		s.exactNameBoost = enabled
+	}
+}
+
+// WithSearchScope sets the scope of name searches that don't request one,
+// model.SearchScopeName or model.SearchScopeAll
+func WithSearchScope(scope string) ResourceSearchOption {
+	return func(s *ResourceSearch) {
+		s.searchScope = scope
+	}
+}
+
+// WithEmptyCriteria allows any principal, anonymous included, to search
+// without any filter, e.g. for admin listings: the search then matches every
+// resource, public ones only for anonymous principals.
+func WithEmptyCriteria(enabled bool) ResourceSearchOption {
+	return func(s *ResourceSearch) {
+		s.allowEmptyCriteria = enabled
+	}
+}
+
+// WithNameMinQueryLength sets the minimum length (in characters) of name
+// searches; shorter names are rejected as they match almost everything
+func WithNameMinQueryLength(length int) ResourceSearchOption {
+	return func(s *ResourceSearch) {
+		s.nameMinQueryLength = length
+	}
+}
+
+// normalizeAccessValue normalizes an access check response value for comparison
+// with the configured allowed values
+func normalizeAccessValue(value string) string {
+	return strings.ToLower(strings.TrimSpace(value))
+}
+
+// isAccessAllowed reports whether an access check response value grants access.
+// Without configured values, only the exact default value does.
+func (s *ResourceSearch) isAccessAllowed(value string) bool {
+	if len(s.accessAllowedValues) == 0 {
+		return value == constants.DefaultAccessCheckAllowedValue
+	}
+	_, ok := s.accessAllowedValues[normalizeAccessValue(value)]
+	return ok
 }
 
 // QueryResources performs resource search with business logic validation
@@ -43,11 +376,16 @@ func (s *ResourceSearch) QueryResources(ctx context.Context, criteria model.Sear
 		"name", criteria.Name,
 		"type", criteria.ResourceType,
 		"parent", criteria.Parent,
+		"parents", criteria.Parents,
 	)
 
+	// Grab the principal which was stored into the context by the security handler.
+	// It is read ahead of validation, since unfiltered search depends on it.
+	principal, ok := ctx.Value(constants.PrincipalContextID).(string)
+
 	// It seems that Goa v3 does not natively support complex conditional validations
 	// like “at least one of these fields must be set"
-	if err := s.validateSearchCriteria(criteria); err != nil {
+	if err := s.validateSearchCriteria(criteria, principal); err != nil {
 		slog.ErrorContext(ctx, "search criteria validation failed", "error", err)
 		return nil, errors.NewValidation(
 			"search criteria validation failed",
@@ -55,8 +393,11 @@ func (s *ResourceSearch) QueryResources(ctx context.Context, criteria model.Sear
 		)
 	}
 
-	// Grab the principal which was stored into the context by the security handler.
-	principal, ok := ctx.Value(constants.PrincipalContextID).(string)
+	if err := s.applyAllowedResourceTypes(&criteria); err != nil {
+		slog.ErrorContext(ctx, "resource type not allowed", "error", err)
+		return nil, err
+	}
+
 	if !ok {
 		// This should not happen; the Auther always sets this or errors.
 		return nil, errors.NewValidation("missing principal in context")
@@ -68,15 +409,61 @@ func (s *ResourceSearch) QueryResources(ctx context.Context, criteria model.Sear
 		criteria.PublicOnly = true
 	}
 
+	if criteria.TrackTotalHits == nil {
+		criteria.TrackTotalHits = s.trackTotalHits
+	}
+	if criteria.RecencyBoostScale == "" {
+		criteria.RecencyBoostScale = s.recencyBoostScale
+	}
+	if criteria.RecencyBoost && criteria.RecencyBoostScale == "" {
+		criteria.RecencyBoostScale = constants.DefaultRecencyBoostScale
+	}
+	criteria.ExactNameBoost = criteria.ExactNameBoost || s.exactNameBoost
+	if criteria.SearchScope == "" {
+		criteria.SearchScope = s.searchScope
+	}
+
+	if criteria.WaitForRefresh {
+		if err := s.waitForRefresh(ctx, principal); err != nil {
+			return nil, err
+		}
+	}
+	if criteria.IncludePermissions && principal != constants.AnonymousPrincipal && s.permissionPrincipals != nil {
+		if _, allowed := s.permissionPrincipals[principal]; !allowed {
+			slog.WarnContext(ctx, "principal not allowed to include permissions", "principal", principal)
+			return nil, errors.NewValidation("include_permissions is not allowed")
+		}
+	}
+
 	// Log the search operation
 	slog.DebugContext(ctx, "validated search criteria, proceeding with search")
 
+	// Authenticated results are only ever cached for the same principal
+	var (
+		cacheKey    resultCacheKey
+		cacheActive bool
+	)
+	if s.resultCache != nil && principal != constants.AnonymousPrincipal && !criteria.WaitForRefresh {
+		cacheKey, cacheActive = s.resultCache.key(principal, criteria)
+		if cacheActive {
+			if cached, hit := s.resultCache.get(cacheKey); hit {
+				slog.DebugContext(ctx, "returning cached search result",
+					"resource_count", len(cached.Resources),
+				)
+				return cached, nil
+			}
+		}
+	}
+
 	// Delegate to the search implementation
 	result, err := s.resourceSearcher.QueryResources(ctx, criteria)
 	if err != nil {
 		slog.ErrorContext(ctx, "search operation failed while executing query resources",
 			"error", err,
 		)
+		if reportedAsIs(err) {
+			return nil, err
+		}
 		return nil, fmt.Errorf("search operation failed: %w", err)
 	}
 
@@ -85,13 +472,19 @@ func (s *ResourceSearch) QueryResources(ctx context.Context, criteria model.Sear
 	)
 
 	messageCheckAccess := s.BuildMessage(ctx, principal, result)
+	// Anonymous principals have no relation beyond viewing public resources
+	includePermissions := criteria.IncludePermissions && principal != constants.AnonymousPrincipal
+	if includePermissions {
+		messageCheckAccess = s.appendPermissionTuples(messageCheckAccess, principal, result.Resources)
+	}
 
 	searchResult := &model.SearchResult{
 		PageToken: result.PageToken,
+		Degraded:  result.Degraded,
 	}
 
 	// Check access control for the resources if needed
-	checkedResources, errCheckAccess := s.CheckAccess(ctx, principal, result.Resources, messageCheckAccess)
+	checkedResources, accessCheckResponses, errCheckAccess := s.checkAccess(ctx, principal, result.Resources, messageCheckAccess, constants.AccessCheckTimeout)
 	if errCheckAccess != nil {
 		slog.ErrorContext(ctx, "access control check failed",
 			"error", errCheckAccess,
@@ -100,6 +493,46 @@ func (s *ResourceSearch) QueryResources(ctx context.Context, criteria model.Sear
 		return nil, fmt.Errorf("access control check failed: %w", errCheckAccess)
 	}
 	searchResult.Resources = checkedResources
+	if includePermissions {
+		s.attachPermissions(principal, searchResult.Resources, accessCheckResponses)
+	}
+
+	// Scores are only returned on request, to keep responses stable and cacheable.
+	if !criteria.IncludeScore {
+		for idx := range searchResult.Resources {
+			searchResult.Resources[idx].Score = nil
+			searchResult.Resources[idx].NormalizedScore = nil
+		}
+	}
+
+	// Ancestry is only returned on request; it is the resource's own
+	// metadata, so the access check of the resource covers it
+	if criteria.IncludeAncestry {
+		for idx := range searchResult.Resources {
+			searchResult.Resources[idx].Ancestry = searchResult.Resources[idx].ParentRefs
+		}
+	}
+
+	if criteria.IncludeChildCounts && len(searchResult.Resources) > 0 {
+		if err := s.attachChildCounts(ctx, principal, searchResult.Resources); err != nil {
+			slog.ErrorContext(ctx, "child count failed",
+				"error", err,
+			)
+			return nil, fmt.Errorf("child count failed: %w", err)
+		}
+	}
+
+	// Matched tags are only surfaced to authenticated users.
+	if principal == constants.AnonymousPrincipal {
+		for idx := range searchResult.Resources {
+			searchResult.Resources[idx].MatchedTags = nil
+		}
+	}
+
+	// Suggest alternative names when a name search finds nothing
+	if criteria.SuggestOnEmpty && criteria.Name != nil && criteria.SearchAfter == nil && len(searchResult.Resources) == 0 {
+		searchResult.Suggestions = s.nameSuggestions(ctx, principal, criteria)
+	}
 
 	slog.DebugContext(ctx, "resource search completed",
 		"query_count", len(result.Resources),
@@ -107,24 +540,336 @@ func (s *ResourceSearch) QueryResources(ctx context.Context, criteria model.Sear
 	)
 
 	if principal == constants.AnonymousPrincipal {
-		// Set a cache control header for anonymous users.
-		cacheControl := constants.AnonymousCacheControlHeader
-		searchResult.CacheControl = &cacheControl
+		// Set a cache control header for anonymous users, unless a fallback
+		// search source served the result.
+		if !searchResult.Degraded {
+			cacheControl := constants.AnonymousCacheControlHeader
+			searchResult.CacheControl = &cacheControl
+		}
+		searchResult.PublicPath = s.reportPublicPath
+		// The total counts hits before access control, so it is only
+		// reported when every hit is public
+		searchResult.Total = result.Total
+		searchResult.TotalRelation = result.TotalRelation
+	}
+
+	// Degraded results are not kept, so the primary serves the next search
+	if cacheActive && !searchResult.Degraded {
+		s.resultCache.set(cacheKey, searchResult)
 	}
 
 	return searchResult, nil
 }
 
-// validateSearchCriteria validates the search criteria according to business rules
-func (s *ResourceSearch) validateSearchCriteria(criteria model.SearchCriteria) error {
+// SuggestResources returns the resources best matching the query that the
+// principal can access. Suggestions are latency-sensitive, so only the first
+// candidates are access checked, with a short timeout.
+func (s *ResourceSearch) SuggestResources(ctx context.Context, query string) (*model.SearchResult, error) {
+
+	slog.DebugContext(ctx, "starting resource suggestions", "query", query)
+
+	principal, ok := ctx.Value(constants.PrincipalContextID).(string)
+	if !ok {
+		// This should not happen; the Auther always sets this or errors.
+		return nil, errors.NewValidation("missing principal in context")
+	}
+
+	criteria := model.SearchCriteria{
+		Name:              &query,
+		PageSize:          constants.SuggestCandidateLimit,
+		PublicOnly:        principal == constants.AnonymousPrincipal,
+		RecencyBoostScale: s.recencyBoostScale,
+		ExactNameBoost:    s.exactNameBoost,
+		SearchScope:       s.searchScope,
+	}
+	if err := s.validateSearchCriteria(criteria, principal); err != nil {
+		return nil, errors.NewValidation("search criteria validation failed", err)
+	}
+	if err := s.applyAllowedResourceTypes(&criteria); err != nil {
+		return nil, err
+	}
+
+	result, err := s.resourceSearcher.QueryResources(ctx, criteria)
+	if err != nil {
+		slog.ErrorContext(ctx, "search operation failed while executing resource suggestions",
+			"error", err,
+		)
+		if reportedAsIs(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("search operation failed: %w", err)
+	}
+
+	// Backends may return more than a page, the access check is kept small
+	if len(result.Resources) > constants.SuggestCandidateLimit {
+		result.Resources = result.Resources[:constants.SuggestCandidateLimit]
+	}
+	message := s.BuildMessage(ctx, principal, result)
+	resources, _, err := s.checkAccess(ctx, principal, result.Resources, message, constants.SuggestAccessCheckTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// Suggestions are ranked by position, and return no scores, as searches
+	for idx := range resources {
+		resources[idx].Score = nil
+		resources[idx].NormalizedScore = nil
+	}
+
+	slog.DebugContext(ctx, "resource suggestions completed",
+		"candidate_count", len(result.Resources),
+		"suggestion_count", len(resources),
+	)
+
+	return &model.SearchResult{
+		Resources: resources,
+		Degraded:  result.Degraded,
+	}, nil
+}
+
+// nameSuggestions returns the names of the accessible resources matching the
+// criteria with a typo-tolerant name, for a name search that found nothing.
+// Suggestions are a best effort: a failure only leaves them out.
+func (s *ResourceSearch) nameSuggestions(ctx context.Context, principal string, criteria model.SearchCriteria) []string {
+	criteria.NameFuzzy = true
+	criteria.PageSize = constants.NameSuggestionLimit
+	criteria.PageToken = nil
+	criteria.SearchAfter = nil
+	criteria.TrackTotalHits = nil
+	criteria.IncludeChildCounts = false
+
+	result, err := s.resourceSearcher.QueryResources(ctx, criteria)
+	if err != nil {
+		slog.WarnContext(ctx, "name suggestion search failed", "error", err)
+		return nil
+	}
+	message := s.BuildMessage(ctx, principal, result)
+	resources, err := s.CheckAccess(ctx, principal, result.Resources, message)
+	if err != nil {
+		slog.WarnContext(ctx, "name suggestion access check failed", "error", err)
+		return nil
+	}
+
+	var suggestions []string
+	for _, resource := range resources {
+		data, _ := resource.Data.(map[string]any)
+		name, _ := data["name"].(string)
+		if name == "" || strings.EqualFold(name, *criteria.Name) || slices.Contains(suggestions, name) {
+			continue
+		}
+		suggestions = append(suggestions, name)
+	}
+	return suggestions
+}
+
+// attachChildCounts sets the number of children by type on each resource. The
+// children of all the resources are counted with a single search, and the
+// private ones with a single access check, to avoid a query per resource.
+func (s *ResourceSearch) attachChildCounts(ctx context.Context, principal string, resources []model.Resource) error {
+
+	parentRefs := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		if resource.ObjectRef != "" && !slices.Contains(parentRefs, resource.ObjectRef) {
+			parentRefs = append(parentRefs, resource.ObjectRef)
+		}
+	}
+
+	publicOnly := principal == constants.AnonymousPrincipal
+	buckets, err := s.resourceSearcher.QueryChildCounts(ctx, parentRefs, publicOnly)
+	if err != nil {
+		return err
+	}
+
+	// Children of types outside the allowlist are not counted either
+	if len(s.allowedTypes) > 0 {
+		buckets = slices.DeleteFunc(buckets, func(bucket model.ChildCountBucket) bool {
+			return !slices.Contains(s.allowedTypes, bucket.Type)
+		})
+	}
+
+	var allowedQueries map[string]bool
+	if !publicOnly {
+		queries := make([]string, 0, len(buckets))
+		for _, bucket := range buckets {
+			queries = append(queries, bucket.AccessCheckQuery)
+		}
+		allowedQueries, err = s.allowedAccessCheckQueries(ctx, principal, queries)
+		if err != nil {
+			return err
+		}
+	}
+
+	childCounts := make(map[string]map[string]uint64, len(parentRefs))
+	for _, bucket := range buckets {
+		if bucket.AccessCheckQuery != "" && !allowedQueries[bucket.AccessCheckQuery] {
+			continue
+		}
+		if childCounts[bucket.ParentRef] == nil {
+			childCounts[bucket.ParentRef] = make(map[string]uint64)
+		}
+		childCounts[bucket.ParentRef][bucket.Type] += bucket.DocCount
+	}
+
+	for idx := range resources {
+		counts := childCounts[resources[idx].ObjectRef]
+		if counts == nil {
+			counts = make(map[string]uint64)
+		}
+		resources[idx].ChildCounts = counts
+	}
+
+	return nil
+}
+
+// verifyAccessCheckResponses fails, in strict mode, when the access check
+// responses lack any tuple of the message; otherwise missing tuples are denied
+func (s *ResourceSearch) verifyAccessCheckResponses(ctx context.Context, accessCheckMessage []byte, accessCheckResponses map[string]string) error {
+	if !s.strictAccessCheck {
+		return nil
+	}
+	var missing []string
+	for _, tuple := range strings.Split(string(accessCheckMessage), "\n") {
+		if _, ok := accessCheckResponses[tuple]; tuple != "" && !ok {
+			missing = append(missing, tuple)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	slog.ErrorContext(ctx, "access check response is missing tuples",
+		"missing", len(missing),
+		"first_missing", missing[0],
+	)
+	return fmt.Errorf("access check response is missing %d tuples, e.g. %q", len(missing), missing[0])
+}
+
+// allowedAccessCheckQueries checks the access of the principal to the
+// distinct non-empty access check queries with a single access control
+// request, and returns those granting access
+func (s *ResourceSearch) allowedAccessCheckQueries(ctx context.Context, principal string, queries []string) (map[string]bool, error) {
+	// One access check line per distinct access check query
+	var accessCheckMessage []byte
+	seenQueries := make(map[string]struct{})
+	for _, query := range queries {
+		if query == "" {
+			continue
+		}
+		if _, seen := seenQueries[query]; seen {
+			continue
+		}
+		seenQueries[query] = struct{}{}
+		accessCheckMessage = append(accessCheckMessage, query...)
+		accessCheckMessage = append(accessCheckMessage, []byte("@"+constants.PrincipalUserPrefix)...)
+		accessCheckMessage = append(accessCheckMessage, []byte(principal)...)
+		accessCheckMessage = append(accessCheckMessage, '\n')
+	}
+	if len(accessCheckMessage) == 0 {
+		return nil, nil
+	}
+
+	// Trim trailing newline.
+	accessCheckMessage = accessCheckMessage[:len(accessCheckMessage)-1]
+	accessCheckResponses, err := s.accessChecker.CheckAccess(ctx, constants.AccessCheckSubject, accessCheckMessage, 15*time.Second)
+	if err != nil {
+		return nil, fmt.Errorf("access control check failed: %w", err)
+	}
+	if err := s.verifyAccessCheckResponses(ctx, accessCheckMessage, accessCheckResponses); err != nil {
+		return nil, err
+	}
+
+	allowedQueries := make(map[string]bool, len(seenQueries))
+	for query := range seenQueries {
+		allowed, ok := accessCheckResponses[query+"@"+constants.PrincipalUserPrefix+principal]
+		allowedQueries[query] = ok && s.isAccessAllowed(allowed)
+	}
+	return allowedQueries, nil
+}
+
+// waitForRefresh waits for the index to refresh, for the principals allowed to
+// trade latency for read-after-write consistency
+func (s *ResourceSearch) waitForRefresh(ctx context.Context, principal string) error {
+	if _, allowed := s.refreshPrincipals[principal]; !allowed {
+		slog.WarnContext(ctx, "principal not allowed to wait for refresh", "principal", principal)
+		return errors.NewValidation("wait_for_refresh is not allowed")
+	}
+
+	slog.DebugContext(ctx, "waiting for index refresh", "wait", s.refreshWait)
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(s.refreshWait):
+		return nil
+	}
+}
+
+// validateSearchCriteria validates the search criteria according to business rules;
+// an empty criteria is only accepted for an authenticated principal when
+// unfiltered search is enabled
+func (s *ResourceSearch) validateSearchCriteria(criteria model.SearchCriteria, principal string) error {
+	if err := validatePageSize(criteria); err != nil {
+		return err
+	}
+	if criteria.TagsMinMatch != nil {
+		if *criteria.TagsMinMatch < 1 || *criteria.TagsMinMatch > len(criteria.Tags) {
+			return fmt.Errorf("tags_min_match must be between 1 and the number of tags (%d)", len(criteria.Tags))
+		}
+	}
+	if criteria.CollapseBy != nil && !slices.Contains(model.CollapseFields, *criteria.CollapseBy) {
+		return fmt.Errorf("unsupported collapse field %q, use one of: %s", *criteria.CollapseBy, strings.Join(model.CollapseFields, ", "))
+	}
+	// Collapsed results have no stable order to resume from
+	if criteria.CollapseBy != nil && criteria.SearchAfter != nil {
+		return fmt.Errorf("page_token cannot be used with collapse_by")
+	}
+	if criteria.Name != nil {
+		nameLength := utf8.RuneCountInString(strings.TrimSpace(*criteria.Name))
+		if nameLength > 0 && nameLength < s.nameMinQueryLength {
+			return fmt.Errorf("name must be at least %d characters long", s.nameMinQueryLength)
+		}
+	}
+
+	if s.allowUnfiltered && principal != "" && principal != constants.AnonymousPrincipal {
+		return nil
+	}
+	if s.allowEmptyCriteria {
+		return nil
+	}
+
 	// At least one search parameter must be provided
-	if criteria.Name == nil && criteria.Parent == nil && criteria.ResourceType == nil && len(criteria.Tags) == 0 {
-		return fmt.Errorf("at least one search parameter must be provided: name, parent, type, or tags")
+	if criteria.Name == nil && criteria.NameExact == nil && criteria.Parent == nil && len(criteria.Parents) == 0 && criteria.ResourceType == nil && criteria.CreatedBy == nil && criteria.UpdatedBy == nil && criteria.TransactionID == nil && len(criteria.Tags) == 0 && criteria.Template == "" {
+		return fmt.Errorf("at least one search parameter must be provided: name, name_exact, parent, type, created_by, updated_by, transaction_id, tags, or template")
 	}
 
 	return nil
 }
 
+// validatePageSize rejects negative page sizes, except the count sentinel
+func validatePageSize(criteria model.SearchCriteria) error {
+	if criteria.PageSize < 0 && !criteria.CountOnly() {
+		return fmt.Errorf("page size must not be negative, got %d", criteria.PageSize)
+	}
+	return nil
+}
+
+// applyAllowedResourceTypes enforces the resource type allowlist, if any: an
+// explicitly requested type must be allowed, otherwise the search is
+// constrained to the allowed types
+func (s *ResourceSearch) applyAllowedResourceTypes(criteria *model.SearchCriteria) error {
+	if len(s.allowedTypes) == 0 {
+		return nil
+	}
+
+	if criteria.ResourceType != nil {
+		if !slices.Contains(s.allowedTypes, *criteria.ResourceType) {
+			return errors.NewValidation(fmt.Sprintf("resource type %q is not allowed", *criteria.ResourceType))
+		}
+		return nil
+	}
+
+	criteria.ResourceTypes = s.allowedTypes
+	return nil
+}
+
 func (s *ResourceSearch) BuildMessage(ctx context.Context, principal string, result *model.SearchResult) []byte {
 
 	// avoid duplicate resource references in the result
@@ -140,18 +885,35 @@ func (s *ResourceSearch) BuildMessage(ctx context.Context, principal string, res
 		}
 		seenRefs[result.Resources[idx].ObjectRef] = struct{}{}
 
+		missingMetadata := result.Resources[idx].AccessCheckObject == "" || result.Resources[idx].AccessCheckRelation == ""
+		policy := s.missingAccessPolicy[result.Resources[idx].Type]
+
+		if missingMetadata && policy == model.MissingAccessMetadataDeny {
+			// Denied by default, even when public; left without a tuple so
+			// the access check excludes it.
+			slog.WarnContext(ctx, "resource missing access control information, denied",
+				"object_ref", result.Resources[idx].ObjectRef,
+				"object_type", result.Resources[idx].ObjectType,
+				"object_id", result.Resources[idx].ObjectID,
+			)
+			result.Resources[idx].NeedCheck = true
+			continue
+		}
+
 		if result.Resources[idx].Public {
 			result.Resources[idx].NeedCheck = false
 			continue
 		}
 
-		if result.Resources[idx].AccessCheckObject == "" || result.Resources[idx].AccessCheckRelation == "" {
+		if missingMetadata {
 			// Unable to perform access check without these fields.
-			slog.WarnContext(ctx, "resource missing access control information, skipping",
-				"object_ref", result.Resources[idx].ObjectRef,
-				"object_type", result.Resources[idx].ObjectType,
-				"object_id", result.Resources[idx].ObjectID,
-			)
+			if policy != model.MissingAccessMetadataIncludeIfPublic {
+				slog.WarnContext(ctx, "resource missing access control information, skipping",
+					"object_ref", result.Resources[idx].ObjectRef,
+					"object_type", result.Resources[idx].ObjectType,
+					"object_id", result.Resources[idx].ObjectID,
+				)
+			}
 			result.Resources[idx].NeedCheck = true
 			continue
 		}
@@ -160,7 +922,7 @@ func (s *ResourceSearch) BuildMessage(ctx context.Context, principal string, res
 		accessCheckMessage = append(accessCheckMessage, result.Resources[idx].AccessCheckObject...)
 		accessCheckMessage = append(accessCheckMessage, byte('#'))
 		accessCheckMessage = append(accessCheckMessage, result.Resources[idx].AccessCheckRelation...)
-		accessCheckMessage = append(accessCheckMessage, []byte("@user:")...)
+		accessCheckMessage = append(accessCheckMessage, []byte("@"+constants.PrincipalUserPrefix)...)
 		accessCheckMessage = append(accessCheckMessage, []byte(principal)...)
 		accessCheckMessage = append(accessCheckMessage, '\n')
 
@@ -168,7 +930,56 @@ func (s *ResourceSearch) BuildMessage(ctx context.Context, principal string, res
 	return accessCheckMessage
 }
 
+// appendPermissionTuples adds to the access check message the tuples of the
+// permission relations of each resource, so they are checked in the same
+// request as the view access
+func (s *ResourceSearch) appendPermissionTuples(accessCheckMessage []byte, principal string, resources []model.Resource) []byte {
+	seenTuples := make(map[string]struct{})
+	for _, resource := range resources {
+		if resource.AccessCheckObject == "" {
+			continue
+		}
+		for _, relation := range s.permissionRelations {
+			tuple := resource.AccessCheckObject + "#" + relation + "@" + constants.PrincipalUserPrefix + principal
+			if _, seen := seenTuples[tuple]; seen {
+				continue
+			}
+			seenTuples[tuple] = struct{}{}
+			accessCheckMessage = append(accessCheckMessage, tuple...)
+			accessCheckMessage = append(accessCheckMessage, '\n')
+		}
+	}
+	return accessCheckMessage
+}
+
+// attachPermissions sets the permissions of each resource from the access
+// check responses; resources without access check object have none granted
+func (s *ResourceSearch) attachPermissions(principal string, resources []model.Resource, accessCheckResponses map[string]string) {
+	for idx := range resources {
+		permissions := make(map[string]bool, len(s.permissionRelations))
+		for _, relation := range s.permissionRelations {
+			granted := false
+			if resources[idx].AccessCheckObject != "" {
+				tuple := resources[idx].AccessCheckObject + "#" + relation + "@" + constants.PrincipalUserPrefix + principal
+				if allowed, ok := accessCheckResponses[tuple]; ok && s.isAccessAllowed(allowed) {
+					granted = true
+				}
+			}
+			permissions[relation] = granted
+		}
+		resources[idx].Permissions = permissions
+	}
+}
+
 func (s *ResourceSearch) CheckAccess(ctx context.Context, principal string, resourceList []model.Resource, accessCheckMessage []byte) ([]model.Resource, error) {
+	resources, _, err := s.checkAccess(ctx, principal, resourceList, accessCheckMessage, constants.AccessCheckTimeout)
+	return resources, err
+}
+
+// checkAccess sends the access check message, waiting for the response up to
+// the timeout, and returns the resources the principal can view, along with
+// the response to each tuple of the message
+func (s *ResourceSearch) checkAccess(ctx context.Context, principal string, resourceList []model.Resource, accessCheckMessage []byte, timeout time.Duration) ([]model.Resource, map[string]string, error) {
 
 	var accessCheckResponses map[string]string
 	if len(accessCheckMessage) > 0 {
@@ -179,13 +990,16 @@ func (s *ResourceSearch) CheckAccess(ctx context.Context, principal string, reso
 
 		// Trim trailing newline.
 		accessCheckMessage = accessCheckMessage[:len(accessCheckMessage)-1]
-		accessCheckResult, errCheckAccess := s.accessChecker.CheckAccess(ctx, constants.AccessCheckSubject, accessCheckMessage, 15*time.Second)
+		accessCheckResult, errCheckAccess := s.accessChecker.CheckAccess(ctx, constants.AccessCheckSubject, accessCheckMessage, timeout)
 		if errCheckAccess != nil {
 			slog.ErrorContext(ctx, "access control check failed",
 				"error", errCheckAccess,
 				"message", string(accessCheckMessage),
 			)
-			return nil, fmt.Errorf("access control check failed: %w", errCheckAccess)
+			return nil, nil, fmt.Errorf("access control check failed: %w", errCheckAccess)
+		}
+		if err := s.verifyAccessCheckResponses(ctx, accessCheckMessage, accessCheckResult); err != nil {
+			return nil, nil, err
 		}
 		accessCheckResponses = accessCheckResult
 	}
@@ -195,8 +1009,8 @@ func (s *ResourceSearch) CheckAccess(ctx context.Context, principal string, reso
 	for _, resource := range resourceList {
 		addToList := false
 		if resource.NeedCheck && resource.AccessCheckObject != "" && resource.AccessCheckRelation != "" {
-			relationKey := resource.AccessCheckObject + "#" + resource.AccessCheckRelation + "@user:" + principal
-			if allowed, ok := accessCheckResponses[relationKey]; ok && allowed == "true" {
+			relationKey := resource.AccessCheckObject + "#" + resource.AccessCheckRelation + "@" + constants.PrincipalUserPrefix + principal
+			if allowed, ok := accessCheckResponses[relationKey]; ok && s.isAccessAllowed(allowed) {
 				addToList = true
 			}
 		}
@@ -205,7 +1019,7 @@ func (s *ResourceSearch) CheckAccess(ctx context.Context, principal string, reso
 		}
 	}
 
-	return resources, nil
+	return resources, accessCheckResponses, nil
 
 }
 
@@ -220,6 +1034,30 @@ func (s *ResourceSearch) QueryResourcesCount(
 		"aggregation_criteria", aggregationCriteria,
 	)
 
+	for _, criteria := range []model.SearchCriteria{publicCountCriteria, aggregationCriteria} {
+		if err := validatePageSize(criteria); err != nil {
+			return nil, errors.NewValidation("search criteria validation failed", err)
+		}
+	}
+	if err := s.applyAllowedResourceTypes(&publicCountCriteria); err != nil {
+		slog.ErrorContext(ctx, "resource type not allowed", "error", err)
+		return nil, err
+	}
+	if err := s.applyAllowedResourceTypes(&aggregationCriteria); err != nil {
+		slog.ErrorContext(ctx, "resource type not allowed", "error", err)
+		return nil, err
+	}
+	if s.aggregationLimit > 0 && aggregationCriteria.GroupBy != "" {
+		aggregationCriteria.GroupBySize = s.aggregationLimit
+	}
+	// Counts match names like the searches they count
+	if publicCountCriteria.SearchScope == "" {
+		publicCountCriteria.SearchScope = s.searchScope
+	}
+	if aggregationCriteria.SearchScope == "" {
+		aggregationCriteria.SearchScope = s.searchScope
+	}
+
 	// Grab the principal which was stored into the context by the security handler.
 	principal, ok := ctx.Value(constants.PrincipalContextID).(string)
 	if !ok {
@@ -237,6 +1075,9 @@ func (s *ResourceSearch) QueryResourcesCount(
 		slog.ErrorContext(ctx, "search operation failed while executing query resources",
 			"error", err,
 		)
+		if reportedAsIs(err) {
+			return nil, err
+		}
 		return nil, fmt.Errorf("search operation failed: %w", err)
 	}
 
@@ -246,12 +1087,29 @@ func (s *ResourceSearch) QueryResourcesCount(
 		slog.DebugContext(ctx, "returning anonymous count result",
 			"count", result.Count,
 		)
-		// Set a cache control header for anonymous users.
-		cacheControl := constants.AnonymousCacheControlHeader
-		result.CacheControl = &cacheControl
+		// Set a cache control header for anonymous users, unless a fallback
+		// search source served the result.
+		if !result.Degraded {
+			cacheControl := constants.AnonymousCacheControlHeader
+			result.CacheControl = &cacheControl
+		}
+		result.PublicPath = s.reportPublicPath
+		// Anonymous principals only count public resources, all visible
+		result.VisibleCount = result.Count
+		if s.reportsTotalCount(principal) {
+			total := result.Count
+			result.TotalCount = &total
+		}
 		return result, nil
 	}
 
+	// The total counts every private resource matching, whether or not the
+	// principal may access it, along with the public ones
+	total := result.Count + int(result.Aggregation.SumOtherDocCount)
+	for _, bucket := range result.Aggregation.Buckets {
+		total += int(bucket.DocCount)
+	}
+
 	slog.DebugContext(ctx, "checking access control for private resources",
 		"aggregations", result.Aggregation,
 	)
@@ -268,6 +1126,10 @@ func (s *ResourceSearch) QueryResourcesCount(
 	}
 	// The count already contains the count of public resources, so we need to add the count of private resources.
 	result.Count += int(privateCount)
+	result.VisibleCount = result.Count
+	if s.reportsTotalCount(principal) {
+		result.TotalCount = &total
+	}
 
 	// Check for bucket overflow.
 	// There could be more buckets than the page size, and therefore more results.
@@ -278,6 +1140,182 @@ func (s *ResourceSearch) QueryResourcesCount(
 	return result, nil
 }
 
+// QueryResourcesCountBatch runs each count of the batch independently. Only
+// problems affecting the whole batch are returned as an error; a failing
+// count is reported in its item so the other counts remain usable.
+func (s *ResourceSearch) QueryResourcesCountBatch(ctx context.Context, batch []model.CountBatchCriteria) ([]model.CountItem, error) {
+
+	if len(batch) == 0 {
+		return nil, errors.NewValidation("at least one count is required")
+	}
+	if len(batch) > constants.MaxCountBatchSize {
+		return nil, errors.NewValidation(fmt.Sprintf("at most %d counts are allowed per batch", constants.MaxCountBatchSize))
+	}
+	if _, ok := ctx.Value(constants.PrincipalContextID).(string); !ok {
+		// This should not happen; the Auther always sets this or errors.
+		return nil, errors.NewValidation("missing principal in context")
+	}
+
+	items := make([]model.CountItem, len(batch))
+	for idx, criteria := range batch {
+		result, err := s.QueryResourcesCount(ctx, criteria.Count, criteria.Aggregation)
+		if err != nil {
+			slog.WarnContext(ctx, "count of batch failed",
+				"index", idx,
+				"error", err,
+			)
+			items[idx].Error = err
+			continue
+		}
+		items[idx].Result = result
+	}
+
+	return items, nil
+}
+
+// QueryFacets counts the resources matching the criteria by value of each
+// facet field. The facets are computed with a single search, and the private
+// resources are only counted once the principal's access to their access
+// check queries is checked with a single access control request.
+func (s *ResourceSearch) QueryFacets(ctx context.Context, criteria model.SearchCriteria, facetFields []string) (*model.FacetResult, error) {
+
+	slog.DebugContext(ctx, "starting resource facet search",
+		"name", criteria.Name,
+		"type", criteria.ResourceType,
+		"parent", criteria.Parent,
+		"facet_fields", facetFields,
+	)
+
+	if len(facetFields) == 0 {
+		return nil, errors.NewValidation("at least one facet field is required")
+	}
+	var fields []string
+	for _, field := range facetFields {
+		if !slices.Contains(model.FacetFields, field) {
+			return nil, errors.NewValidation(fmt.Sprintf("unsupported facet field %q, use one of: %s", field, strings.Join(model.FacetFields, ", ")))
+		}
+		if !slices.Contains(fields, field) {
+			fields = append(fields, field)
+		}
+	}
+
+	if err := s.applyAllowedResourceTypes(&criteria); err != nil {
+		slog.ErrorContext(ctx, "resource type not allowed", "error", err)
+		return nil, err
+	}
+
+	// Grab the principal which was stored into the context by the security handler.
+	principal, ok := ctx.Value(constants.PrincipalContextID).(string)
+	if !ok {
+		// This should not happen; the Auther always sets this or errors.
+		return nil, errors.NewValidation("missing principal in context")
+	}
+	publicOnly := principal == constants.AnonymousPrincipal
+	criteria.PublicOnly = criteria.PublicOnly || publicOnly
+
+	buckets, err := s.resourceSearcher.QueryFacets(ctx, criteria, fields)
+	if err != nil {
+		slog.ErrorContext(ctx, "search operation failed while executing facet query",
+			"error", err,
+		)
+		if reportedAsIs(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("search operation failed: %w", err)
+	}
+
+	var allowedQueries map[string]bool
+	if !publicOnly {
+		queries := make([]string, 0, len(buckets))
+		for _, bucket := range buckets {
+			queries = append(queries, bucket.AccessCheckQuery)
+		}
+		allowedQueries, err = s.allowedAccessCheckQueries(ctx, principal, queries)
+		if err != nil {
+			slog.ErrorContext(ctx, "access control check failed",
+				"error", err,
+			)
+			return nil, err
+		}
+	}
+
+	counts := make(map[string]map[string]uint64, len(fields))
+	for _, bucket := range buckets {
+		if bucket.AccessCheckQuery != "" && !allowedQueries[bucket.AccessCheckQuery] {
+			continue
+		}
+		if counts[bucket.Field] == nil {
+			counts[bucket.Field] = make(map[string]uint64)
+		}
+		counts[bucket.Field][bucket.Value] += bucket.DocCount
+	}
+
+	// Values are ordered by descending count, then by value
+	result := &model.FacetResult{
+		Facets: make(map[string][]model.FacetValue, len(fields)),
+	}
+	for _, field := range fields {
+		values := make([]model.FacetValue, 0, len(counts[field]))
+		for value, count := range counts[field] {
+			values = append(values, model.FacetValue{Value: value, Count: count})
+		}
+		slices.SortFunc(values, func(a, b model.FacetValue) int {
+			if a.Count != b.Count {
+				return cmp.Compare(b.Count, a.Count)
+			}
+			return strings.Compare(a.Value, b.Value)
+		})
+		result.Facets[field] = values
+	}
+
+	if criteria.IncludeTotal {
+		total, relation, err := s.facetTotal(ctx, criteria)
+		if err != nil {
+			return nil, err
+		}
+		result.Total = &total
+		result.TotalRelation = relation
+	}
+
+	if publicOnly {
+		// Set a cache control header for anonymous users.
+		cacheControl := constants.AnonymousCacheControlHeader
+		result.CacheControl = &cacheControl
+		result.PublicPath = s.reportPublicPath
+	}
+
+	return result, nil
+}
+
+// facetTotal counts the accessible resources matching the criteria of a facet
+// search, like a resource count: public resources are counted directly and
+// private ones by access check query, without fetching any resource. The
+// relation is "gte" when the access check buckets overflow, as the private
+// resources of the buckets left out are not counted, and "eq" otherwise.
+func (s *ResourceSearch) facetTotal(ctx context.Context, criteria model.SearchCriteria) (uint64, string, error) {
+	publicCountCriteria := criteria
+	publicCountCriteria.PageSize = model.PageSizeCount
+	publicCountCriteria.PublicOnly = true
+	publicCountCriteria.GroupBySize = constants.DefaultBucketSize
+
+	aggregationCriteria := criteria
+	aggregationCriteria.PageSize = 0
+	aggregationCriteria.PublicOnly = false
+	aggregationCriteria.PrivateOnly = true
+	aggregationCriteria.GroupBy = "access_check_query.keyword"
+	aggregationCriteria.GroupBySize = constants.DefaultBucketSize
+
+	count, err := s.QueryResourcesCount(ctx, publicCountCriteria, aggregationCriteria)
+	if err != nil {
+		return 0, "", err
+	}
+	if count.HasMore {
+		slog.WarnContext(ctx, "facet total access check buckets overflow, private resources are undercounted")
+		return uint64(count.Count), "gte", nil
+	}
+	return uint64(count.Count), "eq", nil
+}
+
 func (s *ResourceSearch) BuildCountMessage(ctx context.Context, principal string, result *model.CountResult, aggregationCriteria model.SearchCriteria) []byte {
 
 	// Create a map to store the "doc_count" of each aggregation bucket.
@@ -289,7 +1327,7 @@ func (s *ResourceSearch) BuildCountMessage(ctx context.Context, principal string
 	for _, bucket := range result.Aggregation.Buckets {
 		docCountMap[bucket.Key] = bucket.DocCount
 		accessCheckMessage = append(accessCheckMessage, bucket.Key...)
-		accessCheckMessage = append(accessCheckMessage, []byte("@user:")...)
+		accessCheckMessage = append(accessCheckMessage, []byte("@"+constants.PrincipalUserPrefix)...)
 		accessCheckMessage = append(accessCheckMessage, []byte(principal)...)
 		accessCheckMessage = append(accessCheckMessage, '\n')
 	}
@@ -314,6 +1352,9 @@ func (s *ResourceSearch) CheckCountAccess(ctx context.Context, principal string,
 			)
 			return 0, fmt.Errorf("access control check failed: %w", errCheckAccess)
 		}
+		if err := s.verifyAccessCheckResponses(ctx, accessCheckMessage, accessCheckResult); err != nil {
+			return 0, err
+		}
 		accessCheckResponses = accessCheckResult
 	}
 	slog.DebugContext(ctx, "access check responses", "responses", accessCheckResponses)
@@ -324,13 +1365,20 @@ func (s *ResourceSearch) CheckCountAccess(ctx context.Context, principal string,
 		// e.g.: "committee:830513f8-0e77-4a48-a8e4-ede4c1a61f98#viewer@user:project_super_admin"
 		// The BuildCountMessage function appends "@user:" + principal to create the access check key
 		// So we need to use the same format here
-		accessCheckKey := bucket.Key + "@user:" + principal
+		accessCheckKey := bucket.Key + "@" + constants.PrincipalUserPrefix + principal
 		slog.DebugContext(ctx, "checking access control for bucket",
 			"bucket", bucket.Key,
 			"access_check_key", accessCheckKey,
 		)
-		if allowed, ok := accessCheckResponses[accessCheckKey]; ok && allowed == "true" {
+		if allowed, ok := accessCheckResponses[accessCheckKey]; ok && s.isAccessAllowed(allowed) {
 			count += bucket.DocCount
+			// Break the count down by the relation of the bucket
+			if _, relation, found := strings.Cut(bucket.Key, "#"); found {
+				if result.RelationCounts == nil {
+					result.RelationCounts = make(map[string]uint64)
+				}
+				result.RelationCounts[relation] += bucket.DocCount
+			}
 		}
 	}
 
@@ -338,21 +1386,221 @@ func (s *ResourceSearch) CheckCountAccess(ctx context.Context, principal string,
 }
 
 func (s *ResourceSearch) IsReady(ctx context.Context) error {
-	if err := s.resourceSearcher.IsReady(ctx); err != nil {
+	if err := s.dependencyReady(ctx, "search", s.resourceSearcher.IsReady); err != nil {
 		return err
 	}
 
-	if err := s.accessChecker.IsReady(ctx); err != nil {
+	if err := s.dependencyReady(ctx, "access control", s.accessChecker.IsReady); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// dependencyReady checks the readiness of a dependency within the readiness
+// timeout. A dependency that doesn't answer in time is not ready, whether or
+// not its check honors the context.
+func (s *ResourceSearch) dependencyReady(ctx context.Context, name string, isReady func(ctx context.Context) error) error {
+	readyCtx, cancel := context.WithTimeout(ctx, s.readinessTimeout)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- isReady(readyCtx)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-readyCtx.Done():
+		slog.WarnContext(ctx, "dependency readiness check timed out",
+			"dependency", name,
+			"timeout", s.readinessTimeout,
+		)
+		return errors.NewServiceUnavailable(fmt.Sprintf("%s readiness check timed out", name), readyCtx.Err())
+	}
+}
+
+// reportedAsIs tells whether an error of the search source is reported as it
+// is: the search rejected the request itself (e.g. a stale page token), which
+// is for the client to fix, or the index is unavailable, which is for the
+// operators to fix
+func reportedAsIs(err error) bool {
+	switch err.(type) {
+	case errors.Validation, errors.ServiceUnavailable:
+		return true
+	}
+	return false
+}
+
+// ExplainResource explains why a search does or doesn't return a resource, by
+// running it for that resource alone through each stage: indexing, criteria
+// and access control
+func (s *ResourceSearch) ExplainResource(ctx context.Context, criteria model.SearchCriteria, objectRef string) (*model.ResourceExplanation, error) {
+
+	principal, ok := ctx.Value(constants.PrincipalContextID).(string)
+	if !ok {
+		// This should not happen; the Auther always sets this or errors.
+		return nil, errors.NewValidation("missing principal in context")
+	}
+	// Not found rather than forbidden, so the feature is not advertised
+	if _, allowed := s.explainPrincipals[principal]; !allowed {
+		slog.WarnContext(ctx, "principal not allowed to explain resources", "principal", principal)
+		return nil, errors.NewNotFound("resource explanation is not available")
+	}
+	if objectRef == "" {
+		return nil, errors.NewValidation("object reference is required")
+	}
+
+	if err := s.validateSearchCriteria(criteria, principal); err != nil {
+		return nil, errors.NewValidation("search criteria validation failed", err)
+	}
+	if err := s.applyAllowedResourceTypes(&criteria); err != nil {
+		return nil, err
+	}
+	if principal == constants.AnonymousPrincipal {
+		criteria.PublicOnly = true
+	}
+
+	explanation := &model.ResourceExplanation{
+		ObjectRef: objectRef,
+	}
+
+	// Is the resource indexed at all?
+	indexed, err := s.resourceSearcher.QueryResources(ctx, model.SearchCriteria{
+		ObjectRef: &objectRef,
+		PageSize:  1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("search operation failed: %w", err)
+	}
+	if len(indexed.Resources) == 0 {
+		explanation.Stage = model.ExplanationStageNotFound
+		explanation.Reason = "no current revision of the resource is indexed"
+		return explanation, nil
+	}
+	explanation.Found = true
+
+	// Does it match the criteria? The search is restricted to the resource, so
+	// the outcome does not depend on pagination.
+	criteria.ObjectRef = &objectRef
+	criteria.PageSize = 1
+	criteria.PageToken = nil
+	criteria.SearchAfter = nil
+	matched, err := s.resourceSearcher.QueryResources(ctx, criteria)
+	if err != nil {
+		return nil, fmt.Errorf("search operation failed: %w", err)
+	}
+	if len(matched.Resources) == 0 {
+		explanation.Stage = model.ExplanationStageFilteredOut
+		explanation.Reason = "the resource does not match the search criteria"
+		if criteria.PublicOnly && !indexed.Resources[0].Public {
+			explanation.Reason = "the resource is not public, and anonymous searches only return public resources"
+		}
+		return explanation, nil
+	}
+	explanation.MatchedCriteria = true
+
+	// Is the principal granted access?
+	message := s.BuildMessage(ctx, principal, matched)
+	explanation.AccessCheckQuery = strings.TrimSuffix(string(message), "\n")
+	granted, err := s.CheckAccess(ctx, principal, matched.Resources, message)
+	if err != nil {
+		return nil, fmt.Errorf("access control check failed: %w", err)
+	}
+	if len(granted) == 0 {
+		explanation.Stage = model.ExplanationStageAccessDenied
+		explanation.Reason = "the access check did not grant the resource"
+		if explanation.AccessCheckQuery == "" {
+			explanation.Reason = "the resource is private but has no access check information"
+		}
+		return explanation, nil
+	}
+	explanation.AccessGranted = true
+
+	explanation.Stage = model.ExplanationStageReturned
+	explanation.Reason = "the search returns the resource"
+	if explanation.AccessCheckQuery == "" {
+		explanation.Reason = "the search returns the resource, which is public"
+	}
+	return explanation, nil
+}
+
 // NewResourceSearch creates a new ResourceSearch instance
-func NewResourceSearch(resourceSearcher port.ResourceSearcher, accessChecker port.AccessControlChecker) ResourceSearcher {
-	return &ResourceSearch{
-		resourceSearcher: resourceSearcher,
-		accessChecker:    accessChecker,
+func NewResourceSearch(resourceSearcher port.ResourceSearcher, accessChecker port.AccessControlChecker, opts ...ResourceSearchOption) ResourceSearcher {
+	s := &ResourceSearch{
+		resourceSearcher:    resourceSearcher,
+		accessChecker:       accessChecker,
+		nameMinQueryLength:  constants.DefaultMinQueryLength,
+		permissionRelations: []string{constants.DefaultPermissionRelation},
+		readinessTimeout:    constants.DefaultReadinessTimeout,
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
+}
+
+// CheckTuples sends the given access check tuples (e.g.
+// "committee:123#viewer@user:jdoe") to the access checker as they are and
+// returns its answer for each, to debug access issues without a search. Like
+// resource explanations, it is only available to the debugging principals.
+func (s *ResourceSearch) CheckTuples(ctx context.Context, tuples []string) (map[string]string, error) {
+
+	principal, ok := ctx.Value(constants.PrincipalContextID).(string)
+	if !ok {
+		// This should not happen; the Auther always sets this or errors.
+		return nil, errors.NewValidation("missing principal in context")
+	}
+	// Not found rather than forbidden, so the feature is not advertised
+	if _, allowed := s.tupleCheckers[principal]; !allowed {
+		slog.WarnContext(ctx, "principal not allowed to check tuples", "principal", principal)
+		return nil, errors.NewNotFound("tuple checks are not available")
+	}
+	if len(tuples) == 0 {
+		return nil, errors.NewValidation("at least one tuple is required")
+	}
+	if len(tuples) > constants.MaxCheckTuples {
+		return nil, errors.NewValidation(fmt.Sprintf("at most %d tuples can be checked at once", constants.MaxCheckTuples))
+	}
+	for _, tuple := range tuples {
+		object, rest, hasRelation := strings.Cut(tuple, "#")
+		relation, user, hasUser := strings.Cut(rest, "@")
+		if !hasRelation || !hasUser || object == "" || relation == "" || user == "" || strings.ContainsAny(tuple, "\n\r") {
+			return nil, errors.NewValidation(fmt.Sprintf("invalid tuple %q: expected <object>#<relation>@<user>", tuple))
+		}
+	}
+
+	slog.DebugContext(ctx, "checking access tuples", "tuples", len(tuples))
+
+	result, err := s.accessChecker.CheckAccess(ctx, constants.AccessCheckSubject, []byte(strings.Join(tuples, "\n")), constants.AccessCheckTimeout)
+	if err != nil {
+		slog.ErrorContext(ctx, "access control check failed", "error", err)
+		return nil, fmt.Errorf("access control check failed: %w", err)
+	}
+	return result, nil
+}
+
+// FlushCache clears the cached search results, so that searches see the
+// index again right away, e.g. after a reindex. It is only available to the
+// cache admin principals.
+func (s *ResourceSearch) FlushCache(ctx context.Context) (int, error) {
+
+	principal, ok := ctx.Value(constants.PrincipalContextID).(string)
+	if !ok {
+		// This should not happen; the Auther always sets this or errors.
+		return 0, errors.NewValidation("missing principal in context")
+	}
+	// Not found rather than forbidden, so the feature is not advertised
+	if _, allowed := s.cacheAdmins[principal]; !allowed {
+		slog.WarnContext(ctx, "principal not allowed to flush the cache", "principal", principal)
+		return 0, errors.NewNotFound("cache flush is not available")
+	}
+	if s.resultCache == nil {
+		return 0, nil
+	}
+
+	flushed := s.resultCache.flush()
+	slog.InfoContext(ctx, "search result cache flushed", "entries", flushed)
+	return flushed, nil
 }
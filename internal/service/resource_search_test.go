@@ -5,11 +5,16 @@ package service
 
 import (
 	"context"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/model"
+	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/port"
+	"github.com/linuxfoundation/lfx-v2-query-service/internal/infrastructure/fallback"
 	"github.com/linuxfoundation/lfx-v2-query-service/internal/infrastructure/mock"
 	"github.com/linuxfoundation/lfx-v2-query-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -206,6 +211,29 @@ func TestResourceSearchValidateSearchCriteria(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "count sentinel page size",
+			criteria: model.SearchCriteria{
+				Name:     stringPtr("test"),
+				PageSize: model.PageSizeCount,
+			},
+			expectError: false,
+		},
+		{
+			name: "negative page size",
+			criteria: model.SearchCriteria{
+				Name:     stringPtr("test"),
+				PageSize: -5,
+			},
+			expectError: true,
+		},
+		{
+			name: "valid criteria with parents",
+			criteria: model.SearchCriteria{
+				Parents: []string{"project:123", "project:456"},
+			},
+			expectError: false,
+		},
 		{
 			name: "valid criteria with resource type",
 			criteria: model.SearchCriteria{
@@ -213,6 +241,13 @@ func TestResourceSearchValidateSearchCriteria(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "valid criteria with creator",
+			criteria: model.SearchCriteria{
+				CreatedBy: stringPtr("user:jdoe"),
+			},
+			expectError: false,
+		},
 		{
 			name: "valid criteria with tags",
 			criteria: model.SearchCriteria{
@@ -220,6 +255,47 @@ func TestResourceSearchValidateSearchCriteria(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "valid criteria with tags and a minimum match",
+			criteria: model.SearchCriteria{
+				Tags:         []string{"tag1", "tag2"},
+				TagsMinMatch: intPtr(2),
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid criteria - tags minimum match above the number of tags",
+			criteria: model.SearchCriteria{
+				Tags:         []string{"tag1", "tag2"},
+				TagsMinMatch: intPtr(3),
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid criteria - tags minimum match not positive",
+			criteria: model.SearchCriteria{
+				Tags:         []string{"tag1"},
+				TagsMinMatch: intPtr(0),
+			},
+			expectError: true,
+		},
+		{
+			name: "valid criteria with a collapse field",
+			criteria: model.SearchCriteria{
+				ResourceType: stringPtr("project"),
+				CollapseBy:   stringPtr(model.CollapseFieldSlug),
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid criteria - collapse field with a page token",
+			criteria: model.SearchCriteria{
+				ResourceType: stringPtr("project"),
+				CollapseBy:   stringPtr(model.CollapseFieldSlug),
+				SearchAfter:  stringPtr(`["alpha","alpha"]`),
+			},
+			expectError: true,
+		},
 		{
 			name: "valid criteria with multiple fields",
 			criteria: model.SearchCriteria{
@@ -253,7 +329,7 @@ func TestResourceSearchValidateSearchCriteria(t *testing.T) {
 			service := &ResourceSearch{}
 
 			// Execute
-			err := service.validateSearchCriteria(tc.criteria)
+			err := service.validateSearchCriteria(tc.criteria, "")
 
 			// Verify
 			if tc.expectError {
@@ -453,6 +529,167 @@ func TestResourceSearchBuildMessage(t *testing.T) {
 	}
 }
 
+func TestResourceSearchMissingAccessMetadataPolicy(t *testing.T) {
+	resources := func() []model.Resource {
+		return []model.Resource{
+			{
+				Type: "meeting",
+				ID:   "public-meeting",
+				TransactionBodyStub: model.TransactionBodyStub{
+					ObjectRef: "meeting:public-meeting",
+					Public:    true,
+				},
+			},
+			{
+				Type: "meeting",
+				ID:   "private-meeting",
+				TransactionBodyStub: model.TransactionBodyStub{
+					ObjectRef: "meeting:private-meeting",
+				},
+			},
+			{
+				Type: "project",
+				ID:   "private-project",
+				TransactionBodyStub: model.TransactionBodyStub{
+					ObjectRef:           "project:private-project",
+					AccessCheckObject:   "project:private-project",
+					AccessCheckRelation: "viewer",
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		policies    map[string]string
+		expectedIDs []string
+	}{
+		{
+			name:        "default skips private resources only",
+			expectedIDs: []string{"public-meeting", "private-project"},
+		},
+		{
+			name:        "deny excludes public resources too",
+			policies:    map[string]string{"meeting": model.MissingAccessMetadataDeny},
+			expectedIDs: []string{"private-project"},
+		},
+		{
+			name:        "include-if-public returns public resources",
+			policies:    map[string]string{"meeting": model.MissingAccessMetadataIncludeIfPublic},
+			expectedIDs: []string{"public-meeting", "private-project"},
+		},
+		{
+			name:        "policy of another type does not apply",
+			policies:    map[string]string{"committee": model.MissingAccessMetadataDeny},
+			expectedIDs: []string{"public-meeting", "private-project"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			accessChecker := mock.NewMockAccessControlChecker()
+			accessChecker.SetCheckAccessResponse(map[string]string{
+				"project:private-project#viewer@user:user123": "true",
+			})
+			opts := []ResourceSearchOption{}
+			if tc.policies != nil {
+				opts = append(opts, WithMissingAccessMetadataPolicy(tc.policies))
+			}
+			service := NewResourceSearch(mock.NewMockResourceSearcher(), accessChecker, opts...).(*ResourceSearch)
+
+			ctx := context.Background()
+			result := &model.SearchResult{Resources: resources()}
+			message := service.BuildMessage(ctx, "user123", result)
+			assert.NotContains(t, string(message), "meeting:")
+
+			checked, err := service.CheckAccess(ctx, "user123", result.Resources, message)
+			assert.NoError(t, err)
+
+			ids := make([]string, 0, len(checked))
+			for _, resource := range checked {
+				ids = append(ids, resource.ID)
+			}
+			assert.Equal(t, tc.expectedIDs, ids)
+		})
+	}
+}
+
+func TestResourceSearchStrictAccessCheck(t *testing.T) {
+	resources := []model.Resource{
+		{
+			Type: "project",
+			ID:   "answered",
+			TransactionBodyStub: model.TransactionBodyStub{
+				ObjectRef:           "project:answered",
+				AccessCheckObject:   "project:answered",
+				AccessCheckRelation: "viewer",
+			},
+		},
+		{
+			Type: "project",
+			ID:   "unanswered",
+			TransactionBodyStub: model.TransactionBodyStub{
+				ObjectRef:           "project:unanswered",
+				AccessCheckObject:   "project:unanswered",
+				AccessCheckRelation: "viewer",
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		strict        bool
+		response      map[string]string
+		expectedIDs   []string
+		expectedError bool
+	}{
+		{
+			name:        "lenient treats a missing tuple as denied",
+			response:    map[string]string{"project:answered#viewer@user:user123": "true"},
+			expectedIDs: []string{"answered"},
+		},
+		{
+			name:          "strict fails on a missing tuple",
+			strict:        true,
+			response:      map[string]string{"project:answered#viewer@user:user123": "true"},
+			expectedError: true,
+		},
+		{
+			name:   "strict accepts a complete response",
+			strict: true,
+			response: map[string]string{
+				"project:answered#viewer@user:user123":   "true",
+				"project:unanswered#viewer@user:user123": "false",
+			},
+			expectedIDs: []string{"answered"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			accessChecker := mock.NewMockAccessControlChecker()
+			accessChecker.SetCheckAccessResponse(tc.response)
+			service := NewResourceSearch(mock.NewMockResourceSearcher(), accessChecker, WithStrictAccessCheck(tc.strict)).(*ResourceSearch)
+
+			ctx := context.Background()
+			result := &model.SearchResult{Resources: resources}
+			message := service.BuildMessage(ctx, "user123", result)
+
+			checked, err := service.CheckAccess(ctx, "user123", result.Resources, message)
+			if tc.expectedError {
+				assert.ErrorContains(t, err, "project:unanswered#viewer@user:user123")
+				return
+			}
+			assert.NoError(t, err)
+			ids := make([]string, 0, len(checked))
+			for _, resource := range checked {
+				ids = append(ids, resource.ID)
+			}
+			assert.Equal(t, tc.expectedIDs, ids)
+		})
+	}
+}
+
 func TestResourceSearchCheckAccess(t *testing.T) {
 	tests := []struct {
 		name               string
@@ -747,6 +984,55 @@ func TestResourceSearchQueryResourcesEdgeCases(t *testing.T) {
 		assertion.NoError(err)
 		assertion.NotNil(result)
 	})
+
+	for _, includeScore := range []bool{true, false} {
+		t.Run("search with include score "+strconv.FormatBool(includeScore), func(t *testing.T) {
+			// Setup
+			mockSearcher := mock.NewMockResourceSearcher()
+			mockAccessChecker := mock.NewMockAccessControlChecker()
+			service, ok := NewResourceSearch(mockSearcher, mockAccessChecker).(*ResourceSearch)
+			if !ok {
+				t.Fatal("failed to create ResourceSearch service")
+			}
+
+			mockSearcher.ClearResources()
+			mockSearcher.AddResource(model.Resource{
+				Type: "project",
+				ID:   "scored-project",
+				Data: map[string]any{"name": "Scored Project"},
+				TransactionBodyStub: model.TransactionBodyStub{
+					ObjectRef:  "project:scored-project",
+					ObjectType: "project",
+					ObjectID:   "scored-project",
+					Public:     true,
+				},
+			})
+
+			criteria := model.SearchCriteria{
+				Name:         stringPtr("Scored"),
+				IncludeScore: includeScore,
+				PageSize:     10,
+			}
+
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, constants.AnonymousPrincipal)
+
+			// Execute
+			result, err := service.QueryResources(ctx, criteria)
+
+			// Verify
+			assertion.NoError(err)
+			assertion.Len(result.Resources, 1)
+			if includeScore {
+				assertion.NotNil(result.Resources[0].Score)
+				if assertion.NotNil(result.Resources[0].NormalizedScore) {
+					assertion.Equal(1.0, *result.Resources[0].NormalizedScore)
+				}
+			} else {
+				assertion.Nil(result.Resources[0].Score)
+				assertion.Nil(result.Resources[0].NormalizedScore)
+			}
+		})
+	}
 }
 
 func TestResourceCountQueryResourcesCount(t *testing.T) {
@@ -856,6 +1142,17 @@ func TestResourceCountQueryResourcesCount(t *testing.T) {
 			},
 			expectedError: true,
 		},
+		{
+			name: "negative page size other than the count sentinel",
+			countCriteria: model.SearchCriteria{
+				PageSize:   -2,
+				PublicOnly: true,
+			},
+			aggregationCriteria: model.SearchCriteria{},
+			principal:           constants.AnonymousPrincipal,
+			setupMocks:          func(*mock.MockResourceSearcher, *mock.MockAccessControlChecker) {},
+			expectedError:       true,
+		},
 		{
 			name: "access control check error",
 			countCriteria: model.SearchCriteria{
@@ -922,6 +1219,84 @@ func TestResourceCountQueryResourcesCount(t *testing.T) {
 	}
 }
 
+func TestResourceCountVisibleAndTotal(t *testing.T) {
+	countResult := func() *model.CountResult {
+		return &model.CountResult{
+			Count: 4,
+			Aggregation: model.TermsAggregation{
+				Buckets: []model.AggregationBucket{
+					{Key: "committee:789#member", DocCount: 3},
+					{Key: "project:101#viewer", DocCount: 2},
+				},
+				SumOtherDocCount: 1,
+			},
+		}
+	}
+	accessResponse := map[string]string{
+		"committee:789#member@user:admin": "true",
+		"project:101#viewer@user:admin":   "false",
+	}
+
+	tests := []struct {
+		name            string
+		principal       string
+		opts            []ResourceSearchOption
+		expectedVisible int
+		expectedTotal   *int
+	}{
+		{
+			name:            "mix of accessible and inaccessible resources",
+			principal:       "admin",
+			expectedVisible: 7,
+			expectedTotal:   intPtr(10),
+		},
+		{
+			name:            "anonymous principal counts public resources only",
+			principal:       constants.AnonymousPrincipal,
+			expectedVisible: 4,
+			expectedTotal:   intPtr(4),
+		},
+		{
+			name:            "total reported to the allowed principals",
+			principal:       "admin",
+			opts:            []ResourceSearchOption{WithTotalCountPrincipals("admin")},
+			expectedVisible: 7,
+			expectedTotal:   intPtr(10),
+		},
+		{
+			name:            "total not reported to other principals",
+			principal:       "admin",
+			opts:            []ResourceSearchOption{WithTotalCountPrincipals("svc-admin")},
+			expectedVisible: 7,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			resourceSearcher := mock.NewMockResourceSearcher()
+			resourceSearcher.SetQueryResourcesCountResponse(countResult())
+			accessChecker := mock.NewMockAccessControlChecker()
+			accessChecker.SetCheckAccessResponse(accessResponse)
+			service := NewResourceSearch(resourceSearcher, accessChecker, tc.opts...)
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, tc.principal)
+
+			result, err := service.QueryResourcesCount(ctx,
+				model.SearchCriteria{PageSize: -1, PublicOnly: true},
+				model.SearchCriteria{GroupBy: "access_check_query.keyword", PrivateOnly: true},
+			)
+
+			assertion.NoError(err)
+			if assertion.NotNil(result) {
+				assertion.Equal(tc.expectedVisible, result.Count)
+				assertion.Equal(tc.expectedVisible, result.VisibleCount)
+				assertion.Equal(tc.expectedTotal, result.TotalCount)
+			}
+		})
+	}
+}
+
 func TestResourceCountBuildMessage(t *testing.T) {
 	assertion := assert.New(t)
 
@@ -965,6 +1340,7 @@ func TestResourceCountCheckAccess(t *testing.T) {
 		result             *model.CountResult
 		accessResponses    map[string]string
 		expectedCount      uint64
+		expectedRelations  map[string]uint64
 		expectedError      bool
 		setupAccessChecker func(*mock.MockAccessControlChecker)
 	}{
@@ -984,8 +1360,35 @@ func TestResourceCountCheckAccess(t *testing.T) {
 					"project:456#viewer@user:test-user":   "false",
 				})
 			},
-			expectedCount: 2, // Only committee:123#member is allowed
-			expectedError: false,
+			expectedCount:     2, // Only committee:123#member is allowed
+			expectedRelations: map[string]uint64{"member": 2},
+			expectedError:     false,
+		},
+		{
+			name: "mixed allowed and denied buckets broken down by relation",
+			result: &model.CountResult{
+				Aggregation: model.TermsAggregation{
+					Buckets: []model.AggregationBucket{
+						{Key: "project:456#viewer", DocCount: 5},
+						{Key: "project:789#viewer", DocCount: 3},
+						{Key: "committee:123#member", DocCount: 3},
+						{Key: "committee:321#member", DocCount: 4},
+						{Key: "meeting:101#organizer", DocCount: 1},
+					},
+				},
+			},
+			setupAccessChecker: func(checker *mock.MockAccessControlChecker) {
+				checker.SetCheckAccessResponse(map[string]string{
+					"project:456#viewer@user:test-user":    "true",
+					"project:789#viewer@user:test-user":    "true",
+					"committee:123#member@user:test-user":  "true",
+					"committee:321#member@user:test-user":  "false",
+					"meeting:101#organizer@user:test-user": "false",
+				})
+			},
+			expectedCount:     11,
+			expectedRelations: map[string]uint64{"viewer": 8, "member": 3},
+			expectedError:     false,
 		},
 		{
 			name: "successful access check with all denied",
@@ -1049,12 +1452,1847 @@ func TestResourceCountCheckAccess(t *testing.T) {
 			} else {
 				assertion.NoError(err)
 				assertion.Equal(tc.expectedCount, count)
+				assertion.Equal(tc.expectedRelations, tc.result.RelationCounts)
 			}
 		})
 	}
 }
 
-// Helper function to create string pointers
-func stringPtr(s string) *string {
-	return &s
+func TestResourceSearchAccessAllowedValues(t *testing.T) {
+	tests := []struct {
+		name            string
+		opts            []ResourceSearchOption
+		accessResponse  string
+		expectedAllowed bool
+	}{
+		{
+			name:            "default mapping allows true",
+			accessResponse:  "true",
+			expectedAllowed: true,
+		},
+		{
+			name:            "default mapping denies TRUE",
+			accessResponse:  "TRUE",
+			expectedAllowed: false,
+		},
+		{
+			name:            "default mapping denies padded true",
+			accessResponse:  " true ",
+			expectedAllowed: false,
+		},
+		{
+			name:            "default mapping denies allowed",
+			accessResponse:  "allowed",
+			expectedAllowed: false,
+		},
+		{
+			name:            "custom mapping allows allowed",
+			opts:            []ResourceSearchOption{WithAccessAllowedValues("allowed", "ALLOW")},
+			accessResponse:  "allowed",
+			expectedAllowed: true,
+		},
+		{
+			name:            "custom mapping allows ALLOW",
+			opts:            []ResourceSearchOption{WithAccessAllowedValues("allowed", "ALLOW")},
+			accessResponse:  "ALLOW",
+			expectedAllowed: true,
+		},
+		{
+			name:            "custom mapping denies true",
+			opts:            []ResourceSearchOption{WithAccessAllowedValues("allowed", "ALLOW")},
+			accessResponse:  "true",
+			expectedAllowed: false,
+		},
+		{
+			name:            "empty custom mapping keeps default",
+			opts:            []ResourceSearchOption{WithAccessAllowedValues(" ")},
+			accessResponse:  "true",
+			expectedAllowed: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+			ctx := context.Background()
+
+			accessChecker := mock.NewMockAccessControlChecker()
+			accessChecker.SetCheckAccessResponse(map[string]string{
+				"project:test-project#view@user:user123": tc.accessResponse,
+				"committee:123#member@user:user123":      tc.accessResponse,
+			})
+			service, ok := NewResourceSearch(mock.NewMockResourceSearcher(), accessChecker, tc.opts...).(*ResourceSearch)
+			if !ok {
+				t.Fatal("failed to create ResourceSearch service")
+			}
+
+			// Resource access check
+			resources := []model.Resource{
+				{
+					Type:      "project",
+					ID:        "test-project",
+					NeedCheck: true,
+					TransactionBodyStub: model.TransactionBodyStub{
+						ObjectRef:           "project:test-project",
+						AccessCheckObject:   "project:test-project",
+						AccessCheckRelation: "view",
+					},
+				},
+			}
+			checked, err := service.CheckAccess(ctx, "user123", resources, []byte("project:test-project#view@user:user123\n"))
+			assertion.NoError(err)
+			if tc.expectedAllowed {
+				assertion.Len(checked, 1)
+			} else {
+				assertion.Empty(checked)
+			}
+
+			// Count access check
+			countResult := &model.CountResult{
+				Aggregation: model.TermsAggregation{
+					Buckets: []model.AggregationBucket{
+						{Key: "committee:123#member", DocCount: 4},
+					},
+				},
+			}
+			message := service.BuildCountMessage(ctx, "user123", countResult, model.SearchCriteria{PageSize: 10})
+			count, err := service.CheckCountAccess(ctx, "user123", countResult, message)
+			assertion.NoError(err)
+			if tc.expectedAllowed {
+				assertion.Equal(uint64(4), count)
+			} else {
+				assertion.Equal(uint64(0), count)
+			}
+		})
+	}
+}
+
+func TestResourceSearchAllowedResourceTypes(t *testing.T) {
+	tests := []struct {
+		name          string
+		opts          []ResourceSearchOption
+		criteria      model.SearchCriteria
+		expectedError bool
+		expectedTypes []string
+	}{
+		{
+			name:          "allowed explicit type",
+			opts:          []ResourceSearchOption{WithAllowedResourceTypes("project", "committee")},
+			criteria:      model.SearchCriteria{ResourceType: stringPtr("project")},
+			expectedTypes: []string{"project"},
+		},
+		{
+			name:          "disallowed explicit type",
+			opts:          []ResourceSearchOption{WithAllowedResourceTypes("project", "committee")},
+			criteria:      model.SearchCriteria{ResourceType: stringPtr("meeting")},
+			expectedError: true,
+		},
+		{
+			name:          "unconstrained request is limited to the allowlist",
+			opts:          []ResourceSearchOption{WithAllowedResourceTypes("project", "committee")},
+			criteria:      model.SearchCriteria{Tags: []string{"active"}},
+			expectedTypes: []string{"project", "committee"},
+		},
+		{
+			name:          "no allowlist returns all types",
+			criteria:      model.SearchCriteria{Tags: []string{"active"}},
+			expectedTypes: []string{"project", "committee", "meeting"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			mockSearcher := mock.NewMockResourceSearcher()
+			mockSearcher.ClearResources()
+			for _, resourceType := range []string{"project", "committee", "meeting"} {
+				mockSearcher.AddResource(model.Resource{
+					Type: resourceType,
+					ID:   resourceType + "-1",
+					Data: map[string]any{"name": resourceType, "tags": []string{"active"}},
+					TransactionBodyStub: model.TransactionBodyStub{
+						ObjectRef:  resourceType + ":" + resourceType + "-1",
+						ObjectType: resourceType,
+						ObjectID:   resourceType + "-1",
+						Public:     true,
+					},
+				})
+			}
+			service := NewResourceSearch(mockSearcher, mock.NewMockAccessControlChecker(), tc.opts...)
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "user123")
+
+			result, err := service.QueryResources(ctx, tc.criteria)
+			_, errCount := service.QueryResourcesCount(ctx, tc.criteria, tc.criteria)
+
+			if tc.expectedError {
+				var validationErr errors.Validation
+				assertion.ErrorAs(err, &validationErr)
+				assertion.ErrorAs(errCount, &validationErr)
+				return
+			}
+
+			assertion.NoError(err)
+			assertion.NoError(errCount)
+			var types []string
+			for _, resource := range result.Resources {
+				types = append(types, resource.Type)
+			}
+			assertion.ElementsMatch(tc.expectedTypes, types)
+		})
+	}
+}
+
+func TestResourceSearchQueryResourcesCountBatch(t *testing.T) {
+	assertion := assert.New(t)
+
+	mockSearcher := mock.NewMockResourceSearcher()
+	service := NewResourceSearch(mockSearcher, mock.NewMockAccessControlChecker(), WithAllowedResourceTypes("project", "committee"))
+	ctx := context.WithValue(context.Background(), constants.PrincipalContextID, constants.AnonymousPrincipal)
+
+	batch := []model.CountBatchCriteria{
+		{
+			Count:       model.SearchCriteria{ResourceType: stringPtr("project"), PublicOnly: true},
+			Aggregation: model.SearchCriteria{ResourceType: stringPtr("project"), PrivateOnly: true},
+		},
+		{
+			Count:       model.SearchCriteria{ResourceType: stringPtr("meeting"), PublicOnly: true},
+			Aggregation: model.SearchCriteria{ResourceType: stringPtr("meeting"), PrivateOnly: true},
+		},
+		{
+			Count:       model.SearchCriteria{ResourceType: stringPtr("committee"), PublicOnly: true},
+			Aggregation: model.SearchCriteria{ResourceType: stringPtr("committee"), PrivateOnly: true},
+		},
+	}
+
+	items, err := service.QueryResourcesCountBatch(ctx, batch)
+
+	// The failing count does not fail the batch
+	assertion.NoError(err)
+	if !assertion.Len(items, 3) {
+		return
+	}
+	assertion.NoError(items[0].Error)
+	assertion.NotNil(items[0].Result)
+	var validationErr errors.Validation
+	assertion.ErrorAs(items[1].Error, &validationErr)
+	assertion.Nil(items[1].Result)
+	assertion.NoError(items[2].Error)
+	assertion.NotNil(items[2].Result)
+
+	// Batch-level errors
+	_, err = service.QueryResourcesCountBatch(ctx, nil)
+	assertion.ErrorAs(err, &validationErr)
+	_, err = service.QueryResourcesCountBatch(ctx, make([]model.CountBatchCriteria, constants.MaxCountBatchSize+1))
+	assertion.ErrorAs(err, &validationErr)
+	_, err = service.QueryResourcesCountBatch(context.Background(), batch)
+	assertion.ErrorAs(err, &validationErr)
+}
+
+func TestResourceSearchMatchedTags(t *testing.T) {
+	tests := []struct {
+		name                string
+		principal           string
+		expectedMatchedTags []string
+	}{
+		{
+			name:                "authenticated user gets matched tags",
+			principal:           "user123",
+			expectedMatchedTags: []string{"active", "governance"},
+		},
+		{
+			name:                "anonymous user does not get matched tags",
+			principal:           constants.AnonymousPrincipal,
+			expectedMatchedTags: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			mockSearcher := mock.NewMockResourceSearcher()
+			mockSearcher.ClearResources()
+			mockSearcher.AddResource(model.Resource{
+				Type: "committee",
+				ID:   "tagged-committee",
+				Data: map[string]any{
+					"name": "Tagged Committee",
+					"tags": []string{"governance", "active", "security"},
+				},
+				TransactionBodyStub: model.TransactionBodyStub{
+					ObjectRef:  "committee:tagged-committee",
+					ObjectType: "committee",
+					ObjectID:   "tagged-committee",
+					Public:     true,
+				},
+			})
+			service := NewResourceSearch(mockSearcher, mock.NewMockAccessControlChecker())
+
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, tc.principal)
+			result, err := service.QueryResources(ctx, model.SearchCriteria{
+				Tags:     []string{"active", "archived", "governance"},
+				PageSize: 10,
+			})
+
+			assertion.NoError(err)
+			if assertion.Len(result.Resources, 1) {
+				assertion.Equal(tc.expectedMatchedTags, result.Resources[0].MatchedTags)
+			}
+		})
+	}
+}
+
+func TestResourceSearchCreatedBy(t *testing.T) {
+	tests := []struct {
+		name           string
+		createdBy      string
+		accessResponse string
+		expectedIDs    []string
+	}{
+		{
+			name:           "matching creator with access",
+			createdBy:      "user:alice",
+			accessResponse: "true",
+			expectedIDs:    []string{"alice-committee"},
+		},
+		{
+			name:           "matching creator without access",
+			createdBy:      "user:alice",
+			accessResponse: "false",
+			expectedIDs:    nil,
+		},
+		{
+			name:           "non-matching creator",
+			createdBy:      "user:carol",
+			accessResponse: "true",
+			expectedIDs:    nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			mockSearcher := mock.NewMockResourceSearcher()
+			mockSearcher.ClearResources()
+			mockSearcher.AddResource(model.Resource{
+				Type:      "committee",
+				ID:        "alice-committee",
+				Data:      map[string]any{"name": "Alice Committee", "created_by": "user:alice"},
+				NeedCheck: true,
+				TransactionBodyStub: model.TransactionBodyStub{
+					ObjectRef:           "committee:alice-committee",
+					ObjectType:          "committee",
+					ObjectID:            "alice-committee",
+					AccessCheckObject:   "committee:alice-committee",
+					AccessCheckRelation: "viewer",
+				},
+			})
+			accessChecker := mock.NewMockAccessControlChecker()
+			accessChecker.SetCheckAccessResponse(map[string]string{
+				"committee:alice-committee#viewer@user:bob": tc.accessResponse,
+			})
+			service := NewResourceSearch(mockSearcher, accessChecker)
+
+			// Filtering by creator does not bypass access control
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "bob")
+			result, err := service.QueryResources(ctx, model.SearchCriteria{
+				CreatedBy: stringPtr(tc.createdBy),
+				PageSize:  10,
+			})
+
+			assertion.NoError(err)
+			var ids []string
+			for _, resource := range result.Resources {
+				ids = append(ids, resource.ID)
+			}
+			assertion.Equal(tc.expectedIDs, ids)
+		})
+	}
+}
+
+func TestResourceSearchUnfilteredSearch(t *testing.T) {
+	tests := []struct {
+		name          string
+		opts          []ResourceSearchOption
+		principal     string
+		expectedError bool
+		expectedIDs   []string
+	}{
+		{
+			name:          "disabled rejects authenticated",
+			principal:     "bob",
+			expectedError: true,
+		},
+		{
+			name:          "disabled rejects anonymous",
+			principal:     constants.AnonymousPrincipal,
+			expectedError: true,
+		},
+		{
+			name:        "enabled lists everything the authenticated principal can access",
+			opts:        []ResourceSearchOption{WithUnfilteredSearch(true)},
+			principal:   "bob",
+			expectedIDs: []string{"public-project", "bob-committee"},
+		},
+		{
+			name:          "enabled still rejects anonymous",
+			opts:          []ResourceSearchOption{WithUnfilteredSearch(true)},
+			principal:     constants.AnonymousPrincipal,
+			expectedError: true,
+		},
+		{
+			name:        "empty criteria lists everything the authenticated principal can access",
+			opts:        []ResourceSearchOption{WithEmptyCriteria(true)},
+			principal:   "bob",
+			expectedIDs: []string{"public-project", "bob-committee"},
+		},
+		{
+			name:        "empty criteria lists public resources for anonymous",
+			opts:        []ResourceSearchOption{WithEmptyCriteria(true)},
+			principal:   constants.AnonymousPrincipal,
+			expectedIDs: []string{"public-project"},
+		},
+		{
+			name:          "empty criteria disabled rejects anonymous",
+			opts:          []ResourceSearchOption{WithEmptyCriteria(false)},
+			principal:     constants.AnonymousPrincipal,
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			mockSearcher := mock.NewMockResourceSearcher()
+			mockSearcher.ClearResources()
+			mockSearcher.AddResource(model.Resource{
+				Type: "project",
+				ID:   "public-project",
+				Data: map[string]any{"name": "Public Project"},
+				TransactionBodyStub: model.TransactionBodyStub{
+					ObjectRef:  "project:public-project",
+					ObjectType: "project",
+					ObjectID:   "public-project",
+					Public:     true,
+				},
+			})
+			for _, id := range []string{"bob-committee", "alice-committee"} {
+				mockSearcher.AddResource(model.Resource{
+					Type:      "committee",
+					ID:        id,
+					Data:      map[string]any{"name": id},
+					NeedCheck: true,
+					TransactionBodyStub: model.TransactionBodyStub{
+						ObjectRef:           "committee:" + id,
+						ObjectType:          "committee",
+						ObjectID:            id,
+						AccessCheckObject:   "committee:" + id,
+						AccessCheckRelation: "viewer",
+					},
+				})
+			}
+			accessChecker := mock.NewMockAccessControlChecker()
+			accessChecker.SetCheckAccessResponse(map[string]string{
+				"committee:bob-committee#viewer@user:bob":   "true",
+				"committee:alice-committee#viewer@user:bob": "false",
+			})
+			service := NewResourceSearch(mockSearcher, accessChecker, tc.opts...)
+
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, tc.principal)
+			result, err := service.QueryResources(ctx, model.SearchCriteria{PageSize: 10})
+
+			if tc.expectedError {
+				var validationErr errors.Validation
+				assertion.ErrorAs(err, &validationErr)
+				return
+			}
+
+			assertion.NoError(err)
+			var ids []string
+			for _, resource := range result.Resources {
+				ids = append(ids, resource.ID)
+			}
+			assertion.ElementsMatch(tc.expectedIDs, ids)
+		})
+	}
+}
+
+func TestResourceSearchExplainResource(t *testing.T) {
+	tests := []struct {
+		name                string
+		opts                []ResourceSearchOption
+		principal           string
+		criteria            model.SearchCriteria
+		objectRef           string
+		expectedError       bool
+		expectedStage       model.ExplanationStage
+		expectedMatched     bool
+		expectedGranted     bool
+		expectedAccessCheck string
+	}{
+		{
+			name:                "returned private resource",
+			opts:                []ResourceSearchOption{WithExplainPrincipals("bob")},
+			principal:           "bob",
+			criteria:            model.SearchCriteria{ResourceType: stringPtr("committee")},
+			objectRef:           "committee:granted",
+			expectedStage:       model.ExplanationStageReturned,
+			expectedMatched:     true,
+			expectedGranted:     true,
+			expectedAccessCheck: "committee:granted#viewer@user:bob",
+		},
+		{
+			name:            "returned public resource",
+			opts:            []ResourceSearchOption{WithExplainPrincipals("bob")},
+			principal:       "bob",
+			criteria:        model.SearchCriteria{Name: stringPtr("public")},
+			objectRef:       "project:public",
+			expectedStage:   model.ExplanationStageReturned,
+			expectedMatched: true,
+			expectedGranted: true,
+		},
+		{
+			name:                "access denied",
+			opts:                []ResourceSearchOption{WithExplainPrincipals("bob")},
+			principal:           "bob",
+			criteria:            model.SearchCriteria{ResourceType: stringPtr("committee")},
+			objectRef:           "committee:denied",
+			expectedStage:       model.ExplanationStageAccessDenied,
+			expectedMatched:     true,
+			expectedAccessCheck: "committee:denied#viewer@user:bob",
+		},
+		{
+			name:          "filtered out by the criteria",
+			opts:          []ResourceSearchOption{WithExplainPrincipals("bob")},
+			principal:     "bob",
+			criteria:      model.SearchCriteria{ResourceType: stringPtr("project")},
+			objectRef:     "committee:granted",
+			expectedStage: model.ExplanationStageFilteredOut,
+		},
+		{
+			name:          "filtered out for anonymous by the public-only filter",
+			opts:          []ResourceSearchOption{WithExplainPrincipals(constants.AnonymousPrincipal)},
+			principal:     constants.AnonymousPrincipal,
+			criteria:      model.SearchCriteria{ResourceType: stringPtr("committee")},
+			objectRef:     "committee:granted",
+			expectedStage: model.ExplanationStageFilteredOut,
+		},
+		{
+			name:          "not indexed",
+			opts:          []ResourceSearchOption{WithExplainPrincipals("bob")},
+			principal:     "bob",
+			criteria:      model.SearchCriteria{ResourceType: stringPtr("committee")},
+			objectRef:     "committee:missing",
+			expectedStage: model.ExplanationStageNotFound,
+		},
+		{
+			name:          "principal not allowed to explain",
+			opts:          []ResourceSearchOption{WithExplainPrincipals("alice")},
+			principal:     "bob",
+			criteria:      model.SearchCriteria{ResourceType: stringPtr("committee")},
+			objectRef:     "committee:granted",
+			expectedError: true,
+		},
+		{
+			name:          "explaining disabled",
+			principal:     "bob",
+			criteria:      model.SearchCriteria{ResourceType: stringPtr("committee")},
+			objectRef:     "committee:granted",
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			mockSearcher := mock.NewMockResourceSearcher()
+			mockSearcher.ClearResources()
+			mockSearcher.AddResource(model.Resource{
+				Type: "project",
+				ID:   "public",
+				Data: map[string]any{"name": "Public Project"},
+				TransactionBodyStub: model.TransactionBodyStub{
+					ObjectRef:  "project:public",
+					ObjectType: "project",
+					ObjectID:   "public",
+					Public:     true,
+				},
+			})
+			for _, id := range []string{"granted", "denied"} {
+				mockSearcher.AddResource(model.Resource{
+					Type:      "committee",
+					ID:        id,
+					Data:      map[string]any{"name": id},
+					NeedCheck: true,
+					TransactionBodyStub: model.TransactionBodyStub{
+						ObjectRef:           "committee:" + id,
+						ObjectType:          "committee",
+						ObjectID:            id,
+						AccessCheckObject:   "committee:" + id,
+						AccessCheckRelation: "viewer",
+					},
+				})
+			}
+			accessChecker := mock.NewMockAccessControlChecker()
+			accessChecker.SetCheckAccessResponse(map[string]string{
+				"committee:granted#viewer@user:bob": "true",
+				"committee:denied#viewer@user:bob":  "false",
+			})
+			service := NewResourceSearch(mockSearcher, accessChecker, tc.opts...)
+
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, tc.principal)
+			explanation, err := service.ExplainResource(ctx, tc.criteria, tc.objectRef)
+
+			if tc.expectedError {
+				var notFoundErr errors.NotFound
+				assertion.ErrorAs(err, &notFoundErr)
+				return
+			}
+
+			assertion.NoError(err)
+			assertion.Equal(tc.objectRef, explanation.ObjectRef)
+			assertion.Equal(tc.expectedStage, explanation.Stage)
+			assertion.NotEmpty(explanation.Reason)
+			assertion.Equal(tc.expectedStage != model.ExplanationStageNotFound, explanation.Found)
+			assertion.Equal(tc.expectedMatched, explanation.MatchedCriteria)
+			assertion.Equal(tc.expectedGranted, explanation.AccessGranted)
+			assertion.Equal(tc.expectedAccessCheck, explanation.AccessCheckQuery)
+		})
+	}
+}
+
+func TestResourceSearchCheckTuples(t *testing.T) {
+	tests := []struct {
+		name            string
+		opts            []ResourceSearchOption
+		principal       string
+		tuples          []string
+		checkerErr      error
+		expectedResults map[string]string
+		expectedError   error
+	}{
+		{
+			name:      "mixed answers",
+			opts:      []ResourceSearchOption{WithTupleCheckPrincipals("bob")},
+			principal: "bob",
+			tuples: []string{
+				"committee:allowed#viewer@user:jdoe",
+				"committee:other#viewer@user:jdoe",
+				"committee:blocked#viewer@user:jdoe",
+			},
+			expectedResults: map[string]string{
+				"committee:allowed#viewer@user:jdoe": "true",
+				"committee:other#viewer@user:jdoe":   "false",
+				"committee:blocked#viewer@user:jdoe": "denied",
+			},
+		},
+		{
+			name:          "principal not allowed to check tuples",
+			opts:          []ResourceSearchOption{WithTupleCheckPrincipals("alice")},
+			principal:     "bob",
+			tuples:        []string{"committee:allowed#viewer@user:jdoe"},
+			expectedError: errors.NotFound{},
+		},
+		{
+			name:          "explain principal not allowed to check tuples",
+			opts:          []ResourceSearchOption{WithExplainPrincipals("bob")},
+			principal:     "bob",
+			tuples:        []string{"committee:allowed#viewer@user:jdoe"},
+			expectedError: errors.NotFound{},
+		},
+		{
+			name:          "no tuples",
+			opts:          []ResourceSearchOption{WithTupleCheckPrincipals("bob")},
+			principal:     "bob",
+			expectedError: errors.Validation{},
+		},
+		{
+			name:          "malformed tuple",
+			opts:          []ResourceSearchOption{WithTupleCheckPrincipals("bob")},
+			principal:     "bob",
+			tuples:        []string{"committee:allowed#viewer"},
+			expectedError: errors.Validation{},
+		},
+		{
+			name:          "tuple smuggling another line",
+			opts:          []ResourceSearchOption{WithTupleCheckPrincipals("bob")},
+			principal:     "bob",
+			tuples:        []string{"committee:1#viewer@user:jdoe\ncommittee:2#viewer@user:jdoe"},
+			expectedError: errors.Validation{},
+		},
+		{
+			name:          "too many tuples",
+			opts:          []ResourceSearchOption{WithTupleCheckPrincipals("bob")},
+			principal:     "bob",
+			tuples:        make([]string, constants.MaxCheckTuples+1),
+			expectedError: errors.Validation{},
+		},
+		{
+			name:       "access checker failure",
+			opts:       []ResourceSearchOption{WithTupleCheckPrincipals("bob")},
+			principal:  "bob",
+			tuples:     []string{"committee:allowed#viewer@user:jdoe"},
+			checkerErr: errors.NewServiceUnavailable("nats unavailable"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			accessChecker := mock.NewMockAccessControlChecker()
+			accessChecker.DefaultResult = ""
+			accessChecker.DeniedResourceIDs = []string{"committee:blocked"}
+			if tc.checkerErr != nil {
+				accessChecker.SetCheckAccessError(tc.checkerErr)
+			}
+			service := NewResourceSearch(mock.NewMockResourceSearcher(), accessChecker, tc.opts...)
+
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, tc.principal)
+			results, err := service.CheckTuples(ctx, tc.tuples)
+
+			switch {
+			case tc.expectedError != nil:
+				assertion.IsType(tc.expectedError, err)
+			case tc.checkerErr != nil:
+				assertion.ErrorContains(err, "nats unavailable")
+			default:
+				assertion.NoError(err)
+				assertion.Equal(tc.expectedResults, results)
+			}
+		})
+	}
+}
+
+func TestResourceSearchSuggestOnEmpty(t *testing.T) {
+	tests := []struct {
+		name                string
+		criteria            model.SearchCriteria
+		expectedResources   int
+		expectedSuggestions []string
+	}{
+		{
+			name:                "empty result gets suggestions",
+			criteria:            model.SearchCriteria{Name: stringPtr("budgte"), SuggestOnEmpty: true},
+			expectedSuggestions: []string{"Budget Committee"},
+		},
+		{
+			name:              "non-empty result gets no suggestions",
+			criteria:          model.SearchCriteria{Name: stringPtr("budget"), SuggestOnEmpty: true},
+			expectedResources: 1,
+		},
+		{
+			name:     "suggestions not requested",
+			criteria: model.SearchCriteria{Name: stringPtr("budgte")},
+		},
+		{
+			name:     "inaccessible resources are not suggested",
+			criteria: model.SearchCriteria{Name: stringPtr("secrte"), SuggestOnEmpty: true},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			mockSearcher := mock.NewMockResourceSearcher()
+			mockSearcher.ClearResources()
+			mockSearcher.AddResource(mock.NewResourceWithDefaults("committee", "budget", map[string]any{"name": "Budget Committee"}, true))
+			mockSearcher.AddResource(mock.NewResourceWithDefaults("committee", "secret", map[string]any{"name": "Secret Committee"}, false))
+			accessChecker := mock.NewMockAccessControlChecker()
+			accessChecker.DefaultResult = "denied"
+			service := NewResourceSearch(mockSearcher, accessChecker)
+
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "bob")
+			result, err := service.QueryResources(ctx, tc.criteria)
+
+			assertion.NoError(err)
+			assertion.Len(result.Resources, tc.expectedResources)
+			assertion.Equal(tc.expectedSuggestions, result.Suggestions)
+		})
+	}
+}
+
+func TestResourceSearchPublicPath(t *testing.T) {
+	tests := []struct {
+		name               string
+		opts               []ResourceSearchOption
+		principal          string
+		expectedPublicPath bool
+	}{
+		{
+			name:               "anonymous user is served by the public path",
+			opts:               []ResourceSearchOption{WithPublicPathReporting(true)},
+			principal:          constants.AnonymousPrincipal,
+			expectedPublicPath: true,
+		},
+		{
+			name:               "authenticated user is served by the access-checked path",
+			opts:               []ResourceSearchOption{WithPublicPathReporting(true)},
+			principal:          "user123",
+			expectedPublicPath: false,
+		},
+		{
+			name:               "reporting disabled",
+			principal:          constants.AnonymousPrincipal,
+			expectedPublicPath: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			mockSearcher := mock.NewMockResourceSearcher()
+			mockSearcher.SetQueryResourcesCountResponse(&model.CountResult{Count: 1})
+			service := NewResourceSearch(mockSearcher, mock.NewMockAccessControlChecker(), tc.opts...)
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, tc.principal)
+
+			result, err := service.QueryResources(ctx, model.SearchCriteria{
+				ResourceType: stringPtr("project"),
+				PageSize:     10,
+			})
+			assertion.NoError(err)
+			assertion.Equal(tc.expectedPublicPath, result.PublicPath)
+
+			countResult, err := service.QueryResourcesCount(ctx,
+				model.SearchCriteria{ResourceType: stringPtr("project"), PublicOnly: true},
+				model.SearchCriteria{ResourceType: stringPtr("project"), PrivateOnly: true},
+			)
+			assertion.NoError(err)
+			assertion.Equal(tc.expectedPublicPath, countResult.PublicPath)
+		})
+	}
+}
+
+func TestResourceSearchTotal(t *testing.T) {
+	tests := []struct {
+		name             string
+		principal        string
+		expectedTotal    int
+		expectedRelation string
+	}{
+		{
+			name:             "anonymous results report the total",
+			principal:        constants.AnonymousPrincipal,
+			expectedTotal:    1,
+			expectedRelation: "eq",
+		},
+		{
+			name:      "access-checked results leave the total out",
+			principal: "user123",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			mockSearcher := mock.NewMockResourceSearcher()
+			mockSearcher.ClearResources()
+			mockSearcher.AddResource(model.Resource{Type: "project", ID: "public", TransactionBodyStub: model.TransactionBodyStub{Public: true}})
+			mockSearcher.AddResource(model.Resource{Type: "project", ID: "private"})
+			service := NewResourceSearch(mockSearcher, mock.NewMockAccessControlChecker())
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, tc.principal)
+
+			result, err := service.QueryResources(ctx, model.SearchCriteria{
+				ResourceType: stringPtr("project"),
+				PageSize:     10,
+			})
+			assertion.NoError(err)
+			assertion.Equal(tc.expectedTotal, result.Total)
+			assertion.Equal(tc.expectedRelation, result.TotalRelation)
+		})
+	}
+}
+
+func TestResourceSearchChildCounts(t *testing.T) {
+	tests := []struct {
+		name                string
+		principal           string
+		includeChildCounts  bool
+		accessResponse      map[string]string
+		expectedChildCounts map[string]uint64
+	}{
+		{
+			name:               "authenticated user counts public and accessible children",
+			principal:          "user123",
+			includeChildCounts: true,
+			accessResponse: map[string]string{
+				"committee:123#member@user:user123": "true",
+				"committee:567#member@user:user123": "false",
+			},
+			expectedChildCounts: map[string]uint64{"committee": 2},
+		},
+		{
+			name:                "anonymous user counts public children only",
+			principal:           constants.AnonymousPrincipal,
+			includeChildCounts:  true,
+			expectedChildCounts: map[string]uint64{"committee": 1},
+		},
+		{
+			name:                "child counts not requested",
+			principal:           "user123",
+			includeChildCounts:  false,
+			expectedChildCounts: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			// The seeded project:456 has two private committees; add public children,
+			// the meeting being of a type outside the allowlist
+			mockSearcher := mock.NewMockResourceSearcher()
+			mockSearcher.AddResource(mock.NewResourceWithDefaults("meeting", "202", map[string]any{"name": "Public Meeting"}, true))
+			mockSearcher.SetParentRefs("meeting:202", "project:456")
+			mockSearcher.AddResource(mock.NewResourceWithDefaults("committee", "303", map[string]any{"name": "Public Committee"}, true))
+			mockSearcher.SetParentRefs("committee:303", "project:456")
+
+			accessChecker := mock.NewMockAccessControlChecker()
+			accessChecker.SetCheckAccessResponse(tc.accessResponse)
+			service := NewResourceSearch(mockSearcher, accessChecker, WithAllowedResourceTypes("project", "committee"))
+
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, tc.principal)
+			result, err := service.QueryResources(ctx, model.SearchCriteria{
+				Name:               stringPtr("LFX Platform"),
+				PageSize:           10,
+				IncludeChildCounts: tc.includeChildCounts,
+			})
+
+			assertion.NoError(err)
+			if assertion.Len(result.Resources, 1) {
+				assertion.Equal(tc.expectedChildCounts, result.Resources[0].ChildCounts)
+			}
+		})
+	}
+}
+
+func TestResourceSearchQueryFacets(t *testing.T) {
+	tests := []struct {
+		name                 string
+		principal            string
+		facetFields          []string
+		accessResponse       map[string]string
+		expectedFacets       map[string][]model.FacetValue
+		expectedCacheControl bool
+		expectedError        bool
+	}{
+		{
+			name:        "authenticated user counts public and accessible resources by field",
+			principal:   "user123",
+			facetFields: []string{model.FacetFieldType, model.FacetFieldTags, model.FacetFieldStatus, model.FacetFieldType},
+			accessResponse: map[string]string{
+				"committee:2#member@user:user123": "true",
+				"committee:3#member@user:user123": "false",
+			},
+			expectedFacets: map[string][]model.FacetValue{
+				"type":   {{Value: "committee", Count: 2}, {Value: "meeting", Count: 1}},
+				"tags":   {{Value: "active", Count: 2}, {Value: "tech", Count: 1}, {Value: "weekly", Count: 1}},
+				"status": {{Value: "active", Count: 2}},
+			},
+		},
+		{
+			name:        "anonymous user counts public resources only",
+			principal:   constants.AnonymousPrincipal,
+			facetFields: []string{model.FacetFieldType, model.FacetFieldStatus},
+			expectedFacets: map[string][]model.FacetValue{
+				"type":   {{Value: "committee", Count: 1}, {Value: "meeting", Count: 1}},
+				"status": {{Value: "active", Count: 1}},
+			},
+			expectedCacheControl: true,
+		},
+		{
+			name:        "inaccessible values are left out",
+			principal:   "user123",
+			facetFields: []string{model.FacetFieldStatus},
+			accessResponse: map[string]string{
+				"committee:2#member@user:user123": "false",
+				"committee:3#member@user:user123": "false",
+			},
+			expectedFacets: map[string][]model.FacetValue{
+				"status": {{Value: "active", Count: 1}},
+			},
+		},
+		{
+			name:          "no facet field",
+			principal:     "user123",
+			expectedError: true,
+		},
+		{
+			name:          "unsupported facet field",
+			principal:     "user123",
+			facetFields:   []string{model.FacetFieldType, "name"},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			mockSearcher := mock.NewMockResourceSearcher()
+			mockSearcher.ClearResources()
+			mockSearcher.AddResource(mock.NewResourceWithDefaults("committee", "1", map[string]any{"name": "Board", "tags": []string{"active"}, "status": "active"}, true))
+			mockSearcher.AddResource(mock.NewResourceWithDefaults("committee", "2", map[string]any{"name": "TAC", "tags": []string{"active", "tech"}, "status": "active"}, false))
+			mockSearcher.AddResource(mock.NewResourceWithDefaults("committee", "3", map[string]any{"name": "Legal", "tags": []string{"legal"}, "status": "archived"}, false))
+			mockSearcher.AddResource(mock.NewResourceWithDefaults("meeting", "4", map[string]any{"name": "Sync", "tags": []string{"weekly"}}, true))
+
+			accessChecker := mock.NewMockAccessControlChecker()
+			accessChecker.SetCheckAccessResponse(tc.accessResponse)
+			service := NewResourceSearch(mockSearcher, accessChecker)
+
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, tc.principal)
+			result, err := service.QueryFacets(ctx, model.SearchCriteria{}, tc.facetFields)
+
+			if tc.expectedError {
+				assertion.Error(err)
+				var validationErr errors.Validation
+				assertion.ErrorAs(err, &validationErr)
+				return
+			}
+			assertion.NoError(err)
+			assertion.Equal(tc.expectedFacets, result.Facets)
+			assertion.Equal(tc.expectedCacheControl, result.CacheControl != nil)
+		})
+	}
+}
+
+func TestResourceSearchQueryFacetsTotal(t *testing.T) {
+	tests := []struct {
+		name             string
+		principal        string
+		includeTotal     bool
+		hasMore          bool
+		expectedFacets   []model.FacetValue
+		expectedTotal    *uint64
+		expectedRelation string
+	}{
+		{
+			name:             "authenticated user counts public and accessible resources",
+			principal:        "user123",
+			includeTotal:     true,
+			expectedFacets:   []model.FacetValue{{Value: "committee", Count: 2}, {Value: "meeting", Count: 1}},
+			expectedTotal:    uint64Ptr(3),
+			expectedRelation: "eq",
+		},
+		{
+			name:             "access check buckets overflow",
+			principal:        "user123",
+			includeTotal:     true,
+			hasMore:          true,
+			expectedFacets:   []model.FacetValue{{Value: "committee", Count: 2}, {Value: "meeting", Count: 1}},
+			expectedTotal:    uint64Ptr(3),
+			expectedRelation: "gte",
+		},
+		{
+			name:             "anonymous user counts public resources only",
+			principal:        constants.AnonymousPrincipal,
+			includeTotal:     true,
+			expectedFacets:   []model.FacetValue{{Value: "committee", Count: 1}, {Value: "meeting", Count: 1}},
+			expectedTotal:    uint64Ptr(2),
+			expectedRelation: "eq",
+		},
+		{
+			name:           "total not requested",
+			principal:      "user123",
+			expectedFacets: []model.FacetValue{{Value: "committee", Count: 2}, {Value: "meeting", Count: 1}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			mockSearcher := mock.NewMockResourceSearcher()
+			mockSearcher.ClearResources()
+			mockSearcher.AddResource(mock.NewResourceWithDefaults("committee", "1", map[string]any{"name": "Board", "status": "active"}, true))
+			mockSearcher.AddResource(mock.NewResourceWithDefaults("committee", "2", map[string]any{"name": "TAC", "status": "active"}, false))
+			mockSearcher.AddResource(mock.NewResourceWithDefaults("committee", "3", map[string]any{"name": "Legal", "status": "archived"}, false))
+			mockSearcher.AddResource(mock.NewResourceWithDefaults("meeting", "4", map[string]any{"name": "Sync"}, true))
+			mockSearcher.SetQueryResourcesCountResponse(&model.CountResult{
+				Count: 2,
+				Aggregation: model.TermsAggregation{
+					Buckets: []model.AggregationBucket{
+						{Key: "committee:2#member", DocCount: 1},
+						{Key: "committee:3#member", DocCount: 1},
+					},
+				},
+				HasMore: tc.hasMore,
+			})
+
+			accessChecker := mock.NewMockAccessControlChecker()
+			accessChecker.SetCheckAccessResponse(map[string]string{
+				"committee:2#member@user:user123": "true",
+				"committee:3#member@user:user123": "false",
+			})
+			service := NewResourceSearch(mockSearcher, accessChecker)
+
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, tc.principal)
+			result, err := service.QueryFacets(ctx, model.SearchCriteria{IncludeTotal: tc.includeTotal}, []string{model.FacetFieldType})
+
+			assertion.NoError(err)
+			// Facets and the total are returned without any resource
+			assertion.Equal(tc.expectedFacets, result.Facets[model.FacetFieldType])
+			assertion.Equal(tc.expectedTotal, result.Total)
+			assertion.Equal(tc.expectedRelation, result.TotalRelation)
+		})
+	}
+}
+
+func TestResourceSearchPrincipalCache(t *testing.T) {
+	publicResource := model.Resource{
+		Type: "project",
+		ID:   "cached-project",
+		Data: map[string]any{"name": "Cached Project"},
+		TransactionBodyStub: model.TransactionBodyStub{
+			ObjectRef:  "project:cached-project",
+			ObjectType: "project",
+			ObjectID:   "cached-project",
+			Public:     true,
+		},
+	}
+	criteria := model.SearchCriteria{
+		Name:     stringPtr("Cached"),
+		PageSize: 10,
+	}
+
+	tests := []struct {
+		name               string
+		opts               []ResourceSearchOption
+		firstPrincipal     string
+		secondPrincipal    string
+		advance            time.Duration
+		degraded           bool
+		expectedSecondHits int
+	}{
+		{
+			name:               "repeated query by the same principal is served from cache",
+			opts:               []ResourceSearchOption{WithPrincipalCache(5*time.Second, 10)},
+			firstPrincipal:     "user123",
+			secondPrincipal:    "user123",
+			expectedSecondHits: 1,
+		},
+		{
+			name:               "different principals never share an entry",
+			opts:               []ResourceSearchOption{WithPrincipalCache(5*time.Second, 10)},
+			firstPrincipal:     "user123",
+			secondPrincipal:    "user456",
+			expectedSecondHits: 0,
+		},
+		{
+			name:               "expired entry is not used",
+			opts:               []ResourceSearchOption{WithPrincipalCache(5*time.Second, 10)},
+			firstPrincipal:     "user123",
+			secondPrincipal:    "user123",
+			advance:            6 * time.Second,
+			expectedSecondHits: 0,
+		},
+		{
+			name:               "anonymous results are not cached",
+			opts:               []ResourceSearchOption{WithPrincipalCache(5*time.Second, 10)},
+			firstPrincipal:     constants.AnonymousPrincipal,
+			secondPrincipal:    constants.AnonymousPrincipal,
+			expectedSecondHits: 0,
+		},
+		{
+			name:               "degraded results are not cached",
+			opts:               []ResourceSearchOption{WithPrincipalCache(5*time.Second, 10)},
+			firstPrincipal:     "user123",
+			secondPrincipal:    "user123",
+			degraded:           true,
+			expectedSecondHits: 0,
+		},
+		{
+			name:               "cache disabled by default",
+			firstPrincipal:     "user123",
+			secondPrincipal:    "user123",
+			expectedSecondHits: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			mockSearcher := mock.NewMockResourceSearcher()
+			mockSearcher.ClearResources()
+			mockSearcher.AddResource(publicResource)
+			var searcher port.ResourceSearcher = mockSearcher
+			if tc.degraded {
+				// The fallback serves as the primary fails
+				primary := mock.NewMockResourceSearcher()
+				primary.SetQueryResourcesError(errors.NewServiceUnavailable("opensearch unavailable"))
+				searcher = fallback.NewSearcher(primary, mockSearcher)
+			}
+			service, ok := NewResourceSearch(searcher, mock.NewMockAccessControlChecker(), tc.opts...).(*ResourceSearch)
+			if !ok {
+				t.Fatal("failed to create ResourceSearch service")
+			}
+
+			now := time.Now()
+			if service.resultCache != nil {
+				service.resultCache.now = func() time.Time { return now }
+			}
+
+			firstCtx := context.WithValue(context.Background(), constants.PrincipalContextID, tc.firstPrincipal)
+			first, err := service.QueryResources(firstCtx, criteria)
+			assertion.NoError(err)
+			assertion.Len(first.Resources, 1)
+			assertion.Equal(tc.degraded, first.Degraded)
+
+			// Changes in the backend are only visible when the cache is bypassed
+			mockSearcher.ClearResources()
+			now = now.Add(tc.advance)
+
+			secondCtx := context.WithValue(context.Background(), constants.PrincipalContextID, tc.secondPrincipal)
+			second, err := service.QueryResources(secondCtx, criteria)
+			assertion.NoError(err)
+			assertion.Len(second.Resources, tc.expectedSecondHits)
+		})
+	}
+}
+
+func TestResourceSearchExactNameBoost(t *testing.T) {
+	tests := []struct {
+		name          string
+		opts          []ResourceSearchOption
+		expectedOrder []string
+	}{
+		{
+			name:          "exact name match ranks first",
+			opts:          []ResourceSearchOption{WithExactNameBoost(true)},
+			expectedOrder: []string{"kubernetes", "dashboard"},
+		},
+		{
+			name:          "disabled by default",
+			expectedOrder: []string{"dashboard", "kubernetes"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			mockSearcher := mock.NewMockResourceSearcher()
+			mockSearcher.ClearResources()
+			mockSearcher.AddResource(model.Resource{Type: "project", ID: "dashboard", Data: map[string]any{"name": "Kubernetes Dashboard"}, TransactionBodyStub: model.TransactionBodyStub{Public: true}})
+			mockSearcher.AddResource(model.Resource{Type: "project", ID: "kubernetes", Data: map[string]any{"name": "Kubernetes"}, TransactionBodyStub: model.TransactionBodyStub{Public: true}})
+			service := NewResourceSearch(mockSearcher, mock.NewMockAccessControlChecker(), tc.opts...)
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, constants.AnonymousPrincipal)
+
+			result, err := service.QueryResources(ctx, model.SearchCriteria{Name: stringPtr("kubernetes"), PageSize: 10})
+			assertion.NoError(err)
+
+			var order []string
+			for _, resource := range result.Resources {
+				order = append(order, resource.ID)
+			}
+			assertion.Equal(tc.expectedOrder, order)
+		})
+	}
+}
+
+func TestResourceSearchRecencyBoost(t *testing.T) {
+	tests := []struct {
+		name          string
+		opts          []ResourceSearchOption
+		recencyBoost  bool
+		expectedOrder []string
+	}{
+		{
+			name:          "asked for without a configured scale",
+			recencyBoost:  true,
+			expectedOrder: []string{"committee-b", "committee-a"},
+		},
+		{
+			name:          "configured for the deployment",
+			opts:          []ResourceSearchOption{WithRecencyBoost("7d")},
+			expectedOrder: []string{"committee-b", "committee-a"},
+		},
+		{
+			name:          "disabled by default",
+			expectedOrder: []string{"committee-a", "committee-b"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			mockSearcher := mock.NewMockResourceSearcher()
+			mockSearcher.ClearResources()
+			mockSearcher.AddResource(mock.NewResourceWithDefaults("committee", "committee-a", map[string]any{"name": "Committee A", "updated_at": "2024-01-01T00:00:00Z"}, true))
+			mockSearcher.AddResource(mock.NewResourceWithDefaults("committee", "committee-b", map[string]any{"name": "Committee B", "updated_at": "2025-06-01T00:00:00Z"}, true))
+			service := NewResourceSearch(mockSearcher, mock.NewMockAccessControlChecker(), tc.opts...)
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, constants.AnonymousPrincipal)
+
+			result, err := service.QueryResources(ctx, model.SearchCriteria{Name: stringPtr("committee"), PageSize: 10, RecencyBoost: tc.recencyBoost})
+			assertion.NoError(err)
+
+			var order []string
+			for _, resource := range result.Resources {
+				order = append(order, resource.ID)
+			}
+			assertion.Equal(tc.expectedOrder, order)
+		})
+	}
+}
+
+func TestResourceSearchIncludeAncestry(t *testing.T) {
+	tests := []struct {
+		name             string
+		includeAncestry  bool
+		expectedAncestry []string
+	}{
+		{
+			name:             "ancestry of a nested resource",
+			includeAncestry:  true,
+			expectedAncestry: []string{"project:123", "committee:456"},
+		},
+		{
+			name: "not requested",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			mockSearcher := mock.NewMockResourceSearcher()
+			mockSearcher.ClearResources()
+			mockSearcher.AddResource(mock.NewResourceWithDefaults("meeting", "789", map[string]any{"name": "Board Sync"}, true))
+			mockSearcher.SetParentRefs("meeting:789", "project:123", "committee:456")
+			service := NewResourceSearch(mockSearcher, mock.NewMockAccessControlChecker())
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, constants.AnonymousPrincipal)
+
+			result, err := service.QueryResources(ctx, model.SearchCriteria{ResourceType: stringPtr("meeting"), PageSize: 10, IncludeAncestry: tc.includeAncestry})
+			assertion.NoError(err)
+			if assertion.Len(result.Resources, 1) {
+				assertion.Equal(tc.expectedAncestry, result.Resources[0].Ancestry)
+			}
+		})
+	}
+}
+
+func TestResourceSearchFlushCache(t *testing.T) {
+	assertion := assert.New(t)
+
+	resource := func(name string) model.Resource {
+		return model.Resource{
+			Type: "project",
+			ID:   "cached-project",
+			Data: map[string]any{"name": name},
+			TransactionBodyStub: model.TransactionBodyStub{
+				ObjectRef: "project:cached-project",
+				Public:    true,
+			},
+		}
+	}
+	criteria := model.SearchCriteria{ResourceType: stringPtr("project"), PageSize: 10}
+
+	mockSearcher := mock.NewMockResourceSearcher()
+	mockSearcher.ClearResources()
+	mockSearcher.AddResource(resource("Before Reindex"))
+	service := NewResourceSearch(mockSearcher, mock.NewMockAccessControlChecker(),
+		WithPrincipalCache(time.Minute, 10),
+		WithCacheAdminPrincipals("admin-user"),
+	)
+	userCtx := context.WithValue(context.Background(), constants.PrincipalContextID, "user123")
+	adminCtx := context.WithValue(context.Background(), constants.PrincipalContextID, "admin-user")
+
+	_, err := service.QueryResources(userCtx, criteria)
+	assertion.NoError(err)
+
+	// The reindex is not visible while the result is cached
+	mockSearcher.ClearResources()
+	mockSearcher.AddResource(resource("After Reindex"))
+	result, err := service.QueryResources(userCtx, criteria)
+	assertion.NoError(err)
+	if assertion.Len(result.Resources, 1) {
+		assertion.Equal("Before Reindex", result.Resources[0].Data.(map[string]any)["name"])
+	}
+
+	// Only cache admins can flush
+	_, err = service.FlushCache(userCtx)
+	assertion.IsType(errors.NotFound{}, err)
+
+	flushed, err := service.FlushCache(adminCtx)
+	assertion.NoError(err)
+	assertion.Equal(1, flushed)
+
+	// The next query sees the reindex and caches its result again
+	result, err = service.QueryResources(userCtx, criteria)
+	assertion.NoError(err)
+	if assertion.Len(result.Resources, 1) {
+		assertion.Equal("After Reindex", result.Resources[0].Data.(map[string]any)["name"])
+	}
+	flushed, err = service.FlushCache(adminCtx)
+	assertion.NoError(err)
+	assertion.Equal(1, flushed)
+}
+
+// Helper function to create string pointers
+func stringPtr(s string) *string {
+	return &s
+}
+
+func uint64Ptr(i uint64) *uint64 {
+	return &i
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func TestResourceCountAggregationBucketLimit(t *testing.T) {
+	tests := []struct {
+		name            string
+		opts            []ResourceSearchOption
+		expectedHasMore bool
+	}{
+		{
+			name:            "default bucket size fits every bucket",
+			expectedHasMore: false,
+		},
+		{
+			name:            "limit at the bucket count",
+			opts:            []ResourceSearchOption{WithAggregationBucketLimit(3)},
+			expectedHasMore: false,
+		},
+		{
+			name:            "limit below the bucket count",
+			opts:            []ResourceSearchOption{WithAggregationBucketLimit(2)},
+			expectedHasMore: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			// The mock data groups into 3 buckets, one per resource type
+			service := NewResourceSearch(mock.NewMockResourceSearcher(), mock.NewMockAccessControlChecker(), tc.opts...)
+
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "user:test-user")
+			result, err := service.QueryResourcesCount(ctx,
+				model.SearchCriteria{PageSize: -1, PublicOnly: true},
+				model.SearchCriteria{
+					GroupBy:     "access_check_query.keyword",
+					GroupBySize: constants.DefaultBucketSize,
+					PrivateOnly: true,
+				},
+			)
+
+			assertion.NoError(err)
+			if assertion.NotNil(result) {
+				assertion.Equal(tc.expectedHasMore, result.HasMore)
+			}
+		})
+	}
+}
+
+func TestResourceSearchSearcherValidationError(t *testing.T) {
+	assertion := assert.New(t)
+
+	resourceSearcher := mock.NewMockResourceSearcher()
+	resourceSearcher.SetQueryResourcesError(errors.NewValidation("page token is no longer valid, restart pagination without the page token"))
+	service := NewResourceSearch(resourceSearcher, mock.NewMockAccessControlChecker())
+
+	ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "user:test-user")
+	result, err := service.QueryResources(ctx, model.SearchCriteria{Name: stringPtr("test"), PageToken: stringPtr("stale")})
+
+	// A rejected request is reported as is, so it maps to a bad request
+	assertion.Nil(result)
+	assertion.IsType(errors.Validation{}, err)
+	assertion.Contains(err.Error(), "restart pagination")
+}
+
+func TestResourceSearchNameMinQueryLength(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         string
+		expectedError bool
+	}{
+		{
+			name:          "name below minimum is rejected",
+			query:         "te",
+			expectedError: true,
+		},
+		{
+			name:          "whitespace does not count towards minimum",
+			query:         "  te  ",
+			expectedError: true,
+		},
+		{
+			name:  "name at minimum is searched",
+			query: "tes",
+		},
+		{
+			name:  "name above minimum is searched",
+			query: "test",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			service := NewResourceSearch(mock.NewMockResourceSearcher(), mock.NewMockAccessControlChecker(), WithNameMinQueryLength(3))
+
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "user123")
+			result, err := service.QueryResources(ctx, model.SearchCriteria{Name: stringPtr(tc.query), PageSize: 10})
+
+			if tc.expectedError {
+				assertion.Error(err)
+				assertion.IsType(errors.Validation{}, err)
+				assertion.Nil(result)
+				return
+			}
+			assertion.NoError(err)
+			assertion.NotNil(result)
+		})
+	}
+}
+
+func TestResourceSearchWaitForRefresh(t *testing.T) {
+	const refreshWait = 20 * time.Millisecond
+
+	tests := []struct {
+		name           string
+		principal      string
+		waitForRefresh bool
+		expectedError  bool
+		expectedWait   bool
+	}{
+		{
+			name:           "allowed principal waits for the refresh",
+			principal:      "indexer-tool",
+			waitForRefresh: true,
+			expectedWait:   true,
+		},
+		{
+			name:           "other principal is rejected",
+			principal:      "user123",
+			waitForRefresh: true,
+			expectedError:  true,
+		},
+		{
+			name:      "search without wait",
+			principal: "user123",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			service := NewResourceSearch(mock.NewMockResourceSearcher(), mock.NewMockAccessControlChecker(),
+				WithRefreshWait(refreshWait, "indexer-tool"),
+			)
+
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, tc.principal)
+			start := time.Now()
+			result, err := service.QueryResources(ctx, model.SearchCriteria{
+				ResourceType:   stringPtr("project"),
+				PageSize:       10,
+				WaitForRefresh: tc.waitForRefresh,
+			})
+			elapsed := time.Since(start)
+
+			if tc.expectedError {
+				assertion.IsType(errors.Validation{}, err)
+				assertion.Nil(result)
+				return
+			}
+			assertion.NoError(err)
+			assertion.NotNil(result)
+			if tc.expectedWait {
+				assertion.GreaterOrEqual(elapsed, refreshWait)
+			}
+		})
+	}
+}
+
+func TestResourceSearchIncludePermissions(t *testing.T) {
+	resource := func(id string, public bool) model.Resource {
+		return model.Resource{
+			Type: "project",
+			ID:   id,
+			Data: map[string]any{"name": "Permissions Project " + id},
+			TransactionBodyStub: model.TransactionBodyStub{
+				ObjectRef:           "project:" + id,
+				ObjectType:          "project",
+				ObjectID:            id,
+				Public:              public,
+				AccessCheckObject:   "project:" + id,
+				AccessCheckRelation: "viewer",
+			},
+		}
+	}
+
+	tests := []struct {
+		name                string
+		opts                []ResourceSearchOption
+		principal           string
+		includePermissions  bool
+		expectedPermissions map[string]map[string]bool
+		expectedError       bool
+	}{
+		{
+			name:               "edit permission reported per resource",
+			principal:          "user123",
+			includePermissions: true,
+			expectedPermissions: map[string]map[string]bool{
+				"editable":  {"edit": true},
+				"read-only": {"edit": false},
+				"public":    {"edit": true},
+			},
+		},
+		{
+			name:               "configured relations",
+			opts:               []ResourceSearchOption{WithPermissions([]string{"edit", "admin"})},
+			principal:          "user123",
+			includePermissions: true,
+			expectedPermissions: map[string]map[string]bool{
+				"editable":  {"edit": true, "admin": true},
+				"read-only": {"edit": false, "admin": false},
+				"public":    {"edit": true, "admin": false},
+			},
+		},
+		{
+			name:      "permissions not requested",
+			principal: "user123",
+			expectedPermissions: map[string]map[string]bool{
+				"editable":  nil,
+				"read-only": nil,
+				"public":    nil,
+			},
+		},
+		{
+			name:               "anonymous principals get no permissions",
+			principal:          constants.AnonymousPrincipal,
+			includePermissions: true,
+			expectedPermissions: map[string]map[string]bool{
+				"public": nil,
+			},
+		},
+		{
+			name:               "admin-gated permissions allowed",
+			opts:               []ResourceSearchOption{WithPermissions([]string{"edit"}, "user123")},
+			principal:          "user123",
+			includePermissions: true,
+			expectedPermissions: map[string]map[string]bool{
+				"editable":  {"edit": true},
+				"read-only": {"edit": false},
+				"public":    {"edit": true},
+			},
+		},
+		{
+			name:               "admin-gated permissions denied",
+			opts:               []ResourceSearchOption{WithPermissions([]string{"edit"}, "admin1")},
+			principal:          "user123",
+			includePermissions: true,
+			expectedError:      true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			mockSearcher := mock.NewMockResourceSearcher()
+			mockSearcher.ClearResources()
+			mockSearcher.AddResource(resource("editable", false))
+			mockSearcher.AddResource(resource("read-only", false))
+			mockSearcher.AddResource(resource("public", true))
+
+			accessChecker := mock.NewMockAccessControlChecker()
+			accessChecker.SetCheckAccessResponse(map[string]string{
+				"project:editable#viewer@user:user123":  "true",
+				"project:editable#edit@user:user123":    "true",
+				"project:editable#admin@user:user123":   "true",
+				"project:read-only#viewer@user:user123": "true",
+				"project:read-only#edit@user:user123":   "false",
+				"project:read-only#admin@user:user123":  "false",
+				"project:public#edit@user:user123":      "true",
+				"project:public#admin@user:user123":     "false",
+			})
+
+			service := NewResourceSearch(mockSearcher, accessChecker, tc.opts...)
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, tc.principal)
+			result, err := service.QueryResources(ctx, model.SearchCriteria{
+				Name:               stringPtr("Permissions Project"),
+				PageSize:           10,
+				IncludePermissions: tc.includePermissions,
+			})
+
+			if tc.expectedError {
+				assertion.Error(err)
+				return
+			}
+			assertion.NoError(err)
+
+			permissions := make(map[string]map[string]bool, len(result.Resources))
+			for _, resource := range result.Resources {
+				permissions[resource.ID] = resource.Permissions
+			}
+			assertion.Equal(tc.expectedPermissions, permissions)
+			// The permission tuples are checked along with the view access
+			if tc.principal != constants.AnonymousPrincipal {
+				assertion.Equal(1, accessChecker.CheckAccessCalls())
+			}
+		})
+	}
+}
+
+func TestResourceSearchSuggestResources(t *testing.T) {
+	newSearcher := func() *mock.MockResourceSearcher {
+		searcher := mock.NewMockResourceSearcher()
+		searcher.ClearResources()
+		searcher.AddResource(mock.NewResourceWithDefaults("project", "acme-public", map[string]any{"name": "Acme Public"}, true))
+		searcher.AddResource(mock.NewResourceWithDefaults("project", "acme-visible", map[string]any{"name": "Acme Visible"}, false))
+		searcher.AddResource(mock.NewResourceWithDefaults("project", "acme-hidden", map[string]any{"name": "Acme Hidden"}, false))
+		return searcher
+	}
+	suggestedIDs := func(result *model.SearchResult) []string {
+		ids := make([]string, 0, len(result.Resources))
+		for _, resource := range result.Resources {
+			ids = append(ids, resource.ID)
+		}
+		return ids
+	}
+
+	t.Run("private resources the user cannot see are excluded", func(t *testing.T) {
+		accessChecker := mock.NewMockAccessControlChecker()
+		accessChecker.SetCheckAccessResponse(map[string]string{
+			"project:acme-visible#viewer@user:user123": "true",
+			"project:acme-hidden#viewer@user:user123":  "false",
+		})
+		service := NewResourceSearch(newSearcher(), accessChecker)
+
+		ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "user123")
+		result, err := service.SuggestResources(ctx, "acme")
+
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"acme-public", "acme-visible"}, suggestedIDs(result))
+		assert.Equal(t, 1, accessChecker.CheckAccessCalls())
+	})
+
+	t.Run("anonymous users only get public resources", func(t *testing.T) {
+		accessChecker := mock.NewMockAccessControlChecker()
+		service := NewResourceSearch(newSearcher(), accessChecker)
+
+		ctx := context.WithValue(context.Background(), constants.PrincipalContextID, constants.AnonymousPrincipal)
+		result, err := service.SuggestResources(ctx, "acme")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"acme-public"}, suggestedIDs(result))
+		assert.Equal(t, 0, accessChecker.CheckAccessCalls())
+	})
+
+	t.Run("candidates are capped before the access check", func(t *testing.T) {
+		searcher := mock.NewMockResourceSearcher()
+		searcher.ClearResources()
+		for i := range constants.SuggestCandidateLimit + 5 {
+			id := "acme-" + strconv.Itoa(i)
+			searcher.AddResource(mock.NewResourceWithDefaults("project", id, map[string]any{"name": "Acme " + strconv.Itoa(i)}, false))
+		}
+		service := NewResourceSearch(searcher, mock.NewMockAccessControlChecker())
+
+		ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "user123")
+		result, err := service.SuggestResources(ctx, "acme")
+
+		assert.NoError(t, err)
+		assert.LessOrEqual(t, len(result.Resources), constants.SuggestCandidateLimit)
+	})
+
+	t.Run("access check failure fails the suggestions", func(t *testing.T) {
+		accessChecker := mock.NewMockAccessControlChecker()
+		accessChecker.SetCheckAccessError(errors.NewServiceUnavailable("nats unavailable"))
+		service := NewResourceSearch(newSearcher(), accessChecker)
+
+		ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "user123")
+		_, err := service.SuggestResources(ctx, "acme")
+
+		assert.Error(t, err)
+	})
+}
+
+// hungAccessChecker is an access checker whose readiness check hangs,
+// ignoring its context, until released
+type hungAccessChecker struct {
+	*mock.MockAccessControlChecker
+	release chan struct{}
+}
+
+func (c hungAccessChecker) IsReady(ctx context.Context) error {
+	<-c.release
+	return nil
+}
+
+// slowResourceSearcher is a resource searcher whose readiness check only
+// returns when its context is done
+type slowResourceSearcher struct {
+	*mock.MockResourceSearcher
+}
+
+func (s slowResourceSearcher) IsReady(ctx context.Context) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestResourceSearchIsReadyTimeout(t *testing.T) {
+	const timeout = 50 * time.Millisecond
+
+	release := make(chan struct{})
+	defer close(release)
+
+	tests := []struct {
+		name          string
+		searcher      port.ResourceSearcher
+		accessChecker port.AccessControlChecker
+		expectedError bool
+	}{
+		{
+			name:          "ready dependencies",
+			searcher:      mock.NewMockResourceSearcher(),
+			accessChecker: mock.NewMockAccessControlChecker(),
+		},
+		{
+			name:          "search that honors the timeout",
+			searcher:      slowResourceSearcher{mock.NewMockResourceSearcher()},
+			accessChecker: mock.NewMockAccessControlChecker(),
+			expectedError: true,
+		},
+		{
+			name:          "access checker that hangs regardless of the context",
+			searcher:      mock.NewMockResourceSearcher(),
+			accessChecker: hungAccessChecker{mock.NewMockAccessControlChecker(), release},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			service := NewResourceSearch(tc.searcher, tc.accessChecker, WithReadinessTimeout(timeout))
+
+			start := time.Now()
+			err := service.IsReady(context.Background())
+			elapsed := time.Since(start)
+
+			assert.Less(t, elapsed, 10*timeout)
+			if !tc.expectedError {
+				assert.NoError(t, err)
+				return
+			}
+			var unavailable errors.ServiceUnavailable
+			assert.ErrorAs(t, err, &unavailable)
+		})
+	}
 }
@@ -0,0 +1,131 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/model"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/constants"
+)
+
+// resultCacheKey identifies a cached search result. The principal is part of
+// the key itself, so two principals can never share an entry.
+type resultCacheKey struct {
+	principal    string
+	criteriaHash string
+}
+
+type resultCacheEntry struct {
+	result    *model.SearchResult
+	expiresAt time.Time
+}
+
+// resultCache is a bounded, short-TTL cache of search results per principal.
+// Entries are invalidated when their TTL expires, or all at once by a flush.
+type resultCache struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	maxEntries int
+	entries    map[resultCacheKey]resultCacheEntry
+	now        func() time.Time
+}
+
+func newResultCache(ttl time.Duration, maxEntries int) *resultCache {
+	if maxEntries <= 0 {
+		maxEntries = constants.DefaultPrincipalCacheMaxEntries
+	}
+	return &resultCache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		entries:    make(map[resultCacheKey]resultCacheEntry),
+		now:        time.Now,
+	}
+}
+
+// key builds the cache key for a principal and search criteria
+func (c *resultCache) key(principal string, criteria model.SearchCriteria) (resultCacheKey, bool) {
+	encoded, err := json.Marshal(criteria)
+	if err != nil {
+		return resultCacheKey{}, false
+	}
+	hash := sha256.Sum256(encoded)
+	return resultCacheKey{
+		principal:    principal,
+		criteriaHash: hex.EncodeToString(hash[:]),
+	}, true
+}
+
+// get returns a copy of the cached result, if present and not expired
+func (c *resultCache) get(key resultCacheKey) (*model.SearchResult, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !c.now().Before(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return copySearchResult(entry.result), true
+}
+
+// set stores a copy of the result, evicting expired entries first and then
+// the entry closest to expiry when the cache is full
+func (c *resultCache) set(key resultCacheKey, result *model.SearchResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if _, exists := c.entries[key]; !exists && len(c.entries) >= c.maxEntries {
+		for k, entry := range c.entries {
+			if !now.Before(entry.expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+		for len(c.entries) >= c.maxEntries {
+			var (
+				oldestKey resultCacheKey
+				oldest    time.Time
+				found     bool
+			)
+			for k, entry := range c.entries {
+				if !found || entry.expiresAt.Before(oldest) {
+					oldestKey, oldest, found = k, entry.expiresAt, true
+				}
+			}
+			delete(c.entries, oldestKey)
+		}
+	}
+
+	c.entries[key] = resultCacheEntry{
+		result:    copySearchResult(result),
+		expiresAt: now.Add(c.ttl),
+	}
+}
+
+// flush removes every entry and returns how many there were
+func (c *resultCache) flush() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	flushed := len(c.entries)
+	c.entries = make(map[resultCacheKey]resultCacheEntry)
+	return flushed
+}
+
+// copySearchResult copies the result and its resource list, so callers can
+// modify what they get back without affecting the cached entry
+func copySearchResult(result *model.SearchResult) *model.SearchResult {
+	copied := *result
+	copied.Resources = append([]model.Resource(nil), result.Resources...)
+	copied.Suggestions = append([]string(nil), result.Suggestions...)
+	return &copied
+}
@@ -0,0 +1,86 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResultCacheKey(t *testing.T) {
+	assertion := assert.New(t)
+	cache := newResultCache(time.Minute, 10)
+
+	keyA, ok := cache.key("user-a", model.SearchCriteria{Name: stringPtr("test")})
+	assertion.True(ok)
+	keyB, ok := cache.key("user-b", model.SearchCriteria{Name: stringPtr("test")})
+	assertion.True(ok)
+	keyOther, ok := cache.key("user-a", model.SearchCriteria{Name: stringPtr("other")})
+	assertion.True(ok)
+	keyAgain, ok := cache.key("user-a", model.SearchCriteria{Name: stringPtr("test")})
+	assertion.True(ok)
+
+	assertion.NotEqual(keyA, keyB)
+	assertion.Equal(keyA.criteriaHash, keyB.criteriaHash)
+	assertion.NotEqual(keyA, keyOther)
+	assertion.Equal(keyA, keyAgain)
+}
+
+func TestResultCacheBounded(t *testing.T) {
+	assertion := assert.New(t)
+	cache := newResultCache(time.Minute, 2)
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	for _, principal := range []string{"user-a", "user-b", "user-c"} {
+		key, _ := cache.key(principal, model.SearchCriteria{})
+		cache.set(key, &model.SearchResult{})
+		now = now.Add(time.Second)
+	}
+
+	assertion.Len(cache.entries, 2)
+
+	// The entry closest to expiry was evicted
+	keyA, _ := cache.key("user-a", model.SearchCriteria{})
+	_, hit := cache.get(keyA)
+	assertion.False(hit)
+	keyC, _ := cache.key("user-c", model.SearchCriteria{})
+	_, hit = cache.get(keyC)
+	assertion.True(hit)
+}
+
+func TestResultCacheReturnsCopies(t *testing.T) {
+	assertion := assert.New(t)
+	cache := newResultCache(time.Minute, 10)
+	key, _ := cache.key("user-a", model.SearchCriteria{})
+
+	cache.set(key, &model.SearchResult{Resources: []model.Resource{{ID: "original"}}})
+
+	cached, hit := cache.get(key)
+	assertion.True(hit)
+	cached.Resources[0].ID = "modified"
+
+	cached, hit = cache.get(key)
+	assertion.True(hit)
+	assertion.Equal("original", cached.Resources[0].ID)
+}
+
+func TestResultCacheFlush(t *testing.T) {
+	assertion := assert.New(t)
+	cache := newResultCache(time.Minute, 10)
+
+	for _, principal := range []string{"user-a", "user-b"} {
+		key, _ := cache.key(principal, model.SearchCriteria{})
+		cache.set(key, &model.SearchResult{})
+	}
+
+	assertion.Equal(2, cache.flush())
+	keyA, _ := cache.key("user-a", model.SearchCriteria{})
+	_, hit := cache.get(keyA)
+	assertion.False(hit)
+	assertion.Equal(0, cache.flush())
+}
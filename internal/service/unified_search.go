@@ -0,0 +1,300 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/model"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/errors"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// UnifiedSearcher defines the interface for searching resources and
+// organizations in one call, e.g. for a global search bar
+type UnifiedSearcher interface {
+	// UnifiedSearch searches resources and organizations matching the query
+	UnifiedSearch(ctx context.Context, query string) (*model.UnifiedSearchResult, error)
+
+	// Suggest suggests resources and organizations matching the query in a
+	// single list ordered by relevance; empty kinds means all the kinds
+	Suggest(ctx context.Context, query string, kinds []string) (*model.SuggestionsResult, error)
+}
+
+// UnifiedSearch combines the resource and organization searches
+type UnifiedSearch struct {
+	resourceService     ResourceSearcher
+	organizationService OrganizationSearcher
+	sectionSize         int
+	suggestLimit        int
+	suggestKindLimit    int
+}
+
+// UnifiedSearchOption configures optional UnifiedSearch behavior
+type UnifiedSearchOption func(*UnifiedSearch)
+
+// WithUnifiedSearchSectionSize sets the maximum number of results in each section
+func WithUnifiedSearchSectionSize(size int) UnifiedSearchOption {
+	return func(s *UnifiedSearch) {
+		if size > 0 {
+			s.sectionSize = size
+		}
+	}
+}
+
+// WithSuggestLimits sets the maximum number of combined suggestions, and of
+// suggestions of each kind among them
+func WithSuggestLimits(limit, kindLimit int) UnifiedSearchOption {
+	return func(s *UnifiedSearch) {
+		if limit > 0 {
+			s.suggestLimit = limit
+		}
+		if kindLimit > 0 {
+			s.suggestKindLimit = kindLimit
+		}
+	}
+}
+
+// UnifiedSearch runs the resource search and the organization suggestions
+// concurrently. Resources are the primary section, so their failure fails the
+// whole search; a failed organization search only leaves its section empty
+// and flagged, since suggestions come from a third party.
+func (s *UnifiedSearch) UnifiedSearch(ctx context.Context, query string) (*model.UnifiedSearchResult, error) {
+
+	slog.DebugContext(ctx, "starting unified search", "query", query)
+
+	var (
+		resources     *model.SearchResult
+		organizations *model.OrganizationSuggestionsResult
+		orgsFailed    bool
+	)
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.Go(func() error {
+		// Access control is applied by the resource service, the fetched page
+		// is larger than the section to make up for the filtered resources
+		result, err := s.resourceService.QueryResources(groupCtx, model.SearchCriteria{
+			Name:     &query,
+			PageSize: constants.DefaultPageSize,
+		})
+		if err != nil {
+			return err
+		}
+		resources = result
+		return nil
+	})
+	group.Go(func() error {
+		result, err := s.organizationService.SuggestOrganizations(groupCtx, model.OrganizationSuggestionCriteria{
+			Query: query,
+		})
+		if err != nil {
+			slog.WarnContext(ctx, "unified search organization section failed", "error", err)
+			orgsFailed = true
+			return nil
+		}
+		organizations = result
+		return nil
+	})
+	if err := group.Wait(); err != nil {
+		slog.ErrorContext(ctx, "unified search resource section failed", "error", err)
+		return nil, err
+	}
+
+	result := &model.UnifiedSearchResult{
+		Resources:                []model.Resource{},
+		Organizations:            []model.OrganizationSuggestion{},
+		OrganizationsUnavailable: orgsFailed,
+	}
+	if resources != nil {
+		result.Resources = capSection(resources.Resources, s.sectionSize)
+	}
+	if organizations != nil {
+		result.Organizations = capSection(organizations.Suggestions, s.sectionSize)
+	}
+
+	slog.DebugContext(ctx, "unified search completed",
+		"resource_count", len(result.Resources),
+		"organization_count", len(result.Organizations),
+		"organizations_unavailable", result.OrganizationsUnavailable,
+	)
+
+	return result, nil
+}
+
+// Suggest runs the resource search and the organization suggestions of the
+// requested kinds concurrently, and merges them by relevance. As in the
+// unified search, a failed resource search fails the suggestions while failed
+// organization suggestions are only left out and flagged.
+func (s *UnifiedSearch) Suggest(ctx context.Context, query string, kinds []string) (*model.SuggestionsResult, error) {
+
+	slog.DebugContext(ctx, "starting combined suggestions", "query", query, "kinds", kinds)
+
+	if len(kinds) == 0 {
+		kinds = model.SuggestionKinds
+	}
+	for _, kind := range kinds {
+		if !slices.Contains(model.SuggestionKinds, kind) {
+			return nil, errors.NewValidation(fmt.Sprintf("unsupported suggestion kind %q, use one of: %s", kind, strings.Join(model.SuggestionKinds, ", ")))
+		}
+	}
+
+	var (
+		resources     *model.SearchResult
+		organizations *model.OrganizationSuggestionsResult
+		orgsFailed    bool
+	)
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	if slices.Contains(kinds, model.SuggestionKindResource) {
+		group.Go(func() error {
+			// Access control is applied by the resource service: anonymous
+			// callers only get public resources
+			result, err := s.resourceService.SuggestResources(groupCtx, query)
+			if err != nil {
+				return err
+			}
+			resources = result
+			return nil
+		})
+	}
+	if slices.Contains(kinds, model.SuggestionKindOrganization) {
+		group.Go(func() error {
+			result, err := s.organizationService.SuggestOrganizations(groupCtx, model.OrganizationSuggestionCriteria{
+				Query: query,
+			})
+			if err != nil {
+				slog.WarnContext(ctx, "organization suggestions failed", "error", err)
+				orgsFailed = true
+				return nil
+			}
+			organizations = result
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		slog.ErrorContext(ctx, "resource suggestions failed", "error", err)
+		return nil, err
+	}
+
+	var resourceSuggestions, organizationSuggestions []model.Suggestion
+	if resources != nil {
+		resourceSuggestions = resourcesToSuggestions(capSection(resources.Resources, s.suggestKindLimit))
+	}
+	if organizations != nil {
+		organizationSuggestions = organizationsToSuggestions(capSection(organizations.Suggestions, s.suggestKindLimit))
+	}
+
+	result := &model.SuggestionsResult{
+		Suggestions:              capSection(mergeSuggestions(resourceSuggestions, organizationSuggestions), s.suggestLimit),
+		OrganizationsUnavailable: orgsFailed,
+	}
+
+	slog.DebugContext(ctx, "combined suggestions completed",
+		"suggestion_count", len(result.Suggestions),
+		"organizations_unavailable", result.OrganizationsUnavailable,
+	)
+
+	return result, nil
+}
+
+// resourcesToSuggestions converts the resources to suggestions, relevant as
+// their normalized score or, for unscored resources, their rank
+func resourcesToSuggestions(resources []model.Resource) []model.Suggestion {
+	suggestions := make([]model.Suggestion, len(resources))
+	for i := range resources {
+		resource := resources[i]
+		relevance := rankRelevance(i, len(resources))
+		if resource.NormalizedScore != nil {
+			relevance = *resource.NormalizedScore
+		}
+		var name string
+		if data, ok := resource.Data.(map[string]any); ok {
+			name, _ = data["name"].(string)
+		}
+		suggestions[i] = model.Suggestion{
+			Kind:      model.SuggestionKindResource,
+			Name:      name,
+			Relevance: relevance,
+			Resource:  &resource,
+		}
+	}
+	return suggestions
+}
+
+// organizationsToSuggestions converts the organization suggestions, which are
+// not scored, to suggestions relevant as their rank
+func organizationsToSuggestions(organizations []model.OrganizationSuggestion) []model.Suggestion {
+	suggestions := make([]model.Suggestion, len(organizations))
+	for i := range organizations {
+		organization := organizations[i]
+		suggestions[i] = model.Suggestion{
+			Kind:         model.SuggestionKindOrganization,
+			Name:         organization.Name,
+			Relevance:    rankRelevance(i, len(organizations)),
+			Organization: &organization,
+		}
+	}
+	return suggestions
+}
+
+// rankRelevance is the relevance of the i-th of n ranked items: 1 for the
+// first, decreasing evenly
+func rankRelevance(i, n int) float64 {
+	return 1 - float64(i)/float64(n)
+}
+
+// mergeSuggestions interleaves the suggestions of each kind, then orders them
+// by relevance; equally relevant suggestions keep their interleaved order
+func mergeSuggestions(kinds ...[]model.Suggestion) []model.Suggestion {
+	merged := []model.Suggestion{}
+	for i := 0; ; i++ {
+		added := false
+		for _, suggestions := range kinds {
+			if i < len(suggestions) {
+				merged = append(merged, suggestions[i])
+				added = true
+			}
+		}
+		if !added {
+			break
+		}
+	}
+	slices.SortStableFunc(merged, func(a, b model.Suggestion) int {
+		return cmp.Compare(b.Relevance, a.Relevance)
+	})
+	return merged
+}
+
+// capSection limits a section to size items, never returning nil
+func capSection[T any](items []T, size int) []T {
+	if items == nil {
+		return []T{}
+	}
+	if len(items) > size {
+		return items[:size]
+	}
+	return items
+}
+
+// NewUnifiedSearch creates a new UnifiedSearch instance
+func NewUnifiedSearch(resourceService ResourceSearcher, organizationService OrganizationSearcher, opts ...UnifiedSearchOption) UnifiedSearcher {
+	s := &UnifiedSearch{
+		resourceService:     resourceService,
+		organizationService: organizationService,
+		sectionSize:         constants.DefaultUnifiedSearchSectionSize,
+		suggestLimit:        constants.DefaultSuggestLimit,
+		suggestKindLimit:    constants.DefaultSuggestKindLimit,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
@@ -0,0 +1,273 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/linuxfoundation/lfx-v2-query-service/internal/domain/model"
+	"github.com/linuxfoundation/lfx-v2-query-service/internal/infrastructure/mock"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-query-service/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnifiedSearchUnifiedSearch(t *testing.T) {
+	addAcmeResources := func(searcher *mock.MockResourceSearcher) {
+		for i := range 12 {
+			id := fmt.Sprintf("acme-%d", i)
+			searcher.AddResource(model.Resource{
+				Type: "project",
+				ID:   id,
+				Data: map[string]any{"name": fmt.Sprintf("Acme Project %d", i)},
+				TransactionBodyStub: model.TransactionBodyStub{
+					ObjectRef:           "project:" + id,
+					ObjectType:          "project",
+					ObjectID:            id,
+					Public:              true,
+					AccessCheckObject:   "project:" + id,
+					AccessCheckRelation: "viewer",
+				},
+			})
+		}
+		searcher.AddResource(model.Resource{
+			Type: "project",
+			ID:   "acme-private",
+			Data: map[string]any{"name": "Acme Private Project"},
+			TransactionBodyStub: model.TransactionBodyStub{
+				ObjectRef:           "project:acme-private",
+				ObjectType:          "project",
+				ObjectID:            "acme-private",
+				AccessCheckObject:   "project:acme-private",
+				AccessCheckRelation: "viewer",
+			},
+		})
+	}
+	addAcmeOrganizations := func(searcher *mock.MockOrganizationSearcher) {
+		for i := range 4 {
+			searcher.AddOrganization(model.Organization{
+				Name:   fmt.Sprintf("Acme Corp %d", i),
+				Domain: fmt.Sprintf("acme%d.example", i),
+			})
+		}
+	}
+
+	tests := []struct {
+		name                    string
+		opts                    []UnifiedSearchOption
+		setupMocks              func(*mock.MockResourceSearcher, *mock.MockOrganizationSearcher)
+		expectedError           bool
+		expectedResources       int
+		expectedOrganizations   int
+		expectedOrgsUnavailable bool
+	}{
+		{
+			name: "both sections populated and capped",
+			setupMocks: func(resources *mock.MockResourceSearcher, orgs *mock.MockOrganizationSearcher) {
+				addAcmeResources(resources)
+				addAcmeOrganizations(orgs)
+			},
+			expectedResources:     constants.DefaultUnifiedSearchSectionSize,
+			expectedOrganizations: 4,
+		},
+		{
+			name: "custom section size",
+			opts: []UnifiedSearchOption{WithUnifiedSearchSectionSize(3)},
+			setupMocks: func(resources *mock.MockResourceSearcher, orgs *mock.MockOrganizationSearcher) {
+				addAcmeResources(resources)
+				addAcmeOrganizations(orgs)
+			},
+			expectedResources:     3,
+			expectedOrganizations: 3,
+		},
+		{
+			name: "organization failure leaves resources",
+			setupMocks: func(resources *mock.MockResourceSearcher, orgs *mock.MockOrganizationSearcher) {
+				addAcmeResources(resources)
+				orgs.SetSuggestOrganizationsError(errors.NewServiceUnavailable("clearbit unavailable"))
+			},
+			expectedResources:       constants.DefaultUnifiedSearchSectionSize,
+			expectedOrganizations:   0,
+			expectedOrgsUnavailable: true,
+		},
+		{
+			name: "resource failure fails the search",
+			setupMocks: func(resources *mock.MockResourceSearcher, orgs *mock.MockOrganizationSearcher) {
+				addAcmeOrganizations(orgs)
+				resources.SetQueryResourcesError(errors.NewServiceUnavailable("opensearch unavailable"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			resourceSearcher := mock.NewMockResourceSearcher()
+			organizationSearcher := mock.NewMockOrganizationSearcher()
+			tc.setupMocks(resourceSearcher, organizationSearcher)
+
+			unified := NewUnifiedSearch(
+				NewResourceSearch(resourceSearcher, mock.NewMockAccessControlCheckerDenyAll()),
+				NewOrganizationSearch(organizationSearcher),
+				tc.opts...,
+			)
+
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "user123")
+			result, err := unified.UnifiedSearch(ctx, "acme")
+
+			if tc.expectedError {
+				assertion.Error(err)
+				assertion.Nil(result)
+				return
+			}
+
+			assertion.NoError(err)
+			if assertion.NotNil(result) {
+				assertion.Len(result.Resources, tc.expectedResources)
+				assertion.Len(result.Organizations, tc.expectedOrganizations)
+				assertion.NotNil(result.Organizations)
+				assertion.Equal(tc.expectedOrgsUnavailable, result.OrganizationsUnavailable)
+				for _, resource := range result.Resources {
+					assertion.NotEqual("acme-private", resource.ID)
+				}
+			}
+		})
+	}
+}
+
+func TestUnifiedSearchSuggest(t *testing.T) {
+	setupMocks := func(resources *mock.MockResourceSearcher, orgs *mock.MockOrganizationSearcher) {
+		for i := range 3 {
+			id := fmt.Sprintf("acme-%d", i)
+			resources.AddResource(mock.NewResourceWithDefaults("project", id, map[string]any{"name": fmt.Sprintf("Acme Project %d", i)}, true))
+		}
+		resources.AddResource(mock.NewResourceWithDefaults("project", "acme-private", map[string]any{"name": "Acme Private Project"}, false))
+		for i := range 2 {
+			orgs.AddOrganization(model.Organization{
+				Name:   fmt.Sprintf("Acme Corp %d", i),
+				Domain: fmt.Sprintf("acme%d.example", i),
+			})
+		}
+	}
+
+	tests := []struct {
+		name                    string
+		principal               string
+		kinds                   []string
+		opts                    []UnifiedSearchOption
+		resourceError           error
+		orgError                error
+		expectedError           bool
+		expectedNames           []string
+		expectedOrgsUnavailable bool
+	}{
+		{
+			name:      "mixed kinds interleaved by relevance",
+			principal: constants.AnonymousPrincipal,
+			expectedNames: []string{
+				"Acme Project 0", "Acme Corp 0", "Acme Project 1", "Acme Corp 1", "Acme Project 2",
+			},
+		},
+		{
+			name:          "organizations only",
+			principal:     "user123",
+			kinds:         []string{model.SuggestionKindOrganization},
+			resourceError: errors.NewServiceUnavailable("opensearch unavailable"),
+			expectedNames: []string{"Acme Corp 0", "Acme Corp 1"},
+		},
+		{
+			name:          "resources only",
+			principal:     "user123",
+			kinds:         []string{model.SuggestionKindResource},
+			expectedNames: []string{"Acme Project 0", "Acme Project 1", "Acme Project 2"},
+		},
+		{
+			name:          "total and per-kind limits",
+			principal:     "user123",
+			opts:          []UnifiedSearchOption{WithSuggestLimits(3, 2)},
+			expectedNames: []string{"Acme Project 0", "Acme Corp 0", "Acme Project 1"},
+		},
+		{
+			name:          "per-kind limit",
+			principal:     "user123",
+			kinds:         []string{model.SuggestionKindResource},
+			opts:          []UnifiedSearchOption{WithSuggestLimits(10, 2)},
+			expectedNames: []string{"Acme Project 0", "Acme Project 1"},
+		},
+		{
+			name:                    "organization failure leaves resources",
+			principal:               "user123",
+			orgError:                errors.NewServiceUnavailable("clearbit unavailable"),
+			expectedNames:           []string{"Acme Project 0", "Acme Project 1", "Acme Project 2"},
+			expectedOrgsUnavailable: true,
+		},
+		{
+			name:          "resource failure fails the suggestions",
+			principal:     "user123",
+			resourceError: errors.NewServiceUnavailable("opensearch unavailable"),
+			expectedError: true,
+		},
+		{
+			name:          "unsupported kind",
+			principal:     "user123",
+			kinds:         []string{"meeting"},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := assert.New(t)
+
+			resourceSearcher := mock.NewMockResourceSearcher()
+			resourceSearcher.ClearResources()
+			organizationSearcher := mock.NewMockOrganizationSearcher()
+			setupMocks(resourceSearcher, organizationSearcher)
+			resourceSearcher.SetQueryResourcesError(tc.resourceError)
+			organizationSearcher.SetSuggestOrganizationsError(tc.orgError)
+
+			unified := NewUnifiedSearch(
+				NewResourceSearch(resourceSearcher, mock.NewMockAccessControlCheckerDenyAll()),
+				NewOrganizationSearch(organizationSearcher),
+				tc.opts...,
+			)
+
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, tc.principal)
+			result, err := unified.Suggest(ctx, "acme", tc.kinds)
+
+			if tc.expectedError {
+				assertion.Error(err)
+				assertion.Nil(result)
+				return
+			}
+
+			assertion.NoError(err)
+			if !assertion.NotNil(result) {
+				return
+			}
+			names := make([]string, len(result.Suggestions))
+			for i, suggestion := range result.Suggestions {
+				names[i] = suggestion.Name
+				switch suggestion.Kind {
+				case model.SuggestionKindResource:
+					if assertion.NotNil(suggestion.Resource) {
+						assertion.NotEqual("acme-private", suggestion.Resource.ID)
+					}
+					assertion.Nil(suggestion.Organization)
+				case model.SuggestionKindOrganization:
+					assertion.NotNil(suggestion.Organization)
+					assertion.Nil(suggestion.Resource)
+				default:
+					assertion.Failf("unexpected suggestion kind", "kind %q", suggestion.Kind)
+				}
+			}
+			assertion.Equal(tc.expectedNames, names)
+			assertion.Equal(tc.expectedOrgsUnavailable, result.OrganizationsUnavailable)
+		})
+	}
+}
@@ -3,13 +3,29 @@
 
 package constants
 
+import "time"
+
 const (
 	// AccessCheckSubject is the subject used for access control checks
 	AccessCheckSubject = "lfx.access_check.request"
 	// AnonymousPrincipal is the identifier for anonymous users
 	AnonymousPrincipal = `_anonymous`
+	// PrincipalUserPrefix is the type prefix of user principals in access check tuples
+	PrincipalUserPrefix = "user:"
 	// PrincipalAttribute is the attribute used to indicate the principal in the logging context
 	PrincipalAttribute = "principal"
 	// NonceSize is the size of the number used for nonce generation
 	NonceSize = 24
+	// DefaultAccessCheckAllowedValue is the access check response value that grants access
+	DefaultAccessCheckAllowedValue = "true"
+	// DefaultPermissionRelation is the relation reported in the permissions of
+	// each resource, when requested and no other relations are configured
+	DefaultPermissionRelation = "edit"
+	// AccessCheckTimeout bounds the access control request of a search
+	AccessCheckTimeout = 15 * time.Second
+	// SuggestAccessCheckTimeout bounds the access control request of resource
+	// suggestions, which are latency-sensitive
+	SuggestAccessCheckTimeout = 2 * time.Second
+	// DefaultReadinessTimeout bounds the readiness check of each dependency
+	DefaultReadinessTimeout = 2 * time.Second
 )
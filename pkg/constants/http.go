@@ -12,6 +12,16 @@ const (
 	RequestIDHeader requestIDHeaderType = "X-REQUEST-ID"
 	// PrincipalContextID
 	PrincipalContextID contextID = iota
+	// RequestURLContextID is the context key of the URL of the incoming request
+	RequestURLContextID
 	// AnonymousCacheControlHeader is the cache control header for anonymous users
 	AnonymousCacheControlHeader = "public, max-age=300"
+	// CacheStatusNotModified marks a result whose If-None-Match ETag still matches
+	CacheStatusNotModified = "not-modified"
+	// ContentTypeCSV is the media type of CSV resource search results
+	ContentTypeCSV = "text/csv"
+	// ErrorVerbositySafe hides the message of internal errors from clients
+	ErrorVerbositySafe = "safe"
+	// ErrorVerbosityVerbose reports the message of internal errors to clients
+	ErrorVerbosityVerbose = "verbose"
 )
@@ -9,4 +9,41 @@ const (
 	DefaultPageSize = 50
 	// DefaultBucketSize is the default size of the bucket for queries
 	DefaultBucketSize = 100
+	// DefaultMinQueryLength is the default minimum length of name searches
+	DefaultMinQueryLength = 1
+	// DefaultSuggestMinQueryLength is the default minimum query length for suggestions
+	DefaultSuggestMinQueryLength = 1
+	// DefaultPrincipalCacheMaxEntries is the default maximum number of entries in the per-principal result cache
+	DefaultPrincipalCacheMaxEntries = 1000
+	// MaxCountBatchSize is the maximum number of counts in a single batch
+	MaxCountBatchSize = 20
+	// MaxResolveDomains is the maximum number of domains resolved to organizations at once
+	MaxResolveDomains = 100
+	// NameSuggestionLimit is the maximum number of alternative names suggested
+	// for a name search that found nothing
+	NameSuggestionLimit = 5
+	// DefaultMaxSortValues is the default maximum number of sort values a page
+	// token may carry
+	DefaultMaxSortValues = 4
+	// DefaultMaxOrTerms is the default maximum number of alternatives of an
+	// OR group of a resource search (tags, parents)
+	DefaultMaxOrTerms = 50
+	// DefaultRecencyBoostScale is the scale over which the recency boost of a
+	// resource halves, when asked for but not configured
+	DefaultRecencyBoostScale = "30d"
+	// MaxCheckTuples is the maximum number of access check tuples checked at once
+	MaxCheckTuples = 100
+	// DefaultUnifiedSearchSectionSize is the maximum number of results in each section of a unified search
+	DefaultUnifiedSearchSectionSize = 10
+	// DefaultSuggestLimit is the maximum number of suggestions of a combined suggestion
+	DefaultSuggestLimit = 10
+	// DefaultSuggestKindLimit is the maximum number of suggestions of each kind in a combined suggestion
+	DefaultSuggestKindLimit = 6
+	// SuggestCandidateLimit is the maximum number of resources access checked
+	// for resource suggestions
+	SuggestCandidateLimit = 20
+	// DefaultChildTypesSize is the maximum number of child types counted per parent
+	DefaultChildTypesSize = 20
+	// DefaultFacetSize is the maximum number of values returned per facet field
+	DefaultFacetSize = 50
 )
@@ -0,0 +1,8 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package global
+
+// Version is the build version of the service, set at build time with
+// -ldflags "-X github.com/linuxfoundation/lfx-v2-query-service/pkg/global.Version=<version>"
+var Version = "dev"
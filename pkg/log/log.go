@@ -8,6 +8,7 @@ import (
 	"log"
 	"log/slog"
 	"os"
+	"strings"
 )
 
 type ctxKey string
@@ -89,6 +90,26 @@ func InitStructureLogConfig() {
 			)
 			logOptions.AddSource = addSourceBool
 		},
+		"options-redact": func() {
+			redactFields := os.Getenv("LOG_REDACT_FIELDS")
+			if redactFields == "" {
+				return
+			}
+
+			redactMode := os.Getenv("LOG_REDACT_MODE")
+			if redactMode == "" {
+				redactMode = RedactHash
+			}
+			slog.Info("log config",
+				"LOG_REDACT_FIELDS", redactFields,
+				"LOG_REDACT_MODE", redactMode,
+			)
+			redactor, err := Redactor(strings.Split(redactFields, ","), redactMode)
+			if err != nil {
+				log.Fatalf("invalid log redaction: %v", err)
+			}
+			logOptions.ReplaceAttr = redactor
+		},
 	}
 
 	for name, f := range configurations {
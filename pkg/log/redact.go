@@ -0,0 +1,85 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package log
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"strings"
+)
+
+// Redaction modes of the redacted log attributes
+const (
+	// RedactHash replaces values with a short hash, so that log lines of the
+	// same principal or query can still be correlated
+	RedactHash = "hash"
+	// RedactMask replaces values with a fixed mask
+	RedactMask = "mask"
+)
+
+// redactedMask replaces the values of masked attributes
+const redactedMask = "[REDACTED]"
+
+// hashPrefix tells hashed values apart from logged ones
+const hashPrefix = "sha256:"
+
+// Redactor returns a slog ReplaceAttr function redacting the attributes with
+// the given keys, at any group depth, according to the mode (RedactHash or
+// RedactMask). With no keys, attributes are logged as they are.
+func Redactor(keys []string, mode string) (func(groups []string, a slog.Attr) slog.Attr, error) {
+	if mode != RedactHash && mode != RedactMask {
+		return nil, fmt.Errorf("invalid redaction mode %q: must be %q or %q", mode, RedactHash, RedactMask)
+	}
+
+	redacted := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		if key = strings.TrimSpace(key); key != "" {
+			redacted[key] = struct{}{}
+		}
+	}
+
+	return func(_ []string, a slog.Attr) slog.Attr {
+		if _, ok := redacted[a.Key]; !ok {
+			return a
+		}
+		value, ok := loggedValue(a.Value)
+		if !ok {
+			return a
+		}
+		if mode == RedactMask {
+			return slog.String(a.Key, redactedMask)
+		}
+		hash := sha256.Sum256([]byte(value))
+		return slog.String(a.Key, hashPrefix+hex.EncodeToString(hash[:])[:16])
+	}, nil
+}
+
+// loggedValue returns the content of a value as it would be logged, and false
+// for empty values, which reveal nothing
+func loggedValue(value slog.Value) (string, bool) {
+	value = value.Resolve()
+	switch value.Kind() {
+	case slog.KindString:
+		return value.String(), value.String() != ""
+	case slog.KindAny:
+		anyValue := value.Any()
+		if anyValue == nil {
+			return "", false
+		}
+		if reflected := reflect.ValueOf(anyValue); reflected.Kind() == reflect.Pointer && reflected.IsNil() {
+			return "", false
+		}
+		encoded, err := json.Marshal(anyValue)
+		if err != nil {
+			return fmt.Sprint(anyValue), true
+		}
+		return string(encoded), true
+	default:
+		return value.String(), true
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package log
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// logRecord logs a message through a redacting handler, with the principal
+// stored in the context, and returns the logged attributes
+func logRecord(t *testing.T, keys []string, mode string, args ...any) map[string]any {
+	t.Helper()
+
+	redactor, err := Redactor(keys, mode)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	logger := slog.New(contextHandler{slog.NewJSONHandler(&buf, &slog.HandlerOptions{ReplaceAttr: redactor})})
+	ctx := AppendCtx(context.Background(), slog.String("principal", "user-123"))
+	logger.InfoContext(ctx, "searching", args...)
+
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	return record
+}
+
+func TestRedactor(t *testing.T) {
+	name := "secret project"
+	var noName *string
+
+	tests := []struct {
+		name   string
+		keys   []string
+		mode   string
+		args   []any
+		assert func(t *testing.T, record map[string]any)
+	}{
+		{
+			name: "no keys logs everything",
+			mode: RedactHash,
+			args: []any{"name", &name},
+			assert: func(t *testing.T, record map[string]any) {
+				assert.Equal(t, "user-123", record["principal"])
+				assert.Equal(t, name, record["name"])
+			},
+		},
+		{
+			name: "mask hides the principal from the context",
+			keys: []string{"principal"},
+			mode: RedactMask,
+			args: []any{"name", &name},
+			assert: func(t *testing.T, record map[string]any) {
+				assert.Equal(t, redactedMask, record["principal"])
+				assert.Equal(t, name, record["name"])
+			},
+		},
+		{
+			name: "hash is stable and hides the value",
+			keys: []string{" principal ", "name"},
+			mode: RedactHash,
+			args: []any{"name", &name, "type", "project"},
+			assert: func(t *testing.T, record map[string]any) {
+				principal, ok := record["principal"].(string)
+				require.True(t, ok)
+				assert.True(t, strings.HasPrefix(principal, hashPrefix))
+				assert.NotContains(t, principal, "user-123")
+				assert.Equal(t, principal, logRecord(t, []string{"principal"}, RedactHash)["principal"])
+
+				hashedName, ok := record["name"].(string)
+				require.True(t, ok)
+				assert.True(t, strings.HasPrefix(hashedName, hashPrefix))
+				assert.Equal(t, "project", record["type"])
+			},
+		},
+		{
+			name: "empty values are left as they are",
+			keys: []string{"name"},
+			mode: RedactMask,
+			args: []any{"name", noName},
+			assert: func(t *testing.T, record map[string]any) {
+				assert.Nil(t, record["name"])
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.assert(t, logRecord(t, tc.keys, tc.mode, tc.args...))
+		})
+	}
+}
+
+func TestRedactorInvalidMode(t *testing.T) {
+	_, err := Redactor([]string{"principal"}, "drop")
+	assert.Error(t, err)
+}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"strings"
 
 	"github.com/linuxfoundation/lfx-v2-query-service/pkg/constants"
 	"github.com/linuxfoundation/lfx-v2-query-service/pkg/errors"
@@ -58,6 +59,39 @@ func DecodePageToken(ctx context.Context, encoded string, secretKey *[32]byte) (
 	return string(searchAfterData), nil
 }
 
+// ValidateSearchAfter checks the searchAfter string decoded from a page token:
+// either a single scalar, such as an offset, or an array of at most maxValues
+// scalar sort values. Anything else is rejected, so that a crafted token cannot
+// bloat the query it is copied into.
+func ValidateSearchAfter(searchAfter string, maxValues int) error {
+	var value any
+	decoder := json.NewDecoder(strings.NewReader(searchAfter))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return errors.NewValidation("invalid page token", err)
+	}
+
+	values, isArray := value.([]any)
+	if !isArray {
+		values = []any{value}
+	}
+	if len(values) > maxValues {
+		return errors.NewValidation("invalid page token",
+			fmt.Errorf("expected at most %d sort values, got %d", maxValues, len(values)),
+		)
+	}
+	for _, sortValue := range values {
+		switch sortValue.(type) {
+		case nil, string, json.Number, bool:
+		default:
+			return errors.NewValidation("invalid page token",
+				fmt.Errorf("sort values must be scalars, got %T", sortValue),
+			)
+		}
+	}
+	return nil
+}
+
 // EncodePageToken takes a JSON-serializable value (e.g., []interface{}, map[string]interface{}, etc),
 // encrypts with secretbox, and returns a secure base64 token.
 func EncodePageToken(searchAfter any, secretKey *[32]byte) (string, error) {
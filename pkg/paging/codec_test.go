@@ -263,6 +263,36 @@ func TestDecodePageToken(t *testing.T) {
 	}
 }
 
+func TestValidateSearchAfter(t *testing.T) {
+	tests := []struct {
+		name          string
+		searchAfter   string
+		expectedError bool
+	}{
+		{name: "scalar sort values", searchAfter: `["board", 1700000000, 1.5, true, null]`},
+		{name: "offset", searchAfter: `50`},
+		{name: "string", searchAfter: `"committee:123"`},
+		{name: "empty array", searchAfter: `[]`},
+		{name: "too many sort values", searchAfter: `["a", "b", "c", "d", "e", "f"]`, expectedError: true},
+		{name: "object sort value", searchAfter: `["board", {"match_all": {}}]`, expectedError: true},
+		{name: "nested array", searchAfter: `[["a", "b"]]`, expectedError: true},
+		{name: "object", searchAfter: `{"id": "123"}`, expectedError: true},
+		{name: "invalid JSON", searchAfter: `[`, expectedError: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateSearchAfter(tc.searchAfter, 5)
+			if tc.expectedError {
+				assert.Error(t, err)
+				assert.IsType(t, errors.Validation{}, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
 func TestEncodeDecodeRoundTrip(t *testing.T) {
 	// Create a test secret key (32 bytes)
 	secretKey := [32]byte{}
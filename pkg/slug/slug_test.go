@@ -0,0 +1,39 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package slug
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMake(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "accents and punctuation", input: "Café & Bar!!", expected: "cafe-bar"},
+		{name: "spaces", input: "Linux Foundation", expected: "linux-foundation"},
+		{name: "repeated and edge separators", input: "  --Open__Source--  ", expected: "open-source"},
+		{name: "digits are kept", input: "Project 2024", expected: "project-2024"},
+		{name: "well-formed slug is unchanged", input: "cafe-bar", expected: "cafe-bar"},
+		{name: "non-latin characters are dropped", input: "Ωmega 項目", expected: "mega"},
+		{name: "empty", input: "", expected: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Make(tc.input))
+		})
+	}
+}
+
+func TestMakeIdempotent(t *testing.T) {
+	for _, input := range []string{"Café & Bar!!", "Ünïcödé Ñame", "already-a-slug"} {
+		once := Make(input)
+		assert.Equal(t, once, Make(once), "slug of %q is not stable", input)
+	}
+}